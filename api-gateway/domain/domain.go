@@ -2,6 +2,7 @@ package domain
 
 import (
 	proto "api-gateway/proto/auth"
+	inventoryproto "api-gateway/proto/inventory"
 )
 
 type RegisterUserRequest struct {
@@ -26,70 +27,245 @@ type LoginUserRequest struct {
 	Password string `json:"password"`
 }
 
+// Money is an exact amount in the currency's minor units, so 1250 EUR is 12.50 euros. A
+// request may leave the currency out to use the inventory's default currency.
+type Money struct {
+	MinorUnits int64  `json:"minor_units"`
+	Currency   string `json:"currency,omitempty"`
+}
+
 type LoginUserResponse struct {
 	Message string `json:"message"`
 	Token   string `json:"token"`
 }
 
 type AddItemRequest struct {
-	Name        string  `json:"name"`
-	Description string  `json:"description"`
-	Price       float32 `json:"price"`
-	Quantity    uint32  `json:"quantity"`
+	Name            string     `json:"name"`
+	Description     string     `json:"description"`
+	Price           Money      `json:"price"`
+	Quantity        uint32     `json:"quantity"`
+	ReorderLevel    uint32     `json:"reorder_level"`
+	ReorderQuantity uint32     `json:"reorder_quantity"`
+	SKU             string     `json:"sku,omitempty"`
+	Category        string     `json:"category,omitempty"`
+	Allergens       []string   `json:"allergens,omitempty"`
+	DietaryLabels   []string   `json:"dietary_labels,omitempty"`
+	Nutrition       *Nutrition `json:"nutrition,omitempty"`
+	Unit            string     `json:"unit,omitempty"`
+	SaleUnit        string     `json:"sale_unit,omitempty"`
+	Amount          string     `json:"amount,omitempty"`
+	CostPrice       *Money     `json:"cost_price,omitempty"`
 }
 
 type AddItemResponse struct {
-	ID          int32   `json:"id"`
-	Name        string  `json:"name"`
-	Description string  `json:"description"`
-	Price       float32 `json:"price"`
-	Quantity    uint32  `json:"quantity"`
+	ID              int32      `json:"id"`
+	Name            string     `json:"name"`
+	Description     string     `json:"description"`
+	Price           Money      `json:"price"`
+	Quantity        uint32     `json:"quantity"`
+	ReorderLevel    uint32     `json:"reorder_level"`
+	ReorderQuantity uint32     `json:"reorder_quantity"`
+	SKU             string     `json:"sku,omitempty"`
+	Category        string     `json:"category,omitempty"`
+	Allergens       []string   `json:"allergens,omitempty"`
+	DietaryLabels   []string   `json:"dietary_labels,omitempty"`
+	Nutrition       *Nutrition `json:"nutrition,omitempty"`
+	Unit            string     `json:"unit,omitempty"`
+	SaleUnit        string     `json:"sale_unit,omitempty"`
+	CostPrice       *Money     `json:"cost_price,omitempty"`
 }
 
+// Nutrition holds an item's nutrition facts. Each one is optional, energy in kcal and the
+// macronutrients in grams.
+type Nutrition struct {
+	Kcal          *float32 `json:"kcal,omitempty"`
+	Protein       *float32 `json:"protein,omitempty"`
+	Carbohydrates *float32 `json:"carbohydrates,omitempty"`
+	Fat           *float32 `json:"fat,omitempty"`
+}
+
+// GetItemRequest asks for an item's details, priced at the given time when At is set so
+// past orders can be explained.
 type GetItemRequest struct {
-	ID int32 `json:"id"`
+	ID int32  `json:"id"`
+	At string `json:"at,omitempty"`
 }
 
+// GetItemResponse gives quantities in base units (pieces, grams or millilitres), with the
+// stock also as a decimal Amount of Unit and what is available in whole sale units.
 type GetItemResponse struct {
-	ID          int32   `json:"id"`
-	Name        string  `json:"name"`
-	Description string  `json:"description"`
-	Price       float32 `json:"price"`
-	Quantity    uint32  `json:"quantity"`
+	ID                int32         `json:"id"`
+	Name              string        `json:"name"`
+	Description       string        `json:"description"`
+	Price             Money         `json:"price"`
+	BasePrice         *Money        `json:"base_price,omitempty"`
+	CostPrice         *Money        `json:"cost_price,omitempty"`
+	Quantity          uint32        `json:"quantity"`
+	Amount            string        `json:"amount,omitempty"`
+	AvailableQuantity uint32        `json:"available_quantity"`
+	AvailableToSell   uint32        `json:"available_to_sell"`
+	ReorderLevel      uint32        `json:"reorder_level"`
+	ReorderQuantity   uint32        `json:"reorder_quantity"`
+	SKU               string        `json:"sku,omitempty"`
+	Category          string        `json:"category,omitempty"`
+	AvailableNow      bool          `json:"available_now"`
+	Unavailable       bool          `json:"unavailable"`
+	Allergens         []string      `json:"allergens,omitempty"`
+	DietaryLabels     []string      `json:"dietary_labels,omitempty"`
+	Nutrition         *Nutrition    `json:"nutrition,omitempty"`
+	Unit              string        `json:"unit,omitempty"`
+	SaleUnit          string        `json:"sale_unit,omitempty"`
+	OptionGroups      []OptionGroup `json:"option_groups,omitempty"`
+	Images            []ItemImage   `json:"images,omitempty"`
+	Barcodes          []string      `json:"barcodes,omitempty"`
+	ArchivedAt        string        `json:"archived_at,omitempty"`
+	RatingAverage     float64       `json:"rating_average"`
+	RatingCount       uint32        `json:"rating_count"`
+	Locale            string        `json:"locale,omitempty"`
+}
+
+// ItemImage is a picture of an item, with the URLs of its thumbnails keyed by size name.
+type ItemImage struct {
+	ID            uint32            `json:"id"`
+	ItemID        int32             `json:"item_id"`
+	URL           string            `json:"url"`
+	ThumbnailURLs map[string]string `json:"thumbnail_urls"`
+	ContentType   string            `json:"content_type"`
+	Width         uint32            `json:"width"`
+	Height        uint32            `json:"height"`
+	Size          uint32            `json:"size"`
+}
+
+var SelectionTypeMap = map[string]inventoryproto.SelectionType{
+	"single": inventoryproto.SelectionType_SELECTION_SINGLE,
+	"multi":  inventoryproto.SelectionType_SELECTION_MULTI,
+}
+
+type Option struct {
+	ID         uint32 `json:"id"`
+	Name       string `json:"name"`
+	PriceDelta Money  `json:"price_delta"`
+	TrackStock bool   `json:"track_stock"`
+	Quantity   uint32 `json:"quantity"`
+}
+
+type OptionGroup struct {
+	ID            uint32   `json:"id"`
+	ItemID        int32    `json:"item_id"`
+	Name          string   `json:"name"`
+	SelectionType string   `json:"selection_type"`
+	MinSelections uint32   `json:"min_selections"`
+	MaxSelections uint32   `json:"max_selections"`
+	Required      bool     `json:"required"`
+	Options       []Option `json:"options"`
+}
+
+type SetOptionStockRequest struct {
+	ID         uint32 `json:"id"`
+	TrackStock bool   `json:"track_stock"`
+	Quantity   uint32 `json:"quantity"`
 }
 
 type GetAllItemsResponse struct {
 	Items []GetItemResponse `json:"items"`
 }
 
+// UpdateItemRequest holds the fields of a partial item update; fields left out of the
+// request body are not changed.
+type UpdateItemRequest struct {
+	Name          *string    `json:"name"`
+	Description   *string    `json:"description"`
+	Price         *Money     `json:"price"`
+	Category      *string    `json:"category"`
+	Allergens     *[]string  `json:"allergens"`
+	DietaryLabels *[]string  `json:"dietary_labels"`
+	Nutrition     *Nutrition `json:"nutrition"`
+	Unit          *string    `json:"unit"`
+	SaleUnit      *string    `json:"sale_unit"`
+	CostPrice     *Money     `json:"cost_price"`
+}
+
+// UpdateQuantityRequest addresses the item by its id or, when the id is left out, by its
+// SKU or one of its barcodes. The quantity, or a decimal amount such as "2.5", is in Unit
+// when given, and otherwise in the item's stock unit when adding and its sale unit when
+// lowering.
 type UpdateQuantityRequest struct {
-	ID       int32  `json:"id"`
-	Quantity uint32 `json:"quantity"`
+	ID         int32  `json:"id"`
+	SKU        string `json:"sku,omitempty"`
+	Barcode    string `json:"barcode,omitempty"`
+	Quantity   uint32 `json:"quantity"`
+	Amount     string `json:"amount,omitempty"`
+	Unit       string `json:"unit,omitempty"`
+	Reason     string `json:"reason,omitempty"`
+	Reference  string `json:"reference,omitempty"`
+	LocationID uint32 `json:"location_id,omitempty"`
+	ExpiresAt  string `json:"expires_at,omitempty"`
+	UnitCost   *Money `json:"unit_cost,omitempty"`
+}
+
+type ItemBarcodeRequest struct {
+	ItemID  int32  `json:"item_id"`
+	Barcode string `json:"barcode"`
 }
 
 type UpdateQuantityResponse struct {
 	ID       int32  `json:"id"`
 	Quantity uint32 `json:"quantity"`
+	Amount   string `json:"amount,omitempty"`
+	LotID    uint32 `json:"lot_id,omitempty"`
+}
+
+type SetReorderLevelRequest struct {
+	ID              int32  `json:"id"`
+	ReorderLevel    uint32 `json:"reorder_level"`
+	ReorderQuantity uint32 `json:"reorder_quantity"`
+}
+
+type SetReorderLevelResponse struct {
+	ID              int32  `json:"id"`
+	ReorderLevel    uint32 `json:"reorder_level"`
+	ReorderQuantity uint32 `json:"reorder_quantity"`
 }
 
 type PlaceOrderRequest struct {
-	ItemID   uint32 `json:"item_id"`
-	Quantity uint32 `json:"quantity"`
+	ItemID    uint32   `json:"item_id"`
+	Quantity  uint32   `json:"quantity"`
+	OptionIDs []uint32 `json:"option_ids,omitempty"`
 }
 
 type PlaceOrderResponse struct {
-	OrderID   uint32  `json:"order_id"`
-	Amount    float32 `json:"amount"`
-	OrderTime string  `json:"order_time"`
+	OrderID   uint32        `json:"order_id"`
+	Amount    Money         `json:"amount"`
+	OrderTime string        `json:"order_time"`
+	Options   []OrderOption `json:"options,omitempty"`
 }
 
 type Order struct {
-	OrderID   uint32  `json:"order_id"`
-	UserID    uint32  `json:"user_id"`
-	ItemID    uint32  `json:"item_id"`
-	Quantity  uint32  `json:"quantity"`
-	Amount    float32 `json:"amount"`
-	OrderTime string  `json:"order_time"`
+	OrderID   uint32        `json:"order_id"`
+	UserID    uint32        `json:"user_id"`
+	ItemID    uint32        `json:"item_id"`
+	Quantity  uint32        `json:"quantity"`
+	Amount    Money         `json:"amount"`
+	OrderTime string        `json:"order_time"`
+	Status    string        `json:"status,omitempty"`
+	Options   []OrderOption `json:"options,omitempty"`
+	Item      *OrderItem    `json:"item,omitempty"`
+}
+
+// OrderItem is the current inventory record of an ordered item, marked as archived when the
+// item has since been deleted. It is left out when the item was purged.
+type OrderItem struct {
+	Name     string `json:"name"`
+	Price    Money  `json:"price"`
+	SKU      string `json:"sku,omitempty"`
+	Archived bool   `json:"archived,omitempty"`
+}
+
+type OrderOption struct {
+	OptionID   uint32 `json:"option_id"`
+	Group      string `json:"group"`
+	Name       string `json:"name"`
+	PriceDelta Money  `json:"price_delta"`
 }
 
 type GetOrderResponse struct {
@@ -100,3 +276,477 @@ type GetAllOrdersResponse struct {
 	Orders []*Order `json:"orders"`
 }
 
+var MovementReasonMap = map[string]inventoryproto.MovementReason{
+	"INITIAL_STOCK": inventoryproto.MovementReason_REASON_INITIAL_STOCK,
+	"RESTOCK":       inventoryproto.MovementReason_REASON_RESTOCK,
+	"ORDER":         inventoryproto.MovementReason_REASON_ORDER,
+	"WASTAGE":       inventoryproto.MovementReason_REASON_WASTAGE,
+	"CORRECTION":    inventoryproto.MovementReason_REASON_CORRECTION,
+	"TRANSFER":      inventoryproto.MovementReason_REASON_TRANSFER,
+}
+
+type StockMovement struct {
+	ID         uint32 `json:"id"`
+	ItemID     int32  `json:"item_id"`
+	LocationID uint32 `json:"location_id"`
+	Delta      int64  `json:"delta"`
+	Balance    uint32 `json:"balance"`
+	Reason     string `json:"reason"`
+	Reference  string `json:"reference"`
+	Actor      string `json:"actor"`
+	PairedWith uint32 `json:"paired_with,omitempty"`
+	LotID      uint32 `json:"lot_id,omitempty"`
+	UnitCost   *Money `json:"unit_cost,omitempty"`
+	CreatedAt  string `json:"created_at"`
+}
+
+type ListStockMovementsResponse struct {
+	Movements []StockMovement `json:"movements"`
+	Total     int64           `json:"total"`
+}
+
+type StockDrift struct {
+	ItemID         int32  `json:"item_id"`
+	Name           string `json:"name"`
+	Quantity       uint32 `json:"quantity"`
+	LedgerQuantity int64  `json:"ledger_quantity"`
+	Drift          int64  `json:"drift"`
+}
+
+type ReconcileStockResponse struct {
+	Items []StockDrift `json:"items"`
+}
+
+var FileFormatMap = map[string]inventoryproto.FileFormat{
+	"csv":  inventoryproto.FileFormat_FORMAT_CSV,
+	"json": inventoryproto.FileFormat_FORMAT_JSON,
+}
+
+var ValuationMethodMap = map[string]inventoryproto.ValuationMethod{
+	"FIFO":             inventoryproto.ValuationMethod_VALUATION_FIFO,
+	"WEIGHTED_AVERAGE": inventoryproto.ValuationMethod_VALUATION_WEIGHTED_AVERAGE,
+}
+
+var ReviewStatusMap = map[string]inventoryproto.ReviewStatus{
+	"PENDING":  inventoryproto.ReviewStatus_REVIEW_PENDING,
+	"APPROVED": inventoryproto.ReviewStatus_REVIEW_APPROVED,
+	"REJECTED": inventoryproto.ReviewStatus_REVIEW_REJECTED,
+}
+
+var ImportModeMap = map[string]inventoryproto.ImportMode{
+	"all_or_nothing": inventoryproto.ImportMode_IMPORT_ALL_OR_NOTHING,
+	"best_effort":    inventoryproto.ImportMode_IMPORT_BEST_EFFORT,
+}
+
+var ImportMatchMap = map[string]inventoryproto.ImportMatch{
+	"name": inventoryproto.ImportMatch_MATCH_NAME,
+	"sku":  inventoryproto.ImportMatch_MATCH_SKU,
+}
+
+type ImportRowResult struct {
+	Row     uint32 `json:"row"`
+	Name    string `json:"name"`
+	SKU     string `json:"sku,omitempty"`
+	Action  string `json:"action,omitempty"`
+	ItemID  int32  `json:"item_id,omitempty"`
+	Error   string `json:"error,omitempty"`
+	Applied bool   `json:"applied"`
+}
+
+type ImportItemsResponse struct {
+	DryRun bool              `json:"dry_run"`
+	Rows   []ImportRowResult `json:"rows"`
+}
+
+type InventoryEvent struct {
+	Sequence  uint64 `json:"sequence"`
+	Type      string `json:"type"`
+	ItemID    int32  `json:"item_id"`
+	Name      string `json:"name"`
+	Quantity  uint32 `json:"quantity"`
+	Price     Money  `json:"price"`
+	CreatedAt string `json:"created_at"`
+}
+
+// DayOfWeekMap names the days an availability window can open on.
+var DayOfWeekMap = map[string]inventoryproto.DayOfWeek{
+	"sun": inventoryproto.DayOfWeek_DAY_SUNDAY,
+	"mon": inventoryproto.DayOfWeek_DAY_MONDAY,
+	"tue": inventoryproto.DayOfWeek_DAY_TUESDAY,
+	"wed": inventoryproto.DayOfWeek_DAY_WEDNESDAY,
+	"thu": inventoryproto.DayOfWeek_DAY_THURSDAY,
+	"fri": inventoryproto.DayOfWeek_DAY_FRIDAY,
+	"sat": inventoryproto.DayOfWeek_DAY_SATURDAY,
+}
+
+type AvailabilityWindow struct {
+	ID        uint32   `json:"id"`
+	ItemID    int32    `json:"item_id,omitempty"`
+	Category  string   `json:"category,omitempty"`
+	Days      []string `json:"days"`
+	StartTime string   `json:"start_time"`
+	EndTime   string   `json:"end_time"`
+	Timezone  string   `json:"timezone"`
+}
+
+type SetItemUnavailableRequest struct {
+	ID          int32 `json:"id"`
+	Unavailable bool  `json:"unavailable"`
+}
+
+type Location struct {
+	ID   uint32 `json:"id"`
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+}
+
+type LocationStock struct {
+	LocationID uint32 `json:"location_id"`
+	Quantity   uint32 `json:"quantity"`
+}
+
+type ItemStockResponse struct {
+	ItemID   int32           `json:"item_id"`
+	Quantity uint32          `json:"quantity"`
+	Levels   []LocationStock `json:"levels"`
+}
+
+type TransferStockRequest struct {
+	ItemID         int32  `json:"item_id"`
+	FromLocationID uint32 `json:"from_location_id"`
+	ToLocationID   uint32 `json:"to_location_id"`
+	Quantity       uint32 `json:"quantity"`
+	Reference      string `json:"reference,omitempty"`
+}
+
+type TransferStockResponse struct {
+	Movements []StockMovement `json:"movements"`
+}
+
+type Lot struct {
+	ID         uint32 `json:"id"`
+	ItemID     int32  `json:"item_id"`
+	LocationID uint32 `json:"location_id"`
+	Received   uint32 `json:"received"`
+	Quantity   uint32 `json:"quantity"`
+	ReceivedAt string `json:"received_at"`
+	ExpiresAt  string `json:"expires_at"`
+	Reference  string `json:"reference,omitempty"`
+	UnitCost   *Money `json:"unit_cost,omitempty"`
+}
+
+type WriteOffExpiredLotsResponse struct {
+	Movements []StockMovement `json:"movements"`
+}
+
+type RecipeLine struct {
+	IngredientID int32  `json:"ingredient_id"`
+	Name         string `json:"name,omitempty"`
+	Quantity     uint32 `json:"quantity"`
+	Unit         string `json:"unit,omitempty"`
+}
+
+type Recipe struct {
+	DishID int32        `json:"dish_id"`
+	Lines  []RecipeLine `json:"lines"`
+}
+
+type RecipeShortage struct {
+	IngredientID       int32  `json:"ingredient_id"`
+	IngredientName     string `json:"ingredient_name"`
+	Unit               string `json:"unit"`
+	AvailableQuantity  uint32 `json:"available_quantity"`
+	ReorderLevel       uint32 `json:"reorder_level"`
+	DishID             int32  `json:"dish_id"`
+	DishName           string `json:"dish_name"`
+	QuantityPerPortion uint32 `json:"quantity_per_portion"`
+	Portions           uint32 `json:"portions"`
+}
+
+type BundleComponent struct {
+	ComponentID int32  `json:"component_id"`
+	Name        string `json:"name,omitempty"`
+	Quantity    uint32 `json:"quantity"`
+	Price       *Money `json:"price,omitempty"`
+}
+
+type Supplier struct {
+	ID           uint32 `json:"id"`
+	Name         string `json:"name"`
+	Contact      string `json:"contact,omitempty"`
+	Email        string `json:"email,omitempty"`
+	Phone        string `json:"phone,omitempty"`
+	LeadTimeDays uint32 `json:"lead_time_days"`
+}
+
+type SupplierItem struct {
+	SupplierID uint32 `json:"supplier_id"`
+	ItemID     int32  `json:"item_id"`
+	CostPrice  Money  `json:"cost_price"`
+}
+
+type PurchaseOrderLine struct {
+	ID        uint32 `json:"id"`
+	ItemID    int32  `json:"item_id"`
+	Quantity  uint32 `json:"quantity"`
+	Received  uint32 `json:"received"`
+	CostPrice Money  `json:"cost_price"`
+}
+
+type PurchaseOrder struct {
+	ID         uint32              `json:"id"`
+	SupplierID uint32              `json:"supplier_id"`
+	Status     string              `json:"status"`
+	Reference  string              `json:"reference,omitempty"`
+	Actor      string              `json:"actor,omitempty"`
+	CreatedAt  string              `json:"created_at"`
+	SentAt     string              `json:"sent_at,omitempty"`
+	ExpectedAt string              `json:"expected_at,omitempty"`
+	ReceivedAt string              `json:"received_at,omitempty"`
+	Lines      []PurchaseOrderLine `json:"lines"`
+}
+
+// CreatePurchaseOrderRequest drafts a purchase order. A line without a cost price is
+// ordered at the supplier's current cost for the item.
+type CreatePurchaseOrderRequest struct {
+	SupplierID uint32              `json:"supplier_id"`
+	Reference  string              `json:"reference,omitempty"`
+	Lines      []PurchaseOrderLine `json:"lines"`
+}
+
+type SendPurchaseOrderRequest struct {
+	ID uint32 `json:"id"`
+}
+
+type ReceivePurchaseOrderLineRequest struct {
+	OrderID    uint32 `json:"order_id"`
+	LineID     uint32 `json:"line_id"`
+	Quantity   uint32 `json:"quantity"`
+	LocationID uint32 `json:"location_id,omitempty"`
+	ExpiresAt  string `json:"expires_at,omitempty"`
+}
+
+type ReceivePurchaseOrderLineResponse struct {
+	Order    PurchaseOrder `json:"order"`
+	ItemID   int32         `json:"item_id"`
+	Quantity uint32        `json:"quantity"`
+}
+
+type StockCount struct {
+	ID         uint32 `json:"id"`
+	ItemID     int32  `json:"item_id"`
+	LocationID uint32 `json:"location_id"`
+	Counted    uint32 `json:"counted"`
+	Expected   uint32 `json:"expected"`
+	CountedBy  string `json:"counted_by,omitempty"`
+	CountedAt  string `json:"counted_at"`
+}
+
+type StockTake struct {
+	ID         uint32       `json:"id"`
+	LocationID uint32       `json:"location_id,omitempty"`
+	Status     string       `json:"status"`
+	Reference  string       `json:"reference,omitempty"`
+	Actor      string       `json:"actor,omitempty"`
+	CreatedAt  string       `json:"created_at"`
+	ClosedAt   string       `json:"closed_at,omitempty"`
+	ClosedBy   string       `json:"closed_by,omitempty"`
+	Counts     []StockCount `json:"counts"`
+}
+
+// StartStockTakeRequest opens a stock-take of one location, or of every location when
+// LocationID is left out.
+type StartStockTakeRequest struct {
+	LocationID uint32 `json:"location_id,omitempty"`
+	Reference  string `json:"reference,omitempty"`
+}
+
+type RecordStockCountRequest struct {
+	StockTakeID uint32 `json:"stock_take_id"`
+	ItemID      int32  `json:"item_id"`
+	LocationID  uint32 `json:"location_id,omitempty"`
+	Counted     uint32 `json:"counted"`
+}
+
+type StockVariance struct {
+	ItemID     int32  `json:"item_id"`
+	Name       string `json:"name"`
+	LocationID uint32 `json:"location_id"`
+	Expected   uint32 `json:"expected"`
+	Counted    uint32 `json:"counted"`
+	Variance   int64  `json:"variance"`
+}
+
+type StockTakeActionRequest struct {
+	ID uint32 `json:"id"`
+}
+
+type ApproveStockTakeResponse struct {
+	StockTake StockTake       `json:"stock_take"`
+	Movements []StockMovement `json:"movements"`
+}
+
+// Bundle is an item sold as a set of other items. Price is optional on the way in and
+// defaults to the sum of the components' prices.
+type Bundle struct {
+	BundleID   int32             `json:"bundle_id"`
+	Price      *Money            `json:"price,omitempty"`
+	Components []BundleComponent `json:"components"`
+}
+
+// ItemPrice is an entry in an item's price history. A price with an end is a promotion,
+// after which the item's regular price applies again.
+type ItemPrice struct {
+	ID        uint32 `json:"id"`
+	ItemID    int32  `json:"item_id"`
+	Price     Money  `json:"price"`
+	StartsAt  string `json:"starts_at"`
+	EndsAt    string `json:"ends_at,omitempty"`
+	Actor     string `json:"actor,omitempty"`
+	CreatedAt string `json:"created_at"`
+}
+
+// SchedulePriceRequest sets an item's price from StartsAt, or from now when it is left out.
+type SchedulePriceRequest struct {
+	ItemID   int32  `json:"item_id"`
+	Price    Money  `json:"price"`
+	StartsAt string `json:"starts_at,omitempty"`
+	EndsAt   string `json:"ends_at,omitempty"`
+}
+
+// StockValue is what an item's stock on hand is worth. UnitCost is the average cost of the
+// stock per unit it is stocked in, and Amount the stock as a decimal amount of that unit.
+type StockValue struct {
+	ItemID   int32  `json:"item_id"`
+	Name     string `json:"name"`
+	Unit     string `json:"unit"`
+	Quantity uint32 `json:"quantity"`
+	Amount   string `json:"amount"`
+	UnitCost *Money `json:"unit_cost,omitempty"`
+	Value    *Money `json:"value,omitempty"`
+}
+
+// StockValuationResponse values the stock on hand, with a total for each currency it was
+// bought in.
+type StockValuationResponse struct {
+	Items  []StockValue `json:"items"`
+	Totals []Money      `json:"totals"`
+}
+
+// MovementSummary adds up an item's stock movements for one reason over a period.
+type MovementSummary struct {
+	ItemID      int32  `json:"item_id"`
+	Name        string `json:"name"`
+	Reason      string `json:"reason"`
+	QuantityIn  uint32 `json:"quantity_in"`
+	QuantityOut uint32 `json:"quantity_out"`
+	ValueIn     *Money `json:"value_in,omitempty"`
+	ValueOut    *Money `json:"value_out,omitempty"`
+	Movements   uint32 `json:"movements"`
+}
+
+type MovementSummaryResponse struct {
+	Summaries []MovementSummary `json:"summaries"`
+}
+
+// SlowMovingItem is an item with stock on hand that has sold little or nothing lately.
+type SlowMovingItem struct {
+	ItemID       int32  `json:"item_id"`
+	Name         string `json:"name"`
+	Unit         string `json:"unit"`
+	Quantity     uint32 `json:"quantity"`
+	Amount       string `json:"amount"`
+	QuantitySold uint32 `json:"quantity_sold"`
+	LastSoldAt   string `json:"last_sold_at,omitempty"`
+	Value        *Money `json:"value,omitempty"`
+}
+
+type SlowMovingItemsResponse struct {
+	Items []SlowMovingItem `json:"items"`
+}
+
+// ReviewPhoto is a picture added to a review, with the URLs of its thumbnails keyed by size name.
+type ReviewPhoto struct {
+	ID            uint32            `json:"id"`
+	URL           string            `json:"url"`
+	ThumbnailURLs map[string]string `json:"thumbnail_urls"`
+	ContentType   string            `json:"content_type"`
+	Width         uint32            `json:"width"`
+	Height        uint32            `json:"height"`
+	Size          uint32            `json:"size"`
+}
+
+type Review struct {
+	ID          uint32        `json:"id"`
+	ItemID      int32         `json:"item_id"`
+	UserID      int32         `json:"user_id"`
+	Rating      uint32        `json:"rating"`
+	Text        string        `json:"text,omitempty"`
+	Status      string        `json:"status"`
+	Photos      []ReviewPhoto `json:"photos,omitempty"`
+	ModeratedBy string        `json:"moderated_by,omitempty"`
+	CreatedAt   string        `json:"created_at"`
+}
+
+type ListReviewsResponse struct {
+	Reviews []Review `json:"reviews"`
+}
+
+type ModerateReviewRequest struct {
+	ID     uint32 `json:"id"`
+	Status string `json:"status"`
+}
+
+// ItemTranslation is an item's name and description in a locale other than the default.
+type ItemTranslation struct {
+	ItemID      int32  `json:"item_id"`
+	Locale      string `json:"locale"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	UpdatedBy   string `json:"updated_by,omitempty"`
+	UpdatedAt   string `json:"updated_at,omitempty"`
+}
+
+type ListItemTranslationsResponse struct {
+	Translations []ItemTranslation `json:"translations"`
+}
+
+// CategoryTranslation is the name of a category in a locale other than the default.
+type CategoryTranslation struct {
+	Category  string `json:"category"`
+	Locale    string `json:"locale"`
+	Name      string `json:"name"`
+	UpdatedBy string `json:"updated_by,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+type ListCategoryTranslationsResponse struct {
+	Translations []CategoryTranslation `json:"translations"`
+}
+
+// LocaleCoverage is how many of the catalog's items and categories are translated into a locale.
+type LocaleCoverage struct {
+	Locale               string `json:"locale"`
+	Items                uint32 `json:"items"`
+	ItemsTranslated      uint32 `json:"items_translated"`
+	Categories           uint32 `json:"categories"`
+	CategoriesTranslated uint32 `json:"categories_translated"`
+}
+
+type MissingItemTranslation struct {
+	Locale string   `json:"locale"`
+	ItemID int32    `json:"item_id"`
+	Name   string   `json:"name"`
+	Fields []string `json:"fields"`
+}
+
+type MissingCategoryTranslation struct {
+	Locale   string `json:"locale"`
+	Category string `json:"category"`
+}
+
+type MissingTranslationsResponse struct {
+	Coverage   []LocaleCoverage             `json:"coverage"`
+	Items      []MissingItemTranslation     `json:"items"`
+	Categories []MissingCategoryTranslation `json:"categories"`
+}
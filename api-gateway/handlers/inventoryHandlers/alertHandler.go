@@ -0,0 +1,105 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+func SetReorderLevel(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		var requestBody domain.SetReorderLevelRequest
+
+		if err := json.NewDecoder(req.Body).Decode(&requestBody); err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("invalid request body: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		grpcRequest := proto.SetReorderLevelRequest{
+			Id:              requestBody.ID,
+			ReorderLevel:    requestBody.ReorderLevel,
+			ReorderQuantity: requestBody.ReorderQuantity,
+		}
+
+		resp, err := inventoryService.SetReorderLevel(req.Context(), &grpcRequest)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		response := domain.SetReorderLevelResponse{
+			ID:              resp.Id,
+			ReorderLevel:    resp.ReorderLevel,
+			ReorderQuantity: resp.ReorderQuantity,
+		}
+
+		res, err := json.Marshal(response)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+func ListLowStockItems(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		resp, err := inventoryService.ListLowStockItems(req.Context(), &proto.ListLowStockItemsRequest{})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		response := domain.GetAllItemsResponse{
+			Items: []domain.GetItemResponse{},
+		}
+		for _, item := range resp.Items {
+			response.Items = append(response.Items, toItemResponse(item))
+		}
+
+		res, err := json.Marshal(response)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
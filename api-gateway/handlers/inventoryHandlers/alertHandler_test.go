@@ -0,0 +1,150 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	moneyproto "api-gateway/proto/money"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_SetReorderLevel() {
+	t := suite.T()
+
+	t.Run("expect to return 200 when reorder level is set", func(t *testing.T) {
+		// Arrange
+		request := domain.SetReorderLevelRequest{
+			ID:              1,
+			ReorderLevel:    5,
+			ReorderQuantity: 20,
+		}
+		expectedRequest := &proto.SetReorderLevelRequest{
+			Id:              1,
+			ReorderLevel:    5,
+			ReorderQuantity: 20,
+		}
+		expectedResponse := &proto.SetReorderLevelResponse{
+			StatusCode:      http.StatusOK,
+			Id:              1,
+			ReorderLevel:    5,
+			ReorderQuantity: 20,
+		}
+		response := domain.SetReorderLevelResponse{
+			ID:              1,
+			ReorderLevel:    5,
+			ReorderQuantity: 20,
+		}
+
+		exp, err := json.Marshal(response)
+		assert.NoError(t, err)
+		body, err := json.Marshal(request)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("POST", "/admin/inventory/item/reorder", strings.NewReader(string(body)))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("SetReorderLevel", context.Background(), expectedRequest).Return(expectedResponse, nil).Once()
+
+		handler := SetReorderLevel(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+
+	t.Run("expect to return 400 when reorder quantity is set without a level", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.SetReorderLevelRequest{
+			Id:              1,
+			ReorderQuantity: 20,
+		}
+		expectedResponse := &proto.SetReorderLevelResponse{
+			StatusCode: http.StatusBadRequest,
+		}
+
+		req := httptest.NewRequest("POST", "/admin/inventory/item/reorder", strings.NewReader(`{"id":1,"reorder_quantity":20}`))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("SetReorderLevel", context.Background(), expectedRequest).Return(expectedResponse, errors.New("reorder quantity requires a reorder level")).Once()
+
+		handler := SetReorderLevel(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_ListLowStockItems() {
+	t := suite.T()
+
+	t.Run("expect to return 200 with the low stock items", func(t *testing.T) {
+		// Arrange
+		expectedResponse := &proto.ListLowStockItemsResponse{
+			StatusCode: http.StatusOK,
+			Items: []*proto.GetItemResponse{
+				{
+					Id:              1,
+					Name:            "test1",
+					Description:     "test1",
+					Price:           &moneyproto.Money{MinorUnits: 10000, Currency: "EUR"},
+					Quantity:        2,
+					ReorderLevel:    5,
+					ReorderQuantity: 20,
+				},
+			},
+		}
+		response := domain.GetAllItemsResponse{
+			Items: []domain.GetItemResponse{
+				{
+					ID:              1,
+					Name:            "test1",
+					Description:     "test1",
+					Price:           domain.Money{MinorUnits: 10000, Currency: "EUR"},
+					Quantity:        2,
+					ReorderLevel:    5,
+					ReorderQuantity: 20,
+				},
+			},
+		}
+
+		exp, err := json.Marshal(response)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("GET", "/admin/inventory/alerts", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("ListLowStockItems", context.Background(), &proto.ListLowStockItemsRequest{}).Return(expectedResponse, nil).Once()
+
+		handler := ListLowStockItems(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+
+	t.Run("expect to return 405 when method is not GET", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("POST", "/admin/inventory/alerts", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := ListLowStockItems(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusMethodNotAllowed, res.Code)
+	})
+}
@@ -0,0 +1,139 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// ListArchivedItems returns the items that have been deleted, most recently deleted first.
+func ListArchivedItems(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		resp, err := inventoryService.ListArchivedItems(req.Context(), &proto.ListArchivedItemsRequest{})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		response := domain.GetAllItemsResponse{
+			Items: []domain.GetItemResponse{},
+		}
+		for _, item := range resp.Items {
+			response.Items = append(response.Items, toItemResponse(item))
+		}
+
+		res, err := json.Marshal(response)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+func RestoreItem(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		itemID, err := strconv.ParseInt(req.URL.Query().Get("id"), 10, 32)
+		if err != nil {
+			http.Error(rw, "Invalid item ID", http.StatusBadRequest)
+			return
+		}
+
+		resp, err := inventoryService.RestoreItem(req.Context(), &proto.RestoreItemRequest{
+			Id: int32(itemID),
+		})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		res, err := json.Marshal(toItemResponse(resp.Item))
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+// PurgeItem removes an archived item for good. Inventory-service refuses while orders for
+// the item have not been delivered.
+func PurgeItem(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodDelete {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		itemID, err := strconv.ParseInt(req.URL.Query().Get("id"), 10, 32)
+		if err != nil {
+			http.Error(rw, "Invalid item ID", http.StatusBadRequest)
+			return
+		}
+
+		resp, err := inventoryService.PurgeItem(req.Context(), &proto.PurgeItemRequest{
+			Id: int32(itemID),
+		})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		response := domain.Message{
+			Message: resp.Message,
+		}
+
+		res, err := json.Marshal(response)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
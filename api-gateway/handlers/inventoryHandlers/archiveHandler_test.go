@@ -0,0 +1,141 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	moneyproto "api-gateway/proto/money"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_ListArchivedItems() {
+	t := suite.T()
+
+	t.Run("expect to return the archived items with when they were deleted", func(t *testing.T) {
+		// Arrange
+		expectedResponse := &proto.ListArchivedItemsResponse{
+			StatusCode: http.StatusOK,
+			Items: []*proto.GetItemResponse{
+				{Id: 3, Name: "uttapam", Price: &moneyproto.Money{MinorUnits: 600, Currency: "EUR"}, ArchivedAt: "2021-02-01T00:00:00Z"},
+			},
+		}
+
+		exp, err := json.Marshal(domain.GetAllItemsResponse{
+			Items: []domain.GetItemResponse{
+				{ID: 3, Name: "uttapam", Price: domain.Money{MinorUnits: 600, Currency: "EUR"}, ArchivedAt: "2021-02-01T00:00:00Z"},
+			},
+		})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("GET", "/admin/inventory/items/archived", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("ListArchivedItems", mock.Anything, &proto.ListArchivedItemsRequest{}).Return(expectedResponse, nil).Once()
+
+		handler := ListArchivedItems(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_RestoreItem() {
+	t := suite.T()
+
+	t.Run("expect to return the restored item", func(t *testing.T) {
+		// Arrange
+		expectedResponse := &proto.RestoreItemResponse{
+			StatusCode: http.StatusOK,
+			Item:       &proto.GetItemResponse{Id: 3, Name: "uttapam", Price: &moneyproto.Money{MinorUnits: 600, Currency: "EUR"}, Quantity: 4},
+		}
+
+		exp, err := json.Marshal(domain.GetItemResponse{ID: 3, Name: "uttapam", Price: domain.Money{MinorUnits: 600, Currency: "EUR"}, Quantity: 4})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("POST", "/admin/inventory/item/restore?id=3", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("RestoreItem", mock.Anything, &proto.RestoreItemRequest{Id: 3}).Return(expectedResponse, nil).Once()
+
+		handler := RestoreItem(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+
+	t.Run("expect to return 409 for an item that is not archived", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("POST", "/admin/inventory/item/restore?id=1", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("RestoreItem", mock.Anything, &proto.RestoreItemRequest{Id: 1}).Return(&proto.RestoreItemResponse{StatusCode: http.StatusConflict}, errors.New("item is not archived")).Once()
+
+		handler := RestoreItem(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusConflict, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_PurgeItem() {
+	t := suite.T()
+
+	t.Run("expect to purge an item no open order refers to", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("DELETE", "/admin/inventory/item/purge?id=3", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("PurgeItem", mock.Anything, &proto.PurgeItemRequest{Id: 3}).Return(&proto.PurgeItemResponse{StatusCode: http.StatusOK, Message: "Item purged successfully"}, nil).Once()
+
+		handler := PurgeItem(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.JSONEq(t, `{"message":"Item purged successfully"}`, res.Body.String())
+	})
+
+	t.Run("expect to return 409 while open orders refer to the item", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("DELETE", "/admin/inventory/item/purge?id=3", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("PurgeItem", mock.Anything, &proto.PurgeItemRequest{Id: 3}).Return(&proto.PurgeItemResponse{StatusCode: http.StatusConflict}, errors.New("item is still on orders that have not been delivered")).Once()
+
+		handler := PurgeItem(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusConflict, res.Code)
+		assert.JSONEq(t, `{"message":"grpc received error: item is still on orders that have not been delivered"}`, res.Body.String())
+	})
+
+	t.Run("expect to return 400 for an invalid item id", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("DELETE", "/admin/inventory/item/purge?id=dosa", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := PurgeItem(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
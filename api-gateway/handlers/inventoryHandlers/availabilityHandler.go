@@ -0,0 +1,232 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+func toAvailabilityWindow(window *proto.AvailabilityWindow) domain.AvailabilityWindow {
+	response := domain.AvailabilityWindow{
+		ID:        window.Id,
+		ItemID:    window.ItemId,
+		Category:  window.Category,
+		Days:      []string{},
+		StartTime: window.StartTime,
+		EndTime:   window.EndTime,
+		Timezone:  window.Timezone,
+	}
+	for _, day := range window.Days {
+		for name, value := range domain.DayOfWeekMap {
+			if value == day {
+				response.Days = append(response.Days, name)
+			}
+		}
+	}
+	return response
+}
+
+func AddAvailabilityWindow(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		var requestBody domain.AvailabilityWindow
+
+		if err := json.NewDecoder(req.Body).Decode(&requestBody); err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("invalid request body: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		window := &proto.AvailabilityWindow{
+			ItemId:    requestBody.ItemID,
+			Category:  requestBody.Category,
+			StartTime: requestBody.StartTime,
+			EndTime:   requestBody.EndTime,
+			Timezone:  requestBody.Timezone,
+		}
+		for _, day := range requestBody.Days {
+			value, ok := domain.DayOfWeekMap[strings.ToLower(day)]
+			if !ok {
+				message := domain.Message{
+					Message: fmt.Sprintf("invalid day: %s", day),
+				}
+				rw.WriteHeader(http.StatusBadRequest)
+				json.NewEncoder(rw).Encode(message)
+				return
+			}
+			window.Days = append(window.Days, value)
+		}
+
+		resp, err := inventoryService.AddAvailabilityWindow(req.Context(), &proto.AddAvailabilityWindowRequest{Window: window})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		res, err := json.Marshal(toAvailabilityWindow(resp.Window))
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+func ListAvailabilityWindows(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		itemID, err := strconv.ParseInt(req.URL.Query().Get("item_id"), 10, 32)
+		if err != nil {
+			http.Error(rw, "Invalid item ID", http.StatusBadRequest)
+			return
+		}
+
+		resp, err := inventoryService.ListAvailabilityWindows(req.Context(), &proto.ListAvailabilityWindowsRequest{ItemId: int32(itemID)})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		windows := []domain.AvailabilityWindow{}
+		for _, window := range resp.Windows {
+			windows = append(windows, toAvailabilityWindow(window))
+		}
+
+		res, err := json.Marshal(windows)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+func DeleteAvailabilityWindow(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodDelete {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		windowID, err := strconv.ParseUint(req.URL.Query().Get("id"), 10, 32)
+		if err != nil {
+			http.Error(rw, "Invalid ID", http.StatusBadRequest)
+			return
+		}
+
+		resp, err := inventoryService.DeleteAvailabilityWindow(req.Context(), &proto.DeleteAvailabilityWindowRequest{Id: uint32(windowID)})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		response := domain.Message{
+			Message: resp.Message,
+		}
+
+		res, err := json.Marshal(response)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+// SetItemUnavailable takes an item off the menu, or puts it back, without changing its quantity.
+func SetItemUnavailable(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		var requestBody domain.SetItemUnavailableRequest
+
+		if err := json.NewDecoder(req.Body).Decode(&requestBody); err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("invalid request body: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		grpcRequest := proto.SetItemUnavailableRequest{
+			Id:          requestBody.ID,
+			Unavailable: requestBody.Unavailable,
+		}
+
+		resp, err := inventoryService.SetItemUnavailable(req.Context(), &grpcRequest)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		res, err := json.Marshal(toItemResponse(resp.Item))
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
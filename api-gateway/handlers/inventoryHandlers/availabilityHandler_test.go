@@ -0,0 +1,213 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	moneyproto "api-gateway/proto/money"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_AddAvailabilityWindow() {
+	t := suite.T()
+
+	t.Run("expect to return 201 with the created window", func(t *testing.T) {
+		// Arrange
+		body := `{"category":"breakfast","days":["Mon","tue"],"start_time":"07:00","end_time":"11:00","timezone":"Asia/Kolkata"}`
+
+		expectedRequest := &proto.AddAvailabilityWindowRequest{
+			Window: &proto.AvailabilityWindow{
+				Category:  "breakfast",
+				Days:      []proto.DayOfWeek{proto.DayOfWeek_DAY_MONDAY, proto.DayOfWeek_DAY_TUESDAY},
+				StartTime: "07:00",
+				EndTime:   "11:00",
+				Timezone:  "Asia/Kolkata",
+			},
+		}
+
+		expectedResponse := &proto.AddAvailabilityWindowResponse{
+			StatusCode: http.StatusCreated,
+			Window: &proto.AvailabilityWindow{
+				Id:        2,
+				Category:  "breakfast",
+				Days:      []proto.DayOfWeek{proto.DayOfWeek_DAY_MONDAY, proto.DayOfWeek_DAY_TUESDAY},
+				StartTime: "07:00",
+				EndTime:   "11:00",
+				Timezone:  "Asia/Kolkata",
+			},
+		}
+
+		exp, err := json.Marshal(domain.AvailabilityWindow{
+			ID:        2,
+			Category:  "breakfast",
+			Days:      []string{"mon", "tue"},
+			StartTime: "07:00",
+			EndTime:   "11:00",
+			Timezone:  "Asia/Kolkata",
+		})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("POST", "/admin/inventory/item/availability", strings.NewReader(body))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("AddAvailabilityWindow", context.Background(), expectedRequest).Return(expectedResponse, nil).Once()
+
+		handler := AddAvailabilityWindow(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+
+	t.Run("expect to return 400 when a day is unknown", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("POST", "/admin/inventory/item/availability", strings.NewReader(`{"item_id":1,"days":["someday"]}`))
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := AddAvailabilityWindow(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("expect to return 400 when the window is invalid", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.AddAvailabilityWindowRequest{
+			Window: &proto.AvailabilityWindow{
+				ItemId:    1,
+				StartTime: "25:00",
+			},
+		}
+
+		req := httptest.NewRequest("POST", "/admin/inventory/item/availability", strings.NewReader(`{"item_id":1,"start_time":"25:00"}`))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("AddAvailabilityWindow", context.Background(), expectedRequest).Return(&proto.AddAvailabilityWindowResponse{
+			StatusCode: http.StatusBadRequest,
+		}, errors.New("invalid availability window")).Once()
+
+		handler := AddAvailabilityWindow(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_ListAvailabilityWindows() {
+	t := suite.T()
+
+	t.Run("expect to return 200 with the item's windows", func(t *testing.T) {
+		// Arrange
+		expectedResponse := &proto.ListAvailabilityWindowsResponse{
+			StatusCode: http.StatusOK,
+			Windows: []*proto.AvailabilityWindow{
+				{Id: 1, ItemId: 3, Days: []proto.DayOfWeek{proto.DayOfWeek_DAY_SUNDAY}, StartTime: "22:00", EndTime: "02:00", Timezone: "UTC"},
+			},
+		}
+
+		req := httptest.NewRequest("GET", "/admin/inventory/item/availability?item_id=3", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("ListAvailabilityWindows", context.Background(), &proto.ListAvailabilityWindowsRequest{ItemId: 3}).Return(expectedResponse, nil).Once()
+
+		handler := ListAvailabilityWindows(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		var windows []domain.AvailabilityWindow
+		assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &windows))
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, []string{"sun"}, windows[0].Days)
+		assert.Equal(t, "22:00", windows[0].StartTime)
+	})
+
+	t.Run("expect to return 400 when item id is invalid", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("GET", "/admin/inventory/item/availability?item_id=abc", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := ListAvailabilityWindows(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_DeleteAvailabilityWindow() {
+	t := suite.T()
+
+	t.Run("expect to return 404 when window is not found", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("DELETE", "/admin/inventory/item/availability?id=9", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("DeleteAvailabilityWindow", context.Background(), &proto.DeleteAvailabilityWindowRequest{Id: 9}).Return(&proto.DeleteAvailabilityWindowResponse{
+			StatusCode: http.StatusNotFound,
+		}, errors.New("availability window not found")).Once()
+
+		handler := DeleteAvailabilityWindow(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_SetItemUnavailable() {
+	t := suite.T()
+
+	t.Run("expect to return 200 with the item marked unavailable", func(t *testing.T) {
+		// Arrange
+		expectedResponse := &proto.SetItemUnavailableResponse{
+			StatusCode: http.StatusOK,
+			Item: &proto.GetItemResponse{
+				Id:          4,
+				Name:        "biryani",
+				Description: "rice",
+				Quantity:    12,
+				Price:       &moneyproto.Money{MinorUnits: 22000, Currency: "EUR"},
+				Unavailable: true,
+			},
+		}
+
+		exp, err := json.Marshal(domain.GetItemResponse{
+			ID:          4,
+			Name:        "biryani",
+			Description: "rice",
+			Quantity:    12,
+			Price:       domain.Money{MinorUnits: 22000, Currency: "EUR"},
+			Unavailable: true,
+		})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("POST", "/admin/inventory/item/unavailable", strings.NewReader(`{"id":4,"unavailable":true}`))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("SetItemUnavailable", context.Background(), &proto.SetItemUnavailableRequest{Id: 4, Unavailable: true}).Return(expectedResponse, nil).Once()
+
+		handler := SetItemUnavailable(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+}
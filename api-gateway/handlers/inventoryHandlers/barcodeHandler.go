@@ -0,0 +1,183 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// GetItemBySKU looks an item up by the SKU given in the sku query parameter.
+func GetItemBySKU(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		resp, err := inventoryService.GetItemBySKU(req.Context(), &proto.GetItemBySKURequest{
+			Sku: req.URL.Query().Get("sku"),
+			At:  req.URL.Query().Get("at"),
+		})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		res, err := json.Marshal(toItemResponse(resp))
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+// GetItemByBarcode looks up the item a scanned barcode, given in the barcode query
+// parameter, belongs to.
+func GetItemByBarcode(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		resp, err := inventoryService.GetItemByBarcode(req.Context(), &proto.GetItemByBarcodeRequest{
+			Barcode: req.URL.Query().Get("barcode"),
+			At:      req.URL.Query().Get("at"),
+		})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		res, err := json.Marshal(toItemResponse(resp))
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+func AddItemBarcode(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		var requestBody domain.ItemBarcodeRequest
+
+		if err := json.NewDecoder(req.Body).Decode(&requestBody); err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("invalid request body: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		resp, err := inventoryService.AddItemBarcode(req.Context(), &proto.AddItemBarcodeRequest{
+			ItemId:  requestBody.ItemID,
+			Barcode: requestBody.Barcode,
+		})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		response := domain.ItemBarcodeRequest{
+			ItemID:  requestBody.ItemID,
+			Barcode: resp.Barcode,
+		}
+
+		res, err := json.Marshal(response)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+func RemoveItemBarcode(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodDelete {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		itemID, err := strconv.ParseInt(req.URL.Query().Get("item_id"), 10, 32)
+		if err != nil {
+			http.Error(rw, "Invalid item ID", http.StatusBadRequest)
+			return
+		}
+
+		resp, err := inventoryService.RemoveItemBarcode(req.Context(), &proto.RemoveItemBarcodeRequest{
+			ItemId:  int32(itemID),
+			Barcode: req.URL.Query().Get("barcode"),
+		})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		response := domain.Message{
+			Message: resp.Message,
+		}
+
+		res, err := json.Marshal(response)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
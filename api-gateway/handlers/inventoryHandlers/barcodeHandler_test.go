@@ -0,0 +1,193 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	moneyproto "api-gateway/proto/money"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_GetItemBySKU() {
+	t := suite.T()
+
+	t.Run("expect to return the item with the SKU", func(t *testing.T) {
+		// Arrange
+		expectedResponse := &proto.GetItemResponse{
+			StatusCode: http.StatusOK,
+			Id:         1,
+			Name:       "lassi",
+			Price:      &moneyproto.Money{MinorUnits: 450, Currency: "EUR"},
+			Sku:        "DRK-001",
+			Barcodes:   []string{"0036000291452"},
+		}
+
+		exp, err := json.Marshal(domain.GetItemResponse{
+			ID:       1,
+			Name:     "lassi",
+			Price:    domain.Money{MinorUnits: 450, Currency: "EUR"},
+			SKU:      "DRK-001",
+			Barcodes: []string{"0036000291452"},
+		})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("GET", "/inventory/item/sku?sku=DRK-001", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("GetItemBySKU", mock.Anything, &proto.GetItemBySKURequest{Sku: "DRK-001"}).Return(expectedResponse, nil).Once()
+
+		handler := GetItemBySKU(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+
+	t.Run("expect to return 404 for an unknown SKU", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("GET", "/inventory/item/sku?sku=DRK-404", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("GetItemBySKU", mock.Anything, &proto.GetItemBySKURequest{Sku: "DRK-404"}).Return(&proto.GetItemResponse{StatusCode: http.StatusNotFound}, errors.New("item not found")).Once()
+
+		handler := GetItemBySKU(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_GetItemByBarcode() {
+	t := suite.T()
+
+	t.Run("expect to return the item the barcode belongs to", func(t *testing.T) {
+		// Arrange
+		expectedResponse := &proto.GetItemResponse{
+			StatusCode: http.StatusOK,
+			Id:         1,
+			Name:       "lassi",
+			Price:      &moneyproto.Money{MinorUnits: 450, Currency: "EUR"},
+			Barcodes:   []string{"0036000291452"},
+		}
+
+		req := httptest.NewRequest("GET", "/inventory/item/barcode?barcode=036000291452", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("GetItemByBarcode", mock.Anything, &proto.GetItemByBarcodeRequest{Barcode: "036000291452"}).Return(expectedResponse, nil).Once()
+
+		handler := GetItemByBarcode(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Contains(t, res.Body.String(), `"barcodes":["0036000291452"]`)
+	})
+
+	t.Run("expect to pass on 400 for an invalid barcode", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("GET", "/inventory/item/barcode?barcode=036000291453", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("GetItemByBarcode", mock.Anything, &proto.GetItemByBarcodeRequest{Barcode: "036000291453"}).Return(&proto.GetItemResponse{StatusCode: http.StatusBadRequest}, errors.New("barcode must be a valid EAN-13, EAN-8 or UPC-A code")).Once()
+
+		handler := GetItemByBarcode(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_AddItemBarcode() {
+	t := suite.T()
+
+	t.Run("expect to return 201 with the barcode as stored", func(t *testing.T) {
+		// Arrange
+		body, err := json.Marshal(domain.ItemBarcodeRequest{ItemID: 1, Barcode: "036000291452"})
+		assert.NoError(t, err)
+
+		exp, err := json.Marshal(domain.ItemBarcodeRequest{ItemID: 1, Barcode: "0036000291452"})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("POST", "/admin/inventory/item/barcodes", bytes.NewReader(body))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("AddItemBarcode", mock.Anything, &proto.AddItemBarcodeRequest{ItemId: 1, Barcode: "036000291452"}).Return(&proto.AddItemBarcodeResponse{StatusCode: http.StatusCreated, Barcode: "0036000291452"}, nil).Once()
+
+		handler := AddItemBarcode(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+
+	t.Run("expect to pass on 422 when the barcode belongs to another item", func(t *testing.T) {
+		// Arrange
+		body, err := json.Marshal(domain.ItemBarcodeRequest{ItemID: 2, Barcode: "036000291452"})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("POST", "/admin/inventory/item/barcodes", bytes.NewReader(body))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("AddItemBarcode", mock.Anything, &proto.AddItemBarcodeRequest{ItemId: 2, Barcode: "036000291452"}).Return(&proto.AddItemBarcodeResponse{StatusCode: http.StatusUnprocessableEntity}, errors.New("barcode already belongs to an item")).Once()
+
+		handler := AddItemBarcode(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusUnprocessableEntity, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_RemoveItemBarcode() {
+	t := suite.T()
+
+	t.Run("expect to return 200 when the barcode is removed", func(t *testing.T) {
+		// Arrange
+		exp, err := json.Marshal(domain.Message{Message: "Barcode removed successfully"})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("DELETE", "/admin/inventory/item/barcodes?item_id=1&barcode=0036000291452", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("RemoveItemBarcode", context.Background(), &proto.RemoveItemBarcodeRequest{ItemId: 1, Barcode: "0036000291452"}).Return(&proto.RemoveItemBarcodeResponse{StatusCode: http.StatusOK, Message: "Barcode removed successfully"}, nil).Once()
+
+		handler := RemoveItemBarcode(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+
+	t.Run("expect to return 400 for an invalid item id", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("DELETE", "/admin/inventory/item/barcodes?item_id=abc&barcode=0036000291452", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := RemoveItemBarcode(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
@@ -0,0 +1,124 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+func toBundle(resp *proto.BundleResponse) domain.Bundle {
+	bundle := domain.Bundle{
+		BundleID:   resp.BundleId,
+		Price:      toOptionalMoney(resp.Price),
+		Components: []domain.BundleComponent{},
+	}
+	for _, component := range resp.Components {
+		bundle.Components = append(bundle.Components, domain.BundleComponent{
+			ComponentID: component.ComponentId,
+			Name:        component.Name,
+			Quantity:    component.Quantity,
+			Price:       toOptionalMoney(component.Price),
+		})
+	}
+	return bundle
+}
+
+// SetBundle makes an item a bundle of other items, such as a meal deal.
+func SetBundle(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPut {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		var requestBody domain.Bundle
+
+		if err := json.NewDecoder(req.Body).Decode(&requestBody); err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("invalid request body: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		grpcRequest := &proto.SetBundleRequest{
+			BundleId: requestBody.BundleID,
+		}
+		if requestBody.Price != nil {
+			grpcRequest.Price = toMoneyProto(*requestBody.Price)
+		}
+		for _, component := range requestBody.Components {
+			grpcRequest.Components = append(grpcRequest.Components, &proto.BundleComponent{
+				ComponentId: component.ComponentID,
+				Quantity:    component.Quantity,
+			})
+		}
+
+		resp, err := inventoryService.SetBundle(req.Context(), grpcRequest)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		res, err := json.Marshal(toBundle(resp))
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+func GetBundle(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		bundleID, err := strconv.ParseInt(req.URL.Query().Get("bundle_id"), 10, 32)
+		if err != nil {
+			http.Error(rw, "Invalid bundle ID", http.StatusBadRequest)
+			return
+		}
+
+		resp, err := inventoryService.GetBundle(req.Context(), &proto.GetBundleRequest{BundleId: int32(bundleID)})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		res, err := json.Marshal(toBundle(resp))
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
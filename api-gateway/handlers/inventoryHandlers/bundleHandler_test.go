@@ -0,0 +1,108 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	moneyproto "api-gateway/proto/money"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_SetBundle() {
+	t := suite.T()
+
+	t.Run("expect to return 200 with the stored bundle", func(t *testing.T) {
+		// Arrange
+		body := `{"bundle_id":1,"price":{"minor_units":1150,"currency":"EUR"},"components":[{"component_id":2,"quantity":1},{"component_id":3,"quantity":1}]}`
+
+		expectedRequest := &proto.SetBundleRequest{
+			BundleId: 1,
+			Price:    &moneyproto.Money{MinorUnits: 1150, Currency: "EUR"},
+			Components: []*proto.BundleComponent{
+				{ComponentId: 2, Quantity: 1},
+				{ComponentId: 3, Quantity: 1},
+			},
+		}
+
+		expectedResponse := &proto.BundleResponse{
+			StatusCode: http.StatusOK,
+			BundleId:   1,
+			Price:      &moneyproto.Money{MinorUnits: 1150, Currency: "EUR"},
+			Components: []*proto.BundleComponent{
+				{ComponentId: 2, Name: "burger", Quantity: 1, Price: &moneyproto.Money{MinorUnits: 800, Currency: "EUR"}},
+				{ComponentId: 3, Name: "fries", Quantity: 1, Price: &moneyproto.Money{MinorUnits: 300, Currency: "EUR"}},
+			},
+		}
+
+		exp, err := json.Marshal(domain.Bundle{
+			BundleID: 1,
+			Price:    &domain.Money{MinorUnits: 1150, Currency: "EUR"},
+			Components: []domain.BundleComponent{
+				{ComponentID: 2, Name: "burger", Quantity: 1, Price: &domain.Money{MinorUnits: 800, Currency: "EUR"}},
+				{ComponentID: 3, Name: "fries", Quantity: 1, Price: &domain.Money{MinorUnits: 300, Currency: "EUR"}},
+			},
+		})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("PUT", "/admin/inventory/item/bundle", strings.NewReader(body))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("SetBundle", context.Background(), expectedRequest).Return(expectedResponse, nil).Once()
+
+		handler := SetBundle(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+
+	t.Run("expect to return 404 when a component does not exist", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.SetBundleRequest{
+			BundleId:   1,
+			Components: []*proto.BundleComponent{{ComponentId: 99, Quantity: 1}},
+		}
+
+		expectedResponse := &proto.BundleResponse{
+			StatusCode: http.StatusNotFound,
+		}
+
+		req := httptest.NewRequest("PUT", "/admin/inventory/item/bundle", strings.NewReader(`{"bundle_id":1,"components":[{"component_id":99,"quantity":1}]}`))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("SetBundle", context.Background(), expectedRequest).Return(expectedResponse, errors.New("bundle component not found")).Once()
+
+		handler := SetBundle(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_GetBundle() {
+	t := suite.T()
+
+	t.Run("expect to return 400 for a missing bundle ID", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("GET", "/admin/inventory/item/bundle", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := GetBundle(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
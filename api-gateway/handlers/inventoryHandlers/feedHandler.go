@@ -0,0 +1,101 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// WatchInventory relays the inventory change feed to the client as server-sent events.
+// Each event carries its sequence as the event id, so a reconnecting browser resumes
+// through the Last-Event-ID header; other clients can pass resume_after instead.
+func WatchInventory(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		flusher, ok := rw.(http.Flusher)
+		if !ok {
+			http.Error(rw, "Streaming unsupported", http.StatusInternalServerError)
+			return
+		}
+
+		queryParams := req.URL.Query()
+		grpcRequest := &proto.WatchInventoryRequest{}
+		for _, value := range queryParams["item_id"] {
+			itemID, err := strconv.ParseInt(value, 10, 32)
+			if err != nil {
+				http.Error(rw, "Invalid item ID", http.StatusBadRequest)
+				return
+			}
+			grpcRequest.ItemIds = append(grpcRequest.ItemIds, int32(itemID))
+		}
+
+		resumeAfter := req.Header.Get("Last-Event-ID")
+		if resumeAfter == "" {
+			resumeAfter = queryParams.Get("resume_after")
+		}
+		if resumeAfter != "" {
+			sequence, err := strconv.ParseUint(resumeAfter, 10, 64)
+			if err != nil {
+				http.Error(rw, "Invalid resume sequence", http.StatusBadRequest)
+				return
+			}
+			grpcRequest.ResumeAfter = sequence
+		}
+
+		stream, err := inventoryService.WatchInventory(req.Context(), grpcRequest)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusBadGateway)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.Header().Set("Content-Type", "text/event-stream")
+		rw.Header().Set("Cache-Control", "no-cache")
+		rw.Header().Set("Connection", "keep-alive")
+		rw.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		for {
+			event, err := stream.Recv()
+			if err == io.EOF || req.Context().Err() != nil {
+				return
+			}
+			if err != nil {
+				message, _ := json.Marshal(domain.Message{
+					Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+				})
+				fmt.Fprintf(rw, "event: error\ndata: %s\n\n", message)
+				flusher.Flush()
+				return
+			}
+
+			eventType := strings.ToLower(strings.TrimPrefix(event.Type.String(), "EVENT_"))
+			data, err := json.Marshal(domain.InventoryEvent{
+				Sequence:  event.Sequence,
+				Type:      eventType,
+				ItemID:    event.ItemId,
+				Name:      event.Name,
+				Quantity:  event.Quantity,
+				Price:     toMoney(event.Price),
+				CreatedAt: event.CreatedAt,
+			})
+			if err != nil {
+				return
+			}
+			fmt.Fprintf(rw, "id: %d\nevent: %s\ndata: %s\n\n", event.Sequence, eventType, data)
+			flusher.Flush()
+		}
+	})
+}
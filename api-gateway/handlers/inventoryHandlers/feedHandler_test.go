@@ -0,0 +1,125 @@
+package inventoryHandlers
+
+import (
+	mocks "api-gateway/mocks/inventorymocks"
+	proto "api-gateway/proto/inventory"
+	moneyproto "api-gateway/proto/money"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_WatchInventory() {
+	t := suite.T()
+
+	t.Run("expect to stream events as server-sent events", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.WatchInventoryRequest{
+			ItemIds:     []int32{1, 2},
+			ResumeAfter: 7,
+		}
+
+		stream := &mocks.InventoryService_WatchInventoryClient{}
+		stream.On("Recv").Return(&proto.InventoryEvent{
+			Sequence:  8,
+			Type:      proto.InventoryEventType_EVENT_QUANTITY_CHANGED,
+			ItemId:    1,
+			Name:      "tea",
+			Quantity:  9,
+			Price:     &moneyproto.Money{MinorUnits: 4000, Currency: "EUR"},
+			CreatedAt: "2023-06-01T10:00:00Z",
+		}, nil).Once()
+		stream.On("Recv").Return(nil, io.EOF).Once()
+
+		expected := "id: 8\nevent: quantity_changed\n" +
+			`data: {"sequence":8,"type":"quantity_changed","item_id":1,"name":"tea","quantity":9,"price":{"minor_units":4000,"currency":"EUR"},"created_at":"2023-06-01T10:00:00Z"}` +
+			"\n\n"
+
+		req := httptest.NewRequest("GET", "/inventory/watch?item_id=1&item_id=2", nil)
+		req.Header.Set("Last-Event-ID", "7")
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("WatchInventory", context.Background(), expectedRequest).Return(stream, nil).Once()
+
+		handler := WatchInventory(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, "text/event-stream", res.Header().Get("Content-Type"))
+		assert.Equal(t, expected, res.Body.String())
+		stream.AssertExpectations(t)
+	})
+
+	t.Run("expect to resume from the query parameter", func(t *testing.T) {
+		// Arrange
+		stream := &mocks.InventoryService_WatchInventoryClient{}
+		stream.On("Recv").Return(nil, io.EOF).Once()
+
+		req := httptest.NewRequest("GET", "/inventory/watch?resume_after=12", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("WatchInventory", context.Background(), &proto.WatchInventoryRequest{ResumeAfter: 12}).Return(stream, nil).Once()
+
+		handler := WatchInventory(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, "", res.Body.String())
+	})
+
+	t.Run("expect to send an error event when the stream fails", func(t *testing.T) {
+		// Arrange
+		stream := &mocks.InventoryService_WatchInventoryClient{}
+		stream.On("Recv").Return(nil, errors.New("watcher fell too far behind")).Once()
+
+		req := httptest.NewRequest("GET", "/inventory/watch", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("WatchInventory", context.Background(), &proto.WatchInventoryRequest{}).Return(stream, nil).Once()
+
+		handler := WatchInventory(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.True(t, strings.HasPrefix(res.Body.String(), "event: error\n"))
+		assert.Contains(t, res.Body.String(), "watcher fell too far behind")
+	})
+
+	t.Run("expect to return 400 when an item id is invalid", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("GET", "/inventory/watch?item_id=tea", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := WatchInventory(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("expect to return 405 for other methods", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("POST", "/inventory/watch", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := WatchInventory(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusMethodNotAllowed, res.Code)
+	})
+}
@@ -0,0 +1,185 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+)
+
+// maxImageUploadSize caps the size of an image upload request. inventory-service applies its
+// own, usually smaller, limit to the image itself.
+const maxImageUploadSize = 10 << 20
+
+func toItemImages(images []*proto.ItemImage) []domain.ItemImage {
+	var response []domain.ItemImage
+	for _, image := range images {
+		response = append(response, toItemImage(image))
+	}
+	return response
+}
+
+func toItemImage(image *proto.ItemImage) domain.ItemImage {
+	return domain.ItemImage{
+		ID:            image.Id,
+		ItemID:        image.ItemId,
+		URL:           image.Url,
+		ThumbnailURLs: image.ThumbnailUrls,
+		ContentType:   image.ContentType,
+		Width:         image.Width,
+		Height:        image.Height,
+		Size:          image.Size,
+	}
+}
+
+// UploadItemImage adds an image to an item from a multipart form with the item's id in an
+// item_id field and the image in an image field.
+func UploadItemImage(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		req.Body = http.MaxBytesReader(rw, req.Body, maxImageUploadSize)
+		if err := req.ParseMultipartForm(maxImageUploadSize); err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("invalid request body: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		itemID, err := strconv.ParseInt(req.FormValue("item_id"), 10, 32)
+		if err != nil {
+			http.Error(rw, "Invalid item ID", http.StatusBadRequest)
+			return
+		}
+
+		file, _, err := req.FormFile("image")
+		if err != nil {
+			http.Error(rw, "Missing image", http.StatusBadRequest)
+			return
+		}
+		defer file.Close()
+
+		data, err := io.ReadAll(file)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("invalid request body: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		resp, err := inventoryService.UploadItemImage(req.Context(), &proto.UploadItemImageRequest{
+			ItemId: int32(itemID),
+			Data:   data,
+		})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		res, err := json.Marshal(toItemImage(resp.Image))
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+func DeleteItemImage(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodDelete {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		itemID, err := strconv.ParseInt(req.URL.Query().Get("item_id"), 10, 32)
+		if err != nil {
+			http.Error(rw, "Invalid item ID", http.StatusBadRequest)
+			return
+		}
+		imageID, err := strconv.ParseUint(req.URL.Query().Get("image_id"), 10, 32)
+		if err != nil {
+			http.Error(rw, "Invalid image ID", http.StatusBadRequest)
+			return
+		}
+
+		resp, err := inventoryService.DeleteItemImage(req.Context(), &proto.DeleteItemImageRequest{
+			ItemId:  int32(itemID),
+			ImageId: uint32(imageID),
+		})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		response := domain.Message{
+			Message: resp.Message,
+		}
+
+		res, err := json.Marshal(response)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+// GetImage serves an item image or thumbnail from the URLs inventory-service hands out.
+func GetImage(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		resp, err := inventoryService.GetImage(req.Context(), &proto.GetImageRequest{Key: mux.Vars(req)["key"]})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.Header().Set("Content-Type", resp.ContentType)
+		rw.Header().Set("Cache-Control", "public, max-age=86400")
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Write(resp.Data)
+	})
+}
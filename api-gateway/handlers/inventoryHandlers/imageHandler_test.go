@@ -0,0 +1,203 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// imageUpload builds a multipart upload of data for the item, leaving out the image when data is nil.
+func imageUpload(t *testing.T, itemID string, data []byte) *http.Request {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	assert.NoError(t, writer.WriteField("item_id", itemID))
+	if data != nil {
+		part, err := writer.CreateFormFile("image", "thali.png")
+		assert.NoError(t, err)
+		_, err = part.Write(data)
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, writer.Close())
+
+	req := httptest.NewRequest("POST", "/admin/inventory/item/images", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_UploadItemImage() {
+	t := suite.T()
+
+	t.Run("expect to return 201 with the stored image and its thumbnails", func(t *testing.T) {
+		// Arrange
+		data := []byte("\x89PNG\r\n\x1a\nimage")
+
+		expectedResponse := &proto.UploadItemImageResponse{
+			StatusCode: http.StatusCreated,
+			Image: &proto.ItemImage{
+				Id:            2,
+				ItemId:        1,
+				Url:           "/inventory/images/items/1/2/original.png",
+				ThumbnailUrls: map[string]string{"small": "/inventory/images/items/1/2/small.png"},
+				ContentType:   "image/png",
+				Width:         800,
+				Height:        400,
+				Size:          13,
+			},
+		}
+
+		exp, err := json.Marshal(domain.ItemImage{
+			ID:            2,
+			ItemID:        1,
+			URL:           "/inventory/images/items/1/2/original.png",
+			ThumbnailURLs: map[string]string{"small": "/inventory/images/items/1/2/small.png"},
+			ContentType:   "image/png",
+			Width:         800,
+			Height:        400,
+			Size:          13,
+		})
+		assert.NoError(t, err)
+
+		req := imageUpload(t, "1", data)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("UploadItemImage", mock.Anything, &proto.UploadItemImageRequest{ItemId: 1, Data: data}).Return(expectedResponse, nil).Once()
+
+		handler := UploadItemImage(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+
+	t.Run("expect to pass on the status when the image is refused", func(t *testing.T) {
+		// Arrange
+		data := []byte("not an image")
+
+		req := imageUpload(t, "1", data)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("UploadItemImage", mock.Anything, &proto.UploadItemImageRequest{ItemId: 1, Data: data}).Return(&proto.UploadItemImageResponse{StatusCode: http.StatusUnsupportedMediaType}, errors.New("image must be a JPEG, PNG or GIF")).Once()
+
+		handler := UploadItemImage(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusUnsupportedMediaType, res.Code)
+	})
+
+	t.Run("expect to return 400 when no image is attached", func(t *testing.T) {
+		// Arrange
+		req := imageUpload(t, "1", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := UploadItemImage(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("expect to return 400 for an invalid item id", func(t *testing.T) {
+		// Arrange
+		req := imageUpload(t, "abc", []byte("image"))
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := UploadItemImage(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_DeleteItemImage() {
+	t := suite.T()
+
+	t.Run("expect to return 200 when the image is deleted", func(t *testing.T) {
+		// Arrange
+		exp, err := json.Marshal(domain.Message{Message: "Image deleted successfully"})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("DELETE", "/admin/inventory/item/images?item_id=1&image_id=2", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("DeleteItemImage", context.Background(), &proto.DeleteItemImageRequest{ItemId: 1, ImageId: 2}).Return(&proto.DeleteItemImageResponse{StatusCode: http.StatusOK, Message: "Image deleted successfully"}, nil).Once()
+
+		handler := DeleteItemImage(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+
+	t.Run("expect to return 400 for an invalid image id", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("DELETE", "/admin/inventory/item/images?item_id=1&image_id=abc", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := DeleteItemImage(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_GetImage() {
+	t := suite.T()
+
+	t.Run("expect to serve the image with its content type", func(t *testing.T) {
+		// Arrange
+		data := []byte("\xff\xd8\xffjpeg")
+
+		req := httptest.NewRequest("GET", "/inventory/images/items/1/2/small.jpg", nil)
+		req = mux.SetURLVars(req, map[string]string{"key": "items/1/2/small.jpg"})
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("GetImage", mock.Anything, &proto.GetImageRequest{Key: "items/1/2/small.jpg"}).Return(&proto.GetImageResponse{StatusCode: http.StatusOK, Data: data, ContentType: "image/jpeg"}, nil).Once()
+
+		handler := GetImage(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, "image/jpeg", res.Header().Get("Content-Type"))
+		assert.Equal(t, data, res.Body.Bytes())
+	})
+
+	t.Run("expect to return 404 for an image that is not stored", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("GET", "/inventory/images/items/1/9/small.jpg", nil)
+		req = mux.SetURLVars(req, map[string]string{"key": "items/1/9/small.jpg"})
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("GetImage", mock.Anything, &proto.GetImageRequest{Key: "items/1/9/small.jpg"}).Return(&proto.GetImageResponse{StatusCode: http.StatusNotFound}, errors.New("image not found")).Once()
+
+		handler := GetImage(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+}
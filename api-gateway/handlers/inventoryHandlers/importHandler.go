@@ -0,0 +1,148 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// maxImportSize caps the size of an uploaded catalog file.
+const maxImportSize = 10 << 20
+
+func ImportItems(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		queryParams := req.URL.Query()
+		format, ok := domain.FileFormatMap[queryParams.Get("format")]
+		if !ok {
+			http.Error(rw, "Invalid format", http.StatusBadRequest)
+			return
+		}
+
+		grpcRequest := &proto.ImportItemsRequest{
+			Format: format,
+			Actor:  actorFromContext(req.Context()),
+		}
+		if dryRun := queryParams.Get("dry_run"); dryRun != "" {
+			value, err := strconv.ParseBool(dryRun)
+			if err != nil {
+				http.Error(rw, "Invalid dry_run", http.StatusBadRequest)
+				return
+			}
+			grpcRequest.DryRun = value
+		}
+		if mode := queryParams.Get("mode"); mode != "" {
+			value, ok := domain.ImportModeMap[mode]
+			if !ok {
+				http.Error(rw, "Invalid mode", http.StatusBadRequest)
+				return
+			}
+			grpcRequest.Mode = value
+		}
+		if matchOn := queryParams.Get("match_on"); matchOn != "" {
+			value, ok := domain.ImportMatchMap[matchOn]
+			if !ok {
+				http.Error(rw, "Invalid match_on", http.StatusBadRequest)
+				return
+			}
+			grpcRequest.MatchOn = value
+		}
+
+		data, err := io.ReadAll(http.MaxBytesReader(rw, req.Body, maxImportSize))
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("invalid request body: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+		grpcRequest.Data = data
+
+		resp, err := inventoryService.ImportItems(req.Context(), grpcRequest)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		response := domain.ImportItemsResponse{
+			DryRun: grpcRequest.DryRun,
+			Rows:   []domain.ImportRowResult{},
+		}
+		for _, row := range resp.Rows {
+			response.Rows = append(response.Rows, domain.ImportRowResult{
+				Row:     row.Row,
+				Name:    row.Name,
+				SKU:     row.Sku,
+				Action:  row.Action,
+				ItemID:  row.ItemId,
+				Error:   row.Error,
+				Applied: row.Applied,
+			})
+		}
+
+		res, err := json.Marshal(response)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Write(res)
+	})
+}
+
+func ExportItems(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		name := req.URL.Query().Get("format")
+		if name == "" {
+			name = "csv"
+		}
+		format, ok := domain.FileFormatMap[name]
+		if !ok {
+			http.Error(rw, "Invalid format", http.StatusBadRequest)
+			return
+		}
+
+		resp, err := inventoryService.ExportItems(req.Context(), &proto.ExportItemsRequest{Format: format})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		contentType := "text/csv"
+		if format == proto.FileFormat_FORMAT_JSON {
+			contentType = "application/json"
+		}
+		rw.Header().Set("Content-Type", contentType)
+		rw.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"items.%s\"", name))
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Write(resp.Data)
+	})
+}
@@ -0,0 +1,210 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_ImportItems() {
+	t := suite.T()
+
+	data := "name,description,price,quantity\ntea,masala chai,40,30\ncoffee,,50,20\n"
+
+	t.Run("expect to return 200 with the result of every row", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.ImportItemsRequest{
+			Format:  proto.FileFormat_FORMAT_CSV,
+			Data:    []byte(data),
+			DryRun:  true,
+			Mode:    proto.ImportMode_IMPORT_BEST_EFFORT,
+			MatchOn: proto.ImportMatch_MATCH_NAME,
+		}
+
+		expectedResponse := &proto.ImportItemsResponse{
+			StatusCode: http.StatusOK,
+			Rows: []*proto.ImportRowResult{
+				{Row: 2, Name: "tea", Action: "update", ItemId: 1},
+				{Row: 3, Name: "coffee", Error: "empty field"},
+			},
+		}
+
+		response := domain.ImportItemsResponse{
+			DryRun: true,
+			Rows: []domain.ImportRowResult{
+				{Row: 2, Name: "tea", Action: "update", ItemID: 1},
+				{Row: 3, Name: "coffee", Error: "empty field"},
+			},
+		}
+
+		exp, err := json.Marshal(response)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("POST", "/admin/inventory/items/import?format=csv&dry_run=true&mode=best_effort&match_on=name", strings.NewReader(data))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("ImportItems", context.Background(), expectedRequest).Return(expectedResponse, nil).Once()
+
+		handler := ImportItems(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+
+	t.Run("expect to return 422 with the rows when the import is rejected", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.ImportItemsRequest{
+			Format: proto.FileFormat_FORMAT_CSV,
+			Data:   []byte(data),
+		}
+
+		expectedResponse := &proto.ImportItemsResponse{
+			StatusCode: http.StatusUnprocessableEntity,
+			Rows: []*proto.ImportRowResult{
+				{Row: 2, Name: "tea", Action: "update", ItemId: 1},
+				{Row: 3, Name: "coffee", Error: "empty field"},
+			},
+		}
+
+		req := httptest.NewRequest("POST", "/admin/inventory/items/import?format=csv", strings.NewReader(data))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("ImportItems", context.Background(), expectedRequest).Return(expectedResponse, nil).Once()
+
+		handler := ImportItems(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusUnprocessableEntity, res.Code)
+		assert.Contains(t, res.Body.String(), `"error":"empty field"`)
+	})
+
+	t.Run("expect to return 400 when format is unknown", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("POST", "/admin/inventory/items/import?format=xml", strings.NewReader(data))
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := ImportItems(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("expect to return 400 when the file cannot be read", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.ImportItemsRequest{
+			Format: proto.FileFormat_FORMAT_JSON,
+			Data:   []byte("{"),
+		}
+
+		expectedResponse := &proto.ImportItemsResponse{
+			StatusCode: http.StatusBadRequest,
+		}
+
+		response := domain.Message{
+			Message: "grpc received error: invalid import file",
+		}
+
+		exp, err := json.Marshal(response)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("POST", "/admin/inventory/items/import?format=json", strings.NewReader("{"))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("ImportItems", context.Background(), expectedRequest).Return(expectedResponse, errors.New("invalid import file")).Once()
+
+		handler := ImportItems(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+		assert.Equal(t, string(exp), strings.Split(res.Body.String(), "\n")[0])
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_ExportItems() {
+	t := suite.T()
+
+	t.Run("expect to return the catalog as a CSV file", func(t *testing.T) {
+		// Arrange
+		data := "name,description,price,quantity,reorder_level,reorder_quantity,sku\ntea,masala chai,40,30,0,0,\n"
+		expectedRequest := &proto.ExportItemsRequest{
+			Format: proto.FileFormat_FORMAT_CSV,
+		}
+
+		expectedResponse := &proto.ExportItemsResponse{
+			StatusCode: http.StatusOK,
+			Data:       []byte(data),
+		}
+
+		req := httptest.NewRequest("GET", "/admin/inventory/items/export", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("ExportItems", context.Background(), expectedRequest).Return(expectedResponse, nil).Once()
+
+		handler := ExportItems(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, "text/csv", res.Header().Get("Content-Type"))
+		assert.Equal(t, `attachment; filename="items.csv"`, res.Header().Get("Content-Disposition"))
+		assert.Equal(t, data, res.Body.String())
+	})
+
+	t.Run("expect to return the catalog as a JSON file", func(t *testing.T) {
+		// Arrange
+		data := `[{"name":"tea","description":"masala chai","price":40,"quantity":30,"reorder_level":0,"reorder_quantity":0}]`
+		expectedRequest := &proto.ExportItemsRequest{
+			Format: proto.FileFormat_FORMAT_JSON,
+		}
+
+		expectedResponse := &proto.ExportItemsResponse{
+			StatusCode: http.StatusOK,
+			Data:       []byte(data),
+		}
+
+		req := httptest.NewRequest("GET", "/admin/inventory/items/export?format=json", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("ExportItems", context.Background(), expectedRequest).Return(expectedResponse, nil).Once()
+
+		handler := ExportItems(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, "application/json", res.Header().Get("Content-Type"))
+		assert.Equal(t, data, res.Body.String())
+	})
+
+	t.Run("expect to return 405 when method is not GET", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("POST", "/admin/inventory/items/export", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := ExportItems(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusMethodNotAllowed, res.Code)
+	})
+}
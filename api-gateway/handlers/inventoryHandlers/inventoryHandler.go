@@ -3,13 +3,106 @@ package inventoryHandlers
 import (
 	"api-gateway/domain"
 	proto "api-gateway/proto/inventory"
+	moneyproto "api-gateway/proto/money"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
+// toMoney maps an amount returned by inventory-service to the gateway's response.
+func toMoney(amount *moneyproto.Money) domain.Money {
+	return domain.Money{
+		MinorUnits: amount.GetMinorUnits(),
+		Currency:   amount.GetCurrency(),
+	}
+}
+
+// toOptionalMoney is toMoney for amounts that may be left out, such as a movement's unit cost.
+func toOptionalMoney(amount *moneyproto.Money) *domain.Money {
+	if amount == nil {
+		return nil
+	}
+	money := toMoney(amount)
+	return &money
+}
+
+// toMoneyProto maps an amount from a request body to inventory-service, leaving out an
+// amount the body did not give.
+func toMoneyProto(amount domain.Money) *moneyproto.Money {
+	if amount == (domain.Money{}) {
+		return nil
+	}
+	return &moneyproto.Money{
+		MinorUnits: amount.MinorUnits,
+		Currency:   amount.Currency,
+	}
+}
+
+// toItemResponse maps the item details returned by inventory-service to the gateway's response.
+func toItemResponse(item *proto.GetItemResponse) domain.GetItemResponse {
+	return domain.GetItemResponse{
+		ID:                item.Id,
+		Name:              item.Name,
+		Description:       item.Description,
+		Quantity:          item.Quantity,
+		Amount:            item.Amount,
+		AvailableQuantity: item.AvailableQuantity,
+		AvailableToSell:   item.AvailableToSell,
+		Price:             toMoney(item.Price),
+		BasePrice:         toOptionalMoney(item.BasePrice),
+		CostPrice:         toOptionalMoney(item.CostPrice),
+		ReorderLevel:      item.ReorderLevel,
+		ReorderQuantity:   item.ReorderQuantity,
+		SKU:               item.Sku,
+		Category:          item.Category,
+		AvailableNow:      item.AvailableNow,
+		Unavailable:       item.Unavailable,
+		Allergens:         item.Allergens,
+		DietaryLabels:     item.DietaryLabels,
+		Nutrition:         toNutrition(item.Nutrition),
+		OptionGroups:      toOptionGroups(item.OptionGroups),
+		Unit:              item.Unit,
+		SaleUnit:          item.SaleUnit,
+		Images:            toItemImages(item.Images),
+		Barcodes:          item.Barcodes,
+		ArchivedAt:        item.ArchivedAt,
+		RatingAverage:     item.RatingAverage,
+		RatingCount:       item.RatingCount,
+		Locale:            item.Locale,
+	}
+}
+
+func toNutrition(nutrition *proto.Nutrition) *domain.Nutrition {
+	if nutrition == nil {
+		return nil
+	}
+	return &domain.Nutrition{
+		Kcal:          nutrition.Kcal,
+		Protein:       nutrition.Protein,
+		Carbohydrates: nutrition.Carbohydrates,
+		Fat:           nutrition.Fat,
+	}
+}
+
+// queryList reads a query parameter that may be repeated or hold comma separated values.
+func queryList(query url.Values, key string) []string {
+	var values []string
+	for _, value := range query[key] {
+		for _, part := range strings.Split(value, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				values = append(values, strings.ToLower(part))
+			}
+		}
+	}
+	return values
+}
+
 func AddItem(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
 	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		if req.Method != http.MethodPost {
@@ -29,10 +122,21 @@ func AddItem(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
 		}
 
 		grpcRequest := proto.AddItemRequest{
-			Name:        requestBody.Name,
-			Description: requestBody.Description,
-			Quantity:    requestBody.Quantity,
-			Price:       requestBody.Price,
+			Name:            requestBody.Name,
+			Description:     requestBody.Description,
+			Quantity:        requestBody.Quantity,
+			Price:           requestBody.Price,
+			ReorderLevel:    requestBody.ReorderLevel,
+			ReorderQuantity: requestBody.ReorderQuantity,
+			Sku:             requestBody.Sku,
+			Category:        requestBody.Category,
+			Allergens:       requestBody.Allergens,
+			DietaryLabels:   requestBody.DietaryLabels,
+			Nutrition:       requestBody.Nutrition,
+			Unit:            requestBody.Unit,
+			SaleUnit:        requestBody.SaleUnit,
+			Amount:          requestBody.Amount,
+			CostPrice:       requestBody.CostPrice,
 		}
 
 		resp, err := inventoryService.AddItem(req.Context(), &grpcRequest)
@@ -46,11 +150,21 @@ func AddItem(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
 		}
 
 		response := domain.AddItemResponse{
-			ID:          resp.Id,
-			Name:        resp.Name,
-			Description: resp.Description,
-			Quantity:    resp.Quantity,
-			Price:       resp.Price,
+			ID:              resp.Id,
+			Name:            resp.Name,
+			Description:     resp.Description,
+			Quantity:        resp.Quantity,
+			Price:           toMoney(resp.Price),
+			ReorderLevel:    resp.ReorderLevel,
+			ReorderQuantity: resp.ReorderQuantity,
+			SKU:             resp.Sku,
+			Category:        resp.Category,
+			Allergens:       resp.Allergens,
+			DietaryLabels:   resp.DietaryLabels,
+			Nutrition:       toNutrition(resp.Nutrition),
+			Unit:            resp.Unit,
+			SaleUnit:        resp.SaleUnit,
+			CostPrice:       toOptionalMoney(resp.CostPrice),
 		}
 
 		res, err := json.Marshal(response)
@@ -89,6 +203,7 @@ func GetItem(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
 
 		grpcRequest := proto.GetItemRequest{
 			Id: requestBody.Id,
+			At: requestBody.At,
 		}
 
 		resp, err := inventoryService.GetItem(req.Context(), &grpcRequest)
@@ -101,13 +216,7 @@ func GetItem(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
 			return
 		}
 
-		response := domain.GetItemResponse{
-			ID:          resp.Id,
-			Name:        resp.Name,
-			Description: resp.Description,
-			Quantity:    resp.Quantity,
-			Price:       resp.Price,
-		}
+		response := toItemResponse(resp)
 
 		res, err := json.Marshal(response)
 		if err != nil {
@@ -132,7 +241,13 @@ func GetAllItems(inventoryService proto.InventoryServiceClient) http.HandlerFunc
 			return
 		}
 
-		resp, err := inventoryService.GetAllItems(req.Context(), &proto.GetAllItemsRequest{})
+		grpcRequest := &proto.GetAllItemsRequest{
+			ExcludeAllergens: queryList(req.URL.Query(), "exclude_allergens"),
+			DietaryLabels:    queryList(req.URL.Query(), "diet"),
+			SortBy:           req.URL.Query().Get("sort"),
+		}
+
+		resp, err := inventoryService.GetAllItems(req.Context(), grpcRequest)
 		if err != nil {
 			message := domain.Message{
 				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
@@ -145,13 +260,7 @@ func GetAllItems(inventoryService proto.InventoryServiceClient) http.HandlerFunc
 		var response domain.GetAllItemsResponse
 
 		for _, item := range resp.Items {
-			response.Items = append(response.Items, domain.GetItemResponse{
-				ID:          item.Id,
-				Name:        item.Name,
-				Description: item.Description,
-				Quantity:    item.Quantity,
-				Price:       item.Price,
-			})
+			response.Items = append(response.Items, toItemResponse(item))
 		}
 
 		res, err := json.Marshal(response)
@@ -188,9 +297,31 @@ func AddQuantity(inventoryService proto.InventoryServiceClient) http.HandlerFunc
 			return
 		}
 
+		reason, ok := domain.MovementReasonMap[requestBody.Reason]
+		if requestBody.Reason != "" && !ok {
+			message := domain.Message{
+				Message: fmt.Sprintf("invalid reason: %s", requestBody.Reason),
+			}
+			rw.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
 		grpcRequest := proto.AddQuantityRequest{
-			Id:       requestBody.ID,
-			Quantity: requestBody.Quantity,
+			Id:         requestBody.ID,
+			Sku:        requestBody.SKU,
+			Barcode:    requestBody.Barcode,
+			Quantity:   requestBody.Quantity,
+			Reason:     reason,
+			Reference:  requestBody.Reference,
+			Actor:      actorFromContext(req.Context()),
+			LocationId: requestBody.LocationID,
+			ExpiresAt:  requestBody.ExpiresAt,
+			Amount:     requestBody.Amount,
+			Unit:       requestBody.Unit,
+		}
+		if requestBody.UnitCost != nil {
+			grpcRequest.UnitCost = toMoneyProto(*requestBody.UnitCost)
 		}
 
 		resp, err := inventoryService.AddQuantity(req.Context(), &grpcRequest)
@@ -206,6 +337,8 @@ func AddQuantity(inventoryService proto.InventoryServiceClient) http.HandlerFunc
 		response := domain.UpdateQuantityResponse{
 			ID:       resp.Id,
 			Quantity: resp.Quantity,
+			Amount:   resp.Amount,
+			LotID:    resp.LotId,
 		}
 
 		res, err := json.Marshal(response)
@@ -242,9 +375,27 @@ func LowerQuantity(inventoryService proto.InventoryServiceClient) http.HandlerFu
 			return
 		}
 
+		reason, ok := domain.MovementReasonMap[requestBody.Reason]
+		if requestBody.Reason != "" && !ok {
+			message := domain.Message{
+				Message: fmt.Sprintf("invalid reason: %s", requestBody.Reason),
+			}
+			rw.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
 		grpcRequest := proto.LowerQuantityRequest{
-			Id:       requestBody.ID,
-			Quantity: requestBody.Quantity,
+			Id:         requestBody.ID,
+			Sku:        requestBody.SKU,
+			Barcode:    requestBody.Barcode,
+			Quantity:   requestBody.Quantity,
+			Reason:     reason,
+			Reference:  requestBody.Reference,
+			Actor:      actorFromContext(req.Context()),
+			LocationId: requestBody.LocationID,
+			Amount:     requestBody.Amount,
+			Unit:       requestBody.Unit,
 		}
 
 		resp, err := inventoryService.LowerQuantity(req.Context(), &grpcRequest)
@@ -260,6 +411,7 @@ func LowerQuantity(inventoryService proto.InventoryServiceClient) http.HandlerFu
 		response := domain.UpdateQuantityResponse{
 			ID:       resp.Id,
 			Quantity: resp.Quantity,
+			Amount:   resp.Amount,
 		}
 
 		res, err := json.Marshal(response)
@@ -331,3 +483,109 @@ func DeleteItem(inventoryService proto.InventoryServiceClient) http.HandlerFunc
 		rw.Write(res)
 	})
 }
+
+func UpdateItem(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPatch {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		itemID, err := strconv.ParseInt(mux.Vars(req)["id"], 10, 32)
+		if err != nil {
+			http.Error(rw, "Invalid ID", http.StatusBadRequest)
+			return
+		}
+
+		var requestBody domain.UpdateItemRequest
+
+		if err := json.NewDecoder(req.Body).Decode(&requestBody); err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("invalid request body: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		grpcRequest := &proto.UpdateItemRequest{
+			Id:         int32(itemID),
+			UpdateMask: &fieldmaskpb.FieldMask{},
+		}
+		if requestBody.Name != nil {
+			grpcRequest.Name = *requestBody.Name
+			grpcRequest.UpdateMask.Paths = append(grpcRequest.UpdateMask.Paths, "name")
+		}
+		if requestBody.Description != nil {
+			grpcRequest.Description = *requestBody.Description
+			grpcRequest.UpdateMask.Paths = append(grpcRequest.UpdateMask.Paths, "description")
+		}
+		if requestBody.Price != nil {
+			grpcRequest.Price = toMoneyProto(*requestBody.Price)
+			grpcRequest.UpdateMask.Paths = append(grpcRequest.UpdateMask.Paths, "price")
+		}
+		if requestBody.CostPrice != nil {
+			grpcRequest.CostPrice = toMoneyProto(*requestBody.CostPrice)
+			grpcRequest.UpdateMask.Paths = append(grpcRequest.UpdateMask.Paths, "cost_price")
+		}
+		if requestBody.Category != nil {
+			grpcRequest.Category = *requestBody.Category
+			grpcRequest.UpdateMask.Paths = append(grpcRequest.UpdateMask.Paths, "category")
+		}
+		if requestBody.Allergens != nil {
+			grpcRequest.Allergens = *requestBody.Allergens
+			grpcRequest.UpdateMask.Paths = append(grpcRequest.UpdateMask.Paths, "allergens")
+		}
+		if requestBody.DietaryLabels != nil {
+			grpcRequest.DietaryLabels = *requestBody.DietaryLabels
+			grpcRequest.UpdateMask.Paths = append(grpcRequest.UpdateMask.Paths, "dietary_labels")
+		}
+		if requestBody.Nutrition != nil {
+			grpcRequest.Nutrition = &proto.Nutrition{
+				Kcal:          requestBody.Nutrition.Kcal,
+				Protein:       requestBody.Nutrition.Protein,
+				Carbohydrates: requestBody.Nutrition.Carbohydrates,
+				Fat:           requestBody.Nutrition.Fat,
+			}
+			grpcRequest.UpdateMask.Paths = append(grpcRequest.UpdateMask.Paths, "nutrition")
+		}
+		if requestBody.Unit != nil {
+			grpcRequest.Unit = *requestBody.Unit
+			grpcRequest.UpdateMask.Paths = append(grpcRequest.UpdateMask.Paths, "unit")
+		}
+		if requestBody.SaleUnit != nil {
+			grpcRequest.SaleUnit = *requestBody.SaleUnit
+			grpcRequest.UpdateMask.Paths = append(grpcRequest.UpdateMask.Paths, "sale_unit")
+		}
+		if len(grpcRequest.UpdateMask.Paths) == 0 {
+			http.Error(rw, "Nothing to update", http.StatusBadRequest)
+			return
+		}
+
+		resp, err := inventoryService.UpdateItem(req.Context(), grpcRequest)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		response := toItemResponse(resp.Item)
+
+		res, err := json.Marshal(response)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
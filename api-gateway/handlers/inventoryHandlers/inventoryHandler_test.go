@@ -5,6 +5,7 @@ import (
 	"api-gateway/domain"
 	mocks "api-gateway/mocks/inventorymocks"
 	proto "api-gateway/proto/inventory"
+	moneyproto "api-gateway/proto/money"
 	"context"
 	"encoding/json"
 	"errors"
@@ -13,8 +14,11 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 type InventoryHandlersTestSuite struct {
@@ -43,7 +47,7 @@ func (suite *InventoryHandlersTestSuite) TestInventoryHandler_AddItem() {
 		requestBody := domain.AddItemRequest{
 			Name:        "test1",
 			Description: "test1",
-			Price:       100,
+			Price:       domain.Money{MinorUnits: 10000, Currency: "EUR"},
 			Quantity:    10,
 		}
 
@@ -51,7 +55,7 @@ func (suite *InventoryHandlersTestSuite) TestInventoryHandler_AddItem() {
 			Name:        requestBody.Name,
 			Description: requestBody.Description,
 			Quantity:    requestBody.Quantity,
-			Price:       requestBody.Price,
+			Price:       toMoneyProto(requestBody.Price),
 		}
 
 		expectedResponse := proto.AddItemResponse{
@@ -59,7 +63,7 @@ func (suite *InventoryHandlersTestSuite) TestInventoryHandler_AddItem() {
 			Id:          1,
 			Name:        requestBody.Name,
 			Description: requestBody.Description,
-			Price:       requestBody.Price,
+			Price:       toMoneyProto(requestBody.Price),
 			Quantity:    requestBody.Quantity,
 		}
 
@@ -92,12 +96,53 @@ func (suite *InventoryHandlersTestSuite) TestInventoryHandler_AddItem() {
 		assert.Equal(t, string(exp), res.Body.String())
 	})
 
+	t.Run("expect to pass allergens, dietary labels and nutrition on", func(t *testing.T) {
+		// Arrange
+		kcal := float32(320)
+		body := `{"name":"paneer roll","description":"wrap","price":{"minor_units":12000,"currency":"EUR"},"quantity":5,"allergens":["milk","gluten"],"dietary_labels":["veg"],"nutrition":{"kcal":320}}`
+
+		expectedRequest := &proto.AddItemRequest{
+			Name:          "paneer roll",
+			Description:   "wrap",
+			Price:         &moneyproto.Money{MinorUnits: 12000, Currency: "EUR"},
+			Quantity:      5,
+			Allergens:     []string{"milk", "gluten"},
+			DietaryLabels: []string{"veg"},
+			Nutrition:     &proto.Nutrition{Kcal: &kcal},
+		}
+
+		expectedResponse := &proto.AddItemResponse{
+			StatusCode:    http.StatusCreated,
+			Id:            2,
+			Name:          "paneer roll",
+			Description:   "wrap",
+			Price:         &moneyproto.Money{MinorUnits: 12000, Currency: "EUR"},
+			Quantity:      5,
+			Allergens:     []string{"gluten", "milk"},
+			DietaryLabels: []string{"veg"},
+			Nutrition:     &proto.Nutrition{Kcal: &kcal},
+		}
+
+		req := httptest.NewRequest("POST", "/admin/inventory/item/add", strings.NewReader(body))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("AddItem", context.Background(), expectedRequest).Return(expectedResponse, nil).Once()
+
+		handler := AddItem(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, res.Code)
+		assert.Contains(t, res.Body.String(), `"allergens":["gluten","milk"]`)
+	})
+
 	t.Run("expect to return 400 when item name is empty", func(t *testing.T) {
 		// Arrange
 		requestBody := domain.AddItemRequest{
 			Name:        "",
 			Description: "test1",
-			Price:       100,
+			Price:       domain.Money{MinorUnits: 10000, Currency: "EUR"},
 			Quantity:    10,
 		}
 
@@ -105,7 +150,7 @@ func (suite *InventoryHandlersTestSuite) TestInventoryHandler_AddItem() {
 			Name:        requestBody.Name,
 			Description: requestBody.Description,
 			Quantity:    requestBody.Quantity,
-			Price:       requestBody.Price,
+			Price:       toMoneyProto(requestBody.Price),
 		}
 
 		expectedResponse := proto.AddItemResponse{
@@ -113,7 +158,7 @@ func (suite *InventoryHandlersTestSuite) TestInventoryHandler_AddItem() {
 			Id:          0,
 			Name:        "",
 			Description: "",
-			Price:       0,
+			Price:       nil,
 			Quantity:    0,
 		}
 
@@ -147,7 +192,7 @@ func (suite *InventoryHandlersTestSuite) TestInventoryHandler_AddItem() {
 		requestBody := domain.AddItemRequest{
 			Name:        "test1",
 			Description: "",
-			Price:       100,
+			Price:       domain.Money{MinorUnits: 10000, Currency: "EUR"},
 			Quantity:    10,
 		}
 
@@ -155,7 +200,7 @@ func (suite *InventoryHandlersTestSuite) TestInventoryHandler_AddItem() {
 			Name:        requestBody.Name,
 			Description: requestBody.Description,
 			Quantity:    requestBody.Quantity,
-			Price:       requestBody.Price,
+			Price:       toMoneyProto(requestBody.Price),
 		}
 
 		expectedResponse := proto.AddItemResponse{
@@ -163,7 +208,7 @@ func (suite *InventoryHandlersTestSuite) TestInventoryHandler_AddItem() {
 			Id:          0,
 			Name:        "",
 			Description: "",
-			Price:       0,
+			Price:       nil,
 			Quantity:    0,
 		}
 
@@ -197,7 +242,7 @@ func (suite *InventoryHandlersTestSuite) TestInventoryHandler_AddItem() {
 		requestBody := domain.AddItemRequest{
 			Name:        "test1",
 			Description: "test1",
-			Price:       0,
+			Price:       domain.Money{},
 			Quantity:    10,
 		}
 
@@ -205,7 +250,7 @@ func (suite *InventoryHandlersTestSuite) TestInventoryHandler_AddItem() {
 			Name:        requestBody.Name,
 			Description: requestBody.Description,
 			Quantity:    requestBody.Quantity,
-			Price:       requestBody.Price,
+			Price:       toMoneyProto(requestBody.Price),
 		}
 
 		expectedResponse := proto.AddItemResponse{
@@ -213,7 +258,7 @@ func (suite *InventoryHandlersTestSuite) TestInventoryHandler_AddItem() {
 			Id:          0,
 			Name:        "",
 			Description: "",
-			Price:       0,
+			Price:       nil,
 			Quantity:    0,
 		}
 
@@ -247,7 +292,7 @@ func (suite *InventoryHandlersTestSuite) TestInventoryHandler_AddItem() {
 		requestBody := domain.AddItemRequest{
 			Name:        "test1",
 			Description: "test1",
-			Price:       100,
+			Price:       domain.Money{MinorUnits: 10000, Currency: "EUR"},
 			Quantity:    0,
 		}
 
@@ -255,7 +300,7 @@ func (suite *InventoryHandlersTestSuite) TestInventoryHandler_AddItem() {
 			Name:        requestBody.Name,
 			Description: requestBody.Description,
 			Quantity:    requestBody.Quantity,
-			Price:       requestBody.Price,
+			Price:       toMoneyProto(requestBody.Price),
 		}
 
 		expectedResponse := proto.AddItemResponse{
@@ -263,7 +308,7 @@ func (suite *InventoryHandlersTestSuite) TestInventoryHandler_AddItem() {
 			Id:          0,
 			Name:        "",
 			Description: "",
-			Price:       0,
+			Price:       nil,
 			Quantity:    0,
 		}
 
@@ -297,7 +342,7 @@ func (suite *InventoryHandlersTestSuite) TestInventoryHandler_AddItem() {
 		requestBody := domain.AddItemRequest{
 			Name:        "test1",
 			Description: "test1",
-			Price:       -100,
+			Price:       domain.Money{MinorUnits: -10000, Currency: "EUR"},
 			Quantity:    10,
 		}
 
@@ -305,7 +350,7 @@ func (suite *InventoryHandlersTestSuite) TestInventoryHandler_AddItem() {
 			Name:        requestBody.Name,
 			Description: requestBody.Description,
 			Quantity:    requestBody.Quantity,
-			Price:       requestBody.Price,
+			Price:       toMoneyProto(requestBody.Price),
 		}
 
 		expectedResponse := proto.AddItemResponse{
@@ -313,7 +358,7 @@ func (suite *InventoryHandlersTestSuite) TestInventoryHandler_AddItem() {
 			Id:          0,
 			Name:        "",
 			Description: "",
-			Price:       0,
+			Price:       nil,
 			Quantity:    0,
 		}
 
@@ -347,7 +392,7 @@ func (suite *InventoryHandlersTestSuite) TestInventoryHandler_AddItem() {
 		requestBody := domain.AddItemRequest{
 			Name:        "test1",
 			Description: "test1",
-			Price:       100,
+			Price:       domain.Money{MinorUnits: 10000, Currency: "EUR"},
 			Quantity:    10,
 		}
 
@@ -355,7 +400,7 @@ func (suite *InventoryHandlersTestSuite) TestInventoryHandler_AddItem() {
 			Name:        requestBody.Name,
 			Description: requestBody.Description,
 			Quantity:    requestBody.Quantity,
-			Price:       requestBody.Price,
+			Price:       toMoneyProto(requestBody.Price),
 		}
 
 		expectedResponse := proto.AddItemResponse{
@@ -363,7 +408,7 @@ func (suite *InventoryHandlersTestSuite) TestInventoryHandler_AddItem() {
 			Id:          0,
 			Name:        "",
 			Description: "",
-			Price:       0,
+			Price:       nil,
 			Quantity:    0,
 		}
 
@@ -426,7 +471,7 @@ func (suite *InventoryHandlersTestSuite) TestInventoryHandler_GetItem() {
 			Id:          1,
 			Name:        "test1",
 			Description: "test1",
-			Price:       100,
+			Price:       &moneyproto.Money{MinorUnits: 10000, Currency: "EUR"},
 			Quantity:    10,
 		}
 
@@ -434,7 +479,7 @@ func (suite *InventoryHandlersTestSuite) TestInventoryHandler_GetItem() {
 			ID:          expectedResponse.Id,
 			Name:        expectedResponse.Name,
 			Description: expectedResponse.Description,
-			Price:       expectedResponse.Price,
+			Price:       toMoney(expectedResponse.Price),
 			Quantity:    expectedResponse.Quantity,
 		}
 
@@ -474,7 +519,7 @@ func (suite *InventoryHandlersTestSuite) TestInventoryHandler_GetItem() {
 			Id:          0,
 			Name:        "",
 			Description: "",
-			Price:       0,
+			Price:       nil,
 			Quantity:    0,
 		}
 
@@ -516,7 +561,7 @@ func (suite *InventoryHandlersTestSuite) TestInventoryHandler_GetItem() {
 			Id:          0,
 			Name:        "",
 			Description: "",
-			Price:       0,
+			Price:       nil,
 			Quantity:    0,
 		}
 
@@ -576,7 +621,7 @@ func (suite *InventoryHandlersTestSuite) TestInventoryHandler_GetAllItems() {
 					Id:          1,
 					Name:        "test1",
 					Description: "test1",
-					Price:       100,
+					Price:       &moneyproto.Money{MinorUnits: 10000, Currency: "EUR"},
 					Quantity:    10,
 				},
 				{
@@ -584,7 +629,7 @@ func (suite *InventoryHandlersTestSuite) TestInventoryHandler_GetAllItems() {
 					Id:          2,
 					Name:        "test2",
 					Description: "test2",
-					Price:       200,
+					Price:       &moneyproto.Money{MinorUnits: 20000, Currency: "EUR"},
 					Quantity:    20,
 				},
 			},
@@ -596,14 +641,14 @@ func (suite *InventoryHandlersTestSuite) TestInventoryHandler_GetAllItems() {
 					ID:          1,
 					Name:        "test1",
 					Description: "test1",
-					Price:       100,
+					Price:       domain.Money{MinorUnits: 10000, Currency: "EUR"},
 					Quantity:    10,
 				},
 				{
 					ID:          2,
 					Name:        "test2",
 					Description: "test2",
-					Price:       200,
+					Price:       domain.Money{MinorUnits: 20000, Currency: "EUR"},
 					Quantity:    20,
 				},
 			},
@@ -727,6 +772,33 @@ func (suite *InventoryHandlersTestSuite) TestInventoryHandler_AddQuantity() {
 		assert.Equal(t, string(exp), strings.Split(res.Body.String(), "\n")[0])
 	})
 
+	t.Run("expect to pass the SKU on when the item is addressed by it", func(t *testing.T) {
+		// Arrange
+		request := domain.UpdateQuantityRequest{
+			SKU:      "DRK-001",
+			Quantity: 10,
+		}
+		expectedRequest := proto.AddQuantityRequest{
+			Sku:      "DRK-001",
+			Quantity: 10,
+		}
+
+		body, err := json.Marshal(request)
+		assert.NoError(t, err)
+		req := httptest.NewRequest("POST", "/admin/inventory/item/quantity/add", strings.NewReader(string(body)))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("AddQuantity", context.Background(), &expectedRequest).Return(&proto.AddQuantityResponse{StatusCode: http.StatusOK, Id: 3, Quantity: 25}, nil).Once()
+
+		handler := AddQuantity(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, `{"id":3,"quantity":25}`, strings.Split(res.Body.String(), "\n")[0])
+	})
+
 	t.Run("expect to return 400 when request body is invalid", func(t *testing.T) {
 		// Arrange
 		request := domain.UpdateQuantityRequest{
@@ -1122,4 +1194,277 @@ func (suite *InventoryHandlersTestSuite) TestInventoryHandler_DeleteItem() {
 		assert.Equal(t, http.StatusNotFound, res.Code)
 		assert.Equal(t, string(exp), strings.Split(res.Body.String(), "\n")[0])
 	})
-}
\ No newline at end of file
+}
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_UpdateItem() {
+	t := suite.T()
+
+	t.Run("expect to return 200 when only the given fields are updated", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.UpdateItemRequest{
+			Id:         1,
+			Name:       "paneer tikka",
+			Price:      &moneyproto.Money{MinorUnits: 27000, Currency: "EUR"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name", "price"}},
+		}
+
+		expectedResponse := &proto.UpdateItemResponse{
+			StatusCode: http.StatusOK,
+			Item: &proto.GetItemResponse{
+				Id:          1,
+				Name:        "paneer tikka",
+				Description: "grilled paneer",
+				Price:       &moneyproto.Money{MinorUnits: 27000, Currency: "EUR"},
+				Quantity:    10,
+			},
+		}
+
+		response := domain.GetItemResponse{
+			ID:          1,
+			Name:        "paneer tikka",
+			Description: "grilled paneer",
+			Price:       domain.Money{MinorUnits: 27000, Currency: "EUR"},
+			Quantity:    10,
+		}
+
+		exp, err := json.Marshal(response)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("PATCH", "/admin/inventory/items/1", strings.NewReader(`{"name":"paneer tikka","price":{"minor_units":27000,"currency":"EUR"}}`))
+		req = mux.SetURLVars(req, map[string]string{"id": "1"})
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("UpdateItem", mock.Anything, expectedRequest).Return(expectedResponse, nil).Once()
+
+		handler := UpdateItem(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+
+	t.Run("expect to return 400 when no field is given", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("PATCH", "/admin/inventory/items/1", strings.NewReader(`{}`))
+		req = mux.SetURLVars(req, map[string]string{"id": "1"})
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := UpdateItem(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("expect to return 400 when id isn't a number", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("PATCH", "/admin/inventory/items/abc", strings.NewReader(`{"name":"naan"}`))
+		req = mux.SetURLVars(req, map[string]string{"id": "abc"})
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := UpdateItem(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("expect to return 422 when the name belongs to another item", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.UpdateItemRequest{
+			Id:         2,
+			Name:       "paneer tikka",
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+		}
+
+		expectedResponse := &proto.UpdateItemResponse{
+			StatusCode: http.StatusUnprocessableEntity,
+		}
+
+		response := domain.Message{
+			Message: "grpc received error: item already exists",
+		}
+
+		exp, err := json.Marshal(response)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("PATCH", "/admin/inventory/items/2", strings.NewReader(`{"name":"paneer tikka"}`))
+		req = mux.SetURLVars(req, map[string]string{"id": "2"})
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("UpdateItem", mock.Anything, expectedRequest).Return(expectedResponse, errors.New("item already exists")).Once()
+
+		handler := UpdateItem(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusUnprocessableEntity, res.Code)
+		assert.Equal(t, string(exp), strings.Split(res.Body.String(), "\n")[0])
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_GetAllItemsFiltered() {
+	t := suite.T()
+
+	t.Run("expect to pass allergen and diet filters and return dietary details", func(t *testing.T) {
+		// Arrange
+		kcal := float32(320)
+		expectedRequest := &proto.GetAllItemsRequest{
+			ExcludeAllergens: []string{"nuts", "gluten", "milk"},
+			DietaryLabels:    []string{"vegan"},
+		}
+
+		expectedResponse := &proto.GetAllItemsResponse{
+			StatusCode: http.StatusOK,
+			Items: []*proto.GetItemResponse{
+				{
+					Id:            1,
+					Name:          "chana masala",
+					Description:   "chickpea curry",
+					Quantity:      10,
+					Price:         &moneyproto.Money{MinorUnits: 18000, Currency: "EUR"},
+					Allergens:     []string{"mustard"},
+					DietaryLabels: []string{"veg", "vegan"},
+					Nutrition:     &proto.Nutrition{Kcal: &kcal},
+				},
+			},
+		}
+
+		exp, err := json.Marshal(domain.GetAllItemsResponse{
+			Items: []domain.GetItemResponse{
+				{
+					ID:            1,
+					Name:          "chana masala",
+					Description:   "chickpea curry",
+					Quantity:      10,
+					Price:         domain.Money{MinorUnits: 18000, Currency: "EUR"},
+					Allergens:     []string{"mustard"},
+					DietaryLabels: []string{"veg", "vegan"},
+					Nutrition:     &domain.Nutrition{Kcal: &kcal},
+				},
+			},
+		})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("GET", "/inventory/item/all?exclude_allergens=nuts,Gluten&exclude_allergens=milk&diet=vegan", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("GetAllItems", context.Background(), expectedRequest).Return(expectedResponse, nil).Once()
+
+		handler := GetAllItems(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+
+	t.Run("expect to return 400 for an unknown allergen", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("GET", "/inventory/item/all?exclude_allergens=chocolate", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("GetAllItems", context.Background(), &proto.GetAllItemsRequest{ExcludeAllergens: []string{"chocolate"}}).Return(&proto.GetAllItemsResponse{
+			StatusCode: http.StatusBadRequest,
+		}, errors.New("unknown allergen")).Once()
+
+		handler := GetAllItems(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_UpdateItemDietary() {
+	t := suite.T()
+
+	t.Run("expect to send dietary fields in the update mask", func(t *testing.T) {
+		// Arrange
+		fat := float32(12)
+		expectedRequest := &proto.UpdateItemRequest{
+			Id:            3,
+			Allergens:     []string{},
+			DietaryLabels: []string{"halal"},
+			Nutrition:     &proto.Nutrition{Fat: &fat},
+			UpdateMask:    &fieldmaskpb.FieldMask{Paths: []string{"allergens", "dietary_labels", "nutrition"}},
+		}
+
+		req := httptest.NewRequest("PATCH", "/admin/inventory/items/3", strings.NewReader(`{"allergens":[],"dietary_labels":["halal"],"nutrition":{"fat":12}}`))
+		req = mux.SetURLVars(req, map[string]string{"id": "3"})
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("UpdateItem", mock.Anything, expectedRequest).Return(&proto.UpdateItemResponse{
+			StatusCode: http.StatusOK,
+			Item:       &proto.GetItemResponse{Id: 3, Name: "kebab", DietaryLabels: []string{"halal"}},
+		}, nil).Once()
+
+		handler := UpdateItem(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_Units() {
+	t := suite.T()
+
+	t.Run("expect to send units in the update mask", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.UpdateItemRequest{
+			Id:         5,
+			Unit:       "dozen",
+			SaleUnit:   "each",
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"unit", "sale_unit"}},
+		}
+
+		req := httptest.NewRequest("PATCH", "/admin/inventory/items/5", strings.NewReader(`{"unit":"dozen","sale_unit":"each"}`))
+		req = mux.SetURLVars(req, map[string]string{"id": "5"})
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("UpdateItem", mock.Anything, expectedRequest).Return(&proto.UpdateItemResponse{
+			StatusCode: http.StatusOK,
+			Item:       &proto.GetItemResponse{Id: 5, Name: "eggs", Quantity: 30, Amount: "2.5", Unit: "dozen", SaleUnit: "each", AvailableQuantity: 30, AvailableToSell: 30},
+		}, nil).Once()
+
+		handler := UpdateItem(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Contains(t, res.Body.String(), `"amount":"2.5"`)
+		assert.Contains(t, res.Body.String(), `"sale_unit":"each"`)
+	})
+
+	t.Run("expect to pass a decimal amount and its unit on when lowering stock", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.LowerQuantityRequest{
+			Id:     7,
+			Amount: "0.25",
+			Unit:   "kg",
+			Reason: proto.MovementReason_REASON_WASTAGE,
+		}
+
+		req := httptest.NewRequest("POST", "/admin/inventory/item/quantity/remove", strings.NewReader(`{"id":7,"amount":"0.25","unit":"kg","reason":"WASTAGE"}`))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("LowerQuantity", context.Background(), expectedRequest).Return(&proto.LowerQuantityResponse{StatusCode: http.StatusOK, Id: 7, Quantity: 1750, Amount: "1.75"}, nil).Once()
+
+		handler := LowerQuantity(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, `{"id":7,"quantity":1750,"amount":"1.75"}`, strings.Split(res.Body.String(), "\n")[0])
+	})
+}
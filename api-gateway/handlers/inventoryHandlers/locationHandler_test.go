@@ -0,0 +1,197 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_AddLocation() {
+	t := suite.T()
+
+	t.Run("expect to return 201 with the created location", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.AddLocationRequest{
+			Location: &proto.Location{Name: "central store", Kind: "warehouse"},
+		}
+
+		expectedResponse := &proto.AddLocationResponse{
+			StatusCode: http.StatusCreated,
+			Location:   &proto.Location{Id: 2, Name: "central store", Kind: "warehouse"},
+		}
+
+		exp, err := json.Marshal(domain.Location{ID: 2, Name: "central store", Kind: "warehouse"})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("POST", "/admin/inventory/locations", strings.NewReader(`{"name":"central store","kind":"warehouse"}`))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("AddLocation", context.Background(), expectedRequest).Return(expectedResponse, nil).Once()
+
+		handler := AddLocation(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+
+	t.Run("expect to return 422 when the name is taken", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.AddLocationRequest{
+			Location: &proto.Location{Name: "main"},
+		}
+
+		expectedResponse := &proto.AddLocationResponse{
+			StatusCode: http.StatusUnprocessableEntity,
+		}
+
+		req := httptest.NewRequest("POST", "/admin/inventory/locations", strings.NewReader(`{"name":"main"}`))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("AddLocation", context.Background(), expectedRequest).Return(expectedResponse, errors.New("location already exists")).Once()
+
+		handler := AddLocation(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusUnprocessableEntity, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_GetItemStock() {
+	t := suite.T()
+
+	t.Run("expect to return 200 with the stock at each location", func(t *testing.T) {
+		// Arrange
+		expectedResponse := &proto.GetItemStockResponse{
+			StatusCode: http.StatusOK,
+			ItemId:     1,
+			Quantity:   8,
+			Levels: []*proto.LocationStock{
+				{LocationId: 1, Quantity: 5},
+				{LocationId: 2, Quantity: 3},
+			},
+		}
+
+		exp, err := json.Marshal(domain.ItemStockResponse{
+			ItemID:   1,
+			Quantity: 8,
+			Levels: []domain.LocationStock{
+				{LocationID: 1, Quantity: 5},
+				{LocationID: 2, Quantity: 3},
+			},
+		})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("GET", "/admin/inventory/item/stock?item_id=1", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("GetItemStock", context.Background(), &proto.GetItemStockRequest{ItemId: 1}).Return(expectedResponse, nil).Once()
+
+		handler := GetItemStock(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+
+	t.Run("expect to return 400 with an invalid item id", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("GET", "/admin/inventory/item/stock?item_id=abc", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := GetItemStock(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_TransferStock() {
+	t := suite.T()
+
+	t.Run("expect to return 200 with both legs of the transfer", func(t *testing.T) {
+		// Arrange
+		body := `{"item_id":1,"from_location_id":1,"to_location_id":2,"quantity":3,"reference":"TR-1"}`
+
+		expectedRequest := &proto.TransferStockRequest{
+			ItemId:         1,
+			FromLocationId: 1,
+			ToLocationId:   2,
+			Quantity:       3,
+			Reference:      "TR-1",
+			Actor:          "4",
+		}
+
+		expectedResponse := &proto.TransferStockResponse{
+			StatusCode: http.StatusOK,
+			Movements: []*proto.StockMovement{
+				{Id: 7, ItemId: 1, LocationId: 1, Delta: -3, Balance: 8, Reason: proto.MovementReason_REASON_TRANSFER, Reference: "TR-1", Actor: "4"},
+				{Id: 8, ItemId: 1, LocationId: 2, Delta: 3, Balance: 8, Reason: proto.MovementReason_REASON_TRANSFER, Reference: "TR-1", Actor: "4", PairedWith: 7},
+			},
+		}
+
+		exp, err := json.Marshal(domain.TransferStockResponse{
+			Movements: []domain.StockMovement{
+				{ID: 7, ItemID: 1, LocationID: 1, Delta: -3, Balance: 8, Reason: "TRANSFER", Reference: "TR-1", Actor: "4"},
+				{ID: 8, ItemID: 1, LocationID: 2, Delta: 3, Balance: 8, Reason: "TRANSFER", Reference: "TR-1", Actor: "4", PairedWith: 7},
+			},
+		})
+		assert.NoError(t, err)
+
+		ctx := context.WithValue(context.Background(), "id", 4)
+		req := httptest.NewRequest("POST", "/admin/inventory/item/transfer", strings.NewReader(body)).WithContext(ctx)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("TransferStock", ctx, expectedRequest).Return(expectedResponse, nil).Once()
+
+		handler := TransferStock(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+
+	t.Run("expect to return 409 when the source location is short", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.TransferStockRequest{
+			ItemId:         1,
+			FromLocationId: 2,
+			ToLocationId:   1,
+			Quantity:       30,
+		}
+
+		expectedResponse := &proto.TransferStockResponse{
+			StatusCode: http.StatusConflict,
+		}
+
+		req := httptest.NewRequest("POST", "/admin/inventory/item/transfer", strings.NewReader(`{"item_id":1,"from_location_id":2,"to_location_id":1,"quantity":30}`))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("TransferStock", context.Background(), expectedRequest).Return(expectedResponse, errors.New("insufficient quantity")).Once()
+
+		handler := TransferStock(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusConflict, res.Code)
+	})
+}
@@ -0,0 +1,156 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_AddQuantityAsLot() {
+	t := suite.T()
+
+	t.Run("expect to return 201 with the lot the stock was received into", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.AddQuantityRequest{
+			Id:        1,
+			Quantity:  6,
+			ExpiresAt: "2026-03-05T00:00:00Z",
+		}
+
+		expectedResponse := &proto.AddQuantityResponse{
+			StatusCode: http.StatusCreated,
+			Id:         1,
+			Quantity:   10,
+			LotId:      3,
+		}
+
+		exp, err := json.Marshal(domain.UpdateQuantityResponse{ID: 1, Quantity: 10, LotID: 3})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("POST", "/admin/inventory/item/quantity/add", strings.NewReader(`{"id":1,"quantity":6,"expires_at":"2026-03-05T00:00:00Z"}`))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("AddQuantity", context.Background(), expectedRequest).Return(expectedResponse, nil).Once()
+
+		handler := AddQuantity(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+
+	t.Run("expect to return 400 when the lot has already expired", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.AddQuantityRequest{
+			Id:        1,
+			Quantity:  6,
+			ExpiresAt: "2020-01-01T00:00:00Z",
+		}
+
+		expectedResponse := &proto.AddQuantityResponse{
+			StatusCode: http.StatusBadRequest,
+		}
+
+		req := httptest.NewRequest("POST", "/admin/inventory/item/quantity/add", strings.NewReader(`{"id":1,"quantity":6,"expires_at":"2020-01-01T00:00:00Z"}`))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("AddQuantity", context.Background(), expectedRequest).Return(expectedResponse, errors.New("expiry must be in the future")).Once()
+
+		handler := AddQuantity(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_ListLots() {
+	t := suite.T()
+
+	t.Run("expect to return 200 with the item's lots", func(t *testing.T) {
+		// Arrange
+		expectedResponse := &proto.ListLotsResponse{
+			StatusCode: http.StatusOK,
+			Lots: []*proto.Lot{
+				{Id: 3, ItemId: 1, LocationId: 1, Received: 6, Quantity: 4, ReceivedAt: "2026-03-02T09:00:00Z", ExpiresAt: "2026-03-05T00:00:00Z", Reference: "GRN-1"},
+			},
+		}
+
+		exp, err := json.Marshal([]domain.Lot{
+			{ID: 3, ItemID: 1, LocationID: 1, Received: 6, Quantity: 4, ReceivedAt: "2026-03-02T09:00:00Z", ExpiresAt: "2026-03-05T00:00:00Z", Reference: "GRN-1"},
+		})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("GET", "/admin/inventory/item/lots?item_id=1", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("ListLots", context.Background(), &proto.ListLotsRequest{ItemId: 1}).Return(expectedResponse, nil).Once()
+
+		handler := ListLots(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+
+	t.Run("expect to return 400 with an invalid item id", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("GET", "/admin/inventory/item/lots?item_id=abc", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := ListLots(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_WriteOffExpiredLots() {
+	t := suite.T()
+
+	t.Run("expect to return 200 with a wastage movement per lot", func(t *testing.T) {
+		// Arrange
+		expectedResponse := &proto.WriteOffExpiredLotsResponse{
+			StatusCode: http.StatusOK,
+			Movements: []*proto.StockMovement{
+				{Id: 9, ItemId: 1, LocationId: 1, LotId: 3, Delta: -4, Balance: 2, Reason: proto.MovementReason_REASON_WASTAGE, Reference: "expired lot 3", Actor: "4"},
+			},
+		}
+
+		exp, err := json.Marshal(domain.WriteOffExpiredLotsResponse{
+			Movements: []domain.StockMovement{
+				{ID: 9, ItemID: 1, LocationID: 1, LotID: 3, Delta: -4, Balance: 2, Reason: "WASTAGE", Reference: "expired lot 3", Actor: "4"},
+			},
+		})
+		assert.NoError(t, err)
+
+		ctx := context.WithValue(context.Background(), "id", 4)
+		req := httptest.NewRequest("POST", "/admin/inventory/lots/expired/writeoff", nil).WithContext(ctx)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("WriteOffExpiredLots", ctx, &proto.WriteOffExpiredLotsRequest{Actor: "4"}).Return(expectedResponse, nil).Once()
+
+		handler := WriteOffExpiredLots(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+}
@@ -0,0 +1,162 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// actorFromContext returns the id of the authenticated user set by the auth middleware.
+func actorFromContext(ctx context.Context) string {
+	id := ctx.Value("id")
+	if id == nil {
+		return ""
+	}
+	return fmt.Sprint(id)
+}
+
+func toStockMovement(movement *proto.StockMovement) domain.StockMovement {
+	return domain.StockMovement{
+		ID:         movement.Id,
+		ItemID:     movement.ItemId,
+		LocationID: movement.LocationId,
+		Delta:      movement.Delta,
+		Balance:    movement.Balance,
+		Reason:     strings.TrimPrefix(movement.Reason.String(), "REASON_"),
+		Reference:  movement.Reference,
+		Actor:      movement.Actor,
+		PairedWith: movement.PairedWith,
+		LotID:      movement.LotId,
+		UnitCost:   toOptionalMoney(movement.UnitCost),
+		CreatedAt:  movement.CreatedAt,
+	}
+}
+
+func ListStockMovements(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		queryParams := req.URL.Query()
+		itemID, err := strconv.ParseInt(queryParams.Get("id"), 10, 32)
+		if err != nil {
+			http.Error(rw, "Invalid ID", http.StatusBadRequest)
+			return
+		}
+
+		grpcRequest := &proto.ListStockMovementsRequest{
+			ItemId: int32(itemID),
+		}
+		if page := queryParams.Get("page"); page != "" {
+			value, err := strconv.ParseUint(page, 10, 32)
+			if err != nil {
+				http.Error(rw, "Invalid page", http.StatusBadRequest)
+				return
+			}
+			grpcRequest.Page = uint32(value)
+		}
+		if pageSize := queryParams.Get("page_size"); pageSize != "" {
+			value, err := strconv.ParseUint(pageSize, 10, 32)
+			if err != nil {
+				http.Error(rw, "Invalid page size", http.StatusBadRequest)
+				return
+			}
+			grpcRequest.PageSize = uint32(value)
+		}
+
+		resp, err := inventoryService.ListStockMovements(req.Context(), grpcRequest)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		response := domain.ListStockMovementsResponse{
+			Movements: []domain.StockMovement{},
+			Total:     resp.Total,
+		}
+		for _, movement := range resp.Movements {
+			response.Movements = append(response.Movements, toStockMovement(movement))
+		}
+
+		res, err := json.Marshal(response)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+func ReconcileStock(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		grpcRequest := &proto.ReconcileStockRequest{}
+		if id := req.URL.Query().Get("id"); id != "" {
+			itemID, err := strconv.ParseInt(id, 10, 32)
+			if err != nil {
+				http.Error(rw, "Invalid ID", http.StatusBadRequest)
+				return
+			}
+			grpcRequest.ItemId = int32(itemID)
+		}
+
+		resp, err := inventoryService.ReconcileStock(req.Context(), grpcRequest)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		response := domain.ReconcileStockResponse{
+			Items: []domain.StockDrift{},
+		}
+		for _, item := range resp.Items {
+			response.Items = append(response.Items, domain.StockDrift{
+				ItemID:         item.ItemId,
+				Name:           item.Name,
+				Quantity:       item.Quantity,
+				LedgerQuantity: item.LedgerQuantity,
+				Drift:          item.Drift,
+			})
+		}
+
+		res, err := json.Marshal(response)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
@@ -0,0 +1,241 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/dependencies"
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_ListStockMovements() {
+	t := suite.T()
+
+	t.Run("expect to return 200 with the movement history", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.ListStockMovementsRequest{
+			ItemId:   1,
+			Page:     2,
+			PageSize: 10,
+		}
+
+		expectedResponse := &proto.ListStockMovementsResponse{
+			StatusCode: http.StatusOK,
+			Movements: []*proto.StockMovement{
+				{
+					Id:        3,
+					ItemId:    1,
+					Delta:     -2,
+					Balance:   8,
+					Reason:    proto.MovementReason_REASON_ORDER,
+					Reference: "12",
+					Actor:     "4",
+					CreatedAt: "2023-06-01T10:00:00Z",
+				},
+			},
+			Total: 11,
+		}
+
+		response := domain.ListStockMovementsResponse{
+			Movements: []domain.StockMovement{
+				{
+					ID:        3,
+					ItemID:    1,
+					Delta:     -2,
+					Balance:   8,
+					Reason:    "ORDER",
+					Reference: "12",
+					Actor:     "4",
+					CreatedAt: "2023-06-01T10:00:00Z",
+				},
+			},
+			Total: 11,
+		}
+
+		exp, err := json.Marshal(response)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("GET", "/admin/inventory/item/movements?id=1&page=2&page_size=10", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("ListStockMovements", context.Background(), expectedRequest).Return(expectedResponse, nil).Once()
+		deps := dependencies.Dependencies{
+			InventoryService: suite.grpc,
+		}
+
+		handler := ListStockMovements(deps.InventoryService)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+
+	t.Run("expect to return 400 when id isn't a number", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("GET", "/admin/inventory/item/movements?id=abc", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := ListStockMovements(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("expect to return 404 when item is not found", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.ListStockMovementsRequest{
+			ItemId: 9,
+		}
+
+		expectedResponse := &proto.ListStockMovementsResponse{
+			StatusCode: http.StatusNotFound,
+		}
+
+		response := domain.Message{
+			Message: "grpc received error: item not found",
+		}
+
+		exp, err := json.Marshal(response)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("GET", "/admin/inventory/item/movements?id=9", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("ListStockMovements", context.Background(), expectedRequest).Return(expectedResponse, errors.New("item not found")).Once()
+
+		handler := ListStockMovements(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, res.Code)
+		assert.Equal(t, string(exp), strings.Split(res.Body.String(), "\n")[0])
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_ReconcileStock() {
+	t := suite.T()
+
+	t.Run("expect to return 200 with the drifting items", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.ReconcileStockRequest{}
+
+		expectedResponse := &proto.ReconcileStockResponse{
+			StatusCode: http.StatusOK,
+			Items: []*proto.StockDrift{
+				{
+					ItemId:         2,
+					Name:           "test2",
+					Quantity:       4,
+					LedgerQuantity: 10,
+					Drift:          -6,
+				},
+			},
+		}
+
+		response := domain.ReconcileStockResponse{
+			Items: []domain.StockDrift{
+				{
+					ItemID:         2,
+					Name:           "test2",
+					Quantity:       4,
+					LedgerQuantity: 10,
+					Drift:          -6,
+				},
+			},
+		}
+
+		exp, err := json.Marshal(response)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("GET", "/admin/inventory/reconcile", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("ReconcileStock", context.Background(), expectedRequest).Return(expectedResponse, nil).Once()
+
+		handler := ReconcileStock(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+
+	t.Run("expect to return 405 when method is not GET", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("POST", "/admin/inventory/reconcile", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := ReconcileStock(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusMethodNotAllowed, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_LowerQuantityWithReason() {
+	t := suite.T()
+
+	t.Run("expect reason and reference to be forwarded", func(t *testing.T) {
+		// Arrange
+		request := domain.UpdateQuantityRequest{
+			ID:        1,
+			Quantity:  2,
+			Reason:    "WASTAGE",
+			Reference: "dropped tray",
+		}
+		expectedRequest := &proto.LowerQuantityRequest{
+			Id:        1,
+			Quantity:  2,
+			Reason:    proto.MovementReason_REASON_WASTAGE,
+			Reference: "dropped tray",
+		}
+
+		expectedResponse := &proto.LowerQuantityResponse{
+			StatusCode: http.StatusOK,
+			Id:         1,
+			Quantity:   8,
+		}
+
+		body, err := json.Marshal(request)
+		assert.NoError(t, err)
+		req := httptest.NewRequest("POST", "/admin/inventory/item/quantity/remove", strings.NewReader(string(body)))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("LowerQuantity", context.Background(), expectedRequest).Return(expectedResponse, nil).Once()
+
+		handler := LowerQuantity(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("expect to return 400 when reason is unknown", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("POST", "/admin/inventory/item/quantity/remove", strings.NewReader(`{"id":1,"quantity":2,"reason":"GIFT"}`))
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := LowerQuantity(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
@@ -0,0 +1,216 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+func toOption(option *proto.Option) domain.Option {
+	return domain.Option{
+		ID:         option.Id,
+		Name:       option.Name,
+		PriceDelta: toMoney(option.PriceDelta),
+		TrackStock: option.TrackStock,
+		Quantity:   option.Quantity,
+	}
+}
+
+func toOptionGroup(group *proto.OptionGroup) domain.OptionGroup {
+	response := domain.OptionGroup{
+		ID:            group.Id,
+		ItemID:        group.ItemId,
+		Name:          group.Name,
+		SelectionType: "single",
+		MinSelections: group.MinSelections,
+		MaxSelections: group.MaxSelections,
+		Required:      group.Required,
+		Options:       []domain.Option{},
+	}
+	if group.SelectionType == proto.SelectionType_SELECTION_MULTI {
+		response.SelectionType = "multi"
+	}
+	for _, option := range group.Options {
+		response.Options = append(response.Options, toOption(option))
+	}
+	return response
+}
+
+func toOptionGroups(groups []*proto.OptionGroup) []domain.OptionGroup {
+	var response []domain.OptionGroup
+	for _, group := range groups {
+		response = append(response, toOptionGroup(group))
+	}
+	return response
+}
+
+func AddOptionGroup(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		var requestBody domain.OptionGroup
+
+		if err := json.NewDecoder(req.Body).Decode(&requestBody); err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("invalid request body: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		selectionType := proto.SelectionType_SELECTION_SINGLE
+		if requestBody.SelectionType != "" {
+			value, ok := domain.SelectionTypeMap[requestBody.SelectionType]
+			if !ok {
+				message := domain.Message{
+					Message: fmt.Sprintf("invalid selection type: %s", requestBody.SelectionType),
+				}
+				rw.WriteHeader(http.StatusBadRequest)
+				json.NewEncoder(rw).Encode(message)
+				return
+			}
+			selectionType = value
+		}
+
+		group := &proto.OptionGroup{
+			ItemId:        requestBody.ItemID,
+			Name:          requestBody.Name,
+			SelectionType: selectionType,
+			MinSelections: requestBody.MinSelections,
+			MaxSelections: requestBody.MaxSelections,
+			Required:      requestBody.Required,
+		}
+		for _, option := range requestBody.Options {
+			group.Options = append(group.Options, &proto.Option{
+				Name:       option.Name,
+				PriceDelta: toMoneyProto(option.PriceDelta),
+				TrackStock: option.TrackStock,
+				Quantity:   option.Quantity,
+			})
+		}
+
+		resp, err := inventoryService.AddOptionGroup(req.Context(), &proto.AddOptionGroupRequest{Group: group})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		res, err := json.Marshal(toOptionGroup(resp.Group))
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+func DeleteOptionGroup(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodDelete {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		groupID, err := strconv.ParseUint(req.URL.Query().Get("id"), 10, 32)
+		if err != nil {
+			http.Error(rw, "Invalid ID", http.StatusBadRequest)
+			return
+		}
+
+		resp, err := inventoryService.DeleteOptionGroup(req.Context(), &proto.DeleteOptionGroupRequest{Id: uint32(groupID)})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		response := domain.Message{
+			Message: resp.Message,
+		}
+
+		res, err := json.Marshal(response)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+func SetOptionStock(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		var requestBody domain.SetOptionStockRequest
+
+		if err := json.NewDecoder(req.Body).Decode(&requestBody); err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("invalid request body: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		grpcRequest := proto.SetOptionStockRequest{
+			Id:         requestBody.ID,
+			TrackStock: requestBody.TrackStock,
+			Quantity:   requestBody.Quantity,
+		}
+
+		resp, err := inventoryService.SetOptionStock(req.Context(), &grpcRequest)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		res, err := json.Marshal(toOption(resp.Option))
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
@@ -0,0 +1,246 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	moneyproto "api-gateway/proto/money"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_AddOptionGroup() {
+	t := suite.T()
+
+	t.Run("expect to return 201 with the created group", func(t *testing.T) {
+		// Arrange
+		body := `{"item_id":1,"name":"toppings","selection_type":"multi","max_selections":2,` +
+			`"options":[{"name":"extra cheese","price_delta":{"minor_units":4000,"currency":"EUR"},"track_stock":true,"quantity":10},{"name":"olives","price_delta":{"minor_units":3000,"currency":"EUR"}}]}`
+
+		expectedRequest := &proto.AddOptionGroupRequest{
+			Group: &proto.OptionGroup{
+				ItemId:        1,
+				Name:          "toppings",
+				SelectionType: proto.SelectionType_SELECTION_MULTI,
+				MaxSelections: 2,
+				Options: []*proto.Option{
+					{Name: "extra cheese", PriceDelta: &moneyproto.Money{MinorUnits: 4000, Currency: "EUR"}, TrackStock: true, Quantity: 10},
+					{Name: "olives", PriceDelta: &moneyproto.Money{MinorUnits: 3000, Currency: "EUR"}},
+				},
+			},
+		}
+
+		expectedResponse := &proto.AddOptionGroupResponse{
+			StatusCode: http.StatusCreated,
+			Group: &proto.OptionGroup{
+				Id:            3,
+				ItemId:        1,
+				Name:          "toppings",
+				SelectionType: proto.SelectionType_SELECTION_MULTI,
+				MaxSelections: 2,
+				Options: []*proto.Option{
+					{Id: 5, Name: "extra cheese", PriceDelta: &moneyproto.Money{MinorUnits: 4000, Currency: "EUR"}, TrackStock: true, Quantity: 10},
+					{Id: 6, Name: "olives", PriceDelta: &moneyproto.Money{MinorUnits: 3000, Currency: "EUR"}},
+				},
+			},
+		}
+
+		response := domain.OptionGroup{
+			ID:            3,
+			ItemID:        1,
+			Name:          "toppings",
+			SelectionType: "multi",
+			MaxSelections: 2,
+			Options: []domain.Option{
+				{ID: 5, Name: "extra cheese", PriceDelta: domain.Money{MinorUnits: 4000, Currency: "EUR"}, TrackStock: true, Quantity: 10},
+				{ID: 6, Name: "olives", PriceDelta: domain.Money{MinorUnits: 3000, Currency: "EUR"}},
+			},
+		}
+
+		exp, err := json.Marshal(response)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("POST", "/admin/inventory/item/options", strings.NewReader(body))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("AddOptionGroup", context.Background(), expectedRequest).Return(expectedResponse, nil).Once()
+
+		handler := AddOptionGroup(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+
+	t.Run("expect to return 400 when selection type is unknown", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("POST", "/admin/inventory/item/options", strings.NewReader(`{"item_id":1,"name":"size","selection_type":"any"}`))
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := AddOptionGroup(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("expect to return 400 when the group is invalid", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.AddOptionGroupRequest{
+			Group: &proto.OptionGroup{
+				ItemId: 1,
+				Name:   "size",
+			},
+		}
+
+		expectedResponse := &proto.AddOptionGroupResponse{
+			StatusCode: http.StatusBadRequest,
+		}
+
+		response := domain.Message{
+			Message: "grpc received error: invalid option group",
+		}
+
+		exp, err := json.Marshal(response)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("POST", "/admin/inventory/item/options", strings.NewReader(`{"item_id":1,"name":"size"}`))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("AddOptionGroup", context.Background(), expectedRequest).Return(expectedResponse, errors.New("invalid option group")).Once()
+
+		handler := AddOptionGroup(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+		assert.Equal(t, string(exp), strings.Split(res.Body.String(), "\n")[0])
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_DeleteOptionGroup() {
+	t := suite.T()
+
+	t.Run("expect to return 200 when group is deleted", func(t *testing.T) {
+		// Arrange
+		expectedResponse := &proto.DeleteOptionGroupResponse{
+			StatusCode: http.StatusOK,
+			Message:    "Option group deleted successfully",
+		}
+
+		req := httptest.NewRequest("DELETE", "/admin/inventory/item/options?id=3", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("DeleteOptionGroup", context.Background(), &proto.DeleteOptionGroupRequest{Id: 3}).Return(expectedResponse, nil).Once()
+
+		handler := DeleteOptionGroup(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, `{"message":"Option group deleted successfully"}`, res.Body.String())
+	})
+
+	t.Run("expect to return 400 when id isn't a number", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("DELETE", "/admin/inventory/item/options?id=abc", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := DeleteOptionGroup(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_SetOptionStock() {
+	t := suite.T()
+
+	t.Run("expect to return 200 with the updated option", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.SetOptionStockRequest{
+			Id:         5,
+			TrackStock: true,
+			Quantity:   25,
+		}
+
+		expectedResponse := &proto.SetOptionStockResponse{
+			StatusCode: http.StatusOK,
+			Option:     &proto.Option{Id: 5, Name: "extra cheese", PriceDelta: &moneyproto.Money{MinorUnits: 4000, Currency: "EUR"}, TrackStock: true, Quantity: 25},
+		}
+
+		exp, err := json.Marshal(domain.Option{ID: 5, Name: "extra cheese", PriceDelta: domain.Money{MinorUnits: 4000, Currency: "EUR"}, TrackStock: true, Quantity: 25})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("POST", "/admin/inventory/item/options/stock", strings.NewReader(`{"id":5,"track_stock":true,"quantity":25}`))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("SetOptionStock", context.Background(), expectedRequest).Return(expectedResponse, nil).Once()
+
+		handler := SetOptionStock(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_GetItemWithOptions() {
+	t := suite.T()
+
+	t.Run("expect option groups to be included with the item", func(t *testing.T) {
+		// Arrange
+		expectedResponse := &proto.GetItemResponse{
+			StatusCode:  http.StatusOK,
+			Id:          1,
+			Name:        "pizza",
+			Description: "margherita",
+			Price:       &moneyproto.Money{MinorUnits: 30000, Currency: "EUR"},
+			Quantity:    20,
+			OptionGroups: []*proto.OptionGroup{
+				{
+					Id:            1,
+					ItemId:        1,
+					Name:          "size",
+					MinSelections: 1,
+					MaxSelections: 1,
+					Required:      true,
+					Options:       []*proto.Option{{Id: 1, Name: "regular"}, {Id: 2, Name: "large", PriceDelta: &moneyproto.Money{MinorUnits: 12000, Currency: "EUR"}}},
+				},
+			},
+		}
+
+		req := httptest.NewRequest("GET", "/inventory/item", strings.NewReader(`{"id":1}`))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("GetItem", context.Background(), &proto.GetItemRequest{Id: 1}).Return(expectedResponse, nil).Once()
+
+		handler := GetItem(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		var response domain.GetItemResponse
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &response))
+		assert.Equal(t, 1, len(response.OptionGroups))
+		assert.Equal(t, "single", response.OptionGroups[0].SelectionType)
+		assert.Equal(t, "large", response.OptionGroups[0].Options[1].Name)
+		assert.Equal(t, domain.Money{MinorUnits: 12000, Currency: "EUR"}, response.OptionGroups[0].Options[1].PriceDelta)
+	})
+}
@@ -0,0 +1,119 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+func toItemPrice(price *proto.ItemPrice) domain.ItemPrice {
+	return domain.ItemPrice{
+		ID:        price.Id,
+		ItemID:    price.ItemId,
+		Price:     toMoney(price.Price),
+		StartsAt:  price.StartsAt,
+		EndsAt:    price.EndsAt,
+		Actor:     price.Actor,
+		CreatedAt: price.CreatedAt,
+	}
+}
+
+// SchedulePrice sets an item's price from a future time, or runs a promotional price
+// between two times.
+func SchedulePrice(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		var requestBody domain.SchedulePriceRequest
+
+		if err := json.NewDecoder(req.Body).Decode(&requestBody); err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("invalid request body: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		resp, err := inventoryService.SchedulePrice(req.Context(), &proto.SchedulePriceRequest{
+			ItemId:   requestBody.ItemID,
+			Price:    toMoneyProto(requestBody.Price),
+			StartsAt: requestBody.StartsAt,
+			EndsAt:   requestBody.EndsAt,
+			Actor:    actorFromContext(req.Context()),
+		})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		res, err := json.Marshal(toItemPrice(resp.Price))
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+// ListPriceHistory returns every price an item has had or is scheduled to have.
+func ListPriceHistory(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		itemID, err := strconv.ParseInt(req.URL.Query().Get("item_id"), 10, 32)
+		if err != nil {
+			http.Error(rw, "Invalid item ID", http.StatusBadRequest)
+			return
+		}
+
+		resp, err := inventoryService.ListPriceHistory(req.Context(), &proto.ListPriceHistoryRequest{ItemId: int32(itemID)})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		prices := []domain.ItemPrice{}
+		for _, price := range resp.Prices {
+			prices = append(prices, toItemPrice(price))
+		}
+
+		res, err := json.Marshal(prices)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
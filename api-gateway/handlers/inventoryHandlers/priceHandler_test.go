@@ -0,0 +1,145 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	moneyproto "api-gateway/proto/money"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_SchedulePrice() {
+	t := suite.T()
+
+	t.Run("expect to return 201 with the scheduled promotion", func(t *testing.T) {
+		// Arrange
+		ctx := context.WithValue(context.Background(), "id", 4)
+		body := `{"item_id":1,"price":{"minor_units":250,"currency":"EUR"},"starts_at":"2026-03-05T00:00:00Z","ends_at":"2026-03-08T00:00:00Z"}`
+
+		expectedRequest := &proto.SchedulePriceRequest{
+			ItemId:   1,
+			Price:    &moneyproto.Money{MinorUnits: 250, Currency: "EUR"},
+			StartsAt: "2026-03-05T00:00:00Z",
+			EndsAt:   "2026-03-08T00:00:00Z",
+			Actor:    "4",
+		}
+
+		expectedResponse := &proto.SchedulePriceResponse{
+			StatusCode: http.StatusCreated,
+			Price: &proto.ItemPrice{
+				Id:        3,
+				ItemId:    1,
+				Price:     &moneyproto.Money{MinorUnits: 250, Currency: "EUR"},
+				StartsAt:  "2026-03-05T00:00:00Z",
+				EndsAt:    "2026-03-08T00:00:00Z",
+				Actor:     "4",
+				CreatedAt: "2026-03-02T09:00:00Z",
+			},
+		}
+
+		exp, err := json.Marshal(domain.ItemPrice{
+			ID:        3,
+			ItemID:    1,
+			Price:     domain.Money{MinorUnits: 250, Currency: "EUR"},
+			StartsAt:  "2026-03-05T00:00:00Z",
+			EndsAt:    "2026-03-08T00:00:00Z",
+			Actor:     "4",
+			CreatedAt: "2026-03-02T09:00:00Z",
+		})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("POST", "/admin/inventory/item/prices", strings.NewReader(body)).WithContext(ctx)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("SchedulePrice", ctx, expectedRequest).Return(expectedResponse, nil).Once()
+
+		handler := SchedulePrice(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+
+	t.Run("expect to return 400 when the price would start in the past", func(t *testing.T) {
+		// Arrange
+		body := `{"item_id":1,"price":{"minor_units":250},"starts_at":"2020-01-01T00:00:00Z"}`
+
+		expectedRequest := &proto.SchedulePriceRequest{
+			ItemId:   1,
+			Price:    &moneyproto.Money{MinorUnits: 250},
+			StartsAt: "2020-01-01T00:00:00Z",
+		}
+
+		expectedResponse := &proto.SchedulePriceResponse{
+			StatusCode: http.StatusBadRequest,
+		}
+
+		req := httptest.NewRequest("POST", "/admin/inventory/item/prices", strings.NewReader(body))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("SchedulePrice", context.Background(), expectedRequest).Return(expectedResponse, errors.New("prices can only be scheduled from now on, and must end after they start")).Once()
+
+		handler := SchedulePrice(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_ListPriceHistory() {
+	t := suite.T()
+
+	t.Run("expect to return 200 with the item's prices", func(t *testing.T) {
+		// Arrange
+		expectedResponse := &proto.ListPriceHistoryResponse{
+			StatusCode: http.StatusOK,
+			Prices: []*proto.ItemPrice{
+				{Id: 1, ItemId: 1, Price: &moneyproto.Money{MinorUnits: 300, Currency: "EUR"}, StartsAt: "2026-03-01T09:00:00Z", CreatedAt: "2026-03-01T09:00:00Z"},
+				{Id: 3, ItemId: 1, Price: &moneyproto.Money{MinorUnits: 350, Currency: "EUR"}, StartsAt: "2026-04-01T00:00:00Z", Actor: "4", CreatedAt: "2026-03-02T09:00:00Z"},
+			},
+		}
+
+		exp, err := json.Marshal([]domain.ItemPrice{
+			{ID: 1, ItemID: 1, Price: domain.Money{MinorUnits: 300, Currency: "EUR"}, StartsAt: "2026-03-01T09:00:00Z", CreatedAt: "2026-03-01T09:00:00Z"},
+			{ID: 3, ItemID: 1, Price: domain.Money{MinorUnits: 350, Currency: "EUR"}, StartsAt: "2026-04-01T00:00:00Z", Actor: "4", CreatedAt: "2026-03-02T09:00:00Z"},
+		})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("GET", "/admin/inventory/item/prices?item_id=1", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("ListPriceHistory", context.Background(), &proto.ListPriceHistoryRequest{ItemId: 1}).Return(expectedResponse, nil).Once()
+
+		handler := ListPriceHistory(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+
+	t.Run("expect to return 400 for an invalid item id", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("GET", "/admin/inventory/item/prices?item_id=abc", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := ListPriceHistory(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
@@ -0,0 +1,495 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+func toSupplier(supplier *proto.Supplier) domain.Supplier {
+	return domain.Supplier{
+		ID:           supplier.Id,
+		Name:         supplier.Name,
+		Contact:      supplier.Contact,
+		Email:        supplier.Email,
+		Phone:        supplier.Phone,
+		LeadTimeDays: supplier.LeadTimeDays,
+	}
+}
+
+func toSupplierItem(supplierItem *proto.SupplierItem) domain.SupplierItem {
+	return domain.SupplierItem{
+		SupplierID: supplierItem.SupplierId,
+		ItemID:     supplierItem.ItemId,
+		CostPrice:  toMoney(supplierItem.CostPrice),
+	}
+}
+
+func toPurchaseOrder(order *proto.PurchaseOrder) domain.PurchaseOrder {
+	response := domain.PurchaseOrder{
+		ID:         order.Id,
+		SupplierID: order.SupplierId,
+		Status:     strings.ToLower(strings.TrimPrefix(order.Status.String(), "PO_STATUS_")),
+		Reference:  order.Reference,
+		Actor:      order.Actor,
+		CreatedAt:  order.CreatedAt,
+		SentAt:     order.SentAt,
+		ExpectedAt: order.ExpectedAt,
+		ReceivedAt: order.ReceivedAt,
+		Lines:      []domain.PurchaseOrderLine{},
+	}
+	for _, line := range order.Lines {
+		response.Lines = append(response.Lines, domain.PurchaseOrderLine{
+			ID:        line.Id,
+			ItemID:    line.ItemId,
+			Quantity:  line.Quantity,
+			Received:  line.Received,
+			CostPrice: toMoney(line.CostPrice),
+		})
+	}
+	return response
+}
+
+func AddSupplier(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		var requestBody domain.Supplier
+
+		if err := json.NewDecoder(req.Body).Decode(&requestBody); err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("invalid request body: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		resp, err := inventoryService.AddSupplier(req.Context(), &proto.AddSupplierRequest{
+			Supplier: &proto.Supplier{
+				Name:         requestBody.Name,
+				Contact:      requestBody.Contact,
+				Email:        requestBody.Email,
+				Phone:        requestBody.Phone,
+				LeadTimeDays: requestBody.LeadTimeDays,
+			},
+		})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		res, err := json.Marshal(toSupplier(resp.Supplier))
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+func ListSuppliers(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		resp, err := inventoryService.ListSuppliers(req.Context(), &proto.ListSuppliersRequest{})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		suppliers := []domain.Supplier{}
+		for _, supplier := range resp.Suppliers {
+			suppliers = append(suppliers, toSupplier(supplier))
+		}
+
+		res, err := json.Marshal(suppliers)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+// SetSupplierItem records what an item costs from a supplier.
+func SetSupplierItem(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPut {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		var requestBody domain.SupplierItem
+
+		if err := json.NewDecoder(req.Body).Decode(&requestBody); err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("invalid request body: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		resp, err := inventoryService.SetSupplierItem(req.Context(), &proto.SetSupplierItemRequest{
+			Item: &proto.SupplierItem{
+				SupplierId: requestBody.SupplierID,
+				ItemId:     requestBody.ItemID,
+				CostPrice:  toMoneyProto(requestBody.CostPrice),
+			},
+		})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		res, err := json.Marshal(toSupplierItem(resp.Item))
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+func ListSupplierItems(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		supplierID, err := strconv.ParseUint(req.URL.Query().Get("supplier_id"), 10, 32)
+		if err != nil {
+			http.Error(rw, "Invalid supplier ID", http.StatusBadRequest)
+			return
+		}
+
+		resp, err := inventoryService.ListSupplierItems(req.Context(), &proto.ListSupplierItemsRequest{SupplierId: uint32(supplierID)})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		supplierItems := []domain.SupplierItem{}
+		for _, supplierItem := range resp.Items {
+			supplierItems = append(supplierItems, toSupplierItem(supplierItem))
+		}
+
+		res, err := json.Marshal(supplierItems)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+func CreatePurchaseOrder(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		var requestBody domain.CreatePurchaseOrderRequest
+
+		if err := json.NewDecoder(req.Body).Decode(&requestBody); err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("invalid request body: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		grpcRequest := &proto.CreatePurchaseOrderRequest{
+			SupplierId: requestBody.SupplierID,
+			Reference:  requestBody.Reference,
+			Actor:      actorFromContext(req.Context()),
+		}
+		for _, line := range requestBody.Lines {
+			grpcRequest.Lines = append(grpcRequest.Lines, &proto.PurchaseOrderLine{
+				ItemId:    line.ItemID,
+				Quantity:  line.Quantity,
+				CostPrice: toMoneyProto(line.CostPrice),
+			})
+		}
+
+		resp, err := inventoryService.CreatePurchaseOrder(req.Context(), grpcRequest)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		res, err := json.Marshal(toPurchaseOrder(resp.Order))
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+func GetPurchaseOrder(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		orderID, err := strconv.ParseUint(req.URL.Query().Get("id"), 10, 32)
+		if err != nil {
+			http.Error(rw, "Invalid ID", http.StatusBadRequest)
+			return
+		}
+
+		resp, err := inventoryService.GetPurchaseOrder(req.Context(), &proto.GetPurchaseOrderRequest{Id: uint32(orderID)})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		res, err := json.Marshal(toPurchaseOrder(resp.Order))
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+// ListPurchaseOrders lists purchase orders newest first, optionally narrowed by
+// ?supplier_id and by ?status (draft, sent, partially_received or received).
+func ListPurchaseOrders(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		grpcRequest := &proto.ListPurchaseOrdersRequest{}
+		if value := req.URL.Query().Get("supplier_id"); value != "" {
+			supplierID, err := strconv.ParseUint(value, 10, 32)
+			if err != nil {
+				http.Error(rw, "Invalid supplier ID", http.StatusBadRequest)
+				return
+			}
+			grpcRequest.SupplierId = uint32(supplierID)
+		}
+		if value := req.URL.Query().Get("status"); value != "" {
+			status, ok := proto.PurchaseOrderStatus_value["PO_STATUS_"+strings.ToUpper(value)]
+			if !ok || status == int32(proto.PurchaseOrderStatus_PO_STATUS_UNSPECIFIED) {
+				http.Error(rw, "Invalid status", http.StatusBadRequest)
+				return
+			}
+			grpcRequest.Status = proto.PurchaseOrderStatus(status)
+		}
+
+		resp, err := inventoryService.ListPurchaseOrders(req.Context(), grpcRequest)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		orders := []domain.PurchaseOrder{}
+		for _, order := range resp.Orders {
+			orders = append(orders, toPurchaseOrder(order))
+		}
+
+		res, err := json.Marshal(orders)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+// SendPurchaseOrder marks a draft purchase order as sent to its supplier.
+func SendPurchaseOrder(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		var requestBody domain.SendPurchaseOrderRequest
+
+		if err := json.NewDecoder(req.Body).Decode(&requestBody); err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("invalid request body: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		resp, err := inventoryService.SendPurchaseOrder(req.Context(), &proto.SendPurchaseOrderRequest{Id: requestBody.ID})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		res, err := json.Marshal(toPurchaseOrder(resp.Order))
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+// ReceivePurchaseOrderLine books a delivery against a line of a sent purchase order into stock.
+func ReceivePurchaseOrderLine(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		var requestBody domain.ReceivePurchaseOrderLineRequest
+
+		if err := json.NewDecoder(req.Body).Decode(&requestBody); err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("invalid request body: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		resp, err := inventoryService.ReceivePurchaseOrderLine(req.Context(), &proto.ReceivePurchaseOrderLineRequest{
+			OrderId:    requestBody.OrderID,
+			LineId:     requestBody.LineID,
+			Quantity:   requestBody.Quantity,
+			LocationId: requestBody.LocationID,
+			ExpiresAt:  requestBody.ExpiresAt,
+			Actor:      actorFromContext(req.Context()),
+		})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		res, err := json.Marshal(domain.ReceivePurchaseOrderLineResponse{
+			Order:    toPurchaseOrder(resp.Order),
+			ItemID:   resp.ItemId,
+			Quantity: resp.Quantity,
+		})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
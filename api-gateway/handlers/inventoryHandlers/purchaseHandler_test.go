@@ -0,0 +1,218 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	moneyproto "api-gateway/proto/money"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_CreatePurchaseOrder() {
+	t := suite.T()
+
+	t.Run("expect to return 201 with the draft order", func(t *testing.T) {
+		// Arrange
+		body := `{"supplier_id":2,"lines":[{"item_id":5,"quantity":20}]}`
+
+		expectedRequest := &proto.CreatePurchaseOrderRequest{
+			SupplierId: 2,
+			Actor:      "4",
+			Lines:      []*proto.PurchaseOrderLine{{ItemId: 5, Quantity: 20}},
+		}
+
+		expectedResponse := &proto.PurchaseOrderResponse{
+			StatusCode: http.StatusCreated,
+			Order: &proto.PurchaseOrder{
+				Id:         1,
+				SupplierId: 2,
+				Status:     proto.PurchaseOrderStatus_PO_STATUS_DRAFT,
+				Actor:      "4",
+				CreatedAt:  "2026-03-02T09:00:00Z",
+				Lines:      []*proto.PurchaseOrderLine{{Id: 1, ItemId: 5, Quantity: 20, CostPrice: &moneyproto.Money{MinorUnits: 80, Currency: "EUR"}}},
+			},
+		}
+
+		exp, err := json.Marshal(domain.PurchaseOrder{
+			ID:         1,
+			SupplierID: 2,
+			Status:     "draft",
+			Actor:      "4",
+			CreatedAt:  "2026-03-02T09:00:00Z",
+			Lines:      []domain.PurchaseOrderLine{{ID: 1, ItemID: 5, Quantity: 20, CostPrice: domain.Money{MinorUnits: 80, Currency: "EUR"}}},
+		})
+		assert.NoError(t, err)
+
+		ctx := context.WithValue(context.Background(), "id", 4)
+		req := httptest.NewRequest("POST", "/admin/inventory/purchaseorders", strings.NewReader(body)).WithContext(ctx)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("CreatePurchaseOrder", ctx, expectedRequest).Return(expectedResponse, nil).Once()
+
+		handler := CreatePurchaseOrder(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+
+	t.Run("expect to return 400 for an item the supplier does not sell", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.CreatePurchaseOrderRequest{
+			SupplierId: 2,
+			Lines:      []*proto.PurchaseOrderLine{{ItemId: 6, Quantity: 1}},
+		}
+
+		expectedResponse := &proto.PurchaseOrderResponse{
+			StatusCode: http.StatusBadRequest,
+		}
+
+		req := httptest.NewRequest("POST", "/admin/inventory/purchaseorders", strings.NewReader(`{"supplier_id":2,"lines":[{"item_id":6,"quantity":1}]}`))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("CreatePurchaseOrder", context.Background(), expectedRequest).Return(expectedResponse, errors.New("item is not sold by this supplier")).Once()
+
+		handler := CreatePurchaseOrder(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_ListPurchaseOrders() {
+	t := suite.T()
+
+	t.Run("expect to filter by status", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.ListPurchaseOrdersRequest{
+			SupplierId: 2,
+			Status:     proto.PurchaseOrderStatus_PO_STATUS_PARTIALLY_RECEIVED,
+		}
+
+		expectedResponse := &proto.ListPurchaseOrdersResponse{
+			StatusCode: http.StatusOK,
+		}
+
+		req := httptest.NewRequest("GET", "/admin/inventory/purchaseorders?supplier_id=2&status=partially_received", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("ListPurchaseOrders", context.Background(), expectedRequest).Return(expectedResponse, nil).Once()
+
+		handler := ListPurchaseOrders(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, "[]", res.Body.String())
+	})
+
+	t.Run("expect to return 400 for an unknown status", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("GET", "/admin/inventory/purchaseorders?status=lost", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := ListPurchaseOrders(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_ReceivePurchaseOrderLine() {
+	t := suite.T()
+
+	t.Run("expect to return 200 with the order and new stock level", func(t *testing.T) {
+		// Arrange
+		body := `{"order_id":1,"line_id":1,"quantity":15}`
+
+		expectedRequest := &proto.ReceivePurchaseOrderLineRequest{
+			OrderId:  1,
+			LineId:   1,
+			Quantity: 15,
+			Actor:    "4",
+		}
+
+		expectedResponse := &proto.ReceivePurchaseOrderLineResponse{
+			StatusCode: http.StatusOK,
+			Order: &proto.PurchaseOrder{
+				Id:         1,
+				SupplierId: 2,
+				Status:     proto.PurchaseOrderStatus_PO_STATUS_PARTIALLY_RECEIVED,
+				CreatedAt:  "2026-03-02T09:00:00Z",
+				SentAt:     "2026-03-02T09:00:00Z",
+				ExpectedAt: "2026-03-05T09:00:00Z",
+				Lines:      []*proto.PurchaseOrderLine{{Id: 1, ItemId: 5, Quantity: 20, Received: 15, CostPrice: &moneyproto.Money{MinorUnits: 80, Currency: "EUR"}}},
+			},
+			ItemId:   5,
+			Quantity: 25,
+		}
+
+		exp, err := json.Marshal(domain.ReceivePurchaseOrderLineResponse{
+			Order: domain.PurchaseOrder{
+				ID:         1,
+				SupplierID: 2,
+				Status:     "partially_received",
+				CreatedAt:  "2026-03-02T09:00:00Z",
+				SentAt:     "2026-03-02T09:00:00Z",
+				ExpectedAt: "2026-03-05T09:00:00Z",
+				Lines:      []domain.PurchaseOrderLine{{ID: 1, ItemID: 5, Quantity: 20, Received: 15, CostPrice: domain.Money{MinorUnits: 80, Currency: "EUR"}}},
+			},
+			ItemID:   5,
+			Quantity: 25,
+		})
+		assert.NoError(t, err)
+
+		ctx := context.WithValue(context.Background(), "id", 4)
+		req := httptest.NewRequest("POST", "/admin/inventory/purchaseorder/receive", strings.NewReader(body)).WithContext(ctx)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("ReceivePurchaseOrderLine", ctx, expectedRequest).Return(expectedResponse, nil).Once()
+
+		handler := ReceivePurchaseOrderLine(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+
+	t.Run("expect to return 409 when receiving more than is outstanding", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.ReceivePurchaseOrderLineRequest{
+			OrderId:  1,
+			LineId:   1,
+			Quantity: 50,
+		}
+
+		expectedResponse := &proto.ReceivePurchaseOrderLineResponse{
+			StatusCode: http.StatusConflict,
+		}
+
+		req := httptest.NewRequest("POST", "/admin/inventory/purchaseorder/receive", strings.NewReader(`{"order_id":1,"line_id":1,"quantity":50}`))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("ReceivePurchaseOrderLine", context.Background(), expectedRequest).Return(expectedResponse, errors.New("receipt exceeds the quantity outstanding on the purchase order line")).Once()
+
+		handler := ReceivePurchaseOrderLine(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusConflict, res.Code)
+	})
+}
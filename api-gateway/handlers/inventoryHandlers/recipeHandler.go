@@ -0,0 +1,168 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+func toRecipe(resp *proto.RecipeResponse) domain.Recipe {
+	recipe := domain.Recipe{
+		DishID: resp.DishId,
+		Lines:  []domain.RecipeLine{},
+	}
+	for _, line := range resp.Lines {
+		recipe.Lines = append(recipe.Lines, domain.RecipeLine{
+			IngredientID: line.IngredientId,
+			Name:         line.Name,
+			Quantity:     line.Quantity,
+			Unit:         line.Unit,
+		})
+	}
+	return recipe
+}
+
+func SetRecipe(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPut {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		var requestBody domain.Recipe
+
+		if err := json.NewDecoder(req.Body).Decode(&requestBody); err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("invalid request body: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		grpcRequest := &proto.SetRecipeRequest{
+			DishId: requestBody.DishID,
+		}
+		for _, line := range requestBody.Lines {
+			grpcRequest.Lines = append(grpcRequest.Lines, &proto.RecipeLine{
+				IngredientId: line.IngredientID,
+				Quantity:     line.Quantity,
+				Unit:         line.Unit,
+			})
+		}
+
+		resp, err := inventoryService.SetRecipe(req.Context(), grpcRequest)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		res, err := json.Marshal(toRecipe(resp))
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+func GetRecipe(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		dishID, err := strconv.ParseInt(req.URL.Query().Get("dish_id"), 10, 32)
+		if err != nil {
+			http.Error(rw, "Invalid dish ID", http.StatusBadRequest)
+			return
+		}
+
+		resp, err := inventoryService.GetRecipe(req.Context(), &proto.GetRecipeRequest{DishId: int32(dishID)})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		res, err := json.Marshal(toRecipe(resp))
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+func ListRecipeShortages(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		resp, err := inventoryService.ListRecipeShortages(req.Context(), &proto.ListRecipeShortagesRequest{})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		shortages := []domain.RecipeShortage{}
+		for _, shortage := range resp.Shortages {
+			shortages = append(shortages, domain.RecipeShortage{
+				IngredientID:       shortage.IngredientId,
+				IngredientName:     shortage.IngredientName,
+				Unit:               shortage.Unit,
+				AvailableQuantity:  shortage.AvailableQuantity,
+				ReorderLevel:       shortage.ReorderLevel,
+				DishID:             shortage.DishId,
+				DishName:           shortage.DishName,
+				QuantityPerPortion: shortage.QuantityPerPortion,
+				Portions:           shortage.Portions,
+			})
+		}
+
+		res, err := json.Marshal(shortages)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
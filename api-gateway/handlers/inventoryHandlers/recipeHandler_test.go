@@ -0,0 +1,133 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_SetRecipe() {
+	t := suite.T()
+
+	t.Run("expect to return 200 with the stored recipe", func(t *testing.T) {
+		// Arrange
+		body := `{"dish_id":1,"lines":[{"ingredient_id":2,"quantity":120,"unit":"g"},{"ingredient_id":3,"quantity":80}]}`
+
+		expectedRequest := &proto.SetRecipeRequest{
+			DishId: 1,
+			Lines: []*proto.RecipeLine{
+				{IngredientId: 2, Quantity: 120, Unit: "g"},
+				{IngredientId: 3, Quantity: 80},
+			},
+		}
+
+		expectedResponse := &proto.RecipeResponse{
+			StatusCode: http.StatusOK,
+			DishId:     1,
+			Lines: []*proto.RecipeLine{
+				{IngredientId: 2, Name: "dosa batter", Quantity: 120, Unit: "g"},
+				{IngredientId: 3, Name: "potato masala", Quantity: 80, Unit: "g"},
+			},
+		}
+
+		exp, err := json.Marshal(domain.Recipe{
+			DishID: 1,
+			Lines: []domain.RecipeLine{
+				{IngredientID: 2, Name: "dosa batter", Quantity: 120, Unit: "g"},
+				{IngredientID: 3, Name: "potato masala", Quantity: 80, Unit: "g"},
+			},
+		})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("PUT", "/admin/inventory/item/recipe", strings.NewReader(body))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("SetRecipe", context.Background(), expectedRequest).Return(expectedResponse, nil).Once()
+
+		handler := SetRecipe(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+
+	t.Run("expect to return 404 when an ingredient does not exist", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.SetRecipeRequest{
+			DishId: 1,
+			Lines:  []*proto.RecipeLine{{IngredientId: 99, Quantity: 1}},
+		}
+
+		expectedResponse := &proto.RecipeResponse{
+			StatusCode: http.StatusNotFound,
+		}
+
+		req := httptest.NewRequest("PUT", "/admin/inventory/item/recipe", strings.NewReader(`{"dish_id":1,"lines":[{"ingredient_id":99,"quantity":1}]}`))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("SetRecipe", context.Background(), expectedRequest).Return(expectedResponse, errors.New("ingredient not found")).Once()
+
+		handler := SetRecipe(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_ListRecipeShortages() {
+	t := suite.T()
+
+	t.Run("expect to return 200 with the dishes at risk", func(t *testing.T) {
+		// Arrange
+		expectedResponse := &proto.ListRecipeShortagesResponse{
+			StatusCode: http.StatusOK,
+			Shortages: []*proto.RecipeShortage{{
+				IngredientId:       3,
+				IngredientName:     "potato masala",
+				Unit:               "g",
+				AvailableQuantity:  10,
+				ReorderLevel:       100,
+				DishId:             1,
+				DishName:           "masala dosa",
+				QuantityPerPortion: 80,
+			}},
+		}
+
+		exp, err := json.Marshal([]domain.RecipeShortage{{
+			IngredientID:       3,
+			IngredientName:     "potato masala",
+			Unit:               "g",
+			AvailableQuantity:  10,
+			ReorderLevel:       100,
+			DishID:             1,
+			DishName:           "masala dosa",
+			QuantityPerPortion: 80,
+		}})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("GET", "/admin/inventory/recipes/shortages", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("ListRecipeShortages", context.Background(), &proto.ListRecipeShortagesRequest{}).Return(expectedResponse, nil).Once()
+
+		handler := ListRecipeShortages(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+}
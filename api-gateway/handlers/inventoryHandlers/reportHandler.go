@@ -0,0 +1,257 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// reportOptions reads the valuation method, FIFO unless given, and whether the report is
+// wanted as a CSV file rather than JSON.
+func reportOptions(query url.Values) (proto.ValuationMethod, bool, bool) {
+	method := proto.ValuationMethod_VALUATION_FIFO
+	if name := query.Get("method"); name != "" {
+		value, ok := domain.ValuationMethodMap[strings.ToUpper(name)]
+		if !ok {
+			return method, false, false
+		}
+		method = value
+	}
+	format := query.Get("format")
+	if format != "" && format != "csv" && format != "json" {
+		return method, false, false
+	}
+	return method, format == "csv", true
+}
+
+// writeReportCSV sends a report as a CSV file to download.
+func writeReportCSV(rw http.ResponseWriter, name string, status int32, data []byte) {
+	rw.Header().Set("Content-Type", "text/csv")
+	rw.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.csv\"", name))
+	rw.WriteHeader(int(status))
+	rw.Write(data)
+}
+
+// GetStockValuation values the stock on hand, now or as it stood at the RFC 3339 time given
+// as at.
+func GetStockValuation(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		queryParams := req.URL.Query()
+		method, csv, ok := reportOptions(queryParams)
+		if !ok {
+			http.Error(rw, "Invalid method or format", http.StatusBadRequest)
+			return
+		}
+
+		resp, err := inventoryService.GetStockValuation(req.Context(), &proto.GetStockValuationRequest{
+			Method: method,
+			At:     queryParams.Get("at"),
+			Csv:    csv,
+		})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+		if csv {
+			writeReportCSV(rw, "stock-valuation", resp.StatusCode, resp.Data)
+			return
+		}
+
+		response := domain.StockValuationResponse{
+			Items:  []domain.StockValue{},
+			Totals: []domain.Money{},
+		}
+		for _, item := range resp.Items {
+			response.Items = append(response.Items, domain.StockValue{
+				ItemID:   item.ItemId,
+				Name:     item.Name,
+				Unit:     item.Unit,
+				Quantity: item.Quantity,
+				Amount:   item.Amount,
+				UnitCost: toOptionalMoney(item.UnitCost),
+				Value:    toOptionalMoney(item.Value),
+			})
+		}
+		for _, total := range resp.Totals {
+			response.Totals = append(response.Totals, toMoney(total))
+		}
+
+		res, err := json.Marshal(response)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+// SummarizeMovements adds up the stock that came in and went out for each item and reason
+// between the RFC 3339 times given as from and to, which default to the beginning and now.
+func SummarizeMovements(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		queryParams := req.URL.Query()
+		method, csv, ok := reportOptions(queryParams)
+		if !ok {
+			http.Error(rw, "Invalid method or format", http.StatusBadRequest)
+			return
+		}
+
+		resp, err := inventoryService.SummarizeMovements(req.Context(), &proto.SummarizeMovementsRequest{
+			Method: method,
+			From:   queryParams.Get("from"),
+			To:     queryParams.Get("to"),
+			Csv:    csv,
+		})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+		if csv {
+			writeReportCSV(rw, "stock-movements", resp.StatusCode, resp.Data)
+			return
+		}
+
+		response := domain.MovementSummaryResponse{
+			Summaries: []domain.MovementSummary{},
+		}
+		for _, summary := range resp.Summaries {
+			response.Summaries = append(response.Summaries, domain.MovementSummary{
+				ItemID:      summary.ItemId,
+				Name:        summary.Name,
+				Reason:      strings.TrimPrefix(summary.Reason.String(), "REASON_"),
+				QuantityIn:  summary.QuantityIn,
+				QuantityOut: summary.QuantityOut,
+				ValueIn:     toOptionalMoney(summary.ValueIn),
+				ValueOut:    toOptionalMoney(summary.ValueOut),
+				Movements:   summary.Movements,
+			})
+		}
+
+		res, err := json.Marshal(response)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+// ListSlowMovingItems lists the items with stock on hand that sold no more than max_quantity
+// over the last days, 30 unless given.
+func ListSlowMovingItems(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		queryParams := req.URL.Query()
+		method, csv, ok := reportOptions(queryParams)
+		if !ok {
+			http.Error(rw, "Invalid method or format", http.StatusBadRequest)
+			return
+		}
+
+		grpcRequest := &proto.ListSlowMovingItemsRequest{
+			Method: method,
+			Csv:    csv,
+		}
+		if days := queryParams.Get("days"); days != "" {
+			value, err := strconv.ParseUint(days, 10, 32)
+			if err != nil {
+				http.Error(rw, "Invalid days", http.StatusBadRequest)
+				return
+			}
+			grpcRequest.Days = uint32(value)
+		}
+		if maxQuantity := queryParams.Get("max_quantity"); maxQuantity != "" {
+			value, err := strconv.ParseUint(maxQuantity, 10, 32)
+			if err != nil {
+				http.Error(rw, "Invalid max quantity", http.StatusBadRequest)
+				return
+			}
+			grpcRequest.MaxQuantity = uint32(value)
+		}
+
+		resp, err := inventoryService.ListSlowMovingItems(req.Context(), grpcRequest)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+		if csv {
+			writeReportCSV(rw, "slow-moving-items", resp.StatusCode, resp.Data)
+			return
+		}
+
+		response := domain.SlowMovingItemsResponse{
+			Items: []domain.SlowMovingItem{},
+		}
+		for _, item := range resp.Items {
+			response.Items = append(response.Items, domain.SlowMovingItem{
+				ItemID:       item.ItemId,
+				Name:         item.Name,
+				Unit:         item.Unit,
+				Quantity:     item.Quantity,
+				Amount:       item.Amount,
+				QuantitySold: item.QuantitySold,
+				LastSoldAt:   item.LastSoldAt,
+				Value:        toOptionalMoney(item.Value),
+			})
+		}
+
+		res, err := json.Marshal(response)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
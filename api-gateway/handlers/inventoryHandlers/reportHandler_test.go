@@ -0,0 +1,192 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	moneyproto "api-gateway/proto/money"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_GetStockValuation() {
+	t := suite.T()
+
+	t.Run("expect to return the stock valuation", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.GetStockValuationRequest{
+			Method: proto.ValuationMethod_VALUATION_WEIGHTED_AVERAGE,
+			At:     "2026-01-01T00:00:00Z",
+		}
+		expectedResponse := &proto.GetStockValuationResponse{
+			StatusCode: http.StatusOK,
+			Items: []*proto.StockValue{
+				{ItemId: 1, Name: "flour", Unit: "kg", Quantity: 3000, Amount: "3", UnitCost: &moneyproto.Money{MinorUnits: 220, Currency: "EUR"}, Value: &moneyproto.Money{MinorUnits: 660, Currency: "EUR"}},
+			},
+			Totals: []*moneyproto.Money{{MinorUnits: 660, Currency: "EUR"}},
+		}
+
+		exp, err := json.Marshal(domain.StockValuationResponse{
+			Items: []domain.StockValue{
+				{ItemID: 1, Name: "flour", Unit: "kg", Quantity: 3000, Amount: "3", UnitCost: &domain.Money{MinorUnits: 220, Currency: "EUR"}, Value: &domain.Money{MinorUnits: 660, Currency: "EUR"}},
+			},
+			Totals: []domain.Money{{MinorUnits: 660, Currency: "EUR"}},
+		})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("GET", "/admin/inventory/reports/valuation?method=weighted_average&at=2026-01-01T00:00:00Z", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("GetStockValuation", mock.Anything, expectedRequest).Return(expectedResponse, nil).Once()
+
+		handler := GetStockValuation(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+
+	t.Run("expect to return the stock valuation as a CSV file", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.GetStockValuationRequest{Csv: true}
+		expectedResponse := &proto.GetStockValuationResponse{
+			StatusCode: http.StatusOK,
+			Data:       []byte("item_id,name,quantity,unit,unit_cost,value,currency\n"),
+		}
+
+		req := httptest.NewRequest("GET", "/admin/inventory/reports/valuation?format=csv", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("GetStockValuation", mock.Anything, expectedRequest).Return(expectedResponse, nil).Once()
+
+		handler := GetStockValuation(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, "text/csv", res.Header().Get("Content-Type"))
+		assert.Equal(t, "attachment; filename=\"stock-valuation.csv\"", res.Header().Get("Content-Disposition"))
+		assert.Equal(t, string(expectedResponse.Data), res.Body.String())
+	})
+
+	t.Run("expect to return 400 for an unknown valuation method", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("GET", "/admin/inventory/reports/valuation?method=lifo", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := GetStockValuation(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_SummarizeMovements() {
+	t := suite.T()
+
+	t.Run("expect to return the movements by reason", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.SummarizeMovementsRequest{From: "2026-01-01T00:00:00Z"}
+		expectedResponse := &proto.SummarizeMovementsResponse{
+			StatusCode: http.StatusOK,
+			Summaries: []*proto.MovementSummary{
+				{ItemId: 1, Name: "flour", Reason: proto.MovementReason_REASON_WASTAGE, QuantityOut: 8000, ValueOut: &moneyproto.Money{MinorUnits: 1720, Currency: "EUR"}, Movements: 1},
+			},
+		}
+
+		exp, err := json.Marshal(domain.MovementSummaryResponse{
+			Summaries: []domain.MovementSummary{
+				{ItemID: 1, Name: "flour", Reason: "WASTAGE", QuantityOut: 8000, ValueOut: &domain.Money{MinorUnits: 1720, Currency: "EUR"}, Movements: 1},
+			},
+		})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("GET", "/admin/inventory/reports/movements?from=2026-01-01T00:00:00Z", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("SummarizeMovements", mock.Anything, expectedRequest).Return(expectedResponse, nil).Once()
+
+		handler := SummarizeMovements(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+
+	t.Run("expect to return 400 for a period that ends before it starts", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.SummarizeMovementsRequest{From: "2026-02-01T00:00:00Z", To: "2026-01-01T00:00:00Z"}
+
+		req := httptest.NewRequest("GET", "/admin/inventory/reports/movements?from=2026-02-01T00:00:00Z&to=2026-01-01T00:00:00Z", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("SummarizeMovements", mock.Anything, expectedRequest).Return(&proto.SummarizeMovementsResponse{StatusCode: http.StatusBadRequest}, errors.New("a report's period must end after it starts")).Once()
+
+		handler := SummarizeMovements(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_ListSlowMovingItems() {
+	t := suite.T()
+
+	t.Run("expect to return the slow-moving items", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.ListSlowMovingItemsRequest{Days: 60, MaxQuantity: 2}
+		expectedResponse := &proto.ListSlowMovingItemsResponse{
+			StatusCode: http.StatusOK,
+			Items: []*proto.SlowMovingItem{
+				{ItemId: 2, Name: "dosa", Unit: "each", Quantity: 18, Amount: "18", QuantitySold: 2, LastSoldAt: "2026-01-10T12:00:00Z", Value: &moneyproto.Money{MinorUnits: 2700, Currency: "EUR"}},
+			},
+		}
+
+		exp, err := json.Marshal(domain.SlowMovingItemsResponse{
+			Items: []domain.SlowMovingItem{
+				{ItemID: 2, Name: "dosa", Unit: "each", Quantity: 18, Amount: "18", QuantitySold: 2, LastSoldAt: "2026-01-10T12:00:00Z", Value: &domain.Money{MinorUnits: 2700, Currency: "EUR"}},
+			},
+		})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("GET", "/admin/inventory/reports/slow-moving?days=60&max_quantity=2", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("ListSlowMovingItems", mock.Anything, expectedRequest).Return(expectedResponse, nil).Once()
+
+		handler := ListSlowMovingItems(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+
+	t.Run("expect to return 400 for an invalid number of days", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("GET", "/admin/inventory/reports/slow-moving?days=month", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := ListSlowMovingItems(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
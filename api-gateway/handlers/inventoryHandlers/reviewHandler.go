@@ -0,0 +1,288 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+func toReview(review *proto.Review) domain.Review {
+	response := domain.Review{
+		ID:          review.Id,
+		ItemID:      review.ItemId,
+		UserID:      review.UserId,
+		Rating:      review.Rating,
+		Text:        review.Text,
+		Status:      strings.TrimPrefix(review.Status.String(), "REVIEW_"),
+		ModeratedBy: review.ModeratedBy,
+		CreatedAt:   review.CreatedAt,
+	}
+	for _, photo := range review.Photos {
+		response.Photos = append(response.Photos, domain.ReviewPhoto{
+			ID:            photo.Id,
+			URL:           photo.Url,
+			ThumbnailURLs: photo.ThumbnailUrls,
+			ContentType:   photo.ContentType,
+			Width:         photo.Width,
+			Height:        photo.Height,
+			Size:          photo.Size,
+		})
+	}
+	return response
+}
+
+func toReviews(reviews []*proto.Review) domain.ListReviewsResponse {
+	response := domain.ListReviewsResponse{
+		Reviews: []domain.Review{},
+	}
+	for _, review := range reviews {
+		response.Reviews = append(response.Reviews, toReview(review))
+	}
+	return response
+}
+
+// SubmitReview adds the signed-in customer's review of an item from a multipart form with the
+// item's id in an item_id field, a rating from 1 to 5 and optional text and photos fields.
+// Inventory-service only accepts it from customers who have had the item delivered to them.
+func SubmitReview(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		req.Body = http.MaxBytesReader(rw, req.Body, maxImageUploadSize)
+		if err := req.ParseMultipartForm(maxImageUploadSize); err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("invalid request body: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		id, _ := req.Context().Value("id").(int)
+		if id == 0 {
+			message := domain.Message{
+				Message: "unauthorized access: invalid user id",
+			}
+			rw.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		itemID, err := strconv.ParseInt(req.FormValue("item_id"), 10, 32)
+		if err != nil {
+			http.Error(rw, "Invalid item ID", http.StatusBadRequest)
+			return
+		}
+
+		rating, err := strconv.ParseUint(req.FormValue("rating"), 10, 32)
+		if err != nil {
+			http.Error(rw, "Invalid rating", http.StatusBadRequest)
+			return
+		}
+
+		var photos [][]byte
+		for _, header := range req.MultipartForm.File["photos"] {
+			file, err := header.Open()
+			if err == nil {
+				var data []byte
+				data, err = io.ReadAll(file)
+				file.Close()
+				photos = append(photos, data)
+			}
+			if err != nil {
+				message := domain.Message{
+					Message: fmt.Sprintf("invalid request body: %s", err.Error()),
+				}
+				rw.WriteHeader(http.StatusBadRequest)
+				json.NewEncoder(rw).Encode(message)
+				return
+			}
+		}
+
+		resp, err := inventoryService.SubmitReview(req.Context(), &proto.SubmitReviewRequest{
+			ItemId: int32(itemID),
+			UserId: int32(id),
+			Rating: uint32(rating),
+			Text:   req.FormValue("text"),
+			Photos: photos,
+		})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		res, err := json.Marshal(toReview(resp.Review))
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+// ListItemReviews returns the approved reviews of an item, newest first.
+func ListItemReviews(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		itemID, err := strconv.ParseInt(req.URL.Query().Get("id"), 10, 32)
+		if err != nil {
+			http.Error(rw, "Invalid item ID", http.StatusBadRequest)
+			return
+		}
+
+		resp, err := inventoryService.ListItemReviews(req.Context(), &proto.ListItemReviewsRequest{
+			ItemId: int32(itemID),
+		})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		res, err := json.Marshal(toReviews(resp.Reviews))
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+// ListReviews returns the reviews of every item in the state given by ?status=, those
+// waiting to be moderated by default.
+func ListReviews(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		status := proto.ReviewStatus_REVIEW_PENDING
+		if value := req.URL.Query().Get("status"); value != "" {
+			var ok bool
+			if status, ok = domain.ReviewStatusMap[strings.ToUpper(value)]; !ok {
+				http.Error(rw, "Invalid status", http.StatusBadRequest)
+				return
+			}
+		}
+
+		resp, err := inventoryService.ListReviews(req.Context(), &proto.ListReviewsRequest{
+			Status: status,
+		})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		res, err := json.Marshal(toReviews(resp.Reviews))
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+// ModerateReview approves or rejects a review. Only approved reviews are shown and count
+// towards the item's rating.
+func ModerateReview(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		var requestBody domain.ModerateReviewRequest
+
+		if err := json.NewDecoder(req.Body).Decode(&requestBody); err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("invalid request body: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		status, ok := domain.ReviewStatusMap[strings.ToUpper(requestBody.Status)]
+		if !ok {
+			message := domain.Message{
+				Message: fmt.Sprintf("invalid status: %s", requestBody.Status),
+			}
+			rw.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		resp, err := inventoryService.ModerateReview(req.Context(), &proto.ModerateReviewRequest{
+			Id:     requestBody.ID,
+			Status: status,
+			Actor:  actorFromContext(req.Context()),
+		})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		res, err := json.Marshal(toReview(resp.Review))
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
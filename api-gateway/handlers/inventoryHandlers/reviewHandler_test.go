@@ -0,0 +1,273 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// reviewUpload builds a multipart review of the item by the user, with a photo when photo is not nil.
+func reviewUpload(t *testing.T, userID int, itemID string, rating string, photo []byte) *http.Request {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	assert.NoError(t, writer.WriteField("item_id", itemID))
+	assert.NoError(t, writer.WriteField("rating", rating))
+	assert.NoError(t, writer.WriteField("text", "crisp and hot"))
+	if photo != nil {
+		part, err := writer.CreateFormFile("photos", "dosa.png")
+		assert.NoError(t, err)
+		_, err = part.Write(photo)
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, writer.Close())
+
+	req := httptest.NewRequest("POST", "/user/review", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req.WithContext(context.WithValue(req.Context(), "id", userID))
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_SubmitReview() {
+	t := suite.T()
+
+	t.Run("expect to return 201 with the review waiting to be moderated", func(t *testing.T) {
+		// Arrange
+		photo := []byte("\x89PNG\r\n\x1a\nimage")
+		req := reviewUpload(t, 7, "3", "5", photo)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("SubmitReview", mock.Anything, &proto.SubmitReviewRequest{ItemId: 3, UserId: 7, Rating: 5, Text: "crisp and hot", Photos: [][]byte{photo}}).Return(&proto.SubmitReviewResponse{
+			StatusCode: http.StatusCreated,
+			Review: &proto.Review{
+				Id:        1,
+				ItemId:    3,
+				UserId:    7,
+				Rating:    5,
+				Text:      "crisp and hot",
+				Status:    proto.ReviewStatus_REVIEW_PENDING,
+				Photos:    []*proto.ReviewPhoto{{Id: 2, Url: "/inventory/images/reviews/1/2/original.png", ContentType: "image/png"}},
+				CreatedAt: "2021-02-01T00:00:00Z",
+			},
+		}, nil).Once()
+
+		handler := SubmitReview(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		var response domain.Review
+		assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &response))
+		assert.Equal(t, http.StatusCreated, res.Code)
+		assert.Equal(t, "PENDING", response.Status)
+		assert.Equal(t, "/inventory/images/reviews/1/2/original.png", response.Photos[0].URL)
+	})
+
+	t.Run("expect to return 403 when the item was never delivered to the customer", func(t *testing.T) {
+		// Arrange
+		req := reviewUpload(t, 7, "3", "5", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("SubmitReview", mock.Anything, &proto.SubmitReviewRequest{ItemId: 3, UserId: 7, Rating: 5, Text: "crisp and hot"}).Return(&proto.SubmitReviewResponse{StatusCode: http.StatusForbidden}, errors.New("only customers who have had the item delivered can review it")).Once()
+
+		handler := SubmitReview(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusForbidden, res.Code)
+		assert.JSONEq(t, `{"message":"grpc received error: only customers who have had the item delivered can review it"}`, res.Body.String())
+	})
+
+	t.Run("expect to return 401 without a signed-in customer", func(t *testing.T) {
+		// Arrange
+		req := reviewUpload(t, 0, "3", "5", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := SubmitReview(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusUnauthorized, res.Code)
+	})
+
+	t.Run("expect to return 400 for a rating that is not a number", func(t *testing.T) {
+		// Arrange
+		req := reviewUpload(t, 7, "3", "great", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := SubmitReview(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_ListItemReviews() {
+	t := suite.T()
+
+	t.Run("expect to return the approved reviews of the item", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("GET", "/inventory/item/reviews?id=3", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("ListItemReviews", mock.Anything, &proto.ListItemReviewsRequest{ItemId: 3}).Return(&proto.ListReviewsResponse{
+			StatusCode: http.StatusOK,
+			Reviews:    []*proto.Review{{Id: 1, ItemId: 3, UserId: 7, Rating: 4, Status: proto.ReviewStatus_REVIEW_APPROVED, ModeratedBy: "1"}},
+		}, nil).Once()
+
+		handler := ListItemReviews(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		var response domain.ListReviewsResponse
+		assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &response))
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, 1, len(response.Reviews))
+		assert.Equal(t, "APPROVED", response.Reviews[0].Status)
+	})
+
+	t.Run("expect to return 400 for an invalid item id", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("GET", "/inventory/item/reviews?id=dosa", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := ListItemReviews(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_ListReviews() {
+	t := suite.T()
+
+	t.Run("expect to list the reviews waiting to be moderated by default", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("GET", "/admin/inventory/reviews", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("ListReviews", mock.Anything, &proto.ListReviewsRequest{Status: proto.ReviewStatus_REVIEW_PENDING}).Return(&proto.ListReviewsResponse{
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+
+		handler := ListReviews(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.JSONEq(t, `{"reviews":[]}`, res.Body.String())
+	})
+
+	t.Run("expect to return 400 for an unknown status", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("GET", "/admin/inventory/reviews?status=hidden", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := ListReviews(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_ModerateReview() {
+	t := suite.T()
+
+	t.Run("expect to approve the review as the signed-in admin", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("POST", "/admin/inventory/reviews/moderate", strings.NewReader(`{"id":1,"status":"approved"}`))
+		req = req.WithContext(context.WithValue(req.Context(), "id", 2))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("ModerateReview", mock.Anything, &proto.ModerateReviewRequest{Id: 1, Status: proto.ReviewStatus_REVIEW_APPROVED, Actor: "2"}).Return(&proto.ModerateReviewResponse{
+			StatusCode: http.StatusOK,
+			Review:     &proto.Review{Id: 1, ItemId: 3, UserId: 7, Rating: 4, Status: proto.ReviewStatus_REVIEW_APPROVED, ModeratedBy: "2"},
+		}, nil).Once()
+
+		handler := ModerateReview(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		var response domain.Review
+		assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &response))
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, "APPROVED", response.Status)
+		assert.Equal(t, "2", response.ModeratedBy)
+	})
+
+	t.Run("expect to pass on an error for a review that does not exist", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("POST", "/admin/inventory/reviews/moderate", strings.NewReader(`{"id":9,"status":"REJECTED"}`))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("ModerateReview", mock.Anything, &proto.ModerateReviewRequest{Id: 9, Status: proto.ReviewStatus_REVIEW_REJECTED}).Return(&proto.ModerateReviewResponse{
+			StatusCode: http.StatusNotFound,
+		}, errors.New("review not found")).Once()
+
+		handler := ModerateReview(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("expect to return 400 for an unknown status", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("POST", "/admin/inventory/reviews/moderate", strings.NewReader(`{"id":1,"status":"hidden"}`))
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := ModerateReview(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_GetAllItemsByRating() {
+	t := suite.T()
+
+	t.Run("expect to pass the sort on and return each item's rating", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("GET", "/inventory/item/all?sort=rating", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("GetAllItems", mock.Anything, &proto.GetAllItemsRequest{SortBy: "rating"}).Return(&proto.GetAllItemsResponse{
+			StatusCode: http.StatusOK,
+			Items:      []*proto.GetItemResponse{{Id: 3, Name: "dosa", RatingAverage: 4.5, RatingCount: 2}},
+		}, nil).Once()
+
+		handler := GetAllItems(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		var response domain.GetAllItemsResponse
+		assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &response))
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, 4.5, response.Items[0].RatingAverage)
+		assert.Equal(t, uint32(2), response.Items[0].RatingCount)
+	})
+}
@@ -0,0 +1,386 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+func toStockTake(take *proto.StockTake) domain.StockTake {
+	response := domain.StockTake{
+		ID:         take.Id,
+		LocationID: take.LocationId,
+		Status:     strings.ToLower(strings.TrimPrefix(take.Status.String(), "STOCK_TAKE_")),
+		Reference:  take.Reference,
+		Actor:      take.Actor,
+		CreatedAt:  take.CreatedAt,
+		ClosedAt:   take.ClosedAt,
+		ClosedBy:   take.ClosedBy,
+		Counts:     []domain.StockCount{},
+	}
+	for _, count := range take.Counts {
+		response.Counts = append(response.Counts, toStockCount(count))
+	}
+	return response
+}
+
+func toStockCount(count *proto.StockCount) domain.StockCount {
+	return domain.StockCount{
+		ID:         count.Id,
+		ItemID:     count.ItemId,
+		LocationID: count.LocationId,
+		Counted:    count.Counted,
+		Expected:   count.Expected,
+		CountedBy:  count.CountedBy,
+		CountedAt:  count.CountedAt,
+	}
+}
+
+// StartStockTake opens a stock-take; corrections and transfers at the counted locations are
+// refused until it is approved or cancelled.
+func StartStockTake(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		var requestBody domain.StartStockTakeRequest
+
+		if err := json.NewDecoder(req.Body).Decode(&requestBody); err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("invalid request body: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		resp, err := inventoryService.StartStockTake(req.Context(), &proto.StartStockTakeRequest{
+			LocationId: requestBody.LocationID,
+			Reference:  requestBody.Reference,
+			Actor:      actorFromContext(req.Context()),
+		})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		res, err := json.Marshal(toStockTake(resp.StockTake))
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+// ListStockTakes lists stock-takes newest first, optionally narrowed by ?status (open,
+// approved or cancelled).
+func ListStockTakes(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		grpcRequest := &proto.ListStockTakesRequest{}
+		if value := req.URL.Query().Get("status"); value != "" {
+			status, ok := proto.StockTakeStatus_value["STOCK_TAKE_"+strings.ToUpper(value)]
+			if !ok || status == int32(proto.StockTakeStatus_STOCK_TAKE_UNSPECIFIED) {
+				http.Error(rw, "Invalid status", http.StatusBadRequest)
+				return
+			}
+			grpcRequest.Status = proto.StockTakeStatus(status)
+		}
+
+		resp, err := inventoryService.ListStockTakes(req.Context(), grpcRequest)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		takes := []domain.StockTake{}
+		for _, take := range resp.StockTakes {
+			takes = append(takes, toStockTake(take))
+		}
+
+		res, err := json.Marshal(takes)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+func GetStockTake(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		id, err := strconv.ParseUint(req.URL.Query().Get("id"), 10, 32)
+		if err != nil {
+			http.Error(rw, "Invalid ID", http.StatusBadRequest)
+			return
+		}
+
+		resp, err := inventoryService.GetStockTake(req.Context(), &proto.GetStockTakeRequest{Id: uint32(id)})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		res, err := json.Marshal(toStockTake(resp.StockTake))
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+// RecordStockCount records how much of an item was counted, replacing an earlier count of
+// the same item and location.
+func RecordStockCount(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		var requestBody domain.RecordStockCountRequest
+
+		if err := json.NewDecoder(req.Body).Decode(&requestBody); err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("invalid request body: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		resp, err := inventoryService.RecordStockCount(req.Context(), &proto.RecordStockCountRequest{
+			StockTakeId: requestBody.StockTakeID,
+			ItemId:      requestBody.ItemID,
+			LocationId:  requestBody.LocationID,
+			Counted:     requestBody.Counted,
+			Actor:       actorFromContext(req.Context()),
+		})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		res, err := json.Marshal(toStockCount(resp.Count))
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+func GetVarianceReport(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		id, err := strconv.ParseUint(req.URL.Query().Get("id"), 10, 32)
+		if err != nil {
+			http.Error(rw, "Invalid ID", http.StatusBadRequest)
+			return
+		}
+
+		resp, err := inventoryService.GetVarianceReport(req.Context(), &proto.GetVarianceReportRequest{StockTakeId: uint32(id)})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		lines := []domain.StockVariance{}
+		for _, line := range resp.Lines {
+			lines = append(lines, domain.StockVariance{
+				ItemID:     line.ItemId,
+				Name:       line.Name,
+				LocationID: line.LocationId,
+				Expected:   line.Expected,
+				Counted:    line.Counted,
+				Variance:   line.Variance,
+			})
+		}
+
+		res, err := json.Marshal(lines)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+// ApproveStockTake applies the counted variances to stock as STOCK_TAKE movements.
+func ApproveStockTake(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		var requestBody domain.StockTakeActionRequest
+
+		if err := json.NewDecoder(req.Body).Decode(&requestBody); err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("invalid request body: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		resp, err := inventoryService.ApproveStockTake(req.Context(), &proto.ApproveStockTakeRequest{
+			Id:    requestBody.ID,
+			Actor: actorFromContext(req.Context()),
+		})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		response := domain.ApproveStockTakeResponse{
+			StockTake: toStockTake(resp.StockTake),
+			Movements: []domain.StockMovement{},
+		}
+		for _, movement := range resp.Movements {
+			response.Movements = append(response.Movements, toStockMovement(movement))
+		}
+
+		res, err := json.Marshal(response)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+func CancelStockTake(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		var requestBody domain.StockTakeActionRequest
+
+		if err := json.NewDecoder(req.Body).Decode(&requestBody); err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("invalid request body: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		resp, err := inventoryService.CancelStockTake(req.Context(), &proto.CancelStockTakeRequest{
+			Id:    requestBody.ID,
+			Actor: actorFromContext(req.Context()),
+		})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		res, err := json.Marshal(toStockTake(resp.StockTake))
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
@@ -0,0 +1,167 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_StartStockTake() {
+	t := suite.T()
+
+	t.Run("expect to return 409 while another count is open", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.StartStockTakeRequest{
+			LocationId: 2,
+			Actor:      "4",
+		}
+
+		expectedResponse := &proto.StockTakeResponse{
+			StatusCode: http.StatusConflict,
+		}
+
+		ctx := context.WithValue(context.Background(), "id", 4)
+		req := httptest.NewRequest("POST", "/admin/inventory/stocktakes", strings.NewReader(`{"location_id":2}`)).WithContext(ctx)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("StartStockTake", ctx, expectedRequest).Return(expectedResponse, errors.New("a stock-take is in progress at this location")).Once()
+
+		handler := StartStockTake(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusConflict, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_RecordStockCount() {
+	t := suite.T()
+
+	t.Run("expect to return 200 with the count and the system quantity", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.RecordStockCountRequest{
+			StockTakeId: 1,
+			ItemId:      5,
+			Counted:     7,
+			Actor:       "4",
+		}
+
+		expectedResponse := &proto.RecordStockCountResponse{
+			StatusCode: http.StatusOK,
+			Count: &proto.StockCount{
+				Id:         1,
+				ItemId:     5,
+				LocationId: 1,
+				Counted:    7,
+				Expected:   10,
+				CountedBy:  "4",
+				CountedAt:  "2026-03-02T09:00:00Z",
+			},
+		}
+
+		exp, err := json.Marshal(domain.StockCount{
+			ID:         1,
+			ItemID:     5,
+			LocationID: 1,
+			Counted:    7,
+			Expected:   10,
+			CountedBy:  "4",
+			CountedAt:  "2026-03-02T09:00:00Z",
+		})
+		assert.NoError(t, err)
+
+		ctx := context.WithValue(context.Background(), "id", 4)
+		req := httptest.NewRequest("POST", "/admin/inventory/stocktake/count", strings.NewReader(`{"stock_take_id":1,"item_id":5,"counted":7}`)).WithContext(ctx)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("RecordStockCount", ctx, expectedRequest).Return(expectedResponse, nil).Once()
+
+		handler := RecordStockCount(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_ApproveStockTake() {
+	t := suite.T()
+
+	t.Run("expect to return 200 with the adjustments applied", func(t *testing.T) {
+		// Arrange
+		expectedRequest := &proto.ApproveStockTakeRequest{
+			Id:    1,
+			Actor: "4",
+		}
+
+		expectedResponse := &proto.ApproveStockTakeResponse{
+			StatusCode: http.StatusOK,
+			StockTake: &proto.StockTake{
+				Id:        1,
+				Status:    proto.StockTakeStatus_STOCK_TAKE_APPROVED,
+				CreatedAt: "2026-03-02T09:00:00Z",
+				ClosedAt:  "2026-03-02T11:00:00Z",
+				ClosedBy:  "4",
+			},
+			Movements: []*proto.StockMovement{{
+				Id:         9,
+				ItemId:     5,
+				LocationId: 1,
+				Delta:      -3,
+				Balance:    5,
+				Reason:     proto.MovementReason_REASON_STOCK_TAKE,
+				Reference:  "stock-take 1",
+				Actor:      "4",
+				CreatedAt:  "2026-03-02T11:00:00Z",
+			}},
+		}
+
+		exp, err := json.Marshal(domain.ApproveStockTakeResponse{
+			StockTake: domain.StockTake{
+				ID:        1,
+				Status:    "approved",
+				CreatedAt: "2026-03-02T09:00:00Z",
+				ClosedAt:  "2026-03-02T11:00:00Z",
+				ClosedBy:  "4",
+				Counts:    []domain.StockCount{},
+			},
+			Movements: []domain.StockMovement{{
+				ID:         9,
+				ItemID:     5,
+				LocationID: 1,
+				Delta:      -3,
+				Balance:    5,
+				Reason:     "STOCK_TAKE",
+				Reference:  "stock-take 1",
+				Actor:      "4",
+				CreatedAt:  "2026-03-02T11:00:00Z",
+			}},
+		})
+		assert.NoError(t, err)
+
+		ctx := context.WithValue(context.Background(), "id", 4)
+		req := httptest.NewRequest("POST", "/admin/inventory/stocktake/approve", strings.NewReader(`{"id":1}`)).WithContext(ctx)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("ApproveStockTake", ctx, expectedRequest).Return(expectedResponse, nil).Once()
+
+		handler := ApproveStockTake(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, string(exp), res.Body.String())
+	})
+}
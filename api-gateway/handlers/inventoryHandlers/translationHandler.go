@@ -0,0 +1,372 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+func toItemTranslation(translation *proto.ItemTranslation) domain.ItemTranslation {
+	return domain.ItemTranslation{
+		ItemID:      translation.ItemId,
+		Locale:      translation.Locale,
+		Name:        translation.Name,
+		Description: translation.Description,
+		UpdatedBy:   translation.UpdatedBy,
+		UpdatedAt:   translation.UpdatedAt,
+	}
+}
+
+func toCategoryTranslation(translation *proto.CategoryTranslation) domain.CategoryTranslation {
+	return domain.CategoryTranslation{
+		Category:  translation.Category,
+		Locale:    translation.Locale,
+		Name:      translation.Name,
+		UpdatedBy: translation.UpdatedBy,
+		UpdatedAt: translation.UpdatedAt,
+	}
+}
+
+// SetItemTranslation sets an item's name and description in a locale other than the
+// default, replacing any translation into that locale it already has.
+func SetItemTranslation(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPut {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		var requestBody domain.ItemTranslation
+
+		if err := json.NewDecoder(req.Body).Decode(&requestBody); err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("invalid request body: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		resp, err := inventoryService.SetItemTranslation(req.Context(), &proto.SetItemTranslationRequest{
+			ItemId:      requestBody.ItemID,
+			Locale:      requestBody.Locale,
+			Name:        requestBody.Name,
+			Description: requestBody.Description,
+			Actor:       actorFromContext(req.Context()),
+		})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		res, err := json.Marshal(toItemTranslation(resp.Translation))
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+func DeleteItemTranslation(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodDelete {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		itemID, err := strconv.ParseInt(req.URL.Query().Get("id"), 10, 32)
+		if err != nil {
+			http.Error(rw, "Invalid item ID", http.StatusBadRequest)
+			return
+		}
+
+		resp, err := inventoryService.DeleteItemTranslation(req.Context(), &proto.DeleteItemTranslationRequest{
+			ItemId: int32(itemID),
+			Locale: req.URL.Query().Get("locale"),
+		})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		res, err := json.Marshal(domain.Message{Message: "Translation deleted successfully"})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+// ListItemTranslations returns every translation of the item given by ?id=.
+func ListItemTranslations(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		itemID, err := strconv.ParseInt(req.URL.Query().Get("id"), 10, 32)
+		if err != nil {
+			http.Error(rw, "Invalid item ID", http.StatusBadRequest)
+			return
+		}
+
+		resp, err := inventoryService.ListItemTranslations(req.Context(), &proto.ListItemTranslationsRequest{
+			ItemId: int32(itemID),
+		})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		response := domain.ListItemTranslationsResponse{
+			Translations: []domain.ItemTranslation{},
+		}
+		for _, translation := range resp.Translations {
+			response.Translations = append(response.Translations, toItemTranslation(translation))
+		}
+
+		res, err := json.Marshal(response)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+// SetCategoryTranslation sets the name of a category in a locale other than the default,
+// replacing any translation into that locale it already has.
+func SetCategoryTranslation(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPut {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		var requestBody domain.CategoryTranslation
+
+		if err := json.NewDecoder(req.Body).Decode(&requestBody); err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("invalid request body: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		resp, err := inventoryService.SetCategoryTranslation(req.Context(), &proto.SetCategoryTranslationRequest{
+			Category: requestBody.Category,
+			Locale:   requestBody.Locale,
+			Name:     requestBody.Name,
+			Actor:    actorFromContext(req.Context()),
+		})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		res, err := json.Marshal(toCategoryTranslation(resp.Translation))
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+func DeleteCategoryTranslation(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodDelete {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		resp, err := inventoryService.DeleteCategoryTranslation(req.Context(), &proto.DeleteCategoryTranslationRequest{
+			Category: req.URL.Query().Get("category"),
+			Locale:   req.URL.Query().Get("locale"),
+		})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		res, err := json.Marshal(domain.Message{Message: "Translation deleted successfully"})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+// ListCategoryTranslations returns the translations of the category given by ?category=, or
+// of every category when none is given.
+func ListCategoryTranslations(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		resp, err := inventoryService.ListCategoryTranslations(req.Context(), &proto.ListCategoryTranslationsRequest{
+			Category: req.URL.Query().Get("category"),
+		})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		response := domain.ListCategoryTranslationsResponse{
+			Translations: []domain.CategoryTranslation{},
+		}
+		for _, translation := range resp.Translations {
+			response.Translations = append(response.Translations, toCategoryTranslation(translation))
+		}
+
+		res, err := json.Marshal(response)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
+
+// GetMissingTranslations reports what is left to translate into each locale given by
+// ?locale=, which can be repeated or comma separated, or into every locale the catalog is
+// translated into when none is given.
+func GetMissingTranslations(inventoryService proto.InventoryServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		resp, err := inventoryService.GetMissingTranslations(req.Context(), &proto.GetMissingTranslationsRequest{
+			Locales: queryList(req.URL.Query(), "locale"),
+		})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		response := domain.MissingTranslationsResponse{
+			Coverage:   []domain.LocaleCoverage{},
+			Items:      []domain.MissingItemTranslation{},
+			Categories: []domain.MissingCategoryTranslation{},
+		}
+		for _, coverage := range resp.Coverage {
+			response.Coverage = append(response.Coverage, domain.LocaleCoverage{
+				Locale:               coverage.Locale,
+				Items:                coverage.Items,
+				ItemsTranslated:      coverage.ItemsTranslated,
+				Categories:           coverage.Categories,
+				CategoriesTranslated: coverage.CategoriesTranslated,
+			})
+		}
+		for _, missing := range resp.Items {
+			response.Items = append(response.Items, domain.MissingItemTranslation{
+				Locale: missing.Locale,
+				ItemID: missing.ItemId,
+				Name:   missing.Name,
+				Fields: missing.Fields,
+			})
+		}
+		for _, missing := range resp.Categories {
+			response.Categories = append(response.Categories, domain.MissingCategoryTranslation{
+				Locale:   missing.Locale,
+				Category: missing.Category,
+			})
+		}
+
+		res, err := json.Marshal(response)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
@@ -0,0 +1,268 @@
+package inventoryHandlers
+
+import (
+	"api-gateway/domain"
+	proto "api-gateway/proto/inventory"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_SetItemTranslation() {
+	t := suite.T()
+
+	t.Run("expect to set the translation as the signed-in admin", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("PUT", "/admin/inventory/item/translations", strings.NewReader(`{"item_id":3,"locale":"ta","name":"தோசை","description":"அரிசி அடை"}`))
+		req = req.WithContext(context.WithValue(req.Context(), "id", 2))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("SetItemTranslation", mock.Anything, &proto.SetItemTranslationRequest{ItemId: 3, Locale: "ta", Name: "தோசை", Description: "அரிசி அடை", Actor: "2"}).Return(&proto.SetItemTranslationResponse{
+			StatusCode:  http.StatusOK,
+			Translation: &proto.ItemTranslation{ItemId: 3, Locale: "ta", Name: "தோசை", Description: "அரிசி அடை", UpdatedBy: "2", UpdatedAt: "2021-02-01T00:00:00Z"},
+		}, nil).Once()
+
+		handler := SetItemTranslation(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		var response domain.ItemTranslation
+		assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &response))
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, "தோசை", response.Name)
+		assert.Equal(t, "2", response.UpdatedBy)
+	})
+
+	t.Run("expect to pass on an error for a locale the catalog is not translated into", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("PUT", "/admin/inventory/item/translations", strings.NewReader(`{"item_id":3,"locale":"fr","name":"crêpe"}`))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("SetItemTranslation", mock.Anything, &proto.SetItemTranslationRequest{ItemId: 3, Locale: "fr", Name: "crêpe"}).Return(&proto.SetItemTranslationResponse{
+			StatusCode: http.StatusBadRequest,
+		}, errors.New("locale is not one the catalog is translated into")).Once()
+
+		handler := SetItemTranslation(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("expect to return 400 for an invalid body", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("PUT", "/admin/inventory/item/translations", strings.NewReader(`{"item_id":"dosa"}`))
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := SetItemTranslation(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_DeleteItemTranslation() {
+	t := suite.T()
+
+	t.Run("expect to delete the item's translation into the locale", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("DELETE", "/admin/inventory/item/translations?id=3&locale=ta", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("DeleteItemTranslation", mock.Anything, &proto.DeleteItemTranslationRequest{ItemId: 3, Locale: "ta"}).Return(&proto.DeleteTranslationResponse{
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+
+		handler := DeleteItemTranslation(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.JSONEq(t, `{"message":"Translation deleted successfully"}`, res.Body.String())
+	})
+
+	t.Run("expect to return 400 for an invalid item id", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("DELETE", "/admin/inventory/item/translations?id=dosa&locale=ta", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := DeleteItemTranslation(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_ListItemTranslations() {
+	t := suite.T()
+
+	t.Run("expect to return an empty list for an item without translations", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("GET", "/admin/inventory/item/translations?id=3", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("ListItemTranslations", mock.Anything, &proto.ListItemTranslationsRequest{ItemId: 3}).Return(&proto.ListItemTranslationsResponse{
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+
+		handler := ListItemTranslations(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.JSONEq(t, `{"translations":[]}`, res.Body.String())
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_SetCategoryTranslation() {
+	t := suite.T()
+
+	t.Run("expect to set the category's name in the locale", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("PUT", "/admin/inventory/categories/translations", strings.NewReader(`{"category":"mains","locale":"hi","name":"मुख्य व्यंजन"}`))
+		req = req.WithContext(context.WithValue(req.Context(), "id", 2))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("SetCategoryTranslation", mock.Anything, &proto.SetCategoryTranslationRequest{Category: "mains", Locale: "hi", Name: "मुख्य व्यंजन", Actor: "2"}).Return(&proto.SetCategoryTranslationResponse{
+			StatusCode:  http.StatusOK,
+			Translation: &proto.CategoryTranslation{Category: "mains", Locale: "hi", Name: "मुख्य व्यंजन", UpdatedBy: "2"},
+		}, nil).Once()
+
+		handler := SetCategoryTranslation(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		var response domain.CategoryTranslation
+		assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &response))
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, "मुख्य व्यंजन", response.Name)
+	})
+
+	t.Run("expect to pass on an error for a category no item is in", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("PUT", "/admin/inventory/categories/translations", strings.NewReader(`{"category":"desserts","locale":"hi","name":"मिठाई"}`))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("SetCategoryTranslation", mock.Anything, &proto.SetCategoryTranslationRequest{Category: "desserts", Locale: "hi", Name: "मिठाई"}).Return(&proto.SetCategoryTranslationResponse{
+			StatusCode: http.StatusNotFound,
+		}, errors.New("no item in the catalog is in that category")).Once()
+
+		handler := SetCategoryTranslation(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_DeleteCategoryTranslation() {
+	t := suite.T()
+
+	t.Run("expect to delete the category's translation into the locale", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("DELETE", "/admin/inventory/categories/translations?category=mains&locale=hi", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("DeleteCategoryTranslation", mock.Anything, &proto.DeleteCategoryTranslationRequest{Category: "mains", Locale: "hi"}).Return(&proto.DeleteTranslationResponse{
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+
+		handler := DeleteCategoryTranslation(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, res.Code)
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_ListCategoryTranslations() {
+	t := suite.T()
+
+	t.Run("expect to list the translations of every category by default", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("GET", "/admin/inventory/categories/translations", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("ListCategoryTranslations", mock.Anything, &proto.ListCategoryTranslationsRequest{}).Return(&proto.ListCategoryTranslationsResponse{
+			StatusCode:   http.StatusOK,
+			Translations: []*proto.CategoryTranslation{{Category: "mains", Locale: "hi", Name: "मुख्य व्यंजन"}},
+		}, nil).Once()
+
+		handler := ListCategoryTranslations(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		var response domain.ListCategoryTranslationsResponse
+		assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &response))
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, 1, len(response.Translations))
+	})
+}
+
+func (suite *InventoryHandlersTestSuite) TestInventoryHandler_GetMissingTranslations() {
+	t := suite.T()
+
+	t.Run("expect to report what is left to translate into the locales asked for", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("GET", "/admin/inventory/translations/missing?locale=hi,TA", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("GetMissingTranslations", mock.Anything, &proto.GetMissingTranslationsRequest{Locales: []string{"hi", "ta"}}).Return(&proto.GetMissingTranslationsResponse{
+			StatusCode: http.StatusOK,
+			Coverage: []*proto.LocaleCoverage{
+				{Locale: "hi", Items: 2, ItemsTranslated: 2, Categories: 1, CategoriesTranslated: 1},
+				{Locale: "ta", Items: 2, ItemsTranslated: 1, Categories: 1, CategoriesTranslated: 0},
+			},
+			Items:      []*proto.MissingItemTranslation{{Locale: "ta", ItemId: 4, Name: "idli", Fields: []string{"description"}}},
+			Categories: []*proto.MissingCategoryTranslation{{Locale: "ta", Category: "mains"}},
+		}, nil).Once()
+
+		handler := GetMissingTranslations(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		var response domain.MissingTranslationsResponse
+		assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &response))
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, 2, len(response.Coverage))
+		assert.Equal(t, []string{"description"}, response.Items[0].Fields)
+		assert.Equal(t, "mains", response.Categories[0].Category)
+	})
+
+	t.Run("expect to pass on an error for the default locale", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("GET", "/admin/inventory/translations/missing?locale=en", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("GetMissingTranslations", mock.Anything, &proto.GetMissingTranslationsRequest{Locales: []string{"en"}}).Return(&proto.GetMissingTranslationsResponse{
+			StatusCode: http.StatusBadRequest,
+		}, errors.New("text in the default locale is the item's or category's own")).Once()
+
+		handler := GetMissingTranslations(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
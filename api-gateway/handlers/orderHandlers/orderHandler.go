@@ -3,13 +3,75 @@ package orderHandlers
 import (
 	"api-gateway/domain"
 	"api-gateway/errors"
+	inventoryproto "api-gateway/proto/inventory"
+	moneyproto "api-gateway/proto/money"
 	proto "api-gateway/proto/order"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
+
+	logger "github.com/sirupsen/logrus"
 )
 
+// toMoney maps an amount returned by order-service to the gateway's response.
+func toMoney(amount *moneyproto.Money) domain.Money {
+	return domain.Money{
+		MinorUnits: amount.GetMinorUnits(),
+		Currency:   amount.GetCurrency(),
+	}
+}
+
+// toOrderOptions maps the options recorded with an order to the gateway's response.
+func toOrderOptions(options []*proto.OrderOption) []domain.OrderOption {
+	var response []domain.OrderOption
+	for _, option := range options {
+		response = append(response, domain.OrderOption{
+			OptionID:   option.OptionId,
+			Group:      option.Group,
+			Name:       option.Name,
+			PriceDelta: toMoney(option.PriceDelta),
+		})
+	}
+	return response
+}
+
+// addItemDetails fills in the item details of the orders with a single inventory lookup.
+// The details are a convenience, so when the lookup fails the orders are returned as they are.
+func addItemDetails(ctx context.Context, inventoryService inventoryproto.InventoryServiceClient, orders []*domain.Order) {
+	ids := []int32{}
+	seen := map[uint32]bool{}
+	for _, order := range orders {
+		if !seen[order.ItemID] {
+			seen[order.ItemID] = true
+			ids = append(ids, int32(order.ItemID))
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	resp, err := inventoryService.BatchGetItems(ctx, &inventoryproto.BatchGetItemsRequest{Ids: ids, IncludeArchived: true})
+	if err != nil {
+		logger.WithField("error", err.Error()).Error("failed to fetch item details for orders")
+		return
+	}
+
+	items := map[uint32]*domain.OrderItem{}
+	for _, item := range resp.Items {
+		items[uint32(item.Id)] = &domain.OrderItem{
+			Name:     item.Name,
+			Price:    toMoney(item.Price),
+			SKU:      item.Sku,
+			Archived: item.ArchivedAt != "",
+		}
+	}
+	for _, order := range orders {
+		order.Item = items[order.ItemID]
+	}
+}
+
 func PlaceOrder(orderService proto.OrderServiceClient) http.HandlerFunc {
 	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		if req.Method != http.MethodPost {
@@ -49,9 +111,10 @@ func PlaceOrder(orderService proto.OrderServiceClient) http.HandlerFunc {
 		userID := uint32(id)
 
 		grpcRequest := proto.PlaceOrderRequest{
-			UserId:   userID,
-			ItemId:   requestBody.ItemID,
-			Quantity: requestBody.Quantity,
+			UserId:    userID,
+			ItemId:    requestBody.ItemID,
+			Quantity:  requestBody.Quantity,
+			OptionIds: requestBody.OptionIDs,
 		}
 
 		resp, err := orderService.PlaceOrder(req.Context(), &grpcRequest)
@@ -66,8 +129,9 @@ func PlaceOrder(orderService proto.OrderServiceClient) http.HandlerFunc {
 
 		response := domain.PlaceOrderResponse{
 			OrderID:   resp.Order.OrderId,
-			Amount:    resp.Order.Amount,
+			Amount:    toMoney(resp.Order.Amount),
 			OrderTime: resp.Order.OrderTime,
+			Options:   toOrderOptions(resp.Order.Options),
 		}
 
 		res, err := json.Marshal(response)
@@ -86,7 +150,7 @@ func PlaceOrder(orderService proto.OrderServiceClient) http.HandlerFunc {
 	})
 }
 
-func GetOrders(orderService proto.OrderServiceClient) http.HandlerFunc {
+func GetOrders(orderService proto.OrderServiceClient, inventoryService inventoryproto.InventoryServiceClient) http.HandlerFunc {
 	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		if req.Method != http.MethodGet {
 			rw.WriteHeader(http.StatusMethodNotAllowed)
@@ -156,8 +220,10 @@ func GetOrders(orderService proto.OrderServiceClient) http.HandlerFunc {
 					UserID:    resp.Order.UserId,
 					ItemID:    resp.Order.ItemId,
 					Quantity:  resp.Order.Quantity,
-					Amount:    resp.Order.Amount,
+					Amount:    toMoney(resp.Order.Amount),
 					OrderTime: resp.Order.OrderTime,
+					Status:    resp.Order.Status,
+					Options:   toOrderOptions(resp.Order.Options),
 				},
 			}
 
@@ -206,10 +272,14 @@ func GetOrders(orderService proto.OrderServiceClient) http.HandlerFunc {
 				UserID:    order.UserId,
 				ItemID:    order.ItemId,
 				Quantity:  order.Quantity,
-				Amount:    order.Amount,
+				Amount:    toMoney(order.Amount),
 				OrderTime: order.OrderTime,
+				Status:    order.Status,
+				Options:   toOrderOptions(order.Options),
 			})
 		}
+		addItemDetails(req.Context(), inventoryService, orders)
+
 		response := domain.GetAllOrdersResponse{
 			Orders: orders,
 		}
@@ -229,3 +299,59 @@ func GetOrders(orderService proto.OrderServiceClient) http.HandlerFunc {
 		rw.Write(res)
 	})
 }
+
+// MarkOrderDelivered records that the order given by ?id= has reached the customer, who can
+// then review what they ordered.
+func MarkOrderDelivered(orderService proto.OrderServiceClient) http.HandlerFunc {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		orderID, err := strconv.ParseUint(req.URL.Query().Get("id"), 10, 32)
+		if err != nil {
+			http.Error(rw, "Invalid order ID", http.StatusBadRequest)
+			return
+		}
+
+		resp, err := orderService.MarkOrderDelivered(req.Context(), &proto.MarkOrderDeliveredRequest{
+			OrderId: uint32(orderID),
+		})
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("grpc received error: %s", err.Error()),
+			}
+			rw.WriteHeader(int(resp.StatusCode))
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		response := domain.GetOrderResponse{
+			Order: &domain.Order{
+				OrderID:   resp.Order.OrderId,
+				UserID:    resp.Order.UserId,
+				ItemID:    resp.Order.ItemId,
+				Quantity:  resp.Order.Quantity,
+				Amount:    toMoney(resp.Order.Amount),
+				OrderTime: resp.Order.OrderTime,
+				Status:    resp.Order.Status,
+				Options:   toOrderOptions(resp.Order.Options),
+			},
+		}
+
+		res, err := json.Marshal(response)
+		if err != nil {
+			message := domain.Message{
+				Message: fmt.Sprintf("error marshalling response: %s", err.Error()),
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(rw).Encode(message)
+			return
+		}
+
+		rw.WriteHeader(int(resp.StatusCode))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write(res)
+	})
+}
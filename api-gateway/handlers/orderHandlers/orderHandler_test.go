@@ -11,7 +11,10 @@ import (
 
 	"api-gateway/dependencies"
 	"api-gateway/domain"
+	inventorymocks "api-gateway/mocks/inventorymocks"
 	mocks "api-gateway/mocks/ordermocks"
+	inventoryproto "api-gateway/proto/inventory"
+	moneyproto "api-gateway/proto/money"
 	proto "api-gateway/proto/order"
 
 	"github.com/stretchr/testify/assert"
@@ -20,7 +23,8 @@ import (
 
 type OrderHandlerTestSuite struct {
 	suite.Suite
-	grpc *mocks.OrderServiceClient
+	grpc      *mocks.OrderServiceClient
+	inventory *inventorymocks.InventoryServiceClient
 }
 
 func TestAuthHandlerTestSuite(t *testing.T) {
@@ -28,10 +32,12 @@ func TestAuthHandlerTestSuite(t *testing.T) {
 }
 func (suite *OrderHandlerTestSuite) SetupTest() {
 	suite.grpc = &mocks.OrderServiceClient{}
+	suite.inventory = &inventorymocks.InventoryServiceClient{}
 }
 
 func (suite *OrderHandlerTestSuite) TearDownTest() {
 	suite.grpc.AssertExpectations(suite.T())
+	suite.inventory.AssertExpectations(suite.T())
 }
 
 func (suite *OrderHandlerTestSuite) TestOrderHandler_PlaceOrder() {
@@ -57,14 +63,14 @@ func (suite *OrderHandlerTestSuite) TestOrderHandler_PlaceOrder() {
 				UserId:    1,
 				ItemId:    1,
 				Quantity:  2,
-				Amount:    200,
+				Amount:    &moneyproto.Money{MinorUnits: 20000, Currency: "EUR"},
 				OrderTime: "2021-01-01 00:00:00",
 			},
 		}
 
 		response := domain.PlaceOrderResponse{
 			OrderID:   expectedResponse.Order.OrderId,
-			Amount:    expectedResponse.Order.Amount,
+			Amount:    toMoney(expectedResponse.Order.Amount),
 			OrderTime: expectedResponse.Order.OrderTime,
 		}
 
@@ -227,7 +233,7 @@ func (suite *OrderHandlerTestSuite) TestOrderHandlers_GetOrders() {
 					UserId:    1,
 					ItemId:    1,
 					Quantity:  2,
-					Amount:    200,
+					Amount:    &moneyproto.Money{MinorUnits: 20000, Currency: "EUR"},
 					OrderTime: "2021-01-01 00:00:00",
 				},
 			},
@@ -240,8 +246,12 @@ func (suite *OrderHandlerTestSuite) TestOrderHandlers_GetOrders() {
 					UserID:    1,
 					ItemID:    1,
 					Quantity:  2,
-					Amount:    200,
+					Amount:    domain.Money{MinorUnits: 20000, Currency: "EUR"},
 					OrderTime: "2021-01-01 00:00:00",
+					Item: &domain.OrderItem{
+						Name:  "pizza",
+						Price: domain.Money{MinorUnits: 10000, Currency: "EUR"},
+					},
 				},
 			},
 		}
@@ -256,10 +266,15 @@ func (suite *OrderHandlerTestSuite) TestOrderHandlers_GetOrders() {
 
 		// Act
 		suite.grpc.On("GetAllOrders", req.Context(), &expectedRequest).Return(&expectedResponse, nil).Once()
+		suite.inventory.On("BatchGetItems", req.Context(), &inventoryproto.BatchGetItemsRequest{Ids: []int32{1}, IncludeArchived: true}).Return(&inventoryproto.BatchGetItemsResponse{
+			StatusCode: http.StatusOK,
+			Items:      []*inventoryproto.GetItemResponse{{Id: 1, Name: "pizza", Price: &moneyproto.Money{MinorUnits: 10000, Currency: "EUR"}}},
+		}, nil).Once()
 		deps := dependencies.Dependencies{
-			OrderService: suite.grpc,
+			OrderService:     suite.grpc,
+			InventoryService: suite.inventory,
 		}
-		handler := GetOrders(deps.OrderService)
+		handler := GetOrders(deps.OrderService, deps.InventoryService)
 		handler.ServeHTTP(res, req)
 
 		// Assert
@@ -280,7 +295,7 @@ func (suite *OrderHandlerTestSuite) TestOrderHandlers_GetOrders() {
 				UserId:    1,
 				ItemId:    1,
 				Quantity:  2,
-				Amount:    200,
+				Amount:    &moneyproto.Money{MinorUnits: 20000, Currency: "EUR"},
 				OrderTime: "2021-01-01 00:00:00",
 			},
 		}
@@ -291,7 +306,7 @@ func (suite *OrderHandlerTestSuite) TestOrderHandlers_GetOrders() {
 				UserID:    expectedResponse.Order.UserId,
 				ItemID:    expectedResponse.Order.ItemId,
 				Quantity:  expectedResponse.Order.Quantity,
-				Amount:    expectedResponse.Order.Amount,
+				Amount:    toMoney(expectedResponse.Order.Amount),
 				OrderTime: expectedResponse.Order.OrderTime,
 			},
 		}
@@ -307,9 +322,10 @@ func (suite *OrderHandlerTestSuite) TestOrderHandlers_GetOrders() {
 		// Act
 		suite.grpc.On("GetOrder", req.Context(), &expectedRequest).Return(&expectedResponse, nil).Once()
 		deps := dependencies.Dependencies{
-			OrderService: suite.grpc,
+			OrderService:     suite.grpc,
+			InventoryService: suite.inventory,
 		}
-		handler := GetOrders(deps.OrderService)
+		handler := GetOrders(deps.OrderService, deps.InventoryService)
 		handler.ServeHTTP(res, req)
 
 		// Assert
@@ -343,9 +359,10 @@ func (suite *OrderHandlerTestSuite) TestOrderHandlers_GetOrders() {
 		// Act
 		suite.grpc.On("GetOrder", req.Context(), &expectedRequest).Return(&expectedResponse, errors.New("order not found")).Once()
 		deps := dependencies.Dependencies{
-			OrderService: suite.grpc,
+			OrderService:     suite.grpc,
+			InventoryService: suite.inventory,
 		}
-		handler := GetOrders(deps.OrderService)
+		handler := GetOrders(deps.OrderService, deps.InventoryService)
 		handler.ServeHTTP(res, req)
 
 		// Assert
@@ -379,9 +396,10 @@ func (suite *OrderHandlerTestSuite) TestOrderHandlers_GetOrders() {
 		// Act
 		suite.grpc.On("GetAllOrders", req.Context(), &expectedRequest).Return(&expectedResponse, errors.New("order not found")).Once()
 		deps := dependencies.Dependencies{
-			OrderService: suite.grpc,
+			OrderService:     suite.grpc,
+			InventoryService: suite.inventory,
 		}
-		handler := GetOrders(deps.OrderService)
+		handler := GetOrders(deps.OrderService, deps.InventoryService)
 		handler.ServeHTTP(res, req)
 
 		// Assert
@@ -415,13 +433,223 @@ func (suite *OrderHandlerTestSuite) TestOrderHandlers_GetOrders() {
 		// Act
 		suite.grpc.On("GetAllOrders", req.Context(), &expectedRequest).Return(&expectedResponse, errors.New("mocked error")).Once()
 		deps := dependencies.Dependencies{
-			OrderService: suite.grpc,
+			OrderService:     suite.grpc,
+			InventoryService: suite.inventory,
 		}
-		handler := GetOrders(deps.OrderService)
+		handler := GetOrders(deps.OrderService, deps.InventoryService)
 		handler.ServeHTTP(res, req)
 
 		// Assert
 		assert.Equal(t, http.StatusInternalServerError, res.Code)
 		assert.Equal(t, string(respBody), strings.Split(res.Body.String(), "\n")[0])
 	})
-}
\ No newline at end of file
+}
+func (suite *OrderHandlerTestSuite) TestOrderHandler_PlaceOrderWithOptions() {
+	t := suite.T()
+
+	t.Run("expect selected options to be forwarded and returned", func(t *testing.T) {
+		// Arrange
+		requestBody := domain.PlaceOrderRequest{
+			ItemID:    1,
+			Quantity:  1,
+			OptionIDs: []uint32{2, 3},
+		}
+
+		expectedRequest := proto.PlaceOrderRequest{
+			UserId:    1,
+			ItemId:    1,
+			Quantity:  1,
+			OptionIds: []uint32{2, 3},
+		}
+
+		expectedResponse := proto.PlaceOrderResponse{
+			StatusCode: http.StatusCreated,
+			Order: &proto.Order{
+				OrderId:   4,
+				UserId:    1,
+				ItemId:    1,
+				Quantity:  1,
+				Amount:    &moneyproto.Money{MinorUnits: 46000, Currency: "EUR"},
+				OrderTime: "2021-01-01 00:00:00",
+				Options: []*proto.OrderOption{
+					{OptionId: 2, Group: "size", Name: "large", PriceDelta: &moneyproto.Money{MinorUnits: 12000, Currency: "EUR"}},
+					{OptionId: 3, Group: "toppings", Name: "extra cheese", PriceDelta: &moneyproto.Money{MinorUnits: 4000, Currency: "EUR"}},
+				},
+			},
+		}
+
+		response := domain.PlaceOrderResponse{
+			OrderID:   4,
+			Amount:    domain.Money{MinorUnits: 46000, Currency: "EUR"},
+			OrderTime: "2021-01-01 00:00:00",
+			Options: []domain.OrderOption{
+				{OptionID: 2, Group: "size", Name: "large", PriceDelta: domain.Money{MinorUnits: 12000, Currency: "EUR"}},
+				{OptionID: 3, Group: "toppings", Name: "extra cheese", PriceDelta: domain.Money{MinorUnits: 4000, Currency: "EUR"}},
+			},
+		}
+
+		respBody, err := json.Marshal(response)
+		assert.NoError(t, err)
+
+		reqBody, err := json.Marshal(requestBody)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("POST", "/user/order", strings.NewReader(string(reqBody)))
+		res := httptest.NewRecorder()
+		req = req.WithContext(context.WithValue(req.Context(), "id", 1))
+
+		// Act
+		suite.grpc.On("PlaceOrder", req.Context(), &expectedRequest).Return(&expectedResponse, nil).Once()
+
+		handler := PlaceOrder(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, res.Code)
+		assert.Equal(t, string(respBody), res.Body.String())
+	})
+}
+
+func (suite *OrderHandlerTestSuite) TestOrderHandlers_GetOrdersItemDetails() {
+	t := suite.T()
+
+	orders := &proto.GetAllOrdersResponse{
+		StatusCode: http.StatusOK,
+		Orders: []*proto.Order{
+			{OrderId: 1, UserId: 1, ItemId: 4, Quantity: 1, Amount: &moneyproto.Money{MinorUnits: 8000, Currency: "EUR"}, OrderTime: "2021-01-01 00:00:00"},
+			{OrderId: 2, UserId: 1, ItemId: 7, Quantity: 2, Amount: &moneyproto.Money{MinorUnits: 12000, Currency: "EUR"}, OrderTime: "2021-01-02 00:00:00"},
+			{OrderId: 3, UserId: 1, ItemId: 4, Quantity: 3, Amount: &moneyproto.Money{MinorUnits: 24000, Currency: "EUR"}, OrderTime: "2021-01-03 00:00:00"},
+		},
+	}
+
+	t.Run("expect to look up each item once and leave out missing items", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("GET", "/user/order", nil)
+		req = req.WithContext(context.WithValue(req.Context(), "id", 1))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("GetAllOrders", req.Context(), &proto.GetAllOrdersRequest{UserId: 1}).Return(orders, nil).Once()
+		suite.inventory.On("BatchGetItems", req.Context(), &inventoryproto.BatchGetItemsRequest{Ids: []int32{4, 7}, IncludeArchived: true}).Return(&inventoryproto.BatchGetItemsResponse{
+			StatusCode: http.StatusOK,
+			Items:      []*inventoryproto.GetItemResponse{{Id: 4, Name: "dosa", Price: &moneyproto.Money{MinorUnits: 8000, Currency: "EUR"}, Sku: "DOSA-1"}},
+			MissingIds: []int32{7},
+		}, nil).Once()
+
+		handler := GetOrders(suite.grpc, suite.inventory)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		var response domain.GetAllOrdersResponse
+		assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &response))
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, &domain.OrderItem{Name: "dosa", Price: domain.Money{MinorUnits: 8000, Currency: "EUR"}, SKU: "DOSA-1"}, response.Orders[0].Item)
+		assert.Nil(t, response.Orders[1].Item)
+		assert.Equal(t, "dosa", response.Orders[2].Item.Name)
+	})
+
+	t.Run("expect to return the orders without details when the lookup fails", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("GET", "/user/order", nil)
+		req = req.WithContext(context.WithValue(req.Context(), "id", 1))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("GetAllOrders", req.Context(), &proto.GetAllOrdersRequest{UserId: 1}).Return(orders, nil).Once()
+		suite.inventory.On("BatchGetItems", req.Context(), &inventoryproto.BatchGetItemsRequest{Ids: []int32{4, 7}, IncludeArchived: true}).Return(&inventoryproto.BatchGetItemsResponse{
+			StatusCode: http.StatusInternalServerError,
+		}, errors.New("inventory unavailable")).Once()
+
+		handler := GetOrders(suite.grpc, suite.inventory)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		var response domain.GetAllOrdersResponse
+		assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &response))
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, 3, len(response.Orders))
+		assert.Nil(t, response.Orders[0].Item)
+	})
+
+	t.Run("expect to still describe items that have been archived", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("GET", "/user/order", nil)
+		req = req.WithContext(context.WithValue(req.Context(), "id", 1))
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("GetAllOrders", req.Context(), &proto.GetAllOrdersRequest{UserId: 1}).Return(orders, nil).Once()
+		suite.inventory.On("BatchGetItems", req.Context(), &inventoryproto.BatchGetItemsRequest{Ids: []int32{4, 7}, IncludeArchived: true}).Return(&inventoryproto.BatchGetItemsResponse{
+			StatusCode: http.StatusOK,
+			Items: []*inventoryproto.GetItemResponse{
+				{Id: 4, Name: "dosa", Price: &moneyproto.Money{MinorUnits: 8000, Currency: "EUR"}},
+				{Id: 7, Name: "uttapam", Price: &moneyproto.Money{MinorUnits: 6000, Currency: "EUR"}, ArchivedAt: "2021-02-01T00:00:00Z"},
+			},
+		}, nil).Once()
+
+		handler := GetOrders(suite.grpc, suite.inventory)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		var response domain.GetAllOrdersResponse
+		assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &response))
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.False(t, response.Orders[0].Item.Archived)
+		assert.Equal(t, &domain.OrderItem{Name: "uttapam", Price: domain.Money{MinorUnits: 6000, Currency: "EUR"}, Archived: true}, response.Orders[1].Item)
+	})
+}
+
+func (suite *OrderHandlerTestSuite) TestOrderHandlers_MarkOrderDelivered() {
+	t := suite.T()
+
+	t.Run("expect to return the order as delivered", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("POST", "/admin/order/deliver?id=12", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("MarkOrderDelivered", req.Context(), &proto.MarkOrderDeliveredRequest{OrderId: 12}).Return(&proto.MarkOrderDeliveredResponse{
+			StatusCode: http.StatusOK,
+			Order:      &proto.Order{OrderId: 12, UserId: 1, ItemId: 4, Quantity: 1, Amount: &moneyproto.Money{MinorUnits: 8000, Currency: "EUR"}, Status: "DELIVERED"},
+		}, nil).Once()
+
+		handler := MarkOrderDelivered(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		var response domain.GetOrderResponse
+		assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &response))
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, "DELIVERED", response.Order.Status)
+	})
+
+	t.Run("expect to pass on a conflict for an order already delivered", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("POST", "/admin/order/deliver?id=12", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		suite.grpc.On("MarkOrderDelivered", req.Context(), &proto.MarkOrderDeliveredRequest{OrderId: 12}).Return(&proto.MarkOrderDeliveredResponse{
+			StatusCode: http.StatusConflict,
+		}, errors.New("order has already been delivered")).Once()
+
+		handler := MarkOrderDelivered(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusConflict, res.Code)
+	})
+
+	t.Run("expect to return 400 for an invalid order id", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("POST", "/admin/order/deliver?id=dosa", nil)
+		res := httptest.NewRecorder()
+
+		// Act
+		handler := MarkOrderDelivered(suite.grpc)
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
@@ -17,6 +17,39 @@ type InventoryServiceClient struct {
 	mock.Mock
 }
 
+// AddAvailabilityWindow provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) AddAvailabilityWindow(ctx context.Context, in *inventory.AddAvailabilityWindowRequest, opts ...grpc.CallOption) (*inventory.AddAvailabilityWindowResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.AddAvailabilityWindowResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.AddAvailabilityWindowRequest, ...grpc.CallOption) (*inventory.AddAvailabilityWindowResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.AddAvailabilityWindowRequest, ...grpc.CallOption) *inventory.AddAvailabilityWindowResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.AddAvailabilityWindowResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.AddAvailabilityWindowRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AddItem provides a mock function with given fields: ctx, in, opts
 func (_m *InventoryServiceClient) AddItem(ctx context.Context, in *inventory.AddItemRequest, opts ...grpc.CallOption) (*inventory.AddItemResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -50,6 +83,105 @@ func (_m *InventoryServiceClient) AddItem(ctx context.Context, in *inventory.Add
 	return r0, r1
 }
 
+// AddItemBarcode provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) AddItemBarcode(ctx context.Context, in *inventory.AddItemBarcodeRequest, opts ...grpc.CallOption) (*inventory.AddItemBarcodeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.AddItemBarcodeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.AddItemBarcodeRequest, ...grpc.CallOption) (*inventory.AddItemBarcodeResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.AddItemBarcodeRequest, ...grpc.CallOption) *inventory.AddItemBarcodeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.AddItemBarcodeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.AddItemBarcodeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddLocation provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) AddLocation(ctx context.Context, in *inventory.AddLocationRequest, opts ...grpc.CallOption) (*inventory.AddLocationResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.AddLocationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.AddLocationRequest, ...grpc.CallOption) (*inventory.AddLocationResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.AddLocationRequest, ...grpc.CallOption) *inventory.AddLocationResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.AddLocationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.AddLocationRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddOptionGroup provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) AddOptionGroup(ctx context.Context, in *inventory.AddOptionGroupRequest, opts ...grpc.CallOption) (*inventory.AddOptionGroupResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.AddOptionGroupResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.AddOptionGroupRequest, ...grpc.CallOption) (*inventory.AddOptionGroupResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.AddOptionGroupRequest, ...grpc.CallOption) *inventory.AddOptionGroupResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.AddOptionGroupResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.AddOptionGroupRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AddQuantity provides a mock function with given fields: ctx, in, opts
 func (_m *InventoryServiceClient) AddQuantity(ctx context.Context, in *inventory.AddQuantityRequest, opts ...grpc.CallOption) (*inventory.AddQuantityResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -83,8 +215,8 @@ func (_m *InventoryServiceClient) AddQuantity(ctx context.Context, in *inventory
 	return r0, r1
 }
 
-// DeleteItem provides a mock function with given fields: ctx, in, opts
-func (_m *InventoryServiceClient) DeleteItem(ctx context.Context, in *inventory.DeleteItemRequest, opts ...grpc.CallOption) (*inventory.DeleteItemResponse, error) {
+// AddSupplier provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) AddSupplier(ctx context.Context, in *inventory.AddSupplierRequest, opts ...grpc.CallOption) (*inventory.AddSupplierResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -94,20 +226,20 @@ func (_m *InventoryServiceClient) DeleteItem(ctx context.Context, in *inventory.
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *inventory.DeleteItemResponse
+	var r0 *inventory.AddSupplierResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *inventory.DeleteItemRequest, ...grpc.CallOption) (*inventory.DeleteItemResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.AddSupplierRequest, ...grpc.CallOption) (*inventory.AddSupplierResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *inventory.DeleteItemRequest, ...grpc.CallOption) *inventory.DeleteItemResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.AddSupplierRequest, ...grpc.CallOption) *inventory.AddSupplierResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*inventory.DeleteItemResponse)
+			r0 = ret.Get(0).(*inventory.AddSupplierResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *inventory.DeleteItemRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.AddSupplierRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -116,8 +248,8 @@ func (_m *InventoryServiceClient) DeleteItem(ctx context.Context, in *inventory.
 	return r0, r1
 }
 
-// GetAllItems provides a mock function with given fields: ctx, in, opts
-func (_m *InventoryServiceClient) GetAllItems(ctx context.Context, in *inventory.GetAllItemsRequest, opts ...grpc.CallOption) (*inventory.GetAllItemsResponse, error) {
+// ApproveStockTake provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) ApproveStockTake(ctx context.Context, in *inventory.ApproveStockTakeRequest, opts ...grpc.CallOption) (*inventory.ApproveStockTakeResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -127,20 +259,20 @@ func (_m *InventoryServiceClient) GetAllItems(ctx context.Context, in *inventory
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *inventory.GetAllItemsResponse
+	var r0 *inventory.ApproveStockTakeResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *inventory.GetAllItemsRequest, ...grpc.CallOption) (*inventory.GetAllItemsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ApproveStockTakeRequest, ...grpc.CallOption) (*inventory.ApproveStockTakeResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *inventory.GetAllItemsRequest, ...grpc.CallOption) *inventory.GetAllItemsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ApproveStockTakeRequest, ...grpc.CallOption) *inventory.ApproveStockTakeResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*inventory.GetAllItemsResponse)
+			r0 = ret.Get(0).(*inventory.ApproveStockTakeResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *inventory.GetAllItemsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.ApproveStockTakeRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -149,8 +281,8 @@ func (_m *InventoryServiceClient) GetAllItems(ctx context.Context, in *inventory
 	return r0, r1
 }
 
-// GetItem provides a mock function with given fields: ctx, in, opts
-func (_m *InventoryServiceClient) GetItem(ctx context.Context, in *inventory.GetItemRequest, opts ...grpc.CallOption) (*inventory.GetItemResponse, error) {
+// BatchGetItems provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) BatchGetItems(ctx context.Context, in *inventory.BatchGetItemsRequest, opts ...grpc.CallOption) (*inventory.BatchGetItemsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -160,20 +292,20 @@ func (_m *InventoryServiceClient) GetItem(ctx context.Context, in *inventory.Get
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *inventory.GetItemResponse
+	var r0 *inventory.BatchGetItemsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *inventory.GetItemRequest, ...grpc.CallOption) (*inventory.GetItemResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.BatchGetItemsRequest, ...grpc.CallOption) (*inventory.BatchGetItemsResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *inventory.GetItemRequest, ...grpc.CallOption) *inventory.GetItemResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.BatchGetItemsRequest, ...grpc.CallOption) *inventory.BatchGetItemsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*inventory.GetItemResponse)
+			r0 = ret.Get(0).(*inventory.BatchGetItemsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *inventory.GetItemRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.BatchGetItemsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -182,8 +314,8 @@ func (_m *InventoryServiceClient) GetItem(ctx context.Context, in *inventory.Get
 	return r0, r1
 }
 
-// LowerQuantity provides a mock function with given fields: ctx, in, opts
-func (_m *InventoryServiceClient) LowerQuantity(ctx context.Context, in *inventory.LowerQuantityRequest, opts ...grpc.CallOption) (*inventory.LowerQuantityResponse, error) {
+// CancelStockTake provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) CancelStockTake(ctx context.Context, in *inventory.CancelStockTakeRequest, opts ...grpc.CallOption) (*inventory.StockTakeResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -193,20 +325,2198 @@ func (_m *InventoryServiceClient) LowerQuantity(ctx context.Context, in *invento
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *inventory.LowerQuantityResponse
+	var r0 *inventory.StockTakeResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *inventory.LowerQuantityRequest, ...grpc.CallOption) (*inventory.LowerQuantityResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.CancelStockTakeRequest, ...grpc.CallOption) (*inventory.StockTakeResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *inventory.LowerQuantityRequest, ...grpc.CallOption) *inventory.LowerQuantityResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.CancelStockTakeRequest, ...grpc.CallOption) *inventory.StockTakeResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*inventory.LowerQuantityResponse)
+			r0 = ret.Get(0).(*inventory.StockTakeResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *inventory.LowerQuantityRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.CancelStockTakeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreatePurchaseOrder provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) CreatePurchaseOrder(ctx context.Context, in *inventory.CreatePurchaseOrderRequest, opts ...grpc.CallOption) (*inventory.PurchaseOrderResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.PurchaseOrderResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.CreatePurchaseOrderRequest, ...grpc.CallOption) (*inventory.PurchaseOrderResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.CreatePurchaseOrderRequest, ...grpc.CallOption) *inventory.PurchaseOrderResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.PurchaseOrderResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.CreatePurchaseOrderRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteAvailabilityWindow provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) DeleteAvailabilityWindow(ctx context.Context, in *inventory.DeleteAvailabilityWindowRequest, opts ...grpc.CallOption) (*inventory.DeleteAvailabilityWindowResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.DeleteAvailabilityWindowResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.DeleteAvailabilityWindowRequest, ...grpc.CallOption) (*inventory.DeleteAvailabilityWindowResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.DeleteAvailabilityWindowRequest, ...grpc.CallOption) *inventory.DeleteAvailabilityWindowResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.DeleteAvailabilityWindowResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.DeleteAvailabilityWindowRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteCategoryTranslation provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) DeleteCategoryTranslation(ctx context.Context, in *inventory.DeleteCategoryTranslationRequest, opts ...grpc.CallOption) (*inventory.DeleteTranslationResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.DeleteTranslationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.DeleteCategoryTranslationRequest, ...grpc.CallOption) (*inventory.DeleteTranslationResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.DeleteCategoryTranslationRequest, ...grpc.CallOption) *inventory.DeleteTranslationResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.DeleteTranslationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.DeleteCategoryTranslationRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteItem provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) DeleteItem(ctx context.Context, in *inventory.DeleteItemRequest, opts ...grpc.CallOption) (*inventory.DeleteItemResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.DeleteItemResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.DeleteItemRequest, ...grpc.CallOption) (*inventory.DeleteItemResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.DeleteItemRequest, ...grpc.CallOption) *inventory.DeleteItemResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.DeleteItemResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.DeleteItemRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteItemImage provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) DeleteItemImage(ctx context.Context, in *inventory.DeleteItemImageRequest, opts ...grpc.CallOption) (*inventory.DeleteItemImageResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.DeleteItemImageResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.DeleteItemImageRequest, ...grpc.CallOption) (*inventory.DeleteItemImageResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.DeleteItemImageRequest, ...grpc.CallOption) *inventory.DeleteItemImageResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.DeleteItemImageResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.DeleteItemImageRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteItemTranslation provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) DeleteItemTranslation(ctx context.Context, in *inventory.DeleteItemTranslationRequest, opts ...grpc.CallOption) (*inventory.DeleteTranslationResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.DeleteTranslationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.DeleteItemTranslationRequest, ...grpc.CallOption) (*inventory.DeleteTranslationResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.DeleteItemTranslationRequest, ...grpc.CallOption) *inventory.DeleteTranslationResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.DeleteTranslationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.DeleteItemTranslationRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteOptionGroup provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) DeleteOptionGroup(ctx context.Context, in *inventory.DeleteOptionGroupRequest, opts ...grpc.CallOption) (*inventory.DeleteOptionGroupResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.DeleteOptionGroupResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.DeleteOptionGroupRequest, ...grpc.CallOption) (*inventory.DeleteOptionGroupResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.DeleteOptionGroupRequest, ...grpc.CallOption) *inventory.DeleteOptionGroupResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.DeleteOptionGroupResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.DeleteOptionGroupRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportItems provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) ExportItems(ctx context.Context, in *inventory.ExportItemsRequest, opts ...grpc.CallOption) (*inventory.ExportItemsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.ExportItemsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ExportItemsRequest, ...grpc.CallOption) (*inventory.ExportItemsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ExportItemsRequest, ...grpc.CallOption) *inventory.ExportItemsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.ExportItemsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.ExportItemsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllItems provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) GetAllItems(ctx context.Context, in *inventory.GetAllItemsRequest, opts ...grpc.CallOption) (*inventory.GetAllItemsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.GetAllItemsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.GetAllItemsRequest, ...grpc.CallOption) (*inventory.GetAllItemsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.GetAllItemsRequest, ...grpc.CallOption) *inventory.GetAllItemsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.GetAllItemsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.GetAllItemsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBundle provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) GetBundle(ctx context.Context, in *inventory.GetBundleRequest, opts ...grpc.CallOption) (*inventory.BundleResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.BundleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.GetBundleRequest, ...grpc.CallOption) (*inventory.BundleResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.GetBundleRequest, ...grpc.CallOption) *inventory.BundleResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.BundleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.GetBundleRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetImage provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) GetImage(ctx context.Context, in *inventory.GetImageRequest, opts ...grpc.CallOption) (*inventory.GetImageResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.GetImageResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.GetImageRequest, ...grpc.CallOption) (*inventory.GetImageResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.GetImageRequest, ...grpc.CallOption) *inventory.GetImageResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.GetImageResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.GetImageRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetItem provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) GetItem(ctx context.Context, in *inventory.GetItemRequest, opts ...grpc.CallOption) (*inventory.GetItemResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.GetItemResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.GetItemRequest, ...grpc.CallOption) (*inventory.GetItemResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.GetItemRequest, ...grpc.CallOption) *inventory.GetItemResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.GetItemResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.GetItemRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetItemByBarcode provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) GetItemByBarcode(ctx context.Context, in *inventory.GetItemByBarcodeRequest, opts ...grpc.CallOption) (*inventory.GetItemResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.GetItemResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.GetItemByBarcodeRequest, ...grpc.CallOption) (*inventory.GetItemResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.GetItemByBarcodeRequest, ...grpc.CallOption) *inventory.GetItemResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.GetItemResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.GetItemByBarcodeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetItemBySKU provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) GetItemBySKU(ctx context.Context, in *inventory.GetItemBySKURequest, opts ...grpc.CallOption) (*inventory.GetItemResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.GetItemResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.GetItemBySKURequest, ...grpc.CallOption) (*inventory.GetItemResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.GetItemBySKURequest, ...grpc.CallOption) *inventory.GetItemResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.GetItemResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.GetItemBySKURequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetItemStock provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) GetItemStock(ctx context.Context, in *inventory.GetItemStockRequest, opts ...grpc.CallOption) (*inventory.GetItemStockResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.GetItemStockResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.GetItemStockRequest, ...grpc.CallOption) (*inventory.GetItemStockResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.GetItemStockRequest, ...grpc.CallOption) *inventory.GetItemStockResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.GetItemStockResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.GetItemStockRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMissingTranslations provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) GetMissingTranslations(ctx context.Context, in *inventory.GetMissingTranslationsRequest, opts ...grpc.CallOption) (*inventory.GetMissingTranslationsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.GetMissingTranslationsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.GetMissingTranslationsRequest, ...grpc.CallOption) (*inventory.GetMissingTranslationsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.GetMissingTranslationsRequest, ...grpc.CallOption) *inventory.GetMissingTranslationsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.GetMissingTranslationsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.GetMissingTranslationsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPurchaseOrder provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) GetPurchaseOrder(ctx context.Context, in *inventory.GetPurchaseOrderRequest, opts ...grpc.CallOption) (*inventory.PurchaseOrderResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.PurchaseOrderResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.GetPurchaseOrderRequest, ...grpc.CallOption) (*inventory.PurchaseOrderResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.GetPurchaseOrderRequest, ...grpc.CallOption) *inventory.PurchaseOrderResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.PurchaseOrderResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.GetPurchaseOrderRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRecipe provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) GetRecipe(ctx context.Context, in *inventory.GetRecipeRequest, opts ...grpc.CallOption) (*inventory.RecipeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.RecipeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.GetRecipeRequest, ...grpc.CallOption) (*inventory.RecipeResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.GetRecipeRequest, ...grpc.CallOption) *inventory.RecipeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.RecipeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.GetRecipeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStockTake provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) GetStockTake(ctx context.Context, in *inventory.GetStockTakeRequest, opts ...grpc.CallOption) (*inventory.StockTakeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.StockTakeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.GetStockTakeRequest, ...grpc.CallOption) (*inventory.StockTakeResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.GetStockTakeRequest, ...grpc.CallOption) *inventory.StockTakeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.StockTakeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.GetStockTakeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStockValuation provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) GetStockValuation(ctx context.Context, in *inventory.GetStockValuationRequest, opts ...grpc.CallOption) (*inventory.GetStockValuationResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.GetStockValuationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.GetStockValuationRequest, ...grpc.CallOption) (*inventory.GetStockValuationResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.GetStockValuationRequest, ...grpc.CallOption) *inventory.GetStockValuationResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.GetStockValuationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.GetStockValuationRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVarianceReport provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) GetVarianceReport(ctx context.Context, in *inventory.GetVarianceReportRequest, opts ...grpc.CallOption) (*inventory.GetVarianceReportResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.GetVarianceReportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.GetVarianceReportRequest, ...grpc.CallOption) (*inventory.GetVarianceReportResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.GetVarianceReportRequest, ...grpc.CallOption) *inventory.GetVarianceReportResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.GetVarianceReportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.GetVarianceReportRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportItems provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) ImportItems(ctx context.Context, in *inventory.ImportItemsRequest, opts ...grpc.CallOption) (*inventory.ImportItemsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.ImportItemsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ImportItemsRequest, ...grpc.CallOption) (*inventory.ImportItemsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ImportItemsRequest, ...grpc.CallOption) *inventory.ImportItemsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.ImportItemsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.ImportItemsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListArchivedItems provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) ListArchivedItems(ctx context.Context, in *inventory.ListArchivedItemsRequest, opts ...grpc.CallOption) (*inventory.ListArchivedItemsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.ListArchivedItemsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListArchivedItemsRequest, ...grpc.CallOption) (*inventory.ListArchivedItemsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListArchivedItemsRequest, ...grpc.CallOption) *inventory.ListArchivedItemsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.ListArchivedItemsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.ListArchivedItemsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAvailabilityWindows provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) ListAvailabilityWindows(ctx context.Context, in *inventory.ListAvailabilityWindowsRequest, opts ...grpc.CallOption) (*inventory.ListAvailabilityWindowsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.ListAvailabilityWindowsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListAvailabilityWindowsRequest, ...grpc.CallOption) (*inventory.ListAvailabilityWindowsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListAvailabilityWindowsRequest, ...grpc.CallOption) *inventory.ListAvailabilityWindowsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.ListAvailabilityWindowsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.ListAvailabilityWindowsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCategoryTranslations provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) ListCategoryTranslations(ctx context.Context, in *inventory.ListCategoryTranslationsRequest, opts ...grpc.CallOption) (*inventory.ListCategoryTranslationsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.ListCategoryTranslationsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListCategoryTranslationsRequest, ...grpc.CallOption) (*inventory.ListCategoryTranslationsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListCategoryTranslationsRequest, ...grpc.CallOption) *inventory.ListCategoryTranslationsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.ListCategoryTranslationsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.ListCategoryTranslationsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListExpiredLots provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) ListExpiredLots(ctx context.Context, in *inventory.ListExpiredLotsRequest, opts ...grpc.CallOption) (*inventory.ListExpiredLotsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.ListExpiredLotsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListExpiredLotsRequest, ...grpc.CallOption) (*inventory.ListExpiredLotsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListExpiredLotsRequest, ...grpc.CallOption) *inventory.ListExpiredLotsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.ListExpiredLotsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.ListExpiredLotsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListItemReviews provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) ListItemReviews(ctx context.Context, in *inventory.ListItemReviewsRequest, opts ...grpc.CallOption) (*inventory.ListReviewsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.ListReviewsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListItemReviewsRequest, ...grpc.CallOption) (*inventory.ListReviewsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListItemReviewsRequest, ...grpc.CallOption) *inventory.ListReviewsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.ListReviewsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.ListItemReviewsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListItemTranslations provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) ListItemTranslations(ctx context.Context, in *inventory.ListItemTranslationsRequest, opts ...grpc.CallOption) (*inventory.ListItemTranslationsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.ListItemTranslationsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListItemTranslationsRequest, ...grpc.CallOption) (*inventory.ListItemTranslationsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListItemTranslationsRequest, ...grpc.CallOption) *inventory.ListItemTranslationsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.ListItemTranslationsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.ListItemTranslationsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListLocations provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) ListLocations(ctx context.Context, in *inventory.ListLocationsRequest, opts ...grpc.CallOption) (*inventory.ListLocationsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.ListLocationsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListLocationsRequest, ...grpc.CallOption) (*inventory.ListLocationsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListLocationsRequest, ...grpc.CallOption) *inventory.ListLocationsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.ListLocationsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.ListLocationsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListLots provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) ListLots(ctx context.Context, in *inventory.ListLotsRequest, opts ...grpc.CallOption) (*inventory.ListLotsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.ListLotsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListLotsRequest, ...grpc.CallOption) (*inventory.ListLotsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListLotsRequest, ...grpc.CallOption) *inventory.ListLotsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.ListLotsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.ListLotsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListLowStockItems provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) ListLowStockItems(ctx context.Context, in *inventory.ListLowStockItemsRequest, opts ...grpc.CallOption) (*inventory.ListLowStockItemsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.ListLowStockItemsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListLowStockItemsRequest, ...grpc.CallOption) (*inventory.ListLowStockItemsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListLowStockItemsRequest, ...grpc.CallOption) *inventory.ListLowStockItemsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.ListLowStockItemsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.ListLowStockItemsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPriceHistory provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) ListPriceHistory(ctx context.Context, in *inventory.ListPriceHistoryRequest, opts ...grpc.CallOption) (*inventory.ListPriceHistoryResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.ListPriceHistoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListPriceHistoryRequest, ...grpc.CallOption) (*inventory.ListPriceHistoryResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListPriceHistoryRequest, ...grpc.CallOption) *inventory.ListPriceHistoryResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.ListPriceHistoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.ListPriceHistoryRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPurchaseOrders provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) ListPurchaseOrders(ctx context.Context, in *inventory.ListPurchaseOrdersRequest, opts ...grpc.CallOption) (*inventory.ListPurchaseOrdersResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.ListPurchaseOrdersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListPurchaseOrdersRequest, ...grpc.CallOption) (*inventory.ListPurchaseOrdersResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListPurchaseOrdersRequest, ...grpc.CallOption) *inventory.ListPurchaseOrdersResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.ListPurchaseOrdersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.ListPurchaseOrdersRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRecipeShortages provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) ListRecipeShortages(ctx context.Context, in *inventory.ListRecipeShortagesRequest, opts ...grpc.CallOption) (*inventory.ListRecipeShortagesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.ListRecipeShortagesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListRecipeShortagesRequest, ...grpc.CallOption) (*inventory.ListRecipeShortagesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListRecipeShortagesRequest, ...grpc.CallOption) *inventory.ListRecipeShortagesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.ListRecipeShortagesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.ListRecipeShortagesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListReviews provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) ListReviews(ctx context.Context, in *inventory.ListReviewsRequest, opts ...grpc.CallOption) (*inventory.ListReviewsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.ListReviewsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListReviewsRequest, ...grpc.CallOption) (*inventory.ListReviewsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListReviewsRequest, ...grpc.CallOption) *inventory.ListReviewsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.ListReviewsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.ListReviewsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSlowMovingItems provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) ListSlowMovingItems(ctx context.Context, in *inventory.ListSlowMovingItemsRequest, opts ...grpc.CallOption) (*inventory.ListSlowMovingItemsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.ListSlowMovingItemsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListSlowMovingItemsRequest, ...grpc.CallOption) (*inventory.ListSlowMovingItemsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListSlowMovingItemsRequest, ...grpc.CallOption) *inventory.ListSlowMovingItemsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.ListSlowMovingItemsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.ListSlowMovingItemsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStockMovements provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) ListStockMovements(ctx context.Context, in *inventory.ListStockMovementsRequest, opts ...grpc.CallOption) (*inventory.ListStockMovementsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.ListStockMovementsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListStockMovementsRequest, ...grpc.CallOption) (*inventory.ListStockMovementsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListStockMovementsRequest, ...grpc.CallOption) *inventory.ListStockMovementsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.ListStockMovementsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.ListStockMovementsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStockTakes provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) ListStockTakes(ctx context.Context, in *inventory.ListStockTakesRequest, opts ...grpc.CallOption) (*inventory.ListStockTakesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.ListStockTakesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListStockTakesRequest, ...grpc.CallOption) (*inventory.ListStockTakesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListStockTakesRequest, ...grpc.CallOption) *inventory.ListStockTakesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.ListStockTakesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.ListStockTakesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSupplierItems provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) ListSupplierItems(ctx context.Context, in *inventory.ListSupplierItemsRequest, opts ...grpc.CallOption) (*inventory.ListSupplierItemsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.ListSupplierItemsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListSupplierItemsRequest, ...grpc.CallOption) (*inventory.ListSupplierItemsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListSupplierItemsRequest, ...grpc.CallOption) *inventory.ListSupplierItemsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.ListSupplierItemsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.ListSupplierItemsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSuppliers provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) ListSuppliers(ctx context.Context, in *inventory.ListSuppliersRequest, opts ...grpc.CallOption) (*inventory.ListSuppliersResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.ListSuppliersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListSuppliersRequest, ...grpc.CallOption) (*inventory.ListSuppliersResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ListSuppliersRequest, ...grpc.CallOption) *inventory.ListSuppliersResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.ListSuppliersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.ListSuppliersRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LowerQuantity provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) LowerQuantity(ctx context.Context, in *inventory.LowerQuantityRequest, opts ...grpc.CallOption) (*inventory.LowerQuantityResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.LowerQuantityResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.LowerQuantityRequest, ...grpc.CallOption) (*inventory.LowerQuantityResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.LowerQuantityRequest, ...grpc.CallOption) *inventory.LowerQuantityResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.LowerQuantityResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.LowerQuantityRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModerateReview provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) ModerateReview(ctx context.Context, in *inventory.ModerateReviewRequest, opts ...grpc.CallOption) (*inventory.ModerateReviewResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.ModerateReviewResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ModerateReviewRequest, ...grpc.CallOption) (*inventory.ModerateReviewResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ModerateReviewRequest, ...grpc.CallOption) *inventory.ModerateReviewResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.ModerateReviewResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.ModerateReviewRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeItem provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) PurgeItem(ctx context.Context, in *inventory.PurgeItemRequest, opts ...grpc.CallOption) (*inventory.PurgeItemResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.PurgeItemResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.PurgeItemRequest, ...grpc.CallOption) (*inventory.PurgeItemResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.PurgeItemRequest, ...grpc.CallOption) *inventory.PurgeItemResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.PurgeItemResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.PurgeItemRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReceivePurchaseOrderLine provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) ReceivePurchaseOrderLine(ctx context.Context, in *inventory.ReceivePurchaseOrderLineRequest, opts ...grpc.CallOption) (*inventory.ReceivePurchaseOrderLineResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.ReceivePurchaseOrderLineResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ReceivePurchaseOrderLineRequest, ...grpc.CallOption) (*inventory.ReceivePurchaseOrderLineResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ReceivePurchaseOrderLineRequest, ...grpc.CallOption) *inventory.ReceivePurchaseOrderLineResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.ReceivePurchaseOrderLineResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.ReceivePurchaseOrderLineRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReconcileStock provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) ReconcileStock(ctx context.Context, in *inventory.ReconcileStockRequest, opts ...grpc.CallOption) (*inventory.ReconcileStockResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.ReconcileStockResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ReconcileStockRequest, ...grpc.CallOption) (*inventory.ReconcileStockResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.ReconcileStockRequest, ...grpc.CallOption) *inventory.ReconcileStockResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.ReconcileStockResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.ReconcileStockRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecordStockCount provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) RecordStockCount(ctx context.Context, in *inventory.RecordStockCountRequest, opts ...grpc.CallOption) (*inventory.RecordStockCountResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.RecordStockCountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.RecordStockCountRequest, ...grpc.CallOption) (*inventory.RecordStockCountResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.RecordStockCountRequest, ...grpc.CallOption) *inventory.RecordStockCountResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.RecordStockCountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.RecordStockCountRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveItemBarcode provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) RemoveItemBarcode(ctx context.Context, in *inventory.RemoveItemBarcodeRequest, opts ...grpc.CallOption) (*inventory.RemoveItemBarcodeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.RemoveItemBarcodeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.RemoveItemBarcodeRequest, ...grpc.CallOption) (*inventory.RemoveItemBarcodeResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.RemoveItemBarcodeRequest, ...grpc.CallOption) *inventory.RemoveItemBarcodeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.RemoveItemBarcodeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.RemoveItemBarcodeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreItem provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) RestoreItem(ctx context.Context, in *inventory.RestoreItemRequest, opts ...grpc.CallOption) (*inventory.RestoreItemResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.RestoreItemResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.RestoreItemRequest, ...grpc.CallOption) (*inventory.RestoreItemResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.RestoreItemRequest, ...grpc.CallOption) *inventory.RestoreItemResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.RestoreItemResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.RestoreItemRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SchedulePrice provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) SchedulePrice(ctx context.Context, in *inventory.SchedulePriceRequest, opts ...grpc.CallOption) (*inventory.SchedulePriceResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.SchedulePriceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.SchedulePriceRequest, ...grpc.CallOption) (*inventory.SchedulePriceResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.SchedulePriceRequest, ...grpc.CallOption) *inventory.SchedulePriceResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.SchedulePriceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.SchedulePriceRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SendPurchaseOrder provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) SendPurchaseOrder(ctx context.Context, in *inventory.SendPurchaseOrderRequest, opts ...grpc.CallOption) (*inventory.PurchaseOrderResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.PurchaseOrderResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.SendPurchaseOrderRequest, ...grpc.CallOption) (*inventory.PurchaseOrderResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.SendPurchaseOrderRequest, ...grpc.CallOption) *inventory.PurchaseOrderResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.PurchaseOrderResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.SendPurchaseOrderRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetBundle provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) SetBundle(ctx context.Context, in *inventory.SetBundleRequest, opts ...grpc.CallOption) (*inventory.BundleResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.BundleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.SetBundleRequest, ...grpc.CallOption) (*inventory.BundleResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.SetBundleRequest, ...grpc.CallOption) *inventory.BundleResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.BundleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.SetBundleRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetCategoryTranslation provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) SetCategoryTranslation(ctx context.Context, in *inventory.SetCategoryTranslationRequest, opts ...grpc.CallOption) (*inventory.SetCategoryTranslationResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.SetCategoryTranslationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.SetCategoryTranslationRequest, ...grpc.CallOption) (*inventory.SetCategoryTranslationResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.SetCategoryTranslationRequest, ...grpc.CallOption) *inventory.SetCategoryTranslationResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.SetCategoryTranslationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.SetCategoryTranslationRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetItemTranslation provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) SetItemTranslation(ctx context.Context, in *inventory.SetItemTranslationRequest, opts ...grpc.CallOption) (*inventory.SetItemTranslationResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.SetItemTranslationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.SetItemTranslationRequest, ...grpc.CallOption) (*inventory.SetItemTranslationResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.SetItemTranslationRequest, ...grpc.CallOption) *inventory.SetItemTranslationResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.SetItemTranslationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.SetItemTranslationRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetItemUnavailable provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) SetItemUnavailable(ctx context.Context, in *inventory.SetItemUnavailableRequest, opts ...grpc.CallOption) (*inventory.SetItemUnavailableResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.SetItemUnavailableResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.SetItemUnavailableRequest, ...grpc.CallOption) (*inventory.SetItemUnavailableResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.SetItemUnavailableRequest, ...grpc.CallOption) *inventory.SetItemUnavailableResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.SetItemUnavailableResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.SetItemUnavailableRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetOptionStock provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) SetOptionStock(ctx context.Context, in *inventory.SetOptionStockRequest, opts ...grpc.CallOption) (*inventory.SetOptionStockResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.SetOptionStockResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.SetOptionStockRequest, ...grpc.CallOption) (*inventory.SetOptionStockResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.SetOptionStockRequest, ...grpc.CallOption) *inventory.SetOptionStockResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.SetOptionStockResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.SetOptionStockRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetRecipe provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) SetRecipe(ctx context.Context, in *inventory.SetRecipeRequest, opts ...grpc.CallOption) (*inventory.RecipeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.RecipeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.SetRecipeRequest, ...grpc.CallOption) (*inventory.RecipeResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.SetRecipeRequest, ...grpc.CallOption) *inventory.RecipeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.RecipeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.SetRecipeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetReorderLevel provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) SetReorderLevel(ctx context.Context, in *inventory.SetReorderLevelRequest, opts ...grpc.CallOption) (*inventory.SetReorderLevelResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.SetReorderLevelResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.SetReorderLevelRequest, ...grpc.CallOption) (*inventory.SetReorderLevelResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.SetReorderLevelRequest, ...grpc.CallOption) *inventory.SetReorderLevelResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.SetReorderLevelResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.SetReorderLevelRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetSupplierItem provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) SetSupplierItem(ctx context.Context, in *inventory.SetSupplierItemRequest, opts ...grpc.CallOption) (*inventory.SetSupplierItemResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.SetSupplierItemResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.SetSupplierItemRequest, ...grpc.CallOption) (*inventory.SetSupplierItemResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.SetSupplierItemRequest, ...grpc.CallOption) *inventory.SetSupplierItemResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.SetSupplierItemResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.SetSupplierItemRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartStockTake provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) StartStockTake(ctx context.Context, in *inventory.StartStockTakeRequest, opts ...grpc.CallOption) (*inventory.StockTakeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.StockTakeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.StartStockTakeRequest, ...grpc.CallOption) (*inventory.StockTakeResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.StartStockTakeRequest, ...grpc.CallOption) *inventory.StockTakeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.StockTakeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.StartStockTakeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubmitReview provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) SubmitReview(ctx context.Context, in *inventory.SubmitReviewRequest, opts ...grpc.CallOption) (*inventory.SubmitReviewResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.SubmitReviewResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.SubmitReviewRequest, ...grpc.CallOption) (*inventory.SubmitReviewResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.SubmitReviewRequest, ...grpc.CallOption) *inventory.SubmitReviewResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.SubmitReviewResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.SubmitReviewRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SummarizeMovements provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) SummarizeMovements(ctx context.Context, in *inventory.SummarizeMovementsRequest, opts ...grpc.CallOption) (*inventory.SummarizeMovementsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.SummarizeMovementsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.SummarizeMovementsRequest, ...grpc.CallOption) (*inventory.SummarizeMovementsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.SummarizeMovementsRequest, ...grpc.CallOption) *inventory.SummarizeMovementsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.SummarizeMovementsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.SummarizeMovementsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransferStock provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) TransferStock(ctx context.Context, in *inventory.TransferStockRequest, opts ...grpc.CallOption) (*inventory.TransferStockResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.TransferStockResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.TransferStockRequest, ...grpc.CallOption) (*inventory.TransferStockResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.TransferStockRequest, ...grpc.CallOption) *inventory.TransferStockResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.TransferStockResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.TransferStockRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateItem provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) UpdateItem(ctx context.Context, in *inventory.UpdateItemRequest, opts ...grpc.CallOption) (*inventory.UpdateItemResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.UpdateItemResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.UpdateItemRequest, ...grpc.CallOption) (*inventory.UpdateItemResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.UpdateItemRequest, ...grpc.CallOption) *inventory.UpdateItemResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.UpdateItemResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.UpdateItemRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UploadItemImage provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) UploadItemImage(ctx context.Context, in *inventory.UploadItemImageRequest, opts ...grpc.CallOption) (*inventory.UploadItemImageResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.UploadItemImageResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.UploadItemImageRequest, ...grpc.CallOption) (*inventory.UploadItemImageResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.UploadItemImageRequest, ...grpc.CallOption) *inventory.UploadItemImageResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.UploadItemImageResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.UploadItemImageRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WatchInventory provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) WatchInventory(ctx context.Context, in *inventory.WatchInventoryRequest, opts ...grpc.CallOption) (inventory.InventoryService_WatchInventoryClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 inventory.InventoryService_WatchInventoryClient
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.WatchInventoryRequest, ...grpc.CallOption) (inventory.InventoryService_WatchInventoryClient, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.WatchInventoryRequest, ...grpc.CallOption) inventory.InventoryService_WatchInventoryClient); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(inventory.InventoryService_WatchInventoryClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.WatchInventoryRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WriteOffExpiredLots provides a mock function with given fields: ctx, in, opts
+func (_m *InventoryServiceClient) WriteOffExpiredLots(ctx context.Context, in *inventory.WriteOffExpiredLotsRequest, opts ...grpc.CallOption) (*inventory.WriteOffExpiredLotsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *inventory.WriteOffExpiredLotsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.WriteOffExpiredLotsRequest, ...grpc.CallOption) (*inventory.WriteOffExpiredLotsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *inventory.WriteOffExpiredLotsRequest, ...grpc.CallOption) *inventory.WriteOffExpiredLotsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.WriteOffExpiredLotsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *inventory.WriteOffExpiredLotsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
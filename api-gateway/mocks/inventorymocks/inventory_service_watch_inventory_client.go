@@ -0,0 +1,158 @@
+// Code generated by mockery v2.27.1. DO NOT EDIT.
+
+package inventorymocks
+
+import (
+	inventory "api-gateway/proto/inventory"
+	context "context"
+
+	metadata "google.golang.org/grpc/metadata"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// InventoryService_WatchInventoryClient is an autogenerated mock type for the InventoryService_WatchInventoryClient type
+type InventoryService_WatchInventoryClient struct {
+	mock.Mock
+}
+
+// CloseSend provides a mock function with given fields:
+func (_m *InventoryService_WatchInventoryClient) CloseSend() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Context provides a mock function with given fields:
+func (_m *InventoryService_WatchInventoryClient) Context() context.Context {
+	ret := _m.Called()
+
+	var r0 context.Context
+	if rf, ok := ret.Get(0).(func() context.Context); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(context.Context)
+		}
+	}
+
+	return r0
+}
+
+// Header provides a mock function with given fields:
+func (_m *InventoryService_WatchInventoryClient) Header() (metadata.MD, error) {
+	ret := _m.Called()
+
+	var r0 metadata.MD
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (metadata.MD, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() metadata.MD); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(metadata.MD)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Recv provides a mock function with given fields:
+func (_m *InventoryService_WatchInventoryClient) Recv() (*inventory.InventoryEvent, error) {
+	ret := _m.Called()
+
+	var r0 *inventory.InventoryEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*inventory.InventoryEvent, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *inventory.InventoryEvent); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventory.InventoryEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecvMsg provides a mock function with given fields: m
+func (_m *InventoryService_WatchInventoryClient) RecvMsg(m interface{}) error {
+	ret := _m.Called(m)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}) error); ok {
+		r0 = rf(m)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SendMsg provides a mock function with given fields: m
+func (_m *InventoryService_WatchInventoryClient) SendMsg(m interface{}) error {
+	ret := _m.Called(m)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}) error); ok {
+		r0 = rf(m)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Trailer provides a mock function with given fields:
+func (_m *InventoryService_WatchInventoryClient) Trailer() metadata.MD {
+	ret := _m.Called()
+
+	var r0 metadata.MD
+	if rf, ok := ret.Get(0).(func() metadata.MD); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(metadata.MD)
+		}
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewInventoryService_WatchInventoryClient interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewInventoryService_WatchInventoryClient creates a new instance of InventoryService_WatchInventoryClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewInventoryService_WatchInventoryClient(t mockConstructorTestingTNewInventoryService_WatchInventoryClient) *InventoryService_WatchInventoryClient {
+	mock := &InventoryService_WatchInventoryClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -17,6 +17,39 @@ type OrderServiceClient struct {
 	mock.Mock
 }
 
+// CountOpenOrders provides a mock function with given fields: ctx, in, opts
+func (_m *OrderServiceClient) CountOpenOrders(ctx context.Context, in *order.CountOpenOrdersRequest, opts ...grpc.CallOption) (*order.CountOpenOrdersResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *order.CountOpenOrdersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *order.CountOpenOrdersRequest, ...grpc.CallOption) (*order.CountOpenOrdersResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *order.CountOpenOrdersRequest, ...grpc.CallOption) *order.CountOpenOrdersResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*order.CountOpenOrdersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *order.CountOpenOrdersRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllOrders provides a mock function with given fields: ctx, in, opts
 func (_m *OrderServiceClient) GetAllOrders(ctx context.Context, in *order.GetAllOrdersRequest, opts ...grpc.CallOption) (*order.GetAllOrdersResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -83,6 +116,72 @@ func (_m *OrderServiceClient) GetOrder(ctx context.Context, in *order.GetOrderRe
 	return r0, r1
 }
 
+// HasDeliveredOrder provides a mock function with given fields: ctx, in, opts
+func (_m *OrderServiceClient) HasDeliveredOrder(ctx context.Context, in *order.HasDeliveredOrderRequest, opts ...grpc.CallOption) (*order.HasDeliveredOrderResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *order.HasDeliveredOrderResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *order.HasDeliveredOrderRequest, ...grpc.CallOption) (*order.HasDeliveredOrderResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *order.HasDeliveredOrderRequest, ...grpc.CallOption) *order.HasDeliveredOrderResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*order.HasDeliveredOrderResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *order.HasDeliveredOrderRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkOrderDelivered provides a mock function with given fields: ctx, in, opts
+func (_m *OrderServiceClient) MarkOrderDelivered(ctx context.Context, in *order.MarkOrderDeliveredRequest, opts ...grpc.CallOption) (*order.MarkOrderDeliveredResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *order.MarkOrderDeliveredResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *order.MarkOrderDeliveredRequest, ...grpc.CallOption) (*order.MarkOrderDeliveredResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *order.MarkOrderDeliveredRequest, ...grpc.CallOption) *order.MarkOrderDeliveredResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*order.MarkOrderDeliveredResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *order.MarkOrderDeliveredRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PlaceOrder provides a mock function with given fields: ctx, in, opts
 func (_m *OrderServiceClient) PlaceOrder(ctx context.Context, in *order.PlaceOrderRequest, opts ...grpc.CallOption) (*order.PlaceOrderResponse, error) {
 	_va := make([]interface{}, len(opts))
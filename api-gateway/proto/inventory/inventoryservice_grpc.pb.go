@@ -19,12 +19,82 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	InventoryService_AddItem_FullMethodName       = "/InventoryService/AddItem"
-	InventoryService_GetItem_FullMethodName       = "/InventoryService/GetItem"
-	InventoryService_GetAllItems_FullMethodName   = "/InventoryService/GetAllItems"
-	InventoryService_AddQuantity_FullMethodName   = "/InventoryService/AddQuantity"
-	InventoryService_LowerQuantity_FullMethodName = "/InventoryService/LowerQuantity"
-	InventoryService_DeleteItem_FullMethodName    = "/InventoryService/DeleteItem"
+	InventoryService_AddItem_FullMethodName                   = "/InventoryService/AddItem"
+	InventoryService_GetItem_FullMethodName                   = "/InventoryService/GetItem"
+	InventoryService_GetAllItems_FullMethodName               = "/InventoryService/GetAllItems"
+	InventoryService_AddQuantity_FullMethodName               = "/InventoryService/AddQuantity"
+	InventoryService_LowerQuantity_FullMethodName             = "/InventoryService/LowerQuantity"
+	InventoryService_DeleteItem_FullMethodName                = "/InventoryService/DeleteItem"
+	InventoryService_ListStockMovements_FullMethodName        = "/InventoryService/ListStockMovements"
+	InventoryService_ReconcileStock_FullMethodName            = "/InventoryService/ReconcileStock"
+	InventoryService_SetReorderLevel_FullMethodName           = "/InventoryService/SetReorderLevel"
+	InventoryService_ListLowStockItems_FullMethodName         = "/InventoryService/ListLowStockItems"
+	InventoryService_ImportItems_FullMethodName               = "/InventoryService/ImportItems"
+	InventoryService_ExportItems_FullMethodName               = "/InventoryService/ExportItems"
+	InventoryService_UpdateItem_FullMethodName                = "/InventoryService/UpdateItem"
+	InventoryService_AddOptionGroup_FullMethodName            = "/InventoryService/AddOptionGroup"
+	InventoryService_DeleteOptionGroup_FullMethodName         = "/InventoryService/DeleteOptionGroup"
+	InventoryService_SetOptionStock_FullMethodName            = "/InventoryService/SetOptionStock"
+	InventoryService_BatchGetItems_FullMethodName             = "/InventoryService/BatchGetItems"
+	InventoryService_AddAvailabilityWindow_FullMethodName     = "/InventoryService/AddAvailabilityWindow"
+	InventoryService_ListAvailabilityWindows_FullMethodName   = "/InventoryService/ListAvailabilityWindows"
+	InventoryService_DeleteAvailabilityWindow_FullMethodName  = "/InventoryService/DeleteAvailabilityWindow"
+	InventoryService_SetItemUnavailable_FullMethodName        = "/InventoryService/SetItemUnavailable"
+	InventoryService_AddLocation_FullMethodName               = "/InventoryService/AddLocation"
+	InventoryService_ListLocations_FullMethodName             = "/InventoryService/ListLocations"
+	InventoryService_GetItemStock_FullMethodName              = "/InventoryService/GetItemStock"
+	InventoryService_TransferStock_FullMethodName             = "/InventoryService/TransferStock"
+	InventoryService_ListLots_FullMethodName                  = "/InventoryService/ListLots"
+	InventoryService_ListExpiredLots_FullMethodName           = "/InventoryService/ListExpiredLots"
+	InventoryService_WriteOffExpiredLots_FullMethodName       = "/InventoryService/WriteOffExpiredLots"
+	InventoryService_SetRecipe_FullMethodName                 = "/InventoryService/SetRecipe"
+	InventoryService_GetRecipe_FullMethodName                 = "/InventoryService/GetRecipe"
+	InventoryService_ListRecipeShortages_FullMethodName       = "/InventoryService/ListRecipeShortages"
+	InventoryService_SetBundle_FullMethodName                 = "/InventoryService/SetBundle"
+	InventoryService_GetBundle_FullMethodName                 = "/InventoryService/GetBundle"
+	InventoryService_AddSupplier_FullMethodName               = "/InventoryService/AddSupplier"
+	InventoryService_ListSuppliers_FullMethodName             = "/InventoryService/ListSuppliers"
+	InventoryService_SetSupplierItem_FullMethodName           = "/InventoryService/SetSupplierItem"
+	InventoryService_ListSupplierItems_FullMethodName         = "/InventoryService/ListSupplierItems"
+	InventoryService_CreatePurchaseOrder_FullMethodName       = "/InventoryService/CreatePurchaseOrder"
+	InventoryService_GetPurchaseOrder_FullMethodName          = "/InventoryService/GetPurchaseOrder"
+	InventoryService_ListPurchaseOrders_FullMethodName        = "/InventoryService/ListPurchaseOrders"
+	InventoryService_SendPurchaseOrder_FullMethodName         = "/InventoryService/SendPurchaseOrder"
+	InventoryService_ReceivePurchaseOrderLine_FullMethodName  = "/InventoryService/ReceivePurchaseOrderLine"
+	InventoryService_StartStockTake_FullMethodName            = "/InventoryService/StartStockTake"
+	InventoryService_GetStockTake_FullMethodName              = "/InventoryService/GetStockTake"
+	InventoryService_ListStockTakes_FullMethodName            = "/InventoryService/ListStockTakes"
+	InventoryService_RecordStockCount_FullMethodName          = "/InventoryService/RecordStockCount"
+	InventoryService_GetVarianceReport_FullMethodName         = "/InventoryService/GetVarianceReport"
+	InventoryService_ApproveStockTake_FullMethodName          = "/InventoryService/ApproveStockTake"
+	InventoryService_CancelStockTake_FullMethodName           = "/InventoryService/CancelStockTake"
+	InventoryService_SchedulePrice_FullMethodName             = "/InventoryService/SchedulePrice"
+	InventoryService_ListPriceHistory_FullMethodName          = "/InventoryService/ListPriceHistory"
+	InventoryService_UploadItemImage_FullMethodName           = "/InventoryService/UploadItemImage"
+	InventoryService_DeleteItemImage_FullMethodName           = "/InventoryService/DeleteItemImage"
+	InventoryService_GetImage_FullMethodName                  = "/InventoryService/GetImage"
+	InventoryService_GetItemBySKU_FullMethodName              = "/InventoryService/GetItemBySKU"
+	InventoryService_GetItemByBarcode_FullMethodName          = "/InventoryService/GetItemByBarcode"
+	InventoryService_AddItemBarcode_FullMethodName            = "/InventoryService/AddItemBarcode"
+	InventoryService_RemoveItemBarcode_FullMethodName         = "/InventoryService/RemoveItemBarcode"
+	InventoryService_ListArchivedItems_FullMethodName         = "/InventoryService/ListArchivedItems"
+	InventoryService_RestoreItem_FullMethodName               = "/InventoryService/RestoreItem"
+	InventoryService_PurgeItem_FullMethodName                 = "/InventoryService/PurgeItem"
+	InventoryService_GetStockValuation_FullMethodName         = "/InventoryService/GetStockValuation"
+	InventoryService_SummarizeMovements_FullMethodName        = "/InventoryService/SummarizeMovements"
+	InventoryService_ListSlowMovingItems_FullMethodName       = "/InventoryService/ListSlowMovingItems"
+	InventoryService_SubmitReview_FullMethodName              = "/InventoryService/SubmitReview"
+	InventoryService_ListItemReviews_FullMethodName           = "/InventoryService/ListItemReviews"
+	InventoryService_ListReviews_FullMethodName               = "/InventoryService/ListReviews"
+	InventoryService_ModerateReview_FullMethodName            = "/InventoryService/ModerateReview"
+	InventoryService_SetItemTranslation_FullMethodName        = "/InventoryService/SetItemTranslation"
+	InventoryService_DeleteItemTranslation_FullMethodName     = "/InventoryService/DeleteItemTranslation"
+	InventoryService_ListItemTranslations_FullMethodName      = "/InventoryService/ListItemTranslations"
+	InventoryService_SetCategoryTranslation_FullMethodName    = "/InventoryService/SetCategoryTranslation"
+	InventoryService_DeleteCategoryTranslation_FullMethodName = "/InventoryService/DeleteCategoryTranslation"
+	InventoryService_ListCategoryTranslations_FullMethodName  = "/InventoryService/ListCategoryTranslations"
+	InventoryService_GetMissingTranslations_FullMethodName    = "/InventoryService/GetMissingTranslations"
+	InventoryService_WatchInventory_FullMethodName            = "/InventoryService/WatchInventory"
 )
 
 // InventoryServiceClient is the client API for InventoryService service.
@@ -37,6 +107,76 @@ type InventoryServiceClient interface {
 	AddQuantity(ctx context.Context, in *AddQuantityRequest, opts ...grpc.CallOption) (*AddQuantityResponse, error)
 	LowerQuantity(ctx context.Context, in *LowerQuantityRequest, opts ...grpc.CallOption) (*LowerQuantityResponse, error)
 	DeleteItem(ctx context.Context, in *DeleteItemRequest, opts ...grpc.CallOption) (*DeleteItemResponse, error)
+	ListStockMovements(ctx context.Context, in *ListStockMovementsRequest, opts ...grpc.CallOption) (*ListStockMovementsResponse, error)
+	ReconcileStock(ctx context.Context, in *ReconcileStockRequest, opts ...grpc.CallOption) (*ReconcileStockResponse, error)
+	SetReorderLevel(ctx context.Context, in *SetReorderLevelRequest, opts ...grpc.CallOption) (*SetReorderLevelResponse, error)
+	ListLowStockItems(ctx context.Context, in *ListLowStockItemsRequest, opts ...grpc.CallOption) (*ListLowStockItemsResponse, error)
+	ImportItems(ctx context.Context, in *ImportItemsRequest, opts ...grpc.CallOption) (*ImportItemsResponse, error)
+	ExportItems(ctx context.Context, in *ExportItemsRequest, opts ...grpc.CallOption) (*ExportItemsResponse, error)
+	UpdateItem(ctx context.Context, in *UpdateItemRequest, opts ...grpc.CallOption) (*UpdateItemResponse, error)
+	AddOptionGroup(ctx context.Context, in *AddOptionGroupRequest, opts ...grpc.CallOption) (*AddOptionGroupResponse, error)
+	DeleteOptionGroup(ctx context.Context, in *DeleteOptionGroupRequest, opts ...grpc.CallOption) (*DeleteOptionGroupResponse, error)
+	SetOptionStock(ctx context.Context, in *SetOptionStockRequest, opts ...grpc.CallOption) (*SetOptionStockResponse, error)
+	BatchGetItems(ctx context.Context, in *BatchGetItemsRequest, opts ...grpc.CallOption) (*BatchGetItemsResponse, error)
+	AddAvailabilityWindow(ctx context.Context, in *AddAvailabilityWindowRequest, opts ...grpc.CallOption) (*AddAvailabilityWindowResponse, error)
+	ListAvailabilityWindows(ctx context.Context, in *ListAvailabilityWindowsRequest, opts ...grpc.CallOption) (*ListAvailabilityWindowsResponse, error)
+	DeleteAvailabilityWindow(ctx context.Context, in *DeleteAvailabilityWindowRequest, opts ...grpc.CallOption) (*DeleteAvailabilityWindowResponse, error)
+	SetItemUnavailable(ctx context.Context, in *SetItemUnavailableRequest, opts ...grpc.CallOption) (*SetItemUnavailableResponse, error)
+	AddLocation(ctx context.Context, in *AddLocationRequest, opts ...grpc.CallOption) (*AddLocationResponse, error)
+	ListLocations(ctx context.Context, in *ListLocationsRequest, opts ...grpc.CallOption) (*ListLocationsResponse, error)
+	GetItemStock(ctx context.Context, in *GetItemStockRequest, opts ...grpc.CallOption) (*GetItemStockResponse, error)
+	TransferStock(ctx context.Context, in *TransferStockRequest, opts ...grpc.CallOption) (*TransferStockResponse, error)
+	ListLots(ctx context.Context, in *ListLotsRequest, opts ...grpc.CallOption) (*ListLotsResponse, error)
+	ListExpiredLots(ctx context.Context, in *ListExpiredLotsRequest, opts ...grpc.CallOption) (*ListExpiredLotsResponse, error)
+	WriteOffExpiredLots(ctx context.Context, in *WriteOffExpiredLotsRequest, opts ...grpc.CallOption) (*WriteOffExpiredLotsResponse, error)
+	SetRecipe(ctx context.Context, in *SetRecipeRequest, opts ...grpc.CallOption) (*RecipeResponse, error)
+	GetRecipe(ctx context.Context, in *GetRecipeRequest, opts ...grpc.CallOption) (*RecipeResponse, error)
+	ListRecipeShortages(ctx context.Context, in *ListRecipeShortagesRequest, opts ...grpc.CallOption) (*ListRecipeShortagesResponse, error)
+	SetBundle(ctx context.Context, in *SetBundleRequest, opts ...grpc.CallOption) (*BundleResponse, error)
+	GetBundle(ctx context.Context, in *GetBundleRequest, opts ...grpc.CallOption) (*BundleResponse, error)
+	AddSupplier(ctx context.Context, in *AddSupplierRequest, opts ...grpc.CallOption) (*AddSupplierResponse, error)
+	ListSuppliers(ctx context.Context, in *ListSuppliersRequest, opts ...grpc.CallOption) (*ListSuppliersResponse, error)
+	SetSupplierItem(ctx context.Context, in *SetSupplierItemRequest, opts ...grpc.CallOption) (*SetSupplierItemResponse, error)
+	ListSupplierItems(ctx context.Context, in *ListSupplierItemsRequest, opts ...grpc.CallOption) (*ListSupplierItemsResponse, error)
+	CreatePurchaseOrder(ctx context.Context, in *CreatePurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrderResponse, error)
+	GetPurchaseOrder(ctx context.Context, in *GetPurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrderResponse, error)
+	ListPurchaseOrders(ctx context.Context, in *ListPurchaseOrdersRequest, opts ...grpc.CallOption) (*ListPurchaseOrdersResponse, error)
+	SendPurchaseOrder(ctx context.Context, in *SendPurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrderResponse, error)
+	ReceivePurchaseOrderLine(ctx context.Context, in *ReceivePurchaseOrderLineRequest, opts ...grpc.CallOption) (*ReceivePurchaseOrderLineResponse, error)
+	StartStockTake(ctx context.Context, in *StartStockTakeRequest, opts ...grpc.CallOption) (*StockTakeResponse, error)
+	GetStockTake(ctx context.Context, in *GetStockTakeRequest, opts ...grpc.CallOption) (*StockTakeResponse, error)
+	ListStockTakes(ctx context.Context, in *ListStockTakesRequest, opts ...grpc.CallOption) (*ListStockTakesResponse, error)
+	RecordStockCount(ctx context.Context, in *RecordStockCountRequest, opts ...grpc.CallOption) (*RecordStockCountResponse, error)
+	GetVarianceReport(ctx context.Context, in *GetVarianceReportRequest, opts ...grpc.CallOption) (*GetVarianceReportResponse, error)
+	ApproveStockTake(ctx context.Context, in *ApproveStockTakeRequest, opts ...grpc.CallOption) (*ApproveStockTakeResponse, error)
+	CancelStockTake(ctx context.Context, in *CancelStockTakeRequest, opts ...grpc.CallOption) (*StockTakeResponse, error)
+	SchedulePrice(ctx context.Context, in *SchedulePriceRequest, opts ...grpc.CallOption) (*SchedulePriceResponse, error)
+	ListPriceHistory(ctx context.Context, in *ListPriceHistoryRequest, opts ...grpc.CallOption) (*ListPriceHistoryResponse, error)
+	UploadItemImage(ctx context.Context, in *UploadItemImageRequest, opts ...grpc.CallOption) (*UploadItemImageResponse, error)
+	DeleteItemImage(ctx context.Context, in *DeleteItemImageRequest, opts ...grpc.CallOption) (*DeleteItemImageResponse, error)
+	GetImage(ctx context.Context, in *GetImageRequest, opts ...grpc.CallOption) (*GetImageResponse, error)
+	GetItemBySKU(ctx context.Context, in *GetItemBySKURequest, opts ...grpc.CallOption) (*GetItemResponse, error)
+	GetItemByBarcode(ctx context.Context, in *GetItemByBarcodeRequest, opts ...grpc.CallOption) (*GetItemResponse, error)
+	AddItemBarcode(ctx context.Context, in *AddItemBarcodeRequest, opts ...grpc.CallOption) (*AddItemBarcodeResponse, error)
+	RemoveItemBarcode(ctx context.Context, in *RemoveItemBarcodeRequest, opts ...grpc.CallOption) (*RemoveItemBarcodeResponse, error)
+	ListArchivedItems(ctx context.Context, in *ListArchivedItemsRequest, opts ...grpc.CallOption) (*ListArchivedItemsResponse, error)
+	RestoreItem(ctx context.Context, in *RestoreItemRequest, opts ...grpc.CallOption) (*RestoreItemResponse, error)
+	PurgeItem(ctx context.Context, in *PurgeItemRequest, opts ...grpc.CallOption) (*PurgeItemResponse, error)
+	GetStockValuation(ctx context.Context, in *GetStockValuationRequest, opts ...grpc.CallOption) (*GetStockValuationResponse, error)
+	SummarizeMovements(ctx context.Context, in *SummarizeMovementsRequest, opts ...grpc.CallOption) (*SummarizeMovementsResponse, error)
+	ListSlowMovingItems(ctx context.Context, in *ListSlowMovingItemsRequest, opts ...grpc.CallOption) (*ListSlowMovingItemsResponse, error)
+	SubmitReview(ctx context.Context, in *SubmitReviewRequest, opts ...grpc.CallOption) (*SubmitReviewResponse, error)
+	ListItemReviews(ctx context.Context, in *ListItemReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error)
+	ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error)
+	ModerateReview(ctx context.Context, in *ModerateReviewRequest, opts ...grpc.CallOption) (*ModerateReviewResponse, error)
+	SetItemTranslation(ctx context.Context, in *SetItemTranslationRequest, opts ...grpc.CallOption) (*SetItemTranslationResponse, error)
+	DeleteItemTranslation(ctx context.Context, in *DeleteItemTranslationRequest, opts ...grpc.CallOption) (*DeleteTranslationResponse, error)
+	ListItemTranslations(ctx context.Context, in *ListItemTranslationsRequest, opts ...grpc.CallOption) (*ListItemTranslationsResponse, error)
+	SetCategoryTranslation(ctx context.Context, in *SetCategoryTranslationRequest, opts ...grpc.CallOption) (*SetCategoryTranslationResponse, error)
+	DeleteCategoryTranslation(ctx context.Context, in *DeleteCategoryTranslationRequest, opts ...grpc.CallOption) (*DeleteTranslationResponse, error)
+	ListCategoryTranslations(ctx context.Context, in *ListCategoryTranslationsRequest, opts ...grpc.CallOption) (*ListCategoryTranslationsResponse, error)
+	GetMissingTranslations(ctx context.Context, in *GetMissingTranslationsRequest, opts ...grpc.CallOption) (*GetMissingTranslationsResponse, error)
+	WatchInventory(ctx context.Context, in *WatchInventoryRequest, opts ...grpc.CallOption) (InventoryService_WatchInventoryClient, error)
 }
 
 type inventoryServiceClient struct {
@@ -101,6 +241,659 @@ func (c *inventoryServiceClient) DeleteItem(ctx context.Context, in *DeleteItemR
 	return out, nil
 }
 
+func (c *inventoryServiceClient) ListStockMovements(ctx context.Context, in *ListStockMovementsRequest, opts ...grpc.CallOption) (*ListStockMovementsResponse, error) {
+	out := new(ListStockMovementsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListStockMovements_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ReconcileStock(ctx context.Context, in *ReconcileStockRequest, opts ...grpc.CallOption) (*ReconcileStockResponse, error) {
+	out := new(ReconcileStockResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ReconcileStock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SetReorderLevel(ctx context.Context, in *SetReorderLevelRequest, opts ...grpc.CallOption) (*SetReorderLevelResponse, error) {
+	out := new(SetReorderLevelResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SetReorderLevel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListLowStockItems(ctx context.Context, in *ListLowStockItemsRequest, opts ...grpc.CallOption) (*ListLowStockItemsResponse, error) {
+	out := new(ListLowStockItemsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListLowStockItems_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ImportItems(ctx context.Context, in *ImportItemsRequest, opts ...grpc.CallOption) (*ImportItemsResponse, error) {
+	out := new(ImportItemsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ImportItems_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ExportItems(ctx context.Context, in *ExportItemsRequest, opts ...grpc.CallOption) (*ExportItemsResponse, error) {
+	out := new(ExportItemsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ExportItems_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) UpdateItem(ctx context.Context, in *UpdateItemRequest, opts ...grpc.CallOption) (*UpdateItemResponse, error) {
+	out := new(UpdateItemResponse)
+	err := c.cc.Invoke(ctx, InventoryService_UpdateItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) AddOptionGroup(ctx context.Context, in *AddOptionGroupRequest, opts ...grpc.CallOption) (*AddOptionGroupResponse, error) {
+	out := new(AddOptionGroupResponse)
+	err := c.cc.Invoke(ctx, InventoryService_AddOptionGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) DeleteOptionGroup(ctx context.Context, in *DeleteOptionGroupRequest, opts ...grpc.CallOption) (*DeleteOptionGroupResponse, error) {
+	out := new(DeleteOptionGroupResponse)
+	err := c.cc.Invoke(ctx, InventoryService_DeleteOptionGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SetOptionStock(ctx context.Context, in *SetOptionStockRequest, opts ...grpc.CallOption) (*SetOptionStockResponse, error) {
+	out := new(SetOptionStockResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SetOptionStock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) BatchGetItems(ctx context.Context, in *BatchGetItemsRequest, opts ...grpc.CallOption) (*BatchGetItemsResponse, error) {
+	out := new(BatchGetItemsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_BatchGetItems_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) AddAvailabilityWindow(ctx context.Context, in *AddAvailabilityWindowRequest, opts ...grpc.CallOption) (*AddAvailabilityWindowResponse, error) {
+	out := new(AddAvailabilityWindowResponse)
+	err := c.cc.Invoke(ctx, InventoryService_AddAvailabilityWindow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListAvailabilityWindows(ctx context.Context, in *ListAvailabilityWindowsRequest, opts ...grpc.CallOption) (*ListAvailabilityWindowsResponse, error) {
+	out := new(ListAvailabilityWindowsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListAvailabilityWindows_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) DeleteAvailabilityWindow(ctx context.Context, in *DeleteAvailabilityWindowRequest, opts ...grpc.CallOption) (*DeleteAvailabilityWindowResponse, error) {
+	out := new(DeleteAvailabilityWindowResponse)
+	err := c.cc.Invoke(ctx, InventoryService_DeleteAvailabilityWindow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SetItemUnavailable(ctx context.Context, in *SetItemUnavailableRequest, opts ...grpc.CallOption) (*SetItemUnavailableResponse, error) {
+	out := new(SetItemUnavailableResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SetItemUnavailable_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) AddLocation(ctx context.Context, in *AddLocationRequest, opts ...grpc.CallOption) (*AddLocationResponse, error) {
+	out := new(AddLocationResponse)
+	err := c.cc.Invoke(ctx, InventoryService_AddLocation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListLocations(ctx context.Context, in *ListLocationsRequest, opts ...grpc.CallOption) (*ListLocationsResponse, error) {
+	out := new(ListLocationsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListLocations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetItemStock(ctx context.Context, in *GetItemStockRequest, opts ...grpc.CallOption) (*GetItemStockResponse, error) {
+	out := new(GetItemStockResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetItemStock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) TransferStock(ctx context.Context, in *TransferStockRequest, opts ...grpc.CallOption) (*TransferStockResponse, error) {
+	out := new(TransferStockResponse)
+	err := c.cc.Invoke(ctx, InventoryService_TransferStock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListLots(ctx context.Context, in *ListLotsRequest, opts ...grpc.CallOption) (*ListLotsResponse, error) {
+	out := new(ListLotsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListLots_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListExpiredLots(ctx context.Context, in *ListExpiredLotsRequest, opts ...grpc.CallOption) (*ListExpiredLotsResponse, error) {
+	out := new(ListExpiredLotsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListExpiredLots_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) WriteOffExpiredLots(ctx context.Context, in *WriteOffExpiredLotsRequest, opts ...grpc.CallOption) (*WriteOffExpiredLotsResponse, error) {
+	out := new(WriteOffExpiredLotsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_WriteOffExpiredLots_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SetRecipe(ctx context.Context, in *SetRecipeRequest, opts ...grpc.CallOption) (*RecipeResponse, error) {
+	out := new(RecipeResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SetRecipe_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetRecipe(ctx context.Context, in *GetRecipeRequest, opts ...grpc.CallOption) (*RecipeResponse, error) {
+	out := new(RecipeResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetRecipe_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListRecipeShortages(ctx context.Context, in *ListRecipeShortagesRequest, opts ...grpc.CallOption) (*ListRecipeShortagesResponse, error) {
+	out := new(ListRecipeShortagesResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListRecipeShortages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SetBundle(ctx context.Context, in *SetBundleRequest, opts ...grpc.CallOption) (*BundleResponse, error) {
+	out := new(BundleResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SetBundle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetBundle(ctx context.Context, in *GetBundleRequest, opts ...grpc.CallOption) (*BundleResponse, error) {
+	out := new(BundleResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetBundle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) AddSupplier(ctx context.Context, in *AddSupplierRequest, opts ...grpc.CallOption) (*AddSupplierResponse, error) {
+	out := new(AddSupplierResponse)
+	err := c.cc.Invoke(ctx, InventoryService_AddSupplier_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListSuppliers(ctx context.Context, in *ListSuppliersRequest, opts ...grpc.CallOption) (*ListSuppliersResponse, error) {
+	out := new(ListSuppliersResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListSuppliers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SetSupplierItem(ctx context.Context, in *SetSupplierItemRequest, opts ...grpc.CallOption) (*SetSupplierItemResponse, error) {
+	out := new(SetSupplierItemResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SetSupplierItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListSupplierItems(ctx context.Context, in *ListSupplierItemsRequest, opts ...grpc.CallOption) (*ListSupplierItemsResponse, error) {
+	out := new(ListSupplierItemsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListSupplierItems_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) CreatePurchaseOrder(ctx context.Context, in *CreatePurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrderResponse, error) {
+	out := new(PurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, InventoryService_CreatePurchaseOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetPurchaseOrder(ctx context.Context, in *GetPurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrderResponse, error) {
+	out := new(PurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetPurchaseOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListPurchaseOrders(ctx context.Context, in *ListPurchaseOrdersRequest, opts ...grpc.CallOption) (*ListPurchaseOrdersResponse, error) {
+	out := new(ListPurchaseOrdersResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListPurchaseOrders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SendPurchaseOrder(ctx context.Context, in *SendPurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrderResponse, error) {
+	out := new(PurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SendPurchaseOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ReceivePurchaseOrderLine(ctx context.Context, in *ReceivePurchaseOrderLineRequest, opts ...grpc.CallOption) (*ReceivePurchaseOrderLineResponse, error) {
+	out := new(ReceivePurchaseOrderLineResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ReceivePurchaseOrderLine_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) StartStockTake(ctx context.Context, in *StartStockTakeRequest, opts ...grpc.CallOption) (*StockTakeResponse, error) {
+	out := new(StockTakeResponse)
+	err := c.cc.Invoke(ctx, InventoryService_StartStockTake_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetStockTake(ctx context.Context, in *GetStockTakeRequest, opts ...grpc.CallOption) (*StockTakeResponse, error) {
+	out := new(StockTakeResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetStockTake_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListStockTakes(ctx context.Context, in *ListStockTakesRequest, opts ...grpc.CallOption) (*ListStockTakesResponse, error) {
+	out := new(ListStockTakesResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListStockTakes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) RecordStockCount(ctx context.Context, in *RecordStockCountRequest, opts ...grpc.CallOption) (*RecordStockCountResponse, error) {
+	out := new(RecordStockCountResponse)
+	err := c.cc.Invoke(ctx, InventoryService_RecordStockCount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetVarianceReport(ctx context.Context, in *GetVarianceReportRequest, opts ...grpc.CallOption) (*GetVarianceReportResponse, error) {
+	out := new(GetVarianceReportResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetVarianceReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ApproveStockTake(ctx context.Context, in *ApproveStockTakeRequest, opts ...grpc.CallOption) (*ApproveStockTakeResponse, error) {
+	out := new(ApproveStockTakeResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ApproveStockTake_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) CancelStockTake(ctx context.Context, in *CancelStockTakeRequest, opts ...grpc.CallOption) (*StockTakeResponse, error) {
+	out := new(StockTakeResponse)
+	err := c.cc.Invoke(ctx, InventoryService_CancelStockTake_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SchedulePrice(ctx context.Context, in *SchedulePriceRequest, opts ...grpc.CallOption) (*SchedulePriceResponse, error) {
+	out := new(SchedulePriceResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SchedulePrice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListPriceHistory(ctx context.Context, in *ListPriceHistoryRequest, opts ...grpc.CallOption) (*ListPriceHistoryResponse, error) {
+	out := new(ListPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListPriceHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) UploadItemImage(ctx context.Context, in *UploadItemImageRequest, opts ...grpc.CallOption) (*UploadItemImageResponse, error) {
+	out := new(UploadItemImageResponse)
+	err := c.cc.Invoke(ctx, InventoryService_UploadItemImage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) DeleteItemImage(ctx context.Context, in *DeleteItemImageRequest, opts ...grpc.CallOption) (*DeleteItemImageResponse, error) {
+	out := new(DeleteItemImageResponse)
+	err := c.cc.Invoke(ctx, InventoryService_DeleteItemImage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetImage(ctx context.Context, in *GetImageRequest, opts ...grpc.CallOption) (*GetImageResponse, error) {
+	out := new(GetImageResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetImage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetItemBySKU(ctx context.Context, in *GetItemBySKURequest, opts ...grpc.CallOption) (*GetItemResponse, error) {
+	out := new(GetItemResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetItemBySKU_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetItemByBarcode(ctx context.Context, in *GetItemByBarcodeRequest, opts ...grpc.CallOption) (*GetItemResponse, error) {
+	out := new(GetItemResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetItemByBarcode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) AddItemBarcode(ctx context.Context, in *AddItemBarcodeRequest, opts ...grpc.CallOption) (*AddItemBarcodeResponse, error) {
+	out := new(AddItemBarcodeResponse)
+	err := c.cc.Invoke(ctx, InventoryService_AddItemBarcode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) RemoveItemBarcode(ctx context.Context, in *RemoveItemBarcodeRequest, opts ...grpc.CallOption) (*RemoveItemBarcodeResponse, error) {
+	out := new(RemoveItemBarcodeResponse)
+	err := c.cc.Invoke(ctx, InventoryService_RemoveItemBarcode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListArchivedItems(ctx context.Context, in *ListArchivedItemsRequest, opts ...grpc.CallOption) (*ListArchivedItemsResponse, error) {
+	out := new(ListArchivedItemsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListArchivedItems_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) RestoreItem(ctx context.Context, in *RestoreItemRequest, opts ...grpc.CallOption) (*RestoreItemResponse, error) {
+	out := new(RestoreItemResponse)
+	err := c.cc.Invoke(ctx, InventoryService_RestoreItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) PurgeItem(ctx context.Context, in *PurgeItemRequest, opts ...grpc.CallOption) (*PurgeItemResponse, error) {
+	out := new(PurgeItemResponse)
+	err := c.cc.Invoke(ctx, InventoryService_PurgeItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetStockValuation(ctx context.Context, in *GetStockValuationRequest, opts ...grpc.CallOption) (*GetStockValuationResponse, error) {
+	out := new(GetStockValuationResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetStockValuation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SummarizeMovements(ctx context.Context, in *SummarizeMovementsRequest, opts ...grpc.CallOption) (*SummarizeMovementsResponse, error) {
+	out := new(SummarizeMovementsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SummarizeMovements_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListSlowMovingItems(ctx context.Context, in *ListSlowMovingItemsRequest, opts ...grpc.CallOption) (*ListSlowMovingItemsResponse, error) {
+	out := new(ListSlowMovingItemsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListSlowMovingItems_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SubmitReview(ctx context.Context, in *SubmitReviewRequest, opts ...grpc.CallOption) (*SubmitReviewResponse, error) {
+	out := new(SubmitReviewResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SubmitReview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListItemReviews(ctx context.Context, in *ListItemReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error) {
+	out := new(ListReviewsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListItemReviews_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error) {
+	out := new(ListReviewsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListReviews_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ModerateReview(ctx context.Context, in *ModerateReviewRequest, opts ...grpc.CallOption) (*ModerateReviewResponse, error) {
+	out := new(ModerateReviewResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ModerateReview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SetItemTranslation(ctx context.Context, in *SetItemTranslationRequest, opts ...grpc.CallOption) (*SetItemTranslationResponse, error) {
+	out := new(SetItemTranslationResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SetItemTranslation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) DeleteItemTranslation(ctx context.Context, in *DeleteItemTranslationRequest, opts ...grpc.CallOption) (*DeleteTranslationResponse, error) {
+	out := new(DeleteTranslationResponse)
+	err := c.cc.Invoke(ctx, InventoryService_DeleteItemTranslation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListItemTranslations(ctx context.Context, in *ListItemTranslationsRequest, opts ...grpc.CallOption) (*ListItemTranslationsResponse, error) {
+	out := new(ListItemTranslationsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListItemTranslations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SetCategoryTranslation(ctx context.Context, in *SetCategoryTranslationRequest, opts ...grpc.CallOption) (*SetCategoryTranslationResponse, error) {
+	out := new(SetCategoryTranslationResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SetCategoryTranslation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) DeleteCategoryTranslation(ctx context.Context, in *DeleteCategoryTranslationRequest, opts ...grpc.CallOption) (*DeleteTranslationResponse, error) {
+	out := new(DeleteTranslationResponse)
+	err := c.cc.Invoke(ctx, InventoryService_DeleteCategoryTranslation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListCategoryTranslations(ctx context.Context, in *ListCategoryTranslationsRequest, opts ...grpc.CallOption) (*ListCategoryTranslationsResponse, error) {
+	out := new(ListCategoryTranslationsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListCategoryTranslations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetMissingTranslations(ctx context.Context, in *GetMissingTranslationsRequest, opts ...grpc.CallOption) (*GetMissingTranslationsResponse, error) {
+	out := new(GetMissingTranslationsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetMissingTranslations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) WatchInventory(ctx context.Context, in *WatchInventoryRequest, opts ...grpc.CallOption) (InventoryService_WatchInventoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &InventoryService_ServiceDesc.Streams[0], InventoryService_WatchInventory_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &inventoryServiceWatchInventoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type InventoryService_WatchInventoryClient interface {
+	Recv() (*InventoryEvent, error)
+	grpc.ClientStream
+}
+
+type inventoryServiceWatchInventoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *inventoryServiceWatchInventoryClient) Recv() (*InventoryEvent, error) {
+	m := new(InventoryEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // InventoryServiceServer is the server API for InventoryService service.
 // All implementations must embed UnimplementedInventoryServiceServer
 // for forward compatibility
@@ -111,152 +904,1695 @@ type InventoryServiceServer interface {
 	AddQuantity(context.Context, *AddQuantityRequest) (*AddQuantityResponse, error)
 	LowerQuantity(context.Context, *LowerQuantityRequest) (*LowerQuantityResponse, error)
 	DeleteItem(context.Context, *DeleteItemRequest) (*DeleteItemResponse, error)
+	ListStockMovements(context.Context, *ListStockMovementsRequest) (*ListStockMovementsResponse, error)
+	ReconcileStock(context.Context, *ReconcileStockRequest) (*ReconcileStockResponse, error)
+	SetReorderLevel(context.Context, *SetReorderLevelRequest) (*SetReorderLevelResponse, error)
+	ListLowStockItems(context.Context, *ListLowStockItemsRequest) (*ListLowStockItemsResponse, error)
+	ImportItems(context.Context, *ImportItemsRequest) (*ImportItemsResponse, error)
+	ExportItems(context.Context, *ExportItemsRequest) (*ExportItemsResponse, error)
+	UpdateItem(context.Context, *UpdateItemRequest) (*UpdateItemResponse, error)
+	AddOptionGroup(context.Context, *AddOptionGroupRequest) (*AddOptionGroupResponse, error)
+	DeleteOptionGroup(context.Context, *DeleteOptionGroupRequest) (*DeleteOptionGroupResponse, error)
+	SetOptionStock(context.Context, *SetOptionStockRequest) (*SetOptionStockResponse, error)
+	BatchGetItems(context.Context, *BatchGetItemsRequest) (*BatchGetItemsResponse, error)
+	AddAvailabilityWindow(context.Context, *AddAvailabilityWindowRequest) (*AddAvailabilityWindowResponse, error)
+	ListAvailabilityWindows(context.Context, *ListAvailabilityWindowsRequest) (*ListAvailabilityWindowsResponse, error)
+	DeleteAvailabilityWindow(context.Context, *DeleteAvailabilityWindowRequest) (*DeleteAvailabilityWindowResponse, error)
+	SetItemUnavailable(context.Context, *SetItemUnavailableRequest) (*SetItemUnavailableResponse, error)
+	AddLocation(context.Context, *AddLocationRequest) (*AddLocationResponse, error)
+	ListLocations(context.Context, *ListLocationsRequest) (*ListLocationsResponse, error)
+	GetItemStock(context.Context, *GetItemStockRequest) (*GetItemStockResponse, error)
+	TransferStock(context.Context, *TransferStockRequest) (*TransferStockResponse, error)
+	ListLots(context.Context, *ListLotsRequest) (*ListLotsResponse, error)
+	ListExpiredLots(context.Context, *ListExpiredLotsRequest) (*ListExpiredLotsResponse, error)
+	WriteOffExpiredLots(context.Context, *WriteOffExpiredLotsRequest) (*WriteOffExpiredLotsResponse, error)
+	SetRecipe(context.Context, *SetRecipeRequest) (*RecipeResponse, error)
+	GetRecipe(context.Context, *GetRecipeRequest) (*RecipeResponse, error)
+	ListRecipeShortages(context.Context, *ListRecipeShortagesRequest) (*ListRecipeShortagesResponse, error)
+	SetBundle(context.Context, *SetBundleRequest) (*BundleResponse, error)
+	GetBundle(context.Context, *GetBundleRequest) (*BundleResponse, error)
+	AddSupplier(context.Context, *AddSupplierRequest) (*AddSupplierResponse, error)
+	ListSuppliers(context.Context, *ListSuppliersRequest) (*ListSuppliersResponse, error)
+	SetSupplierItem(context.Context, *SetSupplierItemRequest) (*SetSupplierItemResponse, error)
+	ListSupplierItems(context.Context, *ListSupplierItemsRequest) (*ListSupplierItemsResponse, error)
+	CreatePurchaseOrder(context.Context, *CreatePurchaseOrderRequest) (*PurchaseOrderResponse, error)
+	GetPurchaseOrder(context.Context, *GetPurchaseOrderRequest) (*PurchaseOrderResponse, error)
+	ListPurchaseOrders(context.Context, *ListPurchaseOrdersRequest) (*ListPurchaseOrdersResponse, error)
+	SendPurchaseOrder(context.Context, *SendPurchaseOrderRequest) (*PurchaseOrderResponse, error)
+	ReceivePurchaseOrderLine(context.Context, *ReceivePurchaseOrderLineRequest) (*ReceivePurchaseOrderLineResponse, error)
+	StartStockTake(context.Context, *StartStockTakeRequest) (*StockTakeResponse, error)
+	GetStockTake(context.Context, *GetStockTakeRequest) (*StockTakeResponse, error)
+	ListStockTakes(context.Context, *ListStockTakesRequest) (*ListStockTakesResponse, error)
+	RecordStockCount(context.Context, *RecordStockCountRequest) (*RecordStockCountResponse, error)
+	GetVarianceReport(context.Context, *GetVarianceReportRequest) (*GetVarianceReportResponse, error)
+	ApproveStockTake(context.Context, *ApproveStockTakeRequest) (*ApproveStockTakeResponse, error)
+	CancelStockTake(context.Context, *CancelStockTakeRequest) (*StockTakeResponse, error)
+	SchedulePrice(context.Context, *SchedulePriceRequest) (*SchedulePriceResponse, error)
+	ListPriceHistory(context.Context, *ListPriceHistoryRequest) (*ListPriceHistoryResponse, error)
+	UploadItemImage(context.Context, *UploadItemImageRequest) (*UploadItemImageResponse, error)
+	DeleteItemImage(context.Context, *DeleteItemImageRequest) (*DeleteItemImageResponse, error)
+	GetImage(context.Context, *GetImageRequest) (*GetImageResponse, error)
+	GetItemBySKU(context.Context, *GetItemBySKURequest) (*GetItemResponse, error)
+	GetItemByBarcode(context.Context, *GetItemByBarcodeRequest) (*GetItemResponse, error)
+	AddItemBarcode(context.Context, *AddItemBarcodeRequest) (*AddItemBarcodeResponse, error)
+	RemoveItemBarcode(context.Context, *RemoveItemBarcodeRequest) (*RemoveItemBarcodeResponse, error)
+	ListArchivedItems(context.Context, *ListArchivedItemsRequest) (*ListArchivedItemsResponse, error)
+	RestoreItem(context.Context, *RestoreItemRequest) (*RestoreItemResponse, error)
+	PurgeItem(context.Context, *PurgeItemRequest) (*PurgeItemResponse, error)
+	GetStockValuation(context.Context, *GetStockValuationRequest) (*GetStockValuationResponse, error)
+	SummarizeMovements(context.Context, *SummarizeMovementsRequest) (*SummarizeMovementsResponse, error)
+	ListSlowMovingItems(context.Context, *ListSlowMovingItemsRequest) (*ListSlowMovingItemsResponse, error)
+	SubmitReview(context.Context, *SubmitReviewRequest) (*SubmitReviewResponse, error)
+	ListItemReviews(context.Context, *ListItemReviewsRequest) (*ListReviewsResponse, error)
+	ListReviews(context.Context, *ListReviewsRequest) (*ListReviewsResponse, error)
+	ModerateReview(context.Context, *ModerateReviewRequest) (*ModerateReviewResponse, error)
+	SetItemTranslation(context.Context, *SetItemTranslationRequest) (*SetItemTranslationResponse, error)
+	DeleteItemTranslation(context.Context, *DeleteItemTranslationRequest) (*DeleteTranslationResponse, error)
+	ListItemTranslations(context.Context, *ListItemTranslationsRequest) (*ListItemTranslationsResponse, error)
+	SetCategoryTranslation(context.Context, *SetCategoryTranslationRequest) (*SetCategoryTranslationResponse, error)
+	DeleteCategoryTranslation(context.Context, *DeleteCategoryTranslationRequest) (*DeleteTranslationResponse, error)
+	ListCategoryTranslations(context.Context, *ListCategoryTranslationsRequest) (*ListCategoryTranslationsResponse, error)
+	GetMissingTranslations(context.Context, *GetMissingTranslationsRequest) (*GetMissingTranslationsResponse, error)
+	WatchInventory(*WatchInventoryRequest, InventoryService_WatchInventoryServer) error
+	mustEmbedUnimplementedInventoryServiceServer()
+}
+
+// UnimplementedInventoryServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedInventoryServiceServer struct {
+}
+
+func (UnimplementedInventoryServiceServer) AddItem(context.Context, *AddItemRequest) (*AddItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddItem not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetItem(context.Context, *GetItemRequest) (*GetItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItem not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetAllItems(context.Context, *GetAllItemsRequest) (*GetAllItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllItems not implemented")
+}
+func (UnimplementedInventoryServiceServer) AddQuantity(context.Context, *AddQuantityRequest) (*AddQuantityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddQuantity not implemented")
+}
+func (UnimplementedInventoryServiceServer) LowerQuantity(context.Context, *LowerQuantityRequest) (*LowerQuantityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LowerQuantity not implemented")
+}
+func (UnimplementedInventoryServiceServer) DeleteItem(context.Context, *DeleteItemRequest) (*DeleteItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteItem not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListStockMovements(context.Context, *ListStockMovementsRequest) (*ListStockMovementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStockMovements not implemented")
+}
+func (UnimplementedInventoryServiceServer) ReconcileStock(context.Context, *ReconcileStockRequest) (*ReconcileStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileStock not implemented")
+}
+func (UnimplementedInventoryServiceServer) SetReorderLevel(context.Context, *SetReorderLevelRequest) (*SetReorderLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReorderLevel not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListLowStockItems(context.Context, *ListLowStockItemsRequest) (*ListLowStockItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLowStockItems not implemented")
+}
+func (UnimplementedInventoryServiceServer) ImportItems(context.Context, *ImportItemsRequest) (*ImportItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportItems not implemented")
+}
+func (UnimplementedInventoryServiceServer) ExportItems(context.Context, *ExportItemsRequest) (*ExportItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportItems not implemented")
+}
+func (UnimplementedInventoryServiceServer) UpdateItem(context.Context, *UpdateItemRequest) (*UpdateItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateItem not implemented")
+}
+func (UnimplementedInventoryServiceServer) AddOptionGroup(context.Context, *AddOptionGroupRequest) (*AddOptionGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOptionGroup not implemented")
+}
+func (UnimplementedInventoryServiceServer) DeleteOptionGroup(context.Context, *DeleteOptionGroupRequest) (*DeleteOptionGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOptionGroup not implemented")
+}
+func (UnimplementedInventoryServiceServer) SetOptionStock(context.Context, *SetOptionStockRequest) (*SetOptionStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOptionStock not implemented")
+}
+func (UnimplementedInventoryServiceServer) BatchGetItems(context.Context, *BatchGetItemsRequest) (*BatchGetItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetItems not implemented")
+}
+func (UnimplementedInventoryServiceServer) AddAvailabilityWindow(context.Context, *AddAvailabilityWindowRequest) (*AddAvailabilityWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAvailabilityWindow not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListAvailabilityWindows(context.Context, *ListAvailabilityWindowsRequest) (*ListAvailabilityWindowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAvailabilityWindows not implemented")
+}
+func (UnimplementedInventoryServiceServer) DeleteAvailabilityWindow(context.Context, *DeleteAvailabilityWindowRequest) (*DeleteAvailabilityWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAvailabilityWindow not implemented")
+}
+func (UnimplementedInventoryServiceServer) SetItemUnavailable(context.Context, *SetItemUnavailableRequest) (*SetItemUnavailableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetItemUnavailable not implemented")
+}
+func (UnimplementedInventoryServiceServer) AddLocation(context.Context, *AddLocationRequest) (*AddLocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddLocation not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListLocations(context.Context, *ListLocationsRequest) (*ListLocationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLocations not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetItemStock(context.Context, *GetItemStockRequest) (*GetItemStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItemStock not implemented")
+}
+func (UnimplementedInventoryServiceServer) TransferStock(context.Context, *TransferStockRequest) (*TransferStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferStock not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListLots(context.Context, *ListLotsRequest) (*ListLotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLots not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListExpiredLots(context.Context, *ListExpiredLotsRequest) (*ListExpiredLotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExpiredLots not implemented")
+}
+func (UnimplementedInventoryServiceServer) WriteOffExpiredLots(context.Context, *WriteOffExpiredLotsRequest) (*WriteOffExpiredLotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteOffExpiredLots not implemented")
+}
+func (UnimplementedInventoryServiceServer) SetRecipe(context.Context, *SetRecipeRequest) (*RecipeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRecipe not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetRecipe(context.Context, *GetRecipeRequest) (*RecipeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecipe not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListRecipeShortages(context.Context, *ListRecipeShortagesRequest) (*ListRecipeShortagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecipeShortages not implemented")
+}
+func (UnimplementedInventoryServiceServer) SetBundle(context.Context, *SetBundleRequest) (*BundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBundle not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetBundle(context.Context, *GetBundleRequest) (*BundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBundle not implemented")
+}
+func (UnimplementedInventoryServiceServer) AddSupplier(context.Context, *AddSupplierRequest) (*AddSupplierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSupplier not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListSuppliers(context.Context, *ListSuppliersRequest) (*ListSuppliersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSuppliers not implemented")
+}
+func (UnimplementedInventoryServiceServer) SetSupplierItem(context.Context, *SetSupplierItemRequest) (*SetSupplierItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSupplierItem not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListSupplierItems(context.Context, *ListSupplierItemsRequest) (*ListSupplierItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSupplierItems not implemented")
+}
+func (UnimplementedInventoryServiceServer) CreatePurchaseOrder(context.Context, *CreatePurchaseOrderRequest) (*PurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePurchaseOrder not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetPurchaseOrder(context.Context, *GetPurchaseOrderRequest) (*PurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPurchaseOrder not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListPurchaseOrders(context.Context, *ListPurchaseOrdersRequest) (*ListPurchaseOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPurchaseOrders not implemented")
+}
+func (UnimplementedInventoryServiceServer) SendPurchaseOrder(context.Context, *SendPurchaseOrderRequest) (*PurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendPurchaseOrder not implemented")
+}
+func (UnimplementedInventoryServiceServer) ReceivePurchaseOrderLine(context.Context, *ReceivePurchaseOrderLineRequest) (*ReceivePurchaseOrderLineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceivePurchaseOrderLine not implemented")
+}
+func (UnimplementedInventoryServiceServer) StartStockTake(context.Context, *StartStockTakeRequest) (*StockTakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartStockTake not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetStockTake(context.Context, *GetStockTakeRequest) (*StockTakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStockTake not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListStockTakes(context.Context, *ListStockTakesRequest) (*ListStockTakesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStockTakes not implemented")
+}
+func (UnimplementedInventoryServiceServer) RecordStockCount(context.Context, *RecordStockCountRequest) (*RecordStockCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordStockCount not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetVarianceReport(context.Context, *GetVarianceReportRequest) (*GetVarianceReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVarianceReport not implemented")
+}
+func (UnimplementedInventoryServiceServer) ApproveStockTake(context.Context, *ApproveStockTakeRequest) (*ApproveStockTakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveStockTake not implemented")
+}
+func (UnimplementedInventoryServiceServer) CancelStockTake(context.Context, *CancelStockTakeRequest) (*StockTakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelStockTake not implemented")
+}
+func (UnimplementedInventoryServiceServer) SchedulePrice(context.Context, *SchedulePriceRequest) (*SchedulePriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchedulePrice not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListPriceHistory(context.Context, *ListPriceHistoryRequest) (*ListPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPriceHistory not implemented")
+}
+func (UnimplementedInventoryServiceServer) UploadItemImage(context.Context, *UploadItemImageRequest) (*UploadItemImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadItemImage not implemented")
+}
+func (UnimplementedInventoryServiceServer) DeleteItemImage(context.Context, *DeleteItemImageRequest) (*DeleteItemImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteItemImage not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetImage(context.Context, *GetImageRequest) (*GetImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImage not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetItemBySKU(context.Context, *GetItemBySKURequest) (*GetItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItemBySKU not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetItemByBarcode(context.Context, *GetItemByBarcodeRequest) (*GetItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItemByBarcode not implemented")
+}
+func (UnimplementedInventoryServiceServer) AddItemBarcode(context.Context, *AddItemBarcodeRequest) (*AddItemBarcodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddItemBarcode not implemented")
+}
+func (UnimplementedInventoryServiceServer) RemoveItemBarcode(context.Context, *RemoveItemBarcodeRequest) (*RemoveItemBarcodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveItemBarcode not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListArchivedItems(context.Context, *ListArchivedItemsRequest) (*ListArchivedItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArchivedItems not implemented")
+}
+func (UnimplementedInventoryServiceServer) RestoreItem(context.Context, *RestoreItemRequest) (*RestoreItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreItem not implemented")
+}
+func (UnimplementedInventoryServiceServer) PurgeItem(context.Context, *PurgeItemRequest) (*PurgeItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeItem not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetStockValuation(context.Context, *GetStockValuationRequest) (*GetStockValuationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStockValuation not implemented")
+}
+func (UnimplementedInventoryServiceServer) SummarizeMovements(context.Context, *SummarizeMovementsRequest) (*SummarizeMovementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SummarizeMovements not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListSlowMovingItems(context.Context, *ListSlowMovingItemsRequest) (*ListSlowMovingItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSlowMovingItems not implemented")
+}
+func (UnimplementedInventoryServiceServer) SubmitReview(context.Context, *SubmitReviewRequest) (*SubmitReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitReview not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListItemReviews(context.Context, *ListItemReviewsRequest) (*ListReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListItemReviews not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListReviews(context.Context, *ListReviewsRequest) (*ListReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviews not implemented")
+}
+func (UnimplementedInventoryServiceServer) ModerateReview(context.Context, *ModerateReviewRequest) (*ModerateReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateReview not implemented")
+}
+func (UnimplementedInventoryServiceServer) SetItemTranslation(context.Context, *SetItemTranslationRequest) (*SetItemTranslationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetItemTranslation not implemented")
+}
+func (UnimplementedInventoryServiceServer) DeleteItemTranslation(context.Context, *DeleteItemTranslationRequest) (*DeleteTranslationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteItemTranslation not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListItemTranslations(context.Context, *ListItemTranslationsRequest) (*ListItemTranslationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListItemTranslations not implemented")
+}
+func (UnimplementedInventoryServiceServer) SetCategoryTranslation(context.Context, *SetCategoryTranslationRequest) (*SetCategoryTranslationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCategoryTranslation not implemented")
+}
+func (UnimplementedInventoryServiceServer) DeleteCategoryTranslation(context.Context, *DeleteCategoryTranslationRequest) (*DeleteTranslationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategoryTranslation not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListCategoryTranslations(context.Context, *ListCategoryTranslationsRequest) (*ListCategoryTranslationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategoryTranslations not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetMissingTranslations(context.Context, *GetMissingTranslationsRequest) (*GetMissingTranslationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMissingTranslations not implemented")
+}
+func (UnimplementedInventoryServiceServer) WatchInventory(*WatchInventoryRequest, InventoryService_WatchInventoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchInventory not implemented")
+}
+func (UnimplementedInventoryServiceServer) mustEmbedUnimplementedInventoryServiceServer() {}
+
+// UnsafeInventoryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InventoryServiceServer will
+// result in compilation errors.
+type UnsafeInventoryServiceServer interface {
 	mustEmbedUnimplementedInventoryServiceServer()
 }
 
-// UnimplementedInventoryServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedInventoryServiceServer struct {
+func RegisterInventoryServiceServer(s grpc.ServiceRegistrar, srv InventoryServiceServer) {
+	s.RegisterService(&InventoryService_ServiceDesc, srv)
+}
+
+func _InventoryService_AddItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).AddItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_AddItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).AddItem(ctx, req.(*AddItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetItem(ctx, req.(*GetItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetAllItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetAllItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetAllItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetAllItems(ctx, req.(*GetAllItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_AddQuantity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddQuantityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).AddQuantity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_AddQuantity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).AddQuantity(ctx, req.(*AddQuantityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_LowerQuantity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LowerQuantityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).LowerQuantity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_LowerQuantity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).LowerQuantity(ctx, req.(*LowerQuantityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_DeleteItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).DeleteItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_DeleteItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).DeleteItem(ctx, req.(*DeleteItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListStockMovements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStockMovementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListStockMovements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListStockMovements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListStockMovements(ctx, req.(*ListStockMovementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ReconcileStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReconcileStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReconcileStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReconcileStock(ctx, req.(*ReconcileStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SetReorderLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReorderLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SetReorderLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SetReorderLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SetReorderLevel(ctx, req.(*SetReorderLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListLowStockItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLowStockItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListLowStockItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListLowStockItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListLowStockItems(ctx, req.(*ListLowStockItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ImportItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ImportItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ImportItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ImportItems(ctx, req.(*ImportItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ExportItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ExportItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ExportItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ExportItems(ctx, req.(*ExportItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_UpdateItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).UpdateItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_UpdateItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).UpdateItem(ctx, req.(*UpdateItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_AddOptionGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOptionGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).AddOptionGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_AddOptionGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).AddOptionGroup(ctx, req.(*AddOptionGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_DeleteOptionGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOptionGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).DeleteOptionGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_DeleteOptionGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).DeleteOptionGroup(ctx, req.(*DeleteOptionGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SetOptionStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOptionStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SetOptionStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SetOptionStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SetOptionStock(ctx, req.(*SetOptionStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_BatchGetItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).BatchGetItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_BatchGetItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).BatchGetItems(ctx, req.(*BatchGetItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_AddAvailabilityWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAvailabilityWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).AddAvailabilityWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_AddAvailabilityWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).AddAvailabilityWindow(ctx, req.(*AddAvailabilityWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListAvailabilityWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAvailabilityWindowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListAvailabilityWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListAvailabilityWindows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListAvailabilityWindows(ctx, req.(*ListAvailabilityWindowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_DeleteAvailabilityWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAvailabilityWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).DeleteAvailabilityWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_DeleteAvailabilityWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).DeleteAvailabilityWindow(ctx, req.(*DeleteAvailabilityWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SetItemUnavailable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetItemUnavailableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SetItemUnavailable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SetItemUnavailable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SetItemUnavailable(ctx, req.(*SetItemUnavailableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_AddLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).AddLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_AddLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).AddLocation(ctx, req.(*AddLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListLocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListLocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListLocations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListLocations(ctx, req.(*ListLocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetItemStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItemStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetItemStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetItemStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetItemStock(ctx, req.(*GetItemStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_TransferStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).TransferStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_TransferStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).TransferStock(ctx, req.(*TransferStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListLots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListLots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListLots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListLots(ctx, req.(*ListLotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListExpiredLots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExpiredLotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListExpiredLots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListExpiredLots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListExpiredLots(ctx, req.(*ListExpiredLotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_WriteOffExpiredLots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteOffExpiredLotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).WriteOffExpiredLots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_WriteOffExpiredLots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).WriteOffExpiredLots(ctx, req.(*WriteOffExpiredLotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SetRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SetRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SetRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SetRecipe(ctx, req.(*SetRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetRecipe(ctx, req.(*GetRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListRecipeShortages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecipeShortagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListRecipeShortages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListRecipeShortages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListRecipeShortages(ctx, req.(*ListRecipeShortagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SetBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SetBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SetBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SetBundle(ctx, req.(*SetBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetBundle(ctx, req.(*GetBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_AddSupplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSupplierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).AddSupplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_AddSupplier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).AddSupplier(ctx, req.(*AddSupplierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListSuppliers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSuppliersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListSuppliers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListSuppliers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListSuppliers(ctx, req.(*ListSuppliersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SetSupplierItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSupplierItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SetSupplierItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SetSupplierItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SetSupplierItem(ctx, req.(*SetSupplierItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListSupplierItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSupplierItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListSupplierItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListSupplierItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListSupplierItems(ctx, req.(*ListSupplierItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CreatePurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePurchaseOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CreatePurchaseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CreatePurchaseOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CreatePurchaseOrder(ctx, req.(*CreatePurchaseOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetPurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPurchaseOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetPurchaseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetPurchaseOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetPurchaseOrder(ctx, req.(*GetPurchaseOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListPurchaseOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPurchaseOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListPurchaseOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListPurchaseOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListPurchaseOrders(ctx, req.(*ListPurchaseOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SendPurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendPurchaseOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SendPurchaseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SendPurchaseOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SendPurchaseOrder(ctx, req.(*SendPurchaseOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ReceivePurchaseOrderLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceivePurchaseOrderLineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReceivePurchaseOrderLine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReceivePurchaseOrderLine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReceivePurchaseOrderLine(ctx, req.(*ReceivePurchaseOrderLineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_StartStockTake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartStockTakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).StartStockTake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_StartStockTake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).StartStockTake(ctx, req.(*StartStockTakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetStockTake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStockTakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetStockTake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetStockTake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetStockTake(ctx, req.(*GetStockTakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListStockTakes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStockTakesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListStockTakes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListStockTakes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListStockTakes(ctx, req.(*ListStockTakesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_RecordStockCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordStockCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).RecordStockCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_RecordStockCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).RecordStockCount(ctx, req.(*RecordStockCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetVarianceReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVarianceReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetVarianceReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetVarianceReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetVarianceReport(ctx, req.(*GetVarianceReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ApproveStockTake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveStockTakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ApproveStockTake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ApproveStockTake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ApproveStockTake(ctx, req.(*ApproveStockTakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CancelStockTake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelStockTakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CancelStockTake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CancelStockTake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CancelStockTake(ctx, req.(*CancelStockTakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SchedulePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulePriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SchedulePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SchedulePrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SchedulePrice(ctx, req.(*SchedulePriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListPriceHistory(ctx, req.(*ListPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_UploadItemImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadItemImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).UploadItemImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_UploadItemImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).UploadItemImage(ctx, req.(*UploadItemImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_DeleteItemImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteItemImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).DeleteItemImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_DeleteItemImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).DeleteItemImage(ctx, req.(*DeleteItemImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetImage(ctx, req.(*GetImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetItemBySKU_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItemBySKURequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetItemBySKU(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetItemBySKU_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetItemBySKU(ctx, req.(*GetItemBySKURequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetItemByBarcode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItemByBarcodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetItemByBarcode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetItemByBarcode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetItemByBarcode(ctx, req.(*GetItemByBarcodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_AddItemBarcode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddItemBarcodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).AddItemBarcode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_AddItemBarcode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).AddItemBarcode(ctx, req.(*AddItemBarcodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_RemoveItemBarcode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveItemBarcodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).RemoveItemBarcode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_RemoveItemBarcode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).RemoveItemBarcode(ctx, req.(*RemoveItemBarcodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListArchivedItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArchivedItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListArchivedItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListArchivedItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListArchivedItems(ctx, req.(*ListArchivedItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_RestoreItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).RestoreItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_RestoreItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).RestoreItem(ctx, req.(*RestoreItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_PurgeItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).PurgeItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_PurgeItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).PurgeItem(ctx, req.(*PurgeItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetStockValuation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStockValuationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetStockValuation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetStockValuation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetStockValuation(ctx, req.(*GetStockValuationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SummarizeMovements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SummarizeMovementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SummarizeMovements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SummarizeMovements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SummarizeMovements(ctx, req.(*SummarizeMovementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListSlowMovingItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSlowMovingItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListSlowMovingItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListSlowMovingItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListSlowMovingItems(ctx, req.(*ListSlowMovingItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SubmitReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SubmitReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SubmitReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SubmitReview(ctx, req.(*SubmitReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListItemReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItemReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListItemReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListItemReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListItemReviews(ctx, req.(*ListItemReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (UnimplementedInventoryServiceServer) AddItem(context.Context, *AddItemRequest) (*AddItemResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddItem not implemented")
-}
-func (UnimplementedInventoryServiceServer) GetItem(context.Context, *GetItemRequest) (*GetItemResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetItem not implemented")
-}
-func (UnimplementedInventoryServiceServer) GetAllItems(context.Context, *GetAllItemsRequest) (*GetAllItemsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllItems not implemented")
-}
-func (UnimplementedInventoryServiceServer) AddQuantity(context.Context, *AddQuantityRequest) (*AddQuantityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddQuantity not implemented")
-}
-func (UnimplementedInventoryServiceServer) LowerQuantity(context.Context, *LowerQuantityRequest) (*LowerQuantityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LowerQuantity not implemented")
-}
-func (UnimplementedInventoryServiceServer) DeleteItem(context.Context, *DeleteItemRequest) (*DeleteItemResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteItem not implemented")
+func _InventoryService_ListReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListReviews(ctx, req.(*ListReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedInventoryServiceServer) mustEmbedUnimplementedInventoryServiceServer() {}
 
-// UnsafeInventoryServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to InventoryServiceServer will
-// result in compilation errors.
-type UnsafeInventoryServiceServer interface {
-	mustEmbedUnimplementedInventoryServiceServer()
+func _InventoryService_ModerateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ModerateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ModerateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ModerateReview(ctx, req.(*ModerateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterInventoryServiceServer(s grpc.ServiceRegistrar, srv InventoryServiceServer) {
-	s.RegisterService(&InventoryService_ServiceDesc, srv)
+func _InventoryService_SetItemTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetItemTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SetItemTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SetItemTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SetItemTranslation(ctx, req.(*SetItemTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_AddItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddItemRequest)
+func _InventoryService_DeleteItemTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteItemTranslationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).AddItem(ctx, in)
+		return srv.(InventoryServiceServer).DeleteItemTranslation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_AddItem_FullMethodName,
+		FullMethod: InventoryService_DeleteItemTranslation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).AddItem(ctx, req.(*AddItemRequest))
+		return srv.(InventoryServiceServer).DeleteItemTranslation(ctx, req.(*DeleteItemTranslationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_GetItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetItemRequest)
+func _InventoryService_ListItemTranslations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItemTranslationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).GetItem(ctx, in)
+		return srv.(InventoryServiceServer).ListItemTranslations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_GetItem_FullMethodName,
+		FullMethod: InventoryService_ListItemTranslations_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).GetItem(ctx, req.(*GetItemRequest))
+		return srv.(InventoryServiceServer).ListItemTranslations(ctx, req.(*ListItemTranslationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_GetAllItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllItemsRequest)
+func _InventoryService_SetCategoryTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCategoryTranslationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).GetAllItems(ctx, in)
+		return srv.(InventoryServiceServer).SetCategoryTranslation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_GetAllItems_FullMethodName,
+		FullMethod: InventoryService_SetCategoryTranslation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).GetAllItems(ctx, req.(*GetAllItemsRequest))
+		return srv.(InventoryServiceServer).SetCategoryTranslation(ctx, req.(*SetCategoryTranslationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_AddQuantity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddQuantityRequest)
+func _InventoryService_DeleteCategoryTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryTranslationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).AddQuantity(ctx, in)
+		return srv.(InventoryServiceServer).DeleteCategoryTranslation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_AddQuantity_FullMethodName,
+		FullMethod: InventoryService_DeleteCategoryTranslation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).AddQuantity(ctx, req.(*AddQuantityRequest))
+		return srv.(InventoryServiceServer).DeleteCategoryTranslation(ctx, req.(*DeleteCategoryTranslationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_LowerQuantity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LowerQuantityRequest)
+func _InventoryService_ListCategoryTranslations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoryTranslationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).LowerQuantity(ctx, in)
+		return srv.(InventoryServiceServer).ListCategoryTranslations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_LowerQuantity_FullMethodName,
+		FullMethod: InventoryService_ListCategoryTranslations_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).LowerQuantity(ctx, req.(*LowerQuantityRequest))
+		return srv.(InventoryServiceServer).ListCategoryTranslations(ctx, req.(*ListCategoryTranslationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_DeleteItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteItemRequest)
+func _InventoryService_GetMissingTranslations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMissingTranslationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).DeleteItem(ctx, in)
+		return srv.(InventoryServiceServer).GetMissingTranslations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_DeleteItem_FullMethodName,
+		FullMethod: InventoryService_GetMissingTranslations_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).DeleteItem(ctx, req.(*DeleteItemRequest))
+		return srv.(InventoryServiceServer).GetMissingTranslations(ctx, req.(*GetMissingTranslationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InventoryService_WatchInventory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchInventoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InventoryServiceServer).WatchInventory(m, &inventoryServiceWatchInventoryServer{stream})
+}
+
+type InventoryService_WatchInventoryServer interface {
+	Send(*InventoryEvent) error
+	grpc.ServerStream
+}
+
+type inventoryServiceWatchInventoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *inventoryServiceWatchInventoryServer) Send(m *InventoryEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // InventoryService_ServiceDesc is the grpc.ServiceDesc for InventoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -288,7 +2624,289 @@ var InventoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteItem",
 			Handler:    _InventoryService_DeleteItem_Handler,
 		},
+		{
+			MethodName: "ListStockMovements",
+			Handler:    _InventoryService_ListStockMovements_Handler,
+		},
+		{
+			MethodName: "ReconcileStock",
+			Handler:    _InventoryService_ReconcileStock_Handler,
+		},
+		{
+			MethodName: "SetReorderLevel",
+			Handler:    _InventoryService_SetReorderLevel_Handler,
+		},
+		{
+			MethodName: "ListLowStockItems",
+			Handler:    _InventoryService_ListLowStockItems_Handler,
+		},
+		{
+			MethodName: "ImportItems",
+			Handler:    _InventoryService_ImportItems_Handler,
+		},
+		{
+			MethodName: "ExportItems",
+			Handler:    _InventoryService_ExportItems_Handler,
+		},
+		{
+			MethodName: "UpdateItem",
+			Handler:    _InventoryService_UpdateItem_Handler,
+		},
+		{
+			MethodName: "AddOptionGroup",
+			Handler:    _InventoryService_AddOptionGroup_Handler,
+		},
+		{
+			MethodName: "DeleteOptionGroup",
+			Handler:    _InventoryService_DeleteOptionGroup_Handler,
+		},
+		{
+			MethodName: "SetOptionStock",
+			Handler:    _InventoryService_SetOptionStock_Handler,
+		},
+		{
+			MethodName: "BatchGetItems",
+			Handler:    _InventoryService_BatchGetItems_Handler,
+		},
+		{
+			MethodName: "AddAvailabilityWindow",
+			Handler:    _InventoryService_AddAvailabilityWindow_Handler,
+		},
+		{
+			MethodName: "ListAvailabilityWindows",
+			Handler:    _InventoryService_ListAvailabilityWindows_Handler,
+		},
+		{
+			MethodName: "DeleteAvailabilityWindow",
+			Handler:    _InventoryService_DeleteAvailabilityWindow_Handler,
+		},
+		{
+			MethodName: "SetItemUnavailable",
+			Handler:    _InventoryService_SetItemUnavailable_Handler,
+		},
+		{
+			MethodName: "AddLocation",
+			Handler:    _InventoryService_AddLocation_Handler,
+		},
+		{
+			MethodName: "ListLocations",
+			Handler:    _InventoryService_ListLocations_Handler,
+		},
+		{
+			MethodName: "GetItemStock",
+			Handler:    _InventoryService_GetItemStock_Handler,
+		},
+		{
+			MethodName: "TransferStock",
+			Handler:    _InventoryService_TransferStock_Handler,
+		},
+		{
+			MethodName: "ListLots",
+			Handler:    _InventoryService_ListLots_Handler,
+		},
+		{
+			MethodName: "ListExpiredLots",
+			Handler:    _InventoryService_ListExpiredLots_Handler,
+		},
+		{
+			MethodName: "WriteOffExpiredLots",
+			Handler:    _InventoryService_WriteOffExpiredLots_Handler,
+		},
+		{
+			MethodName: "SetRecipe",
+			Handler:    _InventoryService_SetRecipe_Handler,
+		},
+		{
+			MethodName: "GetRecipe",
+			Handler:    _InventoryService_GetRecipe_Handler,
+		},
+		{
+			MethodName: "ListRecipeShortages",
+			Handler:    _InventoryService_ListRecipeShortages_Handler,
+		},
+		{
+			MethodName: "SetBundle",
+			Handler:    _InventoryService_SetBundle_Handler,
+		},
+		{
+			MethodName: "GetBundle",
+			Handler:    _InventoryService_GetBundle_Handler,
+		},
+		{
+			MethodName: "AddSupplier",
+			Handler:    _InventoryService_AddSupplier_Handler,
+		},
+		{
+			MethodName: "ListSuppliers",
+			Handler:    _InventoryService_ListSuppliers_Handler,
+		},
+		{
+			MethodName: "SetSupplierItem",
+			Handler:    _InventoryService_SetSupplierItem_Handler,
+		},
+		{
+			MethodName: "ListSupplierItems",
+			Handler:    _InventoryService_ListSupplierItems_Handler,
+		},
+		{
+			MethodName: "CreatePurchaseOrder",
+			Handler:    _InventoryService_CreatePurchaseOrder_Handler,
+		},
+		{
+			MethodName: "GetPurchaseOrder",
+			Handler:    _InventoryService_GetPurchaseOrder_Handler,
+		},
+		{
+			MethodName: "ListPurchaseOrders",
+			Handler:    _InventoryService_ListPurchaseOrders_Handler,
+		},
+		{
+			MethodName: "SendPurchaseOrder",
+			Handler:    _InventoryService_SendPurchaseOrder_Handler,
+		},
+		{
+			MethodName: "ReceivePurchaseOrderLine",
+			Handler:    _InventoryService_ReceivePurchaseOrderLine_Handler,
+		},
+		{
+			MethodName: "StartStockTake",
+			Handler:    _InventoryService_StartStockTake_Handler,
+		},
+		{
+			MethodName: "GetStockTake",
+			Handler:    _InventoryService_GetStockTake_Handler,
+		},
+		{
+			MethodName: "ListStockTakes",
+			Handler:    _InventoryService_ListStockTakes_Handler,
+		},
+		{
+			MethodName: "RecordStockCount",
+			Handler:    _InventoryService_RecordStockCount_Handler,
+		},
+		{
+			MethodName: "GetVarianceReport",
+			Handler:    _InventoryService_GetVarianceReport_Handler,
+		},
+		{
+			MethodName: "ApproveStockTake",
+			Handler:    _InventoryService_ApproveStockTake_Handler,
+		},
+		{
+			MethodName: "CancelStockTake",
+			Handler:    _InventoryService_CancelStockTake_Handler,
+		},
+		{
+			MethodName: "SchedulePrice",
+			Handler:    _InventoryService_SchedulePrice_Handler,
+		},
+		{
+			MethodName: "ListPriceHistory",
+			Handler:    _InventoryService_ListPriceHistory_Handler,
+		},
+		{
+			MethodName: "UploadItemImage",
+			Handler:    _InventoryService_UploadItemImage_Handler,
+		},
+		{
+			MethodName: "DeleteItemImage",
+			Handler:    _InventoryService_DeleteItemImage_Handler,
+		},
+		{
+			MethodName: "GetImage",
+			Handler:    _InventoryService_GetImage_Handler,
+		},
+		{
+			MethodName: "GetItemBySKU",
+			Handler:    _InventoryService_GetItemBySKU_Handler,
+		},
+		{
+			MethodName: "GetItemByBarcode",
+			Handler:    _InventoryService_GetItemByBarcode_Handler,
+		},
+		{
+			MethodName: "AddItemBarcode",
+			Handler:    _InventoryService_AddItemBarcode_Handler,
+		},
+		{
+			MethodName: "RemoveItemBarcode",
+			Handler:    _InventoryService_RemoveItemBarcode_Handler,
+		},
+		{
+			MethodName: "ListArchivedItems",
+			Handler:    _InventoryService_ListArchivedItems_Handler,
+		},
+		{
+			MethodName: "RestoreItem",
+			Handler:    _InventoryService_RestoreItem_Handler,
+		},
+		{
+			MethodName: "PurgeItem",
+			Handler:    _InventoryService_PurgeItem_Handler,
+		},
+		{
+			MethodName: "GetStockValuation",
+			Handler:    _InventoryService_GetStockValuation_Handler,
+		},
+		{
+			MethodName: "SummarizeMovements",
+			Handler:    _InventoryService_SummarizeMovements_Handler,
+		},
+		{
+			MethodName: "ListSlowMovingItems",
+			Handler:    _InventoryService_ListSlowMovingItems_Handler,
+		},
+		{
+			MethodName: "SubmitReview",
+			Handler:    _InventoryService_SubmitReview_Handler,
+		},
+		{
+			MethodName: "ListItemReviews",
+			Handler:    _InventoryService_ListItemReviews_Handler,
+		},
+		{
+			MethodName: "ListReviews",
+			Handler:    _InventoryService_ListReviews_Handler,
+		},
+		{
+			MethodName: "ModerateReview",
+			Handler:    _InventoryService_ModerateReview_Handler,
+		},
+		{
+			MethodName: "SetItemTranslation",
+			Handler:    _InventoryService_SetItemTranslation_Handler,
+		},
+		{
+			MethodName: "DeleteItemTranslation",
+			Handler:    _InventoryService_DeleteItemTranslation_Handler,
+		},
+		{
+			MethodName: "ListItemTranslations",
+			Handler:    _InventoryService_ListItemTranslations_Handler,
+		},
+		{
+			MethodName: "SetCategoryTranslation",
+			Handler:    _InventoryService_SetCategoryTranslation_Handler,
+		},
+		{
+			MethodName: "DeleteCategoryTranslation",
+			Handler:    _InventoryService_DeleteCategoryTranslation_Handler,
+		},
+		{
+			MethodName: "ListCategoryTranslations",
+			Handler:    _InventoryService_ListCategoryTranslations_Handler,
+		},
+		{
+			MethodName: "GetMissingTranslations",
+			Handler:    _InventoryService_GetMissingTranslations_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchInventory",
+			Handler:       _InventoryService_WatchInventory_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/inventoryservice.proto",
 }
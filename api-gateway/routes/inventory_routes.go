@@ -13,7 +13,76 @@ func InitInventoryRoutes(router *mux.Router, inventoryService inventoryproto.Inv
 	router.HandleFunc("/admin/inventory/item/add", authMiddleware(inventoryHandlers.AddItem(inventoryService))).Methods("POST")
 	router.HandleFunc("/inventory/item", inventoryHandlers.GetItem(inventoryService)).Methods("POST")
 	router.HandleFunc("/inventory/item/all", inventoryHandlers.GetAllItems(inventoryService)).Methods("POST")
+	router.HandleFunc("/inventory/item/sku", inventoryHandlers.GetItemBySKU(inventoryService)).Methods("GET")
+	router.HandleFunc("/inventory/item/barcode", inventoryHandlers.GetItemByBarcode(inventoryService)).Methods("GET")
+	router.HandleFunc("/inventory/item/reviews", inventoryHandlers.ListItemReviews(inventoryService)).Methods("GET")
+	router.HandleFunc("/user/review", authMiddleware(inventoryHandlers.SubmitReview(inventoryService))).Methods("POST")
+	router.HandleFunc("/inventory/images/{key:.+}", inventoryHandlers.GetImage(inventoryService)).Methods("GET")
+	router.HandleFunc("/inventory/watch", inventoryHandlers.WatchInventory(inventoryService)).Methods("GET")
 	router.HandleFunc("/admin/inventory/item/quantity/add", authMiddleware(inventoryHandlers.AddQuantity(inventoryService))).Methods("POST")
 	router.HandleFunc("/admin/inventory/item/quantity/remove", authMiddleware(inventoryHandlers.LowerQuantity(inventoryService))).Methods("POST")
 	router.HandleFunc("/admin/inventory/item/remove", authMiddleware(inventoryHandlers.DeleteItem(inventoryService))).Methods("POST")
+	router.HandleFunc("/admin/inventory/items/archived", authMiddleware(inventoryHandlers.ListArchivedItems(inventoryService))).Methods("GET")
+	router.HandleFunc("/admin/inventory/item/restore", authMiddleware(inventoryHandlers.RestoreItem(inventoryService))).Methods("POST")
+	router.HandleFunc("/admin/inventory/item/purge", authMiddleware(inventoryHandlers.PurgeItem(inventoryService))).Methods("DELETE")
+	router.HandleFunc("/admin/inventory/item/movements", authMiddleware(inventoryHandlers.ListStockMovements(inventoryService))).Methods("GET")
+	router.HandleFunc("/admin/inventory/reconcile", authMiddleware(inventoryHandlers.ReconcileStock(inventoryService))).Methods("GET")
+	router.HandleFunc("/admin/inventory/item/reorder", authMiddleware(inventoryHandlers.SetReorderLevel(inventoryService))).Methods("POST")
+	router.HandleFunc("/admin/inventory/alerts", authMiddleware(inventoryHandlers.ListLowStockItems(inventoryService))).Methods("GET")
+	router.HandleFunc("/admin/inventory/items/import", authMiddleware(inventoryHandlers.ImportItems(inventoryService))).Methods("POST")
+	router.HandleFunc("/admin/inventory/items/export", authMiddleware(inventoryHandlers.ExportItems(inventoryService))).Methods("GET")
+	router.HandleFunc("/admin/inventory/reports/valuation", authMiddleware(inventoryHandlers.GetStockValuation(inventoryService))).Methods("GET")
+	router.HandleFunc("/admin/inventory/reports/movements", authMiddleware(inventoryHandlers.SummarizeMovements(inventoryService))).Methods("GET")
+	router.HandleFunc("/admin/inventory/reports/slow-moving", authMiddleware(inventoryHandlers.ListSlowMovingItems(inventoryService))).Methods("GET")
+	router.HandleFunc("/admin/inventory/reviews", authMiddleware(inventoryHandlers.ListReviews(inventoryService))).Methods("GET")
+	router.HandleFunc("/admin/inventory/reviews/moderate", authMiddleware(inventoryHandlers.ModerateReview(inventoryService))).Methods("POST")
+	router.HandleFunc("/admin/inventory/item/translations", authMiddleware(inventoryHandlers.SetItemTranslation(inventoryService))).Methods("PUT")
+	router.HandleFunc("/admin/inventory/item/translations", authMiddleware(inventoryHandlers.DeleteItemTranslation(inventoryService))).Methods("DELETE")
+	router.HandleFunc("/admin/inventory/item/translations", authMiddleware(inventoryHandlers.ListItemTranslations(inventoryService))).Methods("GET")
+	router.HandleFunc("/admin/inventory/categories/translations", authMiddleware(inventoryHandlers.SetCategoryTranslation(inventoryService))).Methods("PUT")
+	router.HandleFunc("/admin/inventory/categories/translations", authMiddleware(inventoryHandlers.DeleteCategoryTranslation(inventoryService))).Methods("DELETE")
+	router.HandleFunc("/admin/inventory/categories/translations", authMiddleware(inventoryHandlers.ListCategoryTranslations(inventoryService))).Methods("GET")
+	router.HandleFunc("/admin/inventory/translations/missing", authMiddleware(inventoryHandlers.GetMissingTranslations(inventoryService))).Methods("GET")
+	router.HandleFunc("/admin/inventory/items/{id}", authMiddleware(inventoryHandlers.UpdateItem(inventoryService))).Methods("PATCH")
+	router.HandleFunc("/admin/inventory/item/prices", authMiddleware(inventoryHandlers.SchedulePrice(inventoryService))).Methods("POST")
+	router.HandleFunc("/admin/inventory/item/images", authMiddleware(inventoryHandlers.UploadItemImage(inventoryService))).Methods("POST")
+	router.HandleFunc("/admin/inventory/item/images", authMiddleware(inventoryHandlers.DeleteItemImage(inventoryService))).Methods("DELETE")
+	router.HandleFunc("/admin/inventory/item/barcodes", authMiddleware(inventoryHandlers.AddItemBarcode(inventoryService))).Methods("POST")
+	router.HandleFunc("/admin/inventory/item/barcodes", authMiddleware(inventoryHandlers.RemoveItemBarcode(inventoryService))).Methods("DELETE")
+	router.HandleFunc("/admin/inventory/item/prices", authMiddleware(inventoryHandlers.ListPriceHistory(inventoryService))).Methods("GET")
+	router.HandleFunc("/admin/inventory/item/options", authMiddleware(inventoryHandlers.AddOptionGroup(inventoryService))).Methods("POST")
+	router.HandleFunc("/admin/inventory/item/options", authMiddleware(inventoryHandlers.DeleteOptionGroup(inventoryService))).Methods("DELETE")
+	router.HandleFunc("/admin/inventory/item/options/stock", authMiddleware(inventoryHandlers.SetOptionStock(inventoryService))).Methods("POST")
+	router.HandleFunc("/admin/inventory/item/availability", authMiddleware(inventoryHandlers.AddAvailabilityWindow(inventoryService))).Methods("POST")
+	router.HandleFunc("/admin/inventory/item/availability", authMiddleware(inventoryHandlers.ListAvailabilityWindows(inventoryService))).Methods("GET")
+	router.HandleFunc("/admin/inventory/item/availability", authMiddleware(inventoryHandlers.DeleteAvailabilityWindow(inventoryService))).Methods("DELETE")
+	router.HandleFunc("/admin/inventory/item/unavailable", authMiddleware(inventoryHandlers.SetItemUnavailable(inventoryService))).Methods("POST")
+	router.HandleFunc("/admin/inventory/locations", authMiddleware(inventoryHandlers.AddLocation(inventoryService))).Methods("POST")
+	router.HandleFunc("/admin/inventory/locations", authMiddleware(inventoryHandlers.ListLocations(inventoryService))).Methods("GET")
+	router.HandleFunc("/admin/inventory/item/stock", authMiddleware(inventoryHandlers.GetItemStock(inventoryService))).Methods("GET")
+	router.HandleFunc("/admin/inventory/item/transfer", authMiddleware(inventoryHandlers.TransferStock(inventoryService))).Methods("POST")
+	router.HandleFunc("/admin/inventory/item/lots", authMiddleware(inventoryHandlers.ListLots(inventoryService))).Methods("GET")
+	router.HandleFunc("/admin/inventory/lots/expired", authMiddleware(inventoryHandlers.ListExpiredLots(inventoryService))).Methods("GET")
+	router.HandleFunc("/admin/inventory/lots/expired/writeoff", authMiddleware(inventoryHandlers.WriteOffExpiredLots(inventoryService))).Methods("POST")
+	router.HandleFunc("/admin/inventory/item/recipe", authMiddleware(inventoryHandlers.SetRecipe(inventoryService))).Methods("PUT")
+	router.HandleFunc("/admin/inventory/item/recipe", authMiddleware(inventoryHandlers.GetRecipe(inventoryService))).Methods("GET")
+	router.HandleFunc("/admin/inventory/recipes/shortages", authMiddleware(inventoryHandlers.ListRecipeShortages(inventoryService))).Methods("GET")
+	router.HandleFunc("/admin/inventory/item/bundle", authMiddleware(inventoryHandlers.SetBundle(inventoryService))).Methods("PUT")
+	router.HandleFunc("/admin/inventory/item/bundle", authMiddleware(inventoryHandlers.GetBundle(inventoryService))).Methods("GET")
+	router.HandleFunc("/admin/inventory/suppliers", authMiddleware(inventoryHandlers.AddSupplier(inventoryService))).Methods("POST")
+	router.HandleFunc("/admin/inventory/suppliers", authMiddleware(inventoryHandlers.ListSuppliers(inventoryService))).Methods("GET")
+	router.HandleFunc("/admin/inventory/suppliers/items", authMiddleware(inventoryHandlers.SetSupplierItem(inventoryService))).Methods("PUT")
+	router.HandleFunc("/admin/inventory/suppliers/items", authMiddleware(inventoryHandlers.ListSupplierItems(inventoryService))).Methods("GET")
+	router.HandleFunc("/admin/inventory/purchaseorders", authMiddleware(inventoryHandlers.CreatePurchaseOrder(inventoryService))).Methods("POST")
+	router.HandleFunc("/admin/inventory/purchaseorders", authMiddleware(inventoryHandlers.ListPurchaseOrders(inventoryService))).Methods("GET")
+	router.HandleFunc("/admin/inventory/purchaseorder", authMiddleware(inventoryHandlers.GetPurchaseOrder(inventoryService))).Methods("GET")
+	router.HandleFunc("/admin/inventory/purchaseorder/send", authMiddleware(inventoryHandlers.SendPurchaseOrder(inventoryService))).Methods("POST")
+	router.HandleFunc("/admin/inventory/purchaseorder/receive", authMiddleware(inventoryHandlers.ReceivePurchaseOrderLine(inventoryService))).Methods("POST")
+	router.HandleFunc("/admin/inventory/stocktakes", authMiddleware(inventoryHandlers.StartStockTake(inventoryService))).Methods("POST")
+	router.HandleFunc("/admin/inventory/stocktakes", authMiddleware(inventoryHandlers.ListStockTakes(inventoryService))).Methods("GET")
+	router.HandleFunc("/admin/inventory/stocktake", authMiddleware(inventoryHandlers.GetStockTake(inventoryService))).Methods("GET")
+	router.HandleFunc("/admin/inventory/stocktake/count", authMiddleware(inventoryHandlers.RecordStockCount(inventoryService))).Methods("POST")
+	router.HandleFunc("/admin/inventory/stocktake/variance", authMiddleware(inventoryHandlers.GetVarianceReport(inventoryService))).Methods("GET")
+	router.HandleFunc("/admin/inventory/stocktake/approve", authMiddleware(inventoryHandlers.ApproveStockTake(inventoryService))).Methods("POST")
+	router.HandleFunc("/admin/inventory/stocktake/cancel", authMiddleware(inventoryHandlers.CancelStockTake(inventoryService))).Methods("POST")
 }
@@ -0,0 +1,81 @@
+package routes
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	logger "github.com/sirupsen/logrus"
+	"golang.org/x/text/language"
+	"google.golang.org/grpc/metadata"
+)
+
+// localeMetadataKey is the gRPC metadata key inventory-service reads the locale to show item
+// text in from.
+const localeMetadataKey = "locale"
+
+// localeMatcher picks, out of the locales the catalog is translated into, the one that best
+// suits a request.
+type localeMatcher struct {
+	locales []language.Tag
+	matcher language.Matcher
+}
+
+// newLocaleMatcher matches requests against the default locale and the comma separated
+// others, falling back to English when the default is not a valid locale.
+func newLocaleMatcher(defaultLocale string, locales string) *localeMatcher {
+	defaultTag, err := language.Parse(defaultLocale)
+	if err != nil {
+		defaultTag = language.English
+	}
+	tags := []language.Tag{defaultTag}
+	for _, locale := range strings.Split(locales, ",") {
+		if locale = strings.TrimSpace(locale); locale == "" {
+			continue
+		}
+		tag, err := language.Parse(locale)
+		if err != nil {
+			logger.WithField("locale", locale).Error("Ignoring invalid locale")
+			continue
+		}
+		if !containsTag(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	return &localeMatcher{locales: tags, matcher: language.NewMatcher(tags)}
+}
+
+func containsTag(tags []language.Tag, tag language.Tag) bool {
+	for _, candidate := range tags {
+		if candidate == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// negotiate returns the locale that best matches an Accept-Language header, or the default
+// locale when the header asks for none of them.
+func (m *localeMatcher) negotiate(acceptLanguage string) string {
+	preferred, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(preferred) == 0 {
+		return m.locales[0].String()
+	}
+	_, index, _ := m.matcher.Match(preferred...)
+	return m.locales[index].String()
+}
+
+// localeMiddleware negotiates the locale of each request from its Accept-Language header and
+// passes it on to the services it calls, which show item text in it where it is translated.
+func localeMiddleware(matcher *localeMatcher) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			locale := matcher.negotiate(req.Header.Get("Accept-Language"))
+			rw.Header().Set("Content-Language", locale)
+			rw.Header().Add("Vary", "Accept-Language")
+
+			ctx := metadata.AppendToOutgoingContext(req.Context(), localeMetadataKey, locale)
+			next.ServeHTTP(rw, req.WithContext(ctx))
+		})
+	}
+}
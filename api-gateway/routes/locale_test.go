@@ -0,0 +1,51 @@
+package routes
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestLocaleMatcher_Negotiate(t *testing.T) {
+	matcher := newLocaleMatcher("en", "hi, ta,not a locale,")
+
+	for header, expected := range map[string]string{
+		"ta-IN,ta;q=0.9,en;q=0.8": "ta",
+		"hi-IN":                   "hi",
+		"fr,ta;q=0.5":             "ta",
+		"en-AU":                   "en",
+		"fr-FR":                   "en",
+		"":                        "en",
+		"garbage;;q":              "en",
+	} {
+		assert.Equal(t, expected, matcher.negotiate(header), header)
+	}
+
+	assert.Equal(t, "en", newLocaleMatcher("", "").negotiate("ta"))
+}
+
+func TestLocaleMiddleware(t *testing.T) {
+	t.Run("expect to pass the negotiated locale on to the services called", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest("GET", "/inventory/item/all", nil)
+		req.Header.Set("Accept-Language", "ta-IN,en;q=0.5")
+		res := httptest.NewRecorder()
+
+		var locale []string
+		handler := localeMiddleware(newLocaleMatcher("en", "hi,ta"))(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			md, _ := metadata.FromOutgoingContext(req.Context())
+			locale = md.Get(localeMetadataKey)
+		}))
+
+		// Act
+		handler.ServeHTTP(res, req)
+
+		// Assert
+		assert.Equal(t, []string{"ta"}, locale)
+		assert.Equal(t, "ta", res.Header().Get("Content-Language"))
+		assert.Equal(t, "Accept-Language", res.Header().Get("Vary"))
+	})
+}
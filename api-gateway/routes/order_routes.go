@@ -4,10 +4,12 @@ import (
 	"api-gateway/handlers/orderHandlers"
 
 	"github.com/gorilla/mux"
+	inventoryproto "api-gateway/proto/inventory"
 	proto "api-gateway/proto/order"
 )
 
-func InitOrderRoutes(router *mux.Router, orderService proto.OrderServiceClient) {
+func InitOrderRoutes(router *mux.Router, orderService proto.OrderServiceClient, inventoryService inventoryproto.InventoryServiceClient) {
 	router.HandleFunc("/user/order", authMiddleware(orderHandlers.PlaceOrder(orderService))).Methods("POST")
-	router.HandleFunc("/user/order", authMiddleware(orderHandlers.GetOrders(orderService))).Methods("GET")
+	router.HandleFunc("/user/order", authMiddleware(orderHandlers.GetOrders(orderService, inventoryService))).Methods("GET")
+	router.HandleFunc("/admin/order/deliver", authMiddleware(orderHandlers.MarkOrderDelivered(orderService))).Methods("POST")
 }
\ No newline at end of file
@@ -2,15 +2,17 @@ package routes
 
 import (
 	"api-gateway/dependencies"
+	"os"
 
 	mux "github.com/gorilla/mux"
 )
 
 func InitRouter(deps *dependencies.Dependencies) *mux.Router {
 	router := mux.NewRouter()
+	router.Use(localeMiddleware(newLocaleMatcher(os.Getenv("DEFAULT_LOCALE"), os.Getenv("LOCALES"))))
 
 	InitAuthRoutes(router, deps.AuthService)
 	InitInventoryRoutes(router, deps.InventoryService)
-	InitOrderRoutes(router, deps.OrderService)
+	InitOrderRoutes(router, deps.OrderService, deps.InventoryService)
 	return router
 }
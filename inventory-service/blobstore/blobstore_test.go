@@ -0,0 +1,36 @@
+package blobstore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalStore_PutGetDelete(t *testing.T) {
+	store := NewLocalStore(t.TempDir(), "/inventory/images/")
+
+	assert.NoError(t, store.Put("items/1/2/original.png", []byte("png")))
+	data, err := store.Get("items/1/2/original.png")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("png"), data)
+
+	assert.NoError(t, store.Delete("items/1/2/original.png"))
+	_, err = store.Get("items/1/2/original.png")
+	assert.Equal(t, ErrNotFound, err)
+	assert.NoError(t, store.Delete("items/1/2/original.png"))
+}
+
+func TestLocalStore_RefusesKeysOutsideItsDirectory(t *testing.T) {
+	store := NewLocalStore(t.TempDir(), "/inventory/images")
+
+	for _, key := range []string{"", "/etc/passwd", "../secret", "items/../../secret", "items//1"} {
+		_, err := store.Get(key)
+		assert.Equal(t, ErrInvalidKey, err, key)
+		assert.Equal(t, ErrInvalidKey, store.Put(key, []byte("x")), key)
+	}
+}
+
+func TestLocalStore_URL(t *testing.T) {
+	assert.Equal(t, "/inventory/images/items/1/2/small.jpg", NewLocalStore("", "/inventory/images/").URL("items/1/2/small.jpg"))
+	assert.Equal(t, "https://cdn.example.com/items/1/2/small.jpg", NewLocalStore("", "https://cdn.example.com").URL("items/1/2/small.jpg"))
+}
@@ -0,0 +1,81 @@
+// Command menusync keeps the inventory catalog in line with a menu file. It prints what has
+// to change for the catalog to match the menu and, with -apply, makes the changes:
+//
+//	menusync -file menu.yaml
+//	menusync -file menu.yaml -apply
+//
+// A menu lists every item that should be in the catalog:
+//
+//	currency: EUR
+//	items:
+//	  - name: dosa
+//	    sku: DOSA-1
+//	    description: rice crepe
+//	    category: mains
+//	    price: 8.50
+//	    stock: 20
+//	  - name: flour
+//	    description: wheat
+//	    price: 3.00
+//	    unit: kg
+//	    stock: 12.5
+//
+// Items in the catalog that are not on the menu are archived.
+package main
+
+import (
+	"context"
+	"flag"
+	"inventory-service/menusync"
+	"inventory-service/proto/inventorypb"
+	"os"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func main() {
+	file := flag.String("file", "menu.yaml", "menu to sync the catalog with, as YAML or JSON")
+	address := flag.String("addr", "localhost:33002", "address of the inventory gRPC service")
+	apply := flag.Bool("apply", false, "make the changes rather than only printing them")
+	actor := flag.String("actor", "menusync", "who stock corrections are recorded as made by")
+	timeout := flag.Duration("timeout", time.Minute, "how long to wait for the inventory service")
+	flag.Parse()
+
+	data, err := os.ReadFile(*file)
+	if err != nil {
+		logger.WithField("error", err).Fatal("Failed to read menu")
+	}
+	menu, err := menusync.Load(data)
+	if err != nil {
+		logger.WithField("error", err).Fatal("Failed to read menu")
+	}
+
+	conn, err := grpc.Dial(*address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		logger.WithField("error", err).Fatal("Failed to connect to inventory service")
+	}
+	defer conn.Close()
+	client := inventorypb.NewInventoryServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	plan, err := menusync.PlanChanges(ctx, client, menu)
+	if err != nil {
+		logger.WithField("error", err).Fatal("Failed to compare the menu with the catalog")
+	}
+	if err := plan.Write(os.Stdout); err != nil {
+		logger.WithField("error", err).Fatal("Failed to print plan")
+	}
+	if !*apply || plan.Empty() {
+		return
+	}
+
+	if err := menusync.Apply(ctx, client, plan, *actor); err != nil {
+		logger.WithField("error", err).Fatal("Failed to apply the menu")
+	}
+	logger.Info("Catalog now matches the menu")
+}
@@ -13,4 +13,84 @@ var (
 	ErrAddItem = errors.New("failed to add item")
 	ErrItemExists = errors.New("item already exists")
 	ErrEmptyField = errors.New("empty field")
+	ErrInvalidMovement = errors.New("invalid stock movement")
+	ErrInvalidReason = errors.New("invalid movement reason")
+	ErrInvalidReorderLevel = errors.New("reorder quantity requires a reorder level")
+	ErrSKUExists = errors.New("sku already exists")
+	ErrInvalidImportFile = errors.New("invalid import file")
+	ErrUnsupportedFormat = errors.New("unsupported file format")
+	ErrDuplicateImportRow = errors.New("item appears more than once in the import")
+	ErrMissingSKU = errors.New("sku is required to match on sku")
+	ErrImportRejected = errors.New("import rejected: one or more rows are invalid")
+	ErrInvalidUpdateMask = errors.New("invalid update mask")
+	ErrInvalidOptionGroup = errors.New("invalid option group")
+	ErrOptionGroupNotFound = errors.New("option group not found")
+	ErrOptionNotFound = errors.New("option not found")
+	ErrInvalidOptionSelection = errors.New("invalid option selection")
+	ErrInsufficientOptionQuantity = errors.New("insufficient option quantity")
+	ErrTooManyItems = errors.New("too many items requested")
+	ErrInvalidAvailabilityWindow = errors.New("invalid availability window")
+	ErrAvailabilityWindowNotFound = errors.New("availability window not found")
+	ErrItemUnavailable = errors.New("item is not available right now")
+	ErrUnknownAllergen = errors.New("unknown allergen")
+	ErrUnknownDietaryLabel = errors.New("unknown dietary label")
+	ErrConflictingDietaryLabels = errors.New("dietary labels conflict with the item's allergens")
+	ErrInvalidNutrition = errors.New("nutrition values must be zero or more")
+	ErrLocationNotFound = errors.New("location not found")
+	ErrLocationExists = errors.New("location already exists")
+	ErrInvalidLocation = errors.New("invalid location")
+	ErrInvalidTransfer = errors.New("invalid stock transfer")
+	ErrLotNotFound = errors.New("lot not found")
+	ErrInvalidExpiry = errors.New("expiry must be in the future")
+	ErrInvalidRecipe = errors.New("invalid recipe")
+	ErrIngredientNotFound = errors.New("ingredient not found")
+	ErrUnitMismatch = errors.New("unit does not measure the same thing as the item's unit")
+	ErrInvalidBundle = errors.New("invalid bundle")
+	ErrComponentNotFound = errors.New("bundle component not found")
+	ErrSupplierExists = errors.New("supplier already exists")
+	ErrSupplierNotFound = errors.New("supplier not found")
+	ErrItemNotSupplied = errors.New("item is not sold by this supplier")
+	ErrInvalidPurchaseOrder = errors.New("invalid purchase order")
+	ErrPurchaseOrderNotFound = errors.New("purchase order not found")
+	ErrPurchaseOrderLineNotFound = errors.New("purchase order line not found")
+	ErrPurchaseOrderState = errors.New("purchase order is not in a state that allows this")
+	ErrOverReceipt = errors.New("receipt exceeds the quantity outstanding on the purchase order line")
+	ErrStockTakeNotFound = errors.New("stock-take not found")
+	ErrStockTakeClosed = errors.New("stock-take is no longer open")
+	ErrStockTakeInProgress = errors.New("a stock-take is in progress at this location")
+	ErrInvalidCurrency = errors.New("unknown currency")
+	ErrCurrencyMismatch = errors.New("amounts are in different currencies")
+	ErrInvalidAmount = errors.New("invalid amount of money")
+	ErrInvalidPriceSchedule = errors.New("prices can only be scheduled from now on, and must end after they start")
+	ErrInvalidTimestamp = errors.New("invalid timestamp")
+	ErrImageNotFound = errors.New("image not found")
+	ErrInvalidImage = errors.New("image must be a JPEG, PNG or GIF")
+	ErrImageTooLarge = errors.New("image is too large")
+	ErrInvalidBarcode = errors.New("barcode must be a valid EAN-13, EAN-8 or UPC-A code")
+	ErrBarcodeExists = errors.New("barcode already belongs to an item")
+	ErrBarcodeNotFound = errors.New("barcode not found")
+	ErrInvalidUnit = errors.New("unit must be one of each, dozen, g, kg, ml or l")
+	ErrInvalidQuantity = errors.New("quantity must come to a whole number of pieces, grams or millilitres")
+	ErrItemNotArchived = errors.New("item is not archived")
+	ErrItemInUse = errors.New("item is still used by a recipe, bundle or purchase order")
+	ErrInvalidValuationMethod = errors.New("valuation method must be FIFO or WEIGHTED_AVERAGE")
+	ErrInvalidPeriod = errors.New("a report's period must end after it starts")
+	ErrInvalidRating = errors.New("rating must be between 1 and 5")
+	ErrReviewTooLong = errors.New("review text is too long")
+	ErrTooManyPhotos = errors.New("too many photos on a review")
+	ErrReviewExists = errors.New("you have already reviewed this item")
+	ErrReviewNotFound = errors.New("review not found")
+	ErrInvalidReviewStatus = errors.New("a review can only be approved or rejected")
+	ErrInvalidSort = errors.New("items can only be sorted by rating")
+	ErrInvalidMenu = errors.New("invalid menu file")
+	ErrInvalidLocale = errors.New("invalid locale")
+	ErrUnsupportedLocale = errors.New("locale is not one the catalog is translated into")
+	ErrDefaultLocale = errors.New("text in the default locale is the item's or category's own")
+	ErrTranslationNotFound = errors.New("translation not found")
+	ErrInvalidTranslation = errors.New("a translation needs a name")
+	ErrCategoryNotFound = errors.New("no item in the catalog is in that category")
+	ErrItemHasOpenOrders = errors.New("item is still on orders that have not been delivered")
+	ErrNotDelivered = errors.New("only customers who have had the item delivered can review it")
+	ErrOrderServiceUnavailable = errors.New("order service is unavailable")
+	ErrWatcherTooSlow = errors.New("watcher fell behind the inventory feed, resume from the last sequence received")
 )
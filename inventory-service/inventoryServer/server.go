@@ -2,62 +2,353 @@ package inventoryServer
 
 import (
 	"context"
+	"fmt"
+	"inventory-service/errors"
+	"inventory-service/locale"
+	"inventory-service/models"
+	"inventory-service/money"
 	proto "inventory-service/proto/inventorypb"
+	"inventory-service/proto/moneypb"
 	"inventory-service/service"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc/metadata"
 )
 
+var reasonToModel = map[proto.MovementReason]string{
+	proto.MovementReason_REASON_UNSPECIFIED:   "",
+	proto.MovementReason_REASON_INITIAL_STOCK: models.ReasonInitialStock,
+	proto.MovementReason_REASON_RESTOCK:       models.ReasonRestock,
+	proto.MovementReason_REASON_ORDER:         models.ReasonOrder,
+	proto.MovementReason_REASON_WASTAGE:       models.ReasonWastage,
+	proto.MovementReason_REASON_CORRECTION:    models.ReasonCorrection,
+	proto.MovementReason_REASON_TRANSFER:      models.ReasonTransfer,
+	proto.MovementReason_REASON_STOCK_TAKE:    models.ReasonStockTake,
+}
+
+var reasonToProto = map[string]proto.MovementReason{
+	models.ReasonInitialStock: proto.MovementReason_REASON_INITIAL_STOCK,
+	models.ReasonRestock:      proto.MovementReason_REASON_RESTOCK,
+	models.ReasonOrder:        proto.MovementReason_REASON_ORDER,
+	models.ReasonWastage:      proto.MovementReason_REASON_WASTAGE,
+	models.ReasonCorrection:   proto.MovementReason_REASON_CORRECTION,
+	models.ReasonTransfer:     proto.MovementReason_REASON_TRANSFER,
+	models.ReasonStockTake:    proto.MovementReason_REASON_STOCK_TAKE,
+}
+
 type GRPCServer struct {
 	proto.UnimplementedInventoryServiceServer
 }
 
+// toItemResponse maps an item to the message shared by every RPC that returns item details.
+func toItemResponse(item *models.Item) *proto.GetItemResponse {
+	return &proto.GetItemResponse{
+		Id:              int32(item.ID),
+		Name:            item.Name,
+		Description:     item.Description,
+		Price:           toMoneyProto(item.Price),
+		BasePrice:       toMoneyProto(item.Price),
+		CostPrice:       toMoneyProto(item.CostPrice),
+		Quantity:        uint32(item.Quantity),
+		ReorderLevel:    uint32(item.ReorderLevel),
+		ReorderQuantity: uint32(item.ReorderQuantity),
+		Sku:             stringValue(item.SKU),
+		Category:        item.Category,
+		Unavailable:     item.Unavailable,
+		Allergens:       models.AllergenNames(item.Allergens),
+		DietaryLabels:   models.DietaryNames(item.DietaryLabels),
+		Nutrition:       toNutrition(item),
+		Unit:            item.Unit,
+		SaleUnit:        item.SellingUnit(),
+		Amount:          service.StockAmount(item),
+		ArchivedAt:      archivedAt(item),
+	}
+}
+
+// archivedAt returns when the item was deleted, or nothing while it is in the catalog.
+func archivedAt(item *models.Item) string {
+	if !item.DeletedAt.Valid {
+		return ""
+	}
+	return item.DeletedAt.Time.Format(time.RFC3339)
+}
+
+// toNutrition returns the item's nutrition facts, or nil when none are recorded.
+func toNutrition(item *models.Item) *proto.Nutrition {
+	if item.Kcal == nil && item.Protein == nil && item.Carbohydrates == nil && item.Fat == nil {
+		return nil
+	}
+	return &proto.Nutrition{
+		Kcal:          item.Kcal,
+		Protein:       item.Protein,
+		Carbohydrates: item.Carbohydrates,
+		Fat:           item.Fat,
+	}
+}
+
+// setDietary copies allergens, dietary labels and nutrition facts from a request onto item.
+func setDietary(item *models.Item, allergens []string, dietaryLabels []string, nutrition *proto.Nutrition) error {
+	var err error
+	if item.Allergens, err = models.AllergenMask(allergens); err != nil {
+		return err
+	}
+	if item.DietaryLabels, err = models.DietaryMask(dietaryLabels); err != nil {
+		return err
+	}
+	if nutrition == nil {
+		nutrition = &proto.Nutrition{}
+	}
+	item.Kcal = nutrition.Kcal
+	item.Protein = nutrition.Protein
+	item.Carbohydrates = nutrition.Carbohydrates
+	item.Fat = nutrition.Fat
+	return nil
+}
+
+// toItemResponses maps items to item messages, working out whether each can be ordered now,
+// how much of it is not sitting in expired lots and what it costs at the moment, and adding
+// its images, barcodes and rating. Names, descriptions and categories are in the locale the
+// caller asked for.
+func toItemResponses(ctx context.Context, items []*models.Item) (uint32, []*proto.GetItemResponse, error) {
+	status, available, err := service.ItemsAvailableNow(items)
+	if err != nil {
+		return status, nil, err
+	}
+	status, quantities, err := service.AvailableQuantities(items)
+	if err != nil {
+		return status, nil, err
+	}
+	status, prices, err := service.CurrentPrices(items)
+	if err != nil {
+		return status, nil, err
+	}
+	status, images, err := service.ItemImages(items)
+	if err != nil {
+		return status, nil, err
+	}
+	status, barcodes, err := service.ItemBarcodes(items)
+	if err != nil {
+		return status, nil, err
+	}
+	status, ratings, err := service.ItemRatings(items)
+	if err != nil {
+		return status, nil, err
+	}
+	status, texts, err := service.LocalizeItems(items, requestLocale(ctx))
+	if err != nil {
+		return status, nil, err
+	}
+	var responses []*proto.GetItemResponse
+	for _, item := range items {
+		response := toItemResponse(item)
+		response.Price = toMoneyProto(prices[item.ID])
+		text := texts[item.ID]
+		response.Name, response.Description, response.Category, response.Locale = text.Name, text.Description, text.Category, text.Locale
+		response.AvailableNow = available[item.ID] && !item.DeletedAt.Valid
+		response.AvailableQuantity = uint32(quantities[item.ID])
+		response.AvailableToSell = uint32(service.SellableQuantity(item, quantities[item.ID]))
+		for _, image := range images[item.ID] {
+			response.Images = append(response.Images, toItemImageResponse(image))
+		}
+		response.Barcodes = barcodes[item.ID]
+		if rating, ok := ratings[item.ID]; ok {
+			response.RatingAverage = rating.Average
+			response.RatingCount = uint32(rating.Count)
+		}
+		responses = append(responses, response)
+	}
+	return status, responses, nil
+}
+
+// requestLocale returns the locale the caller wants text in, or nothing when it did not say.
+func requestLocale(ctx context.Context) string {
+	if values := metadata.ValueFromIncomingContext(ctx, locale.MetadataKey); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
 func (s *GRPCServer) AddItem(ctx context.Context, req *proto.AddItemRequest) (*proto.AddItemResponse, error) {
-	status, item, err := service.AddItem(req.Name, req.Description, req.Price, uint(req.Quantity))
+	status, quantity, err := service.ToBaseQuantity(req.Unit, uint(req.Quantity), req.Amount)
+	if err != nil {
+		return &proto.AddItemResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	newItem := &models.Item{
+		Name:            req.Name,
+		Description:     req.Description,
+		Price:           fromMoneyProto(req.Price),
+		CostPrice:       fromMoneyProto(req.CostPrice),
+		Quantity:        quantity,
+		ReorderLevel:    uint(req.ReorderLevel),
+		ReorderQuantity: uint(req.ReorderQuantity),
+		SKU:             optionalString(req.Sku),
+		Category:        req.Category,
+		Unit:            req.Unit,
+		SaleUnit:        req.SaleUnit,
+	}
+	if err := setDietary(newItem, req.Allergens, req.DietaryLabels, req.Nutrition); err != nil {
+		return &proto.AddItemResponse{
+			StatusCode: http.StatusBadRequest,
+		}, err
+	}
+
+	status, item, err := service.AddItem(newItem)
 	if err != nil {
 		return &proto.AddItemResponse{
 			StatusCode:  int32(status),
 			Id:          0,
 			Name:        "",
 			Description: "",
-			Price:       0,
+			Price:       nil,
 			Quantity:    0,
 		}, err
 	}
 
 	return &proto.AddItemResponse{
-		StatusCode:  int32(status),
-		Id:          int32(item.ID),
-		Name:        item.Name,
-		Description: item.Description,
-		Price:       item.Price,
-		Quantity:    uint32(item.Quantity),
+		StatusCode:      int32(status),
+		Id:              int32(item.ID),
+		Name:            item.Name,
+		Description:     item.Description,
+		Price:           toMoneyProto(item.Price),
+		CostPrice:       toMoneyProto(item.CostPrice),
+		Quantity:        uint32(item.Quantity),
+		ReorderLevel:    uint32(item.ReorderLevel),
+		ReorderQuantity: uint32(item.ReorderQuantity),
+		Sku:             stringValue(item.SKU),
+		Category:        item.Category,
+		Allergens:       models.AllergenNames(item.Allergens),
+		DietaryLabels:   models.DietaryNames(item.DietaryLabels),
+		Nutrition:       toNutrition(item),
+		Unit:            item.Unit,
+		SaleUnit:        item.SellingUnit(),
 	}, nil
 }
 
 func (s *GRPCServer) GetItem(ctx context.Context, req *proto.GetItemRequest) (*proto.GetItemResponse, error) {
-	status, item, err := service.GetItem(uint(req.Id))
+	at, err := parseOptionalTime(req.At)
+	if err != nil {
+		return &proto.GetItemResponse{
+			StatusCode: http.StatusBadRequest,
+		}, err
+	}
+
+	lookup := service.GetItem
+	if req.IncludeArchived {
+		lookup = service.GetItemIncludingArchived
+	}
+	status, item, err := lookup(uint(req.Id))
 	if err != nil {
 		return &proto.GetItemResponse{
 			StatusCode:  int32(status),
 			Id:          0,
 			Name:        "",
 			Description: "",
-			Price:       0,
+			Price:       nil,
 			Quantity:    0,
 		}, err
 	}
+	return toItemDetailResponse(ctx, item, at)
+}
 
-	return &proto.GetItemResponse{
-		StatusCode:  int32(status),
-		Id:          int32(item.ID),
-		Name:        item.Name,
-		Description: item.Description,
-		Price:       item.Price,
-		Quantity:    uint32(item.Quantity),
-	}, nil
+func (s *GRPCServer) GetItemBySKU(ctx context.Context, req *proto.GetItemBySKURequest) (*proto.GetItemResponse, error) {
+	at, err := parseOptionalTime(req.At)
+	if err != nil {
+		return &proto.GetItemResponse{
+			StatusCode: http.StatusBadRequest,
+		}, err
+	}
+
+	status, item, err := service.GetItemBySKU(req.Sku)
+	if err != nil {
+		return &proto.GetItemResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	return toItemDetailResponse(ctx, item, at)
+}
+
+func (s *GRPCServer) GetItemByBarcode(ctx context.Context, req *proto.GetItemByBarcodeRequest) (*proto.GetItemResponse, error) {
+	at, err := parseOptionalTime(req.At)
+	if err != nil {
+		return &proto.GetItemResponse{
+			StatusCode: http.StatusBadRequest,
+		}, err
+	}
+
+	status, item, err := service.GetItemByBarcode(req.Barcode)
+	if err != nil {
+		return &proto.GetItemResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	return toItemDetailResponse(ctx, item, at)
+}
+
+// parseOptionalTime parses an RFC3339 timestamp, returning the zero time when none is given.
+func parseOptionalTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, errors.ErrInvalidTimestamp
+	}
+	return parsed, nil
+}
+
+// toItemDetailResponse maps a single item to an item message along with its option groups,
+// pricing it as of at unless at is zero.
+func toItemDetailResponse(ctx context.Context, item *models.Item, at time.Time) (*proto.GetItemResponse, error) {
+	status, groups, err := service.GetOptionGroups(item.ID)
+	if err != nil {
+		return &proto.GetItemResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	status, responses, err := toItemResponses(ctx, []*models.Item{item})
+	if err != nil {
+		return &proto.GetItemResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	response := responses[0]
+	if !at.IsZero() {
+		status, prices, err := service.PricesAt([]*models.Item{item}, at)
+		if err != nil {
+			return &proto.GetItemResponse{
+				StatusCode: int32(status),
+			}, err
+		}
+		response.Price = toMoneyProto(prices[item.ID])
+	}
+	response.StatusCode = int32(status)
+	for _, group := range groups {
+		response.OptionGroups = append(response.OptionGroups, toOptionGroupResponse(group))
+	}
+	return response, nil
 }
 
 func (s *GRPCServer) GetAllItems(ctx context.Context, req *proto.GetAllItemsRequest) (*proto.GetAllItemsResponse, error) {
-	status, items, err := service.GetAllItems()
+	status, items, err := service.FilterItems(req.ExcludeAllergens, req.DietaryLabels)
 	if err != nil {
 		return &proto.GetAllItemsResponse{
 			StatusCode: int32(status),
@@ -65,15 +356,19 @@ func (s *GRPCServer) GetAllItems(ctx context.Context, req *proto.GetAllItemsRequ
 		}, err
 	}
 
-	var itemsResponse []*proto.GetItemResponse
-	for _, item := range items {
-		itemsResponse = append(itemsResponse, &proto.GetItemResponse{
-			Id:          int32(item.ID),
-			Name:        item.Name,
-			Description: item.Description,
-			Price:       item.Price,
-			Quantity:    uint32(item.Quantity),
-		})
+	status, items, err = service.SortItems(items, req.SortBy)
+	if err != nil {
+		return &proto.GetAllItemsResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	status, itemsResponse, err := toItemResponses(ctx, items)
+	if err != nil {
+		return &proto.GetAllItemsResponse{
+			StatusCode: int32(status),
+			Items:      nil,
+		}, err
 	}
 
 	return &proto.GetAllItemsResponse{
@@ -82,8 +377,54 @@ func (s *GRPCServer) GetAllItems(ctx context.Context, req *proto.GetAllItemsRequ
 	}, nil
 }
 
+func (s *GRPCServer) BatchGetItems(ctx context.Context, req *proto.BatchGetItemsRequest) (*proto.BatchGetItemsResponse, error) {
+	ids := make([]uint, 0, len(req.Ids))
+	for _, id := range req.Ids {
+		ids = append(ids, uint(id))
+	}
+
+	status, items, missing, err := service.BatchGetItems(ids, req.IncludeArchived)
+	if err != nil {
+		return &proto.BatchGetItemsResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	status, itemsResponse, err := toItemResponses(ctx, items)
+	if err != nil {
+		return &proto.BatchGetItemsResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	response := &proto.BatchGetItemsResponse{
+		StatusCode: int32(status),
+		Items:      itemsResponse,
+	}
+	for _, id := range missing {
+		response.MissingIds = append(response.MissingIds, int32(id))
+	}
+	return response, nil
+}
+
 func (s *GRPCServer) AddQuantity(ctx context.Context, req *proto.AddQuantityRequest) (*proto.AddQuantityResponse, error) {
-	status, item, err := service.AddQuantity(uint(req.Id), uint(req.Quantity))
+	status, id, err := service.ResolveItemID(uint(req.Id), req.Sku, req.Barcode)
+	if err != nil {
+		return &proto.AddQuantityResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	status, quantity, err := service.ItemQuantity(id, uint(req.Quantity), req.Amount, req.Unit, false)
+	if err != nil {
+		return &proto.AddQuantityResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	if req.ExpiresAt != "" {
+		return receiveLot(id, quantity, req)
+	}
+
+	status, item, err := service.AddQuantityAt(id, uint(req.LocationId), quantity, fromMoneyProto(req.UnitCost), reasonToModel[req.Reason], req.Reference, req.Actor)
 	if err != nil {
 		return &proto.AddQuantityResponse{
 			StatusCode: int32(status),
@@ -96,11 +437,61 @@ func (s *GRPCServer) AddQuantity(ctx context.Context, req *proto.AddQuantityRequ
 		StatusCode: int32(status),
 		Id:         int32(item.ID),
 		Quantity:   uint32(item.Quantity),
+		Amount:     service.StockAmount(item),
+	}, nil
+}
+
+// receiveLot handles an AddQuantity request that carries an expiry, which adds the stock as a lot.
+func receiveLot(id uint, quantity uint, req *proto.AddQuantityRequest) (*proto.AddQuantityResponse, error) {
+	if req.Reason != proto.MovementReason_REASON_UNSPECIFIED && req.Reason != proto.MovementReason_REASON_RESTOCK {
+		return &proto.AddQuantityResponse{
+			StatusCode: http.StatusBadRequest,
+		}, errors.ErrInvalidReason
+	}
+	expiresAt, err := time.Parse(time.RFC3339, req.ExpiresAt)
+	if err != nil {
+		return &proto.AddQuantityResponse{
+			StatusCode: http.StatusBadRequest,
+		}, errors.ErrInvalidExpiry
+	}
+
+	status, item, lot, err := service.ReceiveLot(id, uint(req.LocationId), quantity, fromMoneyProto(req.UnitCost), expiresAt, req.Reference, req.Actor)
+	if err != nil {
+		return &proto.AddQuantityResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	return &proto.AddQuantityResponse{
+		StatusCode: int32(status),
+		Id:         int32(item.ID),
+		Quantity:   uint32(item.Quantity),
+		LotId:      uint32(lot.ID),
+		Amount:     service.StockAmount(item),
 	}, nil
 }
 
 func (s *GRPCServer) LowerQuantity(ctx context.Context, req *proto.LowerQuantityRequest) (*proto.LowerQuantityResponse, error) {
-	status, item, err := service.LowerQuantity(uint(req.Id), uint(req.Quantity))
+	optionIDs := make([]uint, 0, len(req.OptionIds))
+	for _, id := range req.OptionIds {
+		optionIDs = append(optionIDs, uint(id))
+	}
+
+	status, id, err := service.ResolveItemID(uint(req.Id), req.Sku, req.Barcode)
+	if err != nil {
+		return &proto.LowerQuantityResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	status, quantity, err := service.ItemQuantity(id, uint(req.Quantity), req.Amount, req.Unit, true)
+	if err != nil {
+		return &proto.LowerQuantityResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	status, item, err := service.LowerQuantityAt(id, uint(req.LocationId), quantity, optionIDs, reasonToModel[req.Reason], req.Reference, req.Actor)
 	if err != nil {
 		return &proto.LowerQuantityResponse{
 			StatusCode: int32(status),
@@ -113,6 +504,7 @@ func (s *GRPCServer) LowerQuantity(ctx context.Context, req *proto.LowerQuantity
 		StatusCode: int32(status),
 		Id:         int32(item.ID),
 		Quantity:   uint32(item.Quantity),
+		Amount:     service.StockAmount(item),
 	}, nil
 }
 
@@ -127,4 +519,1692 @@ func (s *GRPCServer) DeleteItem(ctx context.Context, req *proto.DeleteItemReques
 	return &proto.DeleteItemResponse{
 		StatusCode: int32(status),
 	}, nil
-}
\ No newline at end of file
+}
+
+func toStockMovementResponse(movement *models.StockMovement) *proto.StockMovement {
+	return &proto.StockMovement{
+		Id:         uint32(movement.ID),
+		ItemId:     int32(movement.ItemID),
+		Delta:      movement.Delta,
+		Balance:    uint32(movement.Balance),
+		Reason:     reasonToProto[movement.Reason],
+		Reference:  movement.Reference,
+		Actor:      movement.Actor,
+		CreatedAt:  movement.CreatedAt.Format(time.RFC3339),
+		LocationId: uint32(movement.LocationID),
+		PairedWith: uint32(movement.PairedWith),
+		LotId:      uint32(movement.LotID),
+		UnitCost:   toMoneyProto(movement.UnitCost),
+	}
+}
+
+func (s *GRPCServer) ListStockMovements(ctx context.Context, req *proto.ListStockMovementsRequest) (*proto.ListStockMovementsResponse, error) {
+	status, movements, total, err := service.ListStockMovements(uint(req.ItemId), int(req.Page), int(req.PageSize))
+	if err != nil {
+		return &proto.ListStockMovementsResponse{
+			StatusCode: int32(status),
+			Movements:  nil,
+		}, err
+	}
+
+	var movementsResponse []*proto.StockMovement
+	for _, movement := range movements {
+		movementsResponse = append(movementsResponse, toStockMovementResponse(movement))
+	}
+
+	return &proto.ListStockMovementsResponse{
+		StatusCode: int32(status),
+		Movements:  movementsResponse,
+		Total:      total,
+	}, nil
+}
+
+func (s *GRPCServer) ReconcileStock(ctx context.Context, req *proto.ReconcileStockRequest) (*proto.ReconcileStockResponse, error) {
+	status, drifts, err := service.ReconcileStock(uint(req.ItemId))
+	if err != nil {
+		return &proto.ReconcileStockResponse{
+			StatusCode: int32(status),
+			Items:      nil,
+		}, err
+	}
+
+	var itemsResponse []*proto.StockDrift
+	for _, drift := range drifts {
+		itemsResponse = append(itemsResponse, &proto.StockDrift{
+			ItemId:         int32(drift.ItemID),
+			Name:           drift.Name,
+			Quantity:       uint32(drift.Quantity),
+			LedgerQuantity: drift.LedgerQuantity,
+			Drift:          drift.Drift,
+		})
+	}
+
+	return &proto.ReconcileStockResponse{
+		StatusCode: int32(status),
+		Items:      itemsResponse,
+	}, nil
+}
+
+func (s *GRPCServer) SetReorderLevel(ctx context.Context, req *proto.SetReorderLevelRequest) (*proto.SetReorderLevelResponse, error) {
+	status, item, err := service.SetReorderLevel(uint(req.Id), uint(req.ReorderLevel), uint(req.ReorderQuantity))
+	if err != nil {
+		return &proto.SetReorderLevelResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	return &proto.SetReorderLevelResponse{
+		StatusCode:      int32(status),
+		Id:              int32(item.ID),
+		ReorderLevel:    uint32(item.ReorderLevel),
+		ReorderQuantity: uint32(item.ReorderQuantity),
+	}, nil
+}
+
+func (s *GRPCServer) ListLowStockItems(ctx context.Context, req *proto.ListLowStockItemsRequest) (*proto.ListLowStockItemsResponse, error) {
+	status, items, err := service.ListLowStockItems()
+	if err != nil {
+		return &proto.ListLowStockItemsResponse{
+			StatusCode: int32(status),
+			Items:      nil,
+		}, err
+	}
+
+	status, itemsResponse, err := toItemResponses(ctx, items)
+	if err != nil {
+		return &proto.ListLowStockItemsResponse{
+			StatusCode: int32(status),
+			Items:      nil,
+		}, err
+	}
+
+	return &proto.ListLowStockItemsResponse{
+		StatusCode: int32(status),
+		Items:      itemsResponse,
+	}, nil
+}
+
+var fileFormats = map[proto.FileFormat]string{
+	proto.FileFormat_FORMAT_CSV:  service.FormatCSV,
+	proto.FileFormat_FORMAT_JSON: service.FormatJSON,
+}
+
+func (s *GRPCServer) ImportItems(ctx context.Context, req *proto.ImportItemsRequest) (*proto.ImportItemsResponse, error) {
+	options := service.ImportOptions{
+		Format:       fileFormats[req.Format],
+		MatchOn:      service.MatchOnName,
+		DryRun:       req.DryRun,
+		AllOrNothing: req.Mode == proto.ImportMode_IMPORT_ALL_OR_NOTHING,
+		Actor:        req.Actor,
+	}
+	if req.MatchOn == proto.ImportMatch_MATCH_SKU {
+		options.MatchOn = service.MatchOnSKU
+	}
+
+	status, rows, err := service.ImportItems(req.Data, options)
+	if rows == nil && err != nil {
+		return &proto.ImportItemsResponse{
+			StatusCode: int32(status),
+			Rows:       nil,
+		}, err
+	}
+
+	var rowsResponse []*proto.ImportRowResult
+	for _, row := range rows {
+		result := &proto.ImportRowResult{
+			Row:     uint32(row.Row),
+			Name:    row.Name,
+			Sku:     row.SKU,
+			Action:  row.Action,
+			ItemId:  int32(row.ItemID),
+			Applied: row.Applied,
+		}
+		if row.Error != nil {
+			result.Error = row.Error.Error()
+		}
+		rowsResponse = append(rowsResponse, result)
+	}
+
+	// a rejected import is still answered without an error, since gRPC drops the
+	// response, and with it the per-row validation errors, when an error is returned
+	return &proto.ImportItemsResponse{
+		StatusCode: int32(status),
+		Rows:       rowsResponse,
+	}, nil
+}
+
+func (s *GRPCServer) ExportItems(ctx context.Context, req *proto.ExportItemsRequest) (*proto.ExportItemsResponse, error) {
+	status, data, err := service.ExportItems(fileFormats[req.Format])
+	if err != nil {
+		return &proto.ExportItemsResponse{
+			StatusCode: int32(status),
+			Data:       nil,
+		}, err
+	}
+
+	return &proto.ExportItemsResponse{
+		StatusCode: int32(status),
+		Data:       data,
+	}, nil
+}
+
+func (s *GRPCServer) UpdateItem(ctx context.Context, req *proto.UpdateItemRequest) (*proto.UpdateItemResponse, error) {
+	changes := &models.Item{
+		Name:        req.Name,
+		Description: req.Description,
+		Price:       fromMoneyProto(req.Price),
+		CostPrice:   fromMoneyProto(req.CostPrice),
+		Category:    req.Category,
+		Unit:        req.Unit,
+		SaleUnit:    req.SaleUnit,
+	}
+	if err := setDietary(changes, req.Allergens, req.DietaryLabels, req.Nutrition); err != nil {
+		return &proto.UpdateItemResponse{
+			StatusCode: http.StatusBadRequest,
+			Item:       nil,
+		}, err
+	}
+
+	status, item, err := service.UpdateItem(uint(req.Id), changes, req.GetUpdateMask().GetPaths())
+	if err != nil {
+		return &proto.UpdateItemResponse{
+			StatusCode: int32(status),
+			Item:       nil,
+		}, err
+	}
+
+	status, responses, err := toItemResponses(ctx, []*models.Item{item})
+	if err != nil {
+		return &proto.UpdateItemResponse{
+			StatusCode: int32(status),
+			Item:       nil,
+		}, err
+	}
+
+	return &proto.UpdateItemResponse{
+		StatusCode: int32(status),
+		Item:       responses[0],
+	}, nil
+}
+
+var selectionTypes = map[proto.SelectionType]string{
+	proto.SelectionType_SELECTION_SINGLE: models.SelectionSingle,
+	proto.SelectionType_SELECTION_MULTI:  models.SelectionMulti,
+}
+
+func toOptionResponse(option *models.Option) *proto.Option {
+	return &proto.Option{
+		Id:         uint32(option.ID),
+		Name:       option.Name,
+		PriceDelta: toMoneyProto(option.PriceDelta),
+		TrackStock: option.TrackStock,
+		Quantity:   uint32(option.Quantity),
+	}
+}
+
+func toOptionGroupResponse(group *models.OptionGroup) *proto.OptionGroup {
+	response := &proto.OptionGroup{
+		Id:            uint32(group.ID),
+		ItemId:        int32(group.ItemID),
+		Name:          group.Name,
+		SelectionType: proto.SelectionType_SELECTION_SINGLE,
+		MinSelections: uint32(group.MinSelections),
+		MaxSelections: uint32(group.MaxSelections),
+		Required:      group.Required,
+	}
+	if group.SelectionType == models.SelectionMulti {
+		response.SelectionType = proto.SelectionType_SELECTION_MULTI
+	}
+	for _, option := range group.Options {
+		response.Options = append(response.Options, toOptionResponse(option))
+	}
+	return response
+}
+
+func (s *GRPCServer) AddOptionGroup(ctx context.Context, req *proto.AddOptionGroupRequest) (*proto.AddOptionGroupResponse, error) {
+	group := &models.OptionGroup{
+		ItemID:        uint(req.GetGroup().GetItemId()),
+		Name:          req.GetGroup().GetName(),
+		SelectionType: selectionTypes[req.GetGroup().GetSelectionType()],
+		MinSelections: uint(req.GetGroup().GetMinSelections()),
+		MaxSelections: uint(req.GetGroup().GetMaxSelections()),
+		Required:      req.GetGroup().GetRequired(),
+	}
+	for _, option := range req.GetGroup().GetOptions() {
+		group.Options = append(group.Options, &models.Option{
+			Name:       option.Name,
+			PriceDelta: fromMoneyProto(option.PriceDelta),
+			TrackStock: option.TrackStock,
+			Quantity:   uint(option.Quantity),
+		})
+	}
+
+	status, group, err := service.AddOptionGroup(group)
+	if err != nil {
+		return &proto.AddOptionGroupResponse{
+			StatusCode: int32(status),
+			Group:      nil,
+		}, err
+	}
+
+	return &proto.AddOptionGroupResponse{
+		StatusCode: int32(status),
+		Group:      toOptionGroupResponse(group),
+	}, nil
+}
+
+func (s *GRPCServer) DeleteOptionGroup(ctx context.Context, req *proto.DeleteOptionGroupRequest) (*proto.DeleteOptionGroupResponse, error) {
+	status, err := service.DeleteOptionGroup(uint(req.Id))
+	if err != nil {
+		return &proto.DeleteOptionGroupResponse{
+			StatusCode: int32(status),
+			Message:    "",
+		}, err
+	}
+
+	return &proto.DeleteOptionGroupResponse{
+		StatusCode: int32(status),
+		Message:    "Option group deleted successfully",
+	}, nil
+}
+
+func (s *GRPCServer) SetOptionStock(ctx context.Context, req *proto.SetOptionStockRequest) (*proto.SetOptionStockResponse, error) {
+	status, option, err := service.SetOptionStock(uint(req.Id), req.TrackStock, uint(req.Quantity))
+	if err != nil {
+		return &proto.SetOptionStockResponse{
+			StatusCode: int32(status),
+			Option:     nil,
+		}, err
+	}
+
+	return &proto.SetOptionStockResponse{
+		StatusCode: int32(status),
+		Option:     toOptionResponse(option),
+	}, nil
+}
+
+var eventTypes = map[string]proto.InventoryEventType{
+	models.EventCreated:         proto.InventoryEventType_EVENT_CREATED,
+	models.EventUpdated:         proto.InventoryEventType_EVENT_UPDATED,
+	models.EventDeleted:         proto.InventoryEventType_EVENT_DELETED,
+	models.EventQuantityChanged: proto.InventoryEventType_EVENT_QUANTITY_CHANGED,
+	models.EventRestored:        proto.InventoryEventType_EVENT_RESTORED,
+}
+
+// clockLayout is how availability windows write their start and end times.
+const clockLayout = "15:04"
+
+func parseClock(value string) (uint, error) {
+	t, err := time.Parse(clockLayout, value)
+	if err != nil {
+		return 0, errors.ErrInvalidAvailabilityWindow
+	}
+	return uint(t.Hour()*60 + t.Minute()), nil
+}
+
+func formatClock(minute uint) string {
+	return fmt.Sprintf("%02d:%02d", minute/60, minute%60)
+}
+
+func toAvailabilityWindowResponse(window *models.AvailabilityWindow) *proto.AvailabilityWindow {
+	response := &proto.AvailabilityWindow{
+		Id:        uint32(window.ID),
+		ItemId:    int32(window.ItemID),
+		Category:  window.Category,
+		StartTime: formatClock(window.StartMinute),
+		EndTime:   formatClock(window.EndMinute),
+		Timezone:  window.Timezone,
+	}
+	for day := proto.DayOfWeek_DAY_SUNDAY; day <= proto.DayOfWeek_DAY_SATURDAY; day++ {
+		if window.Days&(1<<uint(day)) != 0 {
+			response.Days = append(response.Days, day)
+		}
+	}
+	return response
+}
+
+func (s *GRPCServer) AddAvailabilityWindow(ctx context.Context, req *proto.AddAvailabilityWindowRequest) (*proto.AddAvailabilityWindowResponse, error) {
+	window := req.GetWindow()
+	start, err := parseClock(window.GetStartTime())
+	if err != nil {
+		return &proto.AddAvailabilityWindowResponse{
+			StatusCode: http.StatusBadRequest,
+		}, err
+	}
+	end, err := parseClock(window.GetEndTime())
+	if err != nil {
+		return &proto.AddAvailabilityWindowResponse{
+			StatusCode: http.StatusBadRequest,
+		}, err
+	}
+
+	var days uint8
+	for _, day := range window.GetDays() {
+		days |= 1 << uint(day)
+	}
+
+	status, created, err := service.AddAvailabilityWindow(&models.AvailabilityWindow{
+		ItemID:      uint(window.GetItemId()),
+		Category:    window.GetCategory(),
+		Days:        days,
+		StartMinute: start,
+		EndMinute:   end,
+		Timezone:    window.GetTimezone(),
+	})
+	if err != nil {
+		return &proto.AddAvailabilityWindowResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	return &proto.AddAvailabilityWindowResponse{
+		StatusCode: int32(status),
+		Window:     toAvailabilityWindowResponse(created),
+	}, nil
+}
+
+func (s *GRPCServer) ListAvailabilityWindows(ctx context.Context, req *proto.ListAvailabilityWindowsRequest) (*proto.ListAvailabilityWindowsResponse, error) {
+	status, windows, err := service.GetAvailabilityWindows(uint(req.ItemId))
+	if err != nil {
+		return &proto.ListAvailabilityWindowsResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	response := &proto.ListAvailabilityWindowsResponse{
+		StatusCode: int32(status),
+	}
+	for _, window := range windows {
+		response.Windows = append(response.Windows, toAvailabilityWindowResponse(window))
+	}
+	return response, nil
+}
+
+func (s *GRPCServer) DeleteAvailabilityWindow(ctx context.Context, req *proto.DeleteAvailabilityWindowRequest) (*proto.DeleteAvailabilityWindowResponse, error) {
+	status, err := service.DeleteAvailabilityWindow(uint(req.Id))
+	if err != nil {
+		return &proto.DeleteAvailabilityWindowResponse{
+			StatusCode: int32(status),
+			Message:    "",
+		}, err
+	}
+
+	return &proto.DeleteAvailabilityWindowResponse{
+		StatusCode: int32(status),
+		Message:    "Availability window deleted successfully",
+	}, nil
+}
+
+func (s *GRPCServer) SetItemUnavailable(ctx context.Context, req *proto.SetItemUnavailableRequest) (*proto.SetItemUnavailableResponse, error) {
+	status, item, err := service.SetItemUnavailable(uint(req.Id), req.Unavailable)
+	if err != nil {
+		return &proto.SetItemUnavailableResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	status, responses, err := toItemResponses(ctx, []*models.Item{item})
+	if err != nil {
+		return &proto.SetItemUnavailableResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	return &proto.SetItemUnavailableResponse{
+		StatusCode: int32(status),
+		Item:       responses[0],
+	}, nil
+}
+
+func toLocationResponse(location *models.Location) *proto.Location {
+	return &proto.Location{
+		Id:   uint32(location.ID),
+		Name: location.Name,
+		Kind: location.Kind,
+	}
+}
+
+func (s *GRPCServer) AddLocation(ctx context.Context, req *proto.AddLocationRequest) (*proto.AddLocationResponse, error) {
+	status, location, err := service.AddLocation(&models.Location{
+		Name: req.GetLocation().GetName(),
+		Kind: req.GetLocation().GetKind(),
+	})
+	if err != nil {
+		return &proto.AddLocationResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	return &proto.AddLocationResponse{
+		StatusCode: int32(status),
+		Location:   toLocationResponse(location),
+	}, nil
+}
+
+func (s *GRPCServer) ListLocations(ctx context.Context, req *proto.ListLocationsRequest) (*proto.ListLocationsResponse, error) {
+	status, locations, err := service.ListLocations()
+	if err != nil {
+		return &proto.ListLocationsResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	response := &proto.ListLocationsResponse{
+		StatusCode: int32(status),
+	}
+	for _, location := range locations {
+		response.Locations = append(response.Locations, toLocationResponse(location))
+	}
+	return response, nil
+}
+
+func (s *GRPCServer) GetItemStock(ctx context.Context, req *proto.GetItemStockRequest) (*proto.GetItemStockResponse, error) {
+	status, levels, err := service.GetItemStock(uint(req.ItemId))
+	if err != nil {
+		return &proto.GetItemStockResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	response := &proto.GetItemStockResponse{
+		StatusCode: int32(status),
+		ItemId:     req.ItemId,
+	}
+	for _, level := range levels {
+		response.Quantity += uint32(level.Quantity)
+		response.Levels = append(response.Levels, &proto.LocationStock{
+			LocationId: uint32(level.LocationID),
+			Quantity:   uint32(level.Quantity),
+		})
+	}
+	return response, nil
+}
+
+func (s *GRPCServer) TransferStock(ctx context.Context, req *proto.TransferStockRequest) (*proto.TransferStockResponse, error) {
+	status, movements, err := service.TransferStock(uint(req.ItemId), uint(req.FromLocationId), uint(req.ToLocationId), uint(req.Quantity), req.Reference, req.Actor)
+	if err != nil {
+		return &proto.TransferStockResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	response := &proto.TransferStockResponse{
+		StatusCode: int32(status),
+	}
+	for _, movement := range movements {
+		response.Movements = append(response.Movements, toStockMovementResponse(movement))
+	}
+	return response, nil
+}
+
+func toLotResponse(lot *models.Lot) *proto.Lot {
+	return &proto.Lot{
+		Id:         uint32(lot.ID),
+		ItemId:     int32(lot.ItemID),
+		LocationId: uint32(lot.LocationID),
+		Received:   uint32(lot.Received),
+		Quantity:   uint32(lot.Quantity),
+		ReceivedAt: lot.ReceivedAt.Format(time.RFC3339),
+		ExpiresAt:  lot.ExpiresAt.Format(time.RFC3339),
+		Reference:  lot.Reference,
+		UnitCost:   toMoneyProto(lot.UnitCost),
+	}
+}
+
+func (s *GRPCServer) ListLots(ctx context.Context, req *proto.ListLotsRequest) (*proto.ListLotsResponse, error) {
+	status, lots, err := service.ListLots(uint(req.ItemId))
+	if err != nil {
+		return &proto.ListLotsResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	response := &proto.ListLotsResponse{
+		StatusCode: int32(status),
+	}
+	for _, lot := range lots {
+		response.Lots = append(response.Lots, toLotResponse(lot))
+	}
+	return response, nil
+}
+
+func (s *GRPCServer) ListExpiredLots(ctx context.Context, req *proto.ListExpiredLotsRequest) (*proto.ListExpiredLotsResponse, error) {
+	status, lots, err := service.ListExpiredLots()
+	if err != nil {
+		return &proto.ListExpiredLotsResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	response := &proto.ListExpiredLotsResponse{
+		StatusCode: int32(status),
+	}
+	for _, lot := range lots {
+		response.Lots = append(response.Lots, toLotResponse(lot))
+	}
+	return response, nil
+}
+
+func (s *GRPCServer) WriteOffExpiredLots(ctx context.Context, req *proto.WriteOffExpiredLotsRequest) (*proto.WriteOffExpiredLotsResponse, error) {
+	status, movements, err := service.WriteOffExpiredLots(req.Actor)
+	if err != nil {
+		return &proto.WriteOffExpiredLotsResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	response := &proto.WriteOffExpiredLotsResponse{
+		StatusCode: int32(status),
+	}
+	for _, movement := range movements {
+		response.Movements = append(response.Movements, toStockMovementResponse(movement))
+	}
+	return response, nil
+}
+
+func toRecipeResponse(dishID uint, ingredients []*service.RecipeIngredient) *proto.RecipeResponse {
+	response := &proto.RecipeResponse{
+		StatusCode: http.StatusOK,
+		DishId:     int32(dishID),
+	}
+	for _, ingredient := range ingredients {
+		response.Lines = append(response.Lines, &proto.RecipeLine{
+			IngredientId: int32(ingredient.IngredientID),
+			Name:         ingredient.Name,
+			Quantity:     uint32(ingredient.Quantity),
+			Unit:         ingredient.Unit,
+		})
+	}
+	return response
+}
+
+func (s *GRPCServer) SetRecipe(ctx context.Context, req *proto.SetRecipeRequest) (*proto.RecipeResponse, error) {
+	ingredients := []*service.RecipeIngredient{}
+	for _, line := range req.Lines {
+		ingredients = append(ingredients, &service.RecipeIngredient{
+			IngredientID: uint(line.IngredientId),
+			Quantity:     uint(line.Quantity),
+			Unit:         line.Unit,
+		})
+	}
+
+	status, ingredients, err := service.SetRecipe(uint(req.DishId), ingredients)
+	if err != nil {
+		return &proto.RecipeResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	return toRecipeResponse(uint(req.DishId), ingredients), nil
+}
+
+func (s *GRPCServer) GetRecipe(ctx context.Context, req *proto.GetRecipeRequest) (*proto.RecipeResponse, error) {
+	status, ingredients, err := service.GetRecipe(uint(req.DishId))
+	if err != nil {
+		return &proto.RecipeResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	return toRecipeResponse(uint(req.DishId), ingredients), nil
+}
+
+func (s *GRPCServer) ListRecipeShortages(ctx context.Context, req *proto.ListRecipeShortagesRequest) (*proto.ListRecipeShortagesResponse, error) {
+	status, shortages, err := service.ListRecipeShortages()
+	if err != nil {
+		return &proto.ListRecipeShortagesResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	response := &proto.ListRecipeShortagesResponse{
+		StatusCode: int32(status),
+	}
+	for _, shortage := range shortages {
+		response.Shortages = append(response.Shortages, &proto.RecipeShortage{
+			IngredientId:       int32(shortage.IngredientID),
+			IngredientName:     shortage.IngredientName,
+			Unit:               shortage.Unit,
+			AvailableQuantity:  uint32(shortage.Available),
+			ReorderLevel:       uint32(shortage.ReorderLevel),
+			DishId:             int32(shortage.DishID),
+			DishName:           shortage.DishName,
+			QuantityPerPortion: uint32(shortage.PerPortion),
+			Portions:           uint32(shortage.Portions),
+		})
+	}
+	return response, nil
+}
+
+func toBundleResponse(bundle *models.Item, components []*service.BundleItem) *proto.BundleResponse {
+	response := &proto.BundleResponse{
+		StatusCode: http.StatusOK,
+		BundleId:   int32(bundle.ID),
+		Price:      toMoneyProto(bundle.Price),
+	}
+	for _, component := range components {
+		response.Components = append(response.Components, &proto.BundleComponent{
+			ComponentId: int32(component.ComponentID),
+			Name:        component.Name,
+			Quantity:    uint32(component.Quantity),
+			Price:       toMoneyProto(component.Price),
+		})
+	}
+	return response
+}
+
+func (s *GRPCServer) SetBundle(ctx context.Context, req *proto.SetBundleRequest) (*proto.BundleResponse, error) {
+	components := []*service.BundleItem{}
+	for _, component := range req.Components {
+		components = append(components, &service.BundleItem{
+			ComponentID: uint(component.ComponentId),
+			Quantity:    uint(component.Quantity),
+		})
+	}
+
+	var price *money.Money
+	if req.Price != nil {
+		amount := fromMoneyProto(req.Price)
+		price = &amount
+	}
+
+	status, bundle, components, err := service.SetBundle(uint(req.BundleId), components, price)
+	if err != nil {
+		return &proto.BundleResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	return toBundleResponse(bundle, components), nil
+}
+
+func (s *GRPCServer) GetBundle(ctx context.Context, req *proto.GetBundleRequest) (*proto.BundleResponse, error) {
+	status, bundle, err := service.GetItem(uint(req.BundleId))
+	if err != nil {
+		return &proto.BundleResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	status, components, err := service.GetBundle(uint(req.BundleId))
+	if err != nil {
+		return &proto.BundleResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	return toBundleResponse(bundle, components), nil
+}
+
+var purchaseOrderStatusToModel = map[proto.PurchaseOrderStatus]string{
+	proto.PurchaseOrderStatus_PO_STATUS_UNSPECIFIED:        "",
+	proto.PurchaseOrderStatus_PO_STATUS_DRAFT:              models.PurchaseOrderDraft,
+	proto.PurchaseOrderStatus_PO_STATUS_SENT:               models.PurchaseOrderSent,
+	proto.PurchaseOrderStatus_PO_STATUS_PARTIALLY_RECEIVED: models.PurchaseOrderPartiallyReceived,
+	proto.PurchaseOrderStatus_PO_STATUS_RECEIVED:           models.PurchaseOrderReceived,
+}
+
+var purchaseOrderStatusToProto = map[string]proto.PurchaseOrderStatus{
+	models.PurchaseOrderDraft:             proto.PurchaseOrderStatus_PO_STATUS_DRAFT,
+	models.PurchaseOrderSent:              proto.PurchaseOrderStatus_PO_STATUS_SENT,
+	models.PurchaseOrderPartiallyReceived: proto.PurchaseOrderStatus_PO_STATUS_PARTIALLY_RECEIVED,
+	models.PurchaseOrderReceived:          proto.PurchaseOrderStatus_PO_STATUS_RECEIVED,
+}
+
+func toSupplierResponse(supplier *models.Supplier) *proto.Supplier {
+	return &proto.Supplier{
+		Id:           uint32(supplier.ID),
+		Name:         supplier.Name,
+		Contact:      supplier.Contact,
+		Email:        supplier.Email,
+		Phone:        supplier.Phone,
+		LeadTimeDays: uint32(supplier.LeadTimeDays),
+	}
+}
+
+func toSupplierItemResponse(supplierItem *models.SupplierItem) *proto.SupplierItem {
+	return &proto.SupplierItem{
+		SupplierId: uint32(supplierItem.SupplierID),
+		ItemId:     int32(supplierItem.ItemID),
+		CostPrice:  toMoneyProto(supplierItem.CostPrice),
+	}
+}
+
+// toMoneyProto leaves out the zero Money, which stands for no amount at all.
+func toMoneyProto(amount money.Money) *moneypb.Money {
+	if amount == (money.Money{}) {
+		return nil
+	}
+	return &moneypb.Money{
+		MinorUnits: amount.MinorUnits,
+		Currency:   amount.Currency,
+	}
+}
+
+// fromMoneyProto reads an amount sent without a currency as one in the default currency.
+func fromMoneyProto(amount *moneypb.Money) money.Money {
+	if amount == nil {
+		return money.Money{}
+	}
+	return money.New(amount.MinorUnits, amount.Currency)
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func toPurchaseOrderResponse(order *models.PurchaseOrder) *proto.PurchaseOrder {
+	response := &proto.PurchaseOrder{
+		Id:         uint32(order.ID),
+		SupplierId: uint32(order.SupplierID),
+		Status:     purchaseOrderStatusToProto[order.Status],
+		Reference:  order.Reference,
+		Actor:      order.Actor,
+		CreatedAt:  order.CreatedAt.Format(time.RFC3339),
+		SentAt:     formatOptionalTime(order.SentAt),
+		ExpectedAt: formatOptionalTime(order.ExpectedAt),
+		ReceivedAt: formatOptionalTime(order.ReceivedAt),
+	}
+	for _, line := range order.Lines {
+		response.Lines = append(response.Lines, &proto.PurchaseOrderLine{
+			Id:        uint32(line.ID),
+			ItemId:    int32(line.ItemID),
+			Quantity:  uint32(line.Quantity),
+			Received:  uint32(line.Received),
+			CostPrice: toMoneyProto(line.CostPrice),
+		})
+	}
+	return response
+}
+
+func (s *GRPCServer) AddSupplier(ctx context.Context, req *proto.AddSupplierRequest) (*proto.AddSupplierResponse, error) {
+	if req.Supplier == nil {
+		return &proto.AddSupplierResponse{
+			StatusCode: http.StatusBadRequest,
+		}, errors.ErrEmptyField
+	}
+
+	status, supplier, err := service.AddSupplier(&models.Supplier{
+		Name:         req.Supplier.Name,
+		Contact:      req.Supplier.Contact,
+		Email:        req.Supplier.Email,
+		Phone:        req.Supplier.Phone,
+		LeadTimeDays: uint(req.Supplier.LeadTimeDays),
+	})
+	if err != nil {
+		return &proto.AddSupplierResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	return &proto.AddSupplierResponse{
+		StatusCode: int32(status),
+		Supplier:   toSupplierResponse(supplier),
+	}, nil
+}
+
+func (s *GRPCServer) ListSuppliers(ctx context.Context, req *proto.ListSuppliersRequest) (*proto.ListSuppliersResponse, error) {
+	status, suppliers, err := service.ListSuppliers()
+	if err != nil {
+		return &proto.ListSuppliersResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	response := &proto.ListSuppliersResponse{
+		StatusCode: int32(status),
+	}
+	for _, supplier := range suppliers {
+		response.Suppliers = append(response.Suppliers, toSupplierResponse(supplier))
+	}
+	return response, nil
+}
+
+func (s *GRPCServer) SetSupplierItem(ctx context.Context, req *proto.SetSupplierItemRequest) (*proto.SetSupplierItemResponse, error) {
+	if req.Item == nil {
+		return &proto.SetSupplierItemResponse{
+			StatusCode: http.StatusBadRequest,
+		}, errors.ErrEmptyField
+	}
+
+	status, supplierItem, err := service.SetSupplierItem(uint(req.Item.SupplierId), uint(req.Item.ItemId), fromMoneyProto(req.Item.CostPrice))
+	if err != nil {
+		return &proto.SetSupplierItemResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	return &proto.SetSupplierItemResponse{
+		StatusCode: int32(status),
+		Item:       toSupplierItemResponse(supplierItem),
+	}, nil
+}
+
+func (s *GRPCServer) ListSupplierItems(ctx context.Context, req *proto.ListSupplierItemsRequest) (*proto.ListSupplierItemsResponse, error) {
+	status, supplierItems, err := service.ListSupplierItems(uint(req.SupplierId))
+	if err != nil {
+		return &proto.ListSupplierItemsResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	response := &proto.ListSupplierItemsResponse{
+		StatusCode: int32(status),
+	}
+	for _, supplierItem := range supplierItems {
+		response.Items = append(response.Items, toSupplierItemResponse(supplierItem))
+	}
+	return response, nil
+}
+
+func (s *GRPCServer) CreatePurchaseOrder(ctx context.Context, req *proto.CreatePurchaseOrderRequest) (*proto.PurchaseOrderResponse, error) {
+	order := &models.PurchaseOrder{
+		SupplierID: uint(req.SupplierId),
+		Reference:  req.Reference,
+		Actor:      req.Actor,
+	}
+	for _, line := range req.Lines {
+		order.Lines = append(order.Lines, &models.PurchaseOrderLine{
+			ItemID:    uint(line.ItemId),
+			Quantity:  uint(line.Quantity),
+			CostPrice: fromMoneyProto(line.CostPrice),
+		})
+	}
+
+	status, order, err := service.CreatePurchaseOrder(order)
+	if err != nil {
+		return &proto.PurchaseOrderResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	return &proto.PurchaseOrderResponse{
+		StatusCode: int32(status),
+		Order:      toPurchaseOrderResponse(order),
+	}, nil
+}
+
+func (s *GRPCServer) GetPurchaseOrder(ctx context.Context, req *proto.GetPurchaseOrderRequest) (*proto.PurchaseOrderResponse, error) {
+	status, order, err := service.GetPurchaseOrder(uint(req.Id))
+	if err != nil {
+		return &proto.PurchaseOrderResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	return &proto.PurchaseOrderResponse{
+		StatusCode: int32(status),
+		Order:      toPurchaseOrderResponse(order),
+	}, nil
+}
+
+func (s *GRPCServer) ListPurchaseOrders(ctx context.Context, req *proto.ListPurchaseOrdersRequest) (*proto.ListPurchaseOrdersResponse, error) {
+	status, orders, err := service.ListPurchaseOrders(uint(req.SupplierId), purchaseOrderStatusToModel[req.Status])
+	if err != nil {
+		return &proto.ListPurchaseOrdersResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	response := &proto.ListPurchaseOrdersResponse{
+		StatusCode: int32(status),
+	}
+	for _, order := range orders {
+		response.Orders = append(response.Orders, toPurchaseOrderResponse(order))
+	}
+	return response, nil
+}
+
+func (s *GRPCServer) SendPurchaseOrder(ctx context.Context, req *proto.SendPurchaseOrderRequest) (*proto.PurchaseOrderResponse, error) {
+	status, order, err := service.SendPurchaseOrder(uint(req.Id))
+	if err != nil {
+		return &proto.PurchaseOrderResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	return &proto.PurchaseOrderResponse{
+		StatusCode: int32(status),
+		Order:      toPurchaseOrderResponse(order),
+	}, nil
+}
+
+func (s *GRPCServer) ReceivePurchaseOrderLine(ctx context.Context, req *proto.ReceivePurchaseOrderLineRequest) (*proto.ReceivePurchaseOrderLineResponse, error) {
+	var expiresAt *time.Time
+	if req.ExpiresAt != "" {
+		parsed, err := time.Parse(time.RFC3339, req.ExpiresAt)
+		if err != nil {
+			return &proto.ReceivePurchaseOrderLineResponse{
+				StatusCode: http.StatusBadRequest,
+			}, errors.ErrInvalidExpiry
+		}
+		expiresAt = &parsed
+	}
+
+	status, item, order, err := service.ReceivePurchaseOrderLine(uint(req.OrderId), uint(req.LineId), uint(req.LocationId), uint(req.Quantity), expiresAt, req.Actor)
+	if err != nil {
+		return &proto.ReceivePurchaseOrderLineResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	return &proto.ReceivePurchaseOrderLineResponse{
+		StatusCode: int32(status),
+		Order:      toPurchaseOrderResponse(order),
+		ItemId:     int32(item.ID),
+		Quantity:   uint32(item.Quantity),
+	}, nil
+}
+
+var stockTakeStatusToModel = map[proto.StockTakeStatus]string{
+	proto.StockTakeStatus_STOCK_TAKE_UNSPECIFIED: "",
+	proto.StockTakeStatus_STOCK_TAKE_OPEN:        models.StockTakeOpen,
+	proto.StockTakeStatus_STOCK_TAKE_APPROVED:    models.StockTakeApproved,
+	proto.StockTakeStatus_STOCK_TAKE_CANCELLED:   models.StockTakeCancelled,
+}
+
+var stockTakeStatusToProto = map[string]proto.StockTakeStatus{
+	models.StockTakeOpen:      proto.StockTakeStatus_STOCK_TAKE_OPEN,
+	models.StockTakeApproved:  proto.StockTakeStatus_STOCK_TAKE_APPROVED,
+	models.StockTakeCancelled: proto.StockTakeStatus_STOCK_TAKE_CANCELLED,
+}
+
+func toStockCountResponse(count *models.StockCount) *proto.StockCount {
+	return &proto.StockCount{
+		Id:         uint32(count.ID),
+		ItemId:     int32(count.ItemID),
+		LocationId: uint32(count.LocationID),
+		Counted:    uint32(count.Counted),
+		Expected:   uint32(count.Expected),
+		CountedBy:  count.CountedBy,
+		CountedAt:  count.CountedAt.Format(time.RFC3339),
+	}
+}
+
+func toStockTakeResponse(take *models.StockTake) *proto.StockTake {
+	response := &proto.StockTake{
+		Id:         uint32(take.ID),
+		LocationId: uint32(take.LocationID),
+		Status:     stockTakeStatusToProto[take.Status],
+		Reference:  take.Reference,
+		Actor:      take.Actor,
+		CreatedAt:  take.CreatedAt.Format(time.RFC3339),
+		ClosedAt:   formatOptionalTime(take.ClosedAt),
+		ClosedBy:   take.ClosedBy,
+	}
+	for _, count := range take.Counts {
+		response.Counts = append(response.Counts, toStockCountResponse(count))
+	}
+	return response
+}
+
+func (s *GRPCServer) StartStockTake(ctx context.Context, req *proto.StartStockTakeRequest) (*proto.StockTakeResponse, error) {
+	status, take, err := service.StartStockTake(uint(req.LocationId), req.Reference, req.Actor)
+	if err != nil {
+		return &proto.StockTakeResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	return &proto.StockTakeResponse{
+		StatusCode: int32(status),
+		StockTake:  toStockTakeResponse(take),
+	}, nil
+}
+
+func (s *GRPCServer) GetStockTake(ctx context.Context, req *proto.GetStockTakeRequest) (*proto.StockTakeResponse, error) {
+	status, take, err := service.GetStockTake(uint(req.Id))
+	if err != nil {
+		return &proto.StockTakeResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	return &proto.StockTakeResponse{
+		StatusCode: int32(status),
+		StockTake:  toStockTakeResponse(take),
+	}, nil
+}
+
+func (s *GRPCServer) ListStockTakes(ctx context.Context, req *proto.ListStockTakesRequest) (*proto.ListStockTakesResponse, error) {
+	status, takes, err := service.ListStockTakes(stockTakeStatusToModel[req.Status])
+	if err != nil {
+		return &proto.ListStockTakesResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	response := &proto.ListStockTakesResponse{
+		StatusCode: int32(status),
+	}
+	for _, take := range takes {
+		response.StockTakes = append(response.StockTakes, toStockTakeResponse(take))
+	}
+	return response, nil
+}
+
+func (s *GRPCServer) RecordStockCount(ctx context.Context, req *proto.RecordStockCountRequest) (*proto.RecordStockCountResponse, error) {
+	status, count, err := service.RecordStockCount(uint(req.StockTakeId), uint(req.ItemId), uint(req.LocationId), uint(req.Counted), req.Actor)
+	if err != nil {
+		return &proto.RecordStockCountResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	return &proto.RecordStockCountResponse{
+		StatusCode: int32(status),
+		Count:      toStockCountResponse(count),
+	}, nil
+}
+
+func (s *GRPCServer) GetVarianceReport(ctx context.Context, req *proto.GetVarianceReportRequest) (*proto.GetVarianceReportResponse, error) {
+	status, report, err := service.GetVarianceReport(uint(req.StockTakeId))
+	if err != nil {
+		return &proto.GetVarianceReportResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	response := &proto.GetVarianceReportResponse{
+		StatusCode: int32(status),
+	}
+	for _, line := range report {
+		response.Lines = append(response.Lines, &proto.StockVariance{
+			ItemId:     int32(line.ItemID),
+			Name:       line.Name,
+			LocationId: uint32(line.LocationID),
+			Expected:   uint32(line.Expected),
+			Counted:    uint32(line.Counted),
+			Variance:   line.Variance,
+		})
+	}
+	return response, nil
+}
+
+func (s *GRPCServer) ApproveStockTake(ctx context.Context, req *proto.ApproveStockTakeRequest) (*proto.ApproveStockTakeResponse, error) {
+	status, take, movements, err := service.ApproveStockTake(uint(req.Id), req.Actor)
+	if err != nil {
+		return &proto.ApproveStockTakeResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	response := &proto.ApproveStockTakeResponse{
+		StatusCode: int32(status),
+		StockTake:  toStockTakeResponse(take),
+	}
+	for _, movement := range movements {
+		response.Movements = append(response.Movements, toStockMovementResponse(movement))
+	}
+	return response, nil
+}
+
+func (s *GRPCServer) CancelStockTake(ctx context.Context, req *proto.CancelStockTakeRequest) (*proto.StockTakeResponse, error) {
+	status, take, err := service.CancelStockTake(uint(req.Id), req.Actor)
+	if err != nil {
+		return &proto.StockTakeResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	return &proto.StockTakeResponse{
+		StatusCode: int32(status),
+		StockTake:  toStockTakeResponse(take),
+	}, nil
+}
+
+func toItemPriceResponse(price *models.ItemPrice) *proto.ItemPrice {
+	return &proto.ItemPrice{
+		Id:        uint32(price.ID),
+		ItemId:    int32(price.ItemID),
+		Price:     toMoneyProto(price.Price),
+		StartsAt:  price.StartsAt.Format(time.RFC3339),
+		EndsAt:    formatOptionalTime(price.EndsAt),
+		Actor:     price.Actor,
+		CreatedAt: price.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func (s *GRPCServer) SchedulePrice(ctx context.Context, req *proto.SchedulePriceRequest) (*proto.SchedulePriceResponse, error) {
+	var startsAt time.Time
+	var endsAt *time.Time
+	if req.StartsAt != "" {
+		parsed, err := time.Parse(time.RFC3339, req.StartsAt)
+		if err != nil {
+			return &proto.SchedulePriceResponse{
+				StatusCode: http.StatusBadRequest,
+			}, errors.ErrInvalidTimestamp
+		}
+		startsAt = parsed
+	}
+	if req.EndsAt != "" {
+		parsed, err := time.Parse(time.RFC3339, req.EndsAt)
+		if err != nil {
+			return &proto.SchedulePriceResponse{
+				StatusCode: http.StatusBadRequest,
+			}, errors.ErrInvalidTimestamp
+		}
+		endsAt = &parsed
+	}
+
+	status, price, err := service.SchedulePrice(uint(req.ItemId), fromMoneyProto(req.Price), startsAt, endsAt, req.Actor)
+	if err != nil {
+		return &proto.SchedulePriceResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	return &proto.SchedulePriceResponse{
+		StatusCode: int32(status),
+		Price:      toItemPriceResponse(price),
+	}, nil
+}
+
+func (s *GRPCServer) ListPriceHistory(ctx context.Context, req *proto.ListPriceHistoryRequest) (*proto.ListPriceHistoryResponse, error) {
+	status, prices, err := service.GetPriceHistory(uint(req.ItemId))
+	if err != nil {
+		return &proto.ListPriceHistoryResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	response := &proto.ListPriceHistoryResponse{
+		StatusCode: int32(status),
+	}
+	for _, price := range prices {
+		response.Prices = append(response.Prices, toItemPriceResponse(price))
+	}
+	return response, nil
+}
+
+func toItemImageResponse(image *models.ItemImage) *proto.ItemImage {
+	return &proto.ItemImage{
+		Id:            uint32(image.ID),
+		ItemId:        int32(image.ItemID),
+		Url:           service.ImageURL(image),
+		ThumbnailUrls: service.ThumbnailURLs(image),
+		ContentType:   image.ContentType,
+		Width:         uint32(image.Width),
+		Height:        uint32(image.Height),
+		Size:          uint32(image.Size),
+	}
+}
+
+func (s *GRPCServer) UploadItemImage(ctx context.Context, req *proto.UploadItemImageRequest) (*proto.UploadItemImageResponse, error) {
+	status, image, err := service.UploadItemImage(uint(req.ItemId), req.Data)
+	if err != nil {
+		return &proto.UploadItemImageResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	return &proto.UploadItemImageResponse{
+		StatusCode: int32(status),
+		Image:      toItemImageResponse(image),
+	}, nil
+}
+
+func (s *GRPCServer) DeleteItemImage(ctx context.Context, req *proto.DeleteItemImageRequest) (*proto.DeleteItemImageResponse, error) {
+	status, err := service.DeleteItemImage(uint(req.ItemId), uint(req.ImageId))
+	if err != nil {
+		return &proto.DeleteItemImageResponse{
+			StatusCode: int32(status),
+			Message:    "",
+		}, err
+	}
+	return &proto.DeleteItemImageResponse{
+		StatusCode: int32(status),
+		Message:    "Image deleted successfully",
+	}, nil
+}
+
+func (s *GRPCServer) GetImage(ctx context.Context, req *proto.GetImageRequest) (*proto.GetImageResponse, error) {
+	status, data, contentType, err := service.GetImage(req.Key)
+	if err != nil {
+		return &proto.GetImageResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	return &proto.GetImageResponse{
+		StatusCode:  int32(status),
+		Data:        data,
+		ContentType: contentType,
+	}, nil
+}
+
+func (s *GRPCServer) AddItemBarcode(ctx context.Context, req *proto.AddItemBarcodeRequest) (*proto.AddItemBarcodeResponse, error) {
+	status, barcode, err := service.AddItemBarcode(uint(req.ItemId), req.Barcode)
+	if err != nil {
+		return &proto.AddItemBarcodeResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	return &proto.AddItemBarcodeResponse{
+		StatusCode: int32(status),
+		Barcode:    barcode,
+	}, nil
+}
+
+func (s *GRPCServer) RemoveItemBarcode(ctx context.Context, req *proto.RemoveItemBarcodeRequest) (*proto.RemoveItemBarcodeResponse, error) {
+	status, err := service.RemoveItemBarcode(uint(req.ItemId), req.Barcode)
+	if err != nil {
+		return &proto.RemoveItemBarcodeResponse{
+			StatusCode: int32(status),
+			Message:    "",
+		}, err
+	}
+	return &proto.RemoveItemBarcodeResponse{
+		StatusCode: int32(status),
+		Message:    "Barcode removed successfully",
+	}, nil
+}
+
+func (s *GRPCServer) ListArchivedItems(ctx context.Context, req *proto.ListArchivedItemsRequest) (*proto.ListArchivedItemsResponse, error) {
+	status, items, err := service.ListArchivedItems()
+	if err != nil {
+		return &proto.ListArchivedItemsResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	status, itemsResponse, err := toItemResponses(ctx, items)
+	if err != nil {
+		return &proto.ListArchivedItemsResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	return &proto.ListArchivedItemsResponse{
+		StatusCode: int32(status),
+		Items:      itemsResponse,
+	}, nil
+}
+
+func (s *GRPCServer) RestoreItem(ctx context.Context, req *proto.RestoreItemRequest) (*proto.RestoreItemResponse, error) {
+	status, item, err := service.RestoreItem(uint(req.Id))
+	if err != nil {
+		return &proto.RestoreItemResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	response, err := toItemDetailResponse(ctx, item, time.Time{})
+	if err != nil {
+		return &proto.RestoreItemResponse{
+			StatusCode: response.StatusCode,
+		}, err
+	}
+
+	return &proto.RestoreItemResponse{
+		StatusCode: int32(status),
+		Item:       response,
+	}, nil
+}
+
+func (s *GRPCServer) PurgeItem(ctx context.Context, req *proto.PurgeItemRequest) (*proto.PurgeItemResponse, error) {
+	status, err := service.PurgeItem(ctx, uint(req.Id))
+	if err != nil {
+		return &proto.PurgeItemResponse{
+			StatusCode: int32(status),
+			Message:    "",
+		}, err
+	}
+	return &proto.PurgeItemResponse{
+		StatusCode: int32(status),
+		Message:    "Item purged successfully",
+	}, nil
+}
+
+var valuationMethods = map[proto.ValuationMethod]string{
+	proto.ValuationMethod_VALUATION_FIFO:             service.ValuationFIFO,
+	proto.ValuationMethod_VALUATION_WEIGHTED_AVERAGE: service.ValuationWeightedAverage,
+}
+
+func (s *GRPCServer) GetStockValuation(ctx context.Context, req *proto.GetStockValuationRequest) (*proto.GetStockValuationResponse, error) {
+	at, err := parseOptionalTime(req.At)
+	if err != nil {
+		return &proto.GetStockValuationResponse{
+			StatusCode: http.StatusBadRequest,
+		}, err
+	}
+	status, values, totals, err := service.StockValuation(valuationMethods[req.Method], at)
+	if err != nil {
+		return &proto.GetStockValuationResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	response := &proto.GetStockValuationResponse{
+		StatusCode: int32(status),
+	}
+	for _, value := range values {
+		response.Items = append(response.Items, &proto.StockValue{
+			ItemId:   int32(value.Item.ID),
+			Name:     value.Item.Name,
+			Unit:     value.Item.Unit,
+			Quantity: uint32(value.Quantity),
+			Amount:   service.QuantityAmount(value.Item, value.Quantity),
+			UnitCost: toMoneyProto(value.UnitCost),
+			Value:    toMoneyProto(value.Value),
+		})
+	}
+	for _, total := range totals {
+		response.Totals = append(response.Totals, toMoneyProto(total))
+	}
+	if req.Csv {
+		if response.Data, err = service.StockValuationCSV(values, totals); err != nil {
+			return &proto.GetStockValuationResponse{
+				StatusCode: http.StatusInternalServerError,
+			}, err
+		}
+	}
+	return response, nil
+}
+
+func (s *GRPCServer) SummarizeMovements(ctx context.Context, req *proto.SummarizeMovementsRequest) (*proto.SummarizeMovementsResponse, error) {
+	from, err := parseOptionalTime(req.From)
+	if err != nil {
+		return &proto.SummarizeMovementsResponse{
+			StatusCode: http.StatusBadRequest,
+		}, err
+	}
+	to, err := parseOptionalTime(req.To)
+	if err != nil {
+		return &proto.SummarizeMovementsResponse{
+			StatusCode: http.StatusBadRequest,
+		}, err
+	}
+	status, summaries, err := service.SummarizeMovements(valuationMethods[req.Method], from, to)
+	if err != nil {
+		return &proto.SummarizeMovementsResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	response := &proto.SummarizeMovementsResponse{
+		StatusCode: int32(status),
+	}
+	for _, summary := range summaries {
+		response.Summaries = append(response.Summaries, &proto.MovementSummary{
+			ItemId:      int32(summary.Item.ID),
+			Name:        summary.Item.Name,
+			Reason:      reasonToProto[summary.Reason],
+			QuantityIn:  uint32(summary.QuantityIn),
+			QuantityOut: uint32(summary.QuantityOut),
+			ValueIn:     toMoneyProto(summary.ValueIn),
+			ValueOut:    toMoneyProto(summary.ValueOut),
+			Movements:   uint32(summary.Movements),
+		})
+	}
+	if req.Csv {
+		if response.Data, err = service.MovementSummaryCSV(summaries); err != nil {
+			return &proto.SummarizeMovementsResponse{
+				StatusCode: http.StatusInternalServerError,
+			}, err
+		}
+	}
+	return response, nil
+}
+
+func (s *GRPCServer) ListSlowMovingItems(ctx context.Context, req *proto.ListSlowMovingItemsRequest) (*proto.ListSlowMovingItemsResponse, error) {
+	status, items, err := service.SlowMovingItems(valuationMethods[req.Method], uint(req.Days), uint(req.MaxQuantity))
+	if err != nil {
+		return &proto.ListSlowMovingItemsResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+
+	response := &proto.ListSlowMovingItemsResponse{
+		StatusCode: int32(status),
+	}
+	for _, item := range items {
+		response.Items = append(response.Items, &proto.SlowMovingItem{
+			ItemId:       int32(item.Item.ID),
+			Name:         item.Item.Name,
+			Unit:         item.Item.Unit,
+			Quantity:     uint32(item.Quantity),
+			Amount:       service.QuantityAmount(item.Item, item.Quantity),
+			QuantitySold: uint32(item.QuantitySold),
+			LastSoldAt:   formatOptionalTime(item.LastSoldAt),
+			Value:        toMoneyProto(item.Value),
+		})
+	}
+	if req.Csv {
+		if response.Data, err = service.SlowMovingItemsCSV(items); err != nil {
+			return &proto.ListSlowMovingItemsResponse{
+				StatusCode: http.StatusInternalServerError,
+			}, err
+		}
+	}
+	return response, nil
+}
+
+var reviewStatusToModel = map[proto.ReviewStatus]string{
+	proto.ReviewStatus_REVIEW_PENDING:  models.ReviewPending,
+	proto.ReviewStatus_REVIEW_APPROVED: models.ReviewApproved,
+	proto.ReviewStatus_REVIEW_REJECTED: models.ReviewRejected,
+}
+
+var reviewStatusToProto = map[string]proto.ReviewStatus{
+	models.ReviewPending:  proto.ReviewStatus_REVIEW_PENDING,
+	models.ReviewApproved: proto.ReviewStatus_REVIEW_APPROVED,
+	models.ReviewRejected: proto.ReviewStatus_REVIEW_REJECTED,
+}
+
+func toReviewResponse(review *models.Review) *proto.Review {
+	response := &proto.Review{
+		Id:          uint32(review.ID),
+		ItemId:      int32(review.ItemID),
+		UserId:      int32(review.UserID),
+		Rating:      uint32(review.Rating),
+		Text:        review.Text,
+		Status:      reviewStatusToProto[review.Status],
+		ModeratedBy: review.ModeratedBy,
+		CreatedAt:   review.CreatedAt.Format(time.RFC3339),
+	}
+	for _, photo := range review.Photos {
+		response.Photos = append(response.Photos, &proto.ReviewPhoto{
+			Id:            uint32(photo.ID),
+			Url:           service.PhotoURL(photo),
+			ThumbnailUrls: service.PhotoThumbnailURLs(photo),
+			ContentType:   photo.ContentType,
+			Width:         uint32(photo.Width),
+			Height:        uint32(photo.Height),
+			Size:          uint32(photo.Size),
+		})
+	}
+	return response
+}
+
+func toReviewResponses(reviews []*models.Review) []*proto.Review {
+	var responses []*proto.Review
+	for _, review := range reviews {
+		responses = append(responses, toReviewResponse(review))
+	}
+	return responses
+}
+
+func (s *GRPCServer) SubmitReview(ctx context.Context, req *proto.SubmitReviewRequest) (*proto.SubmitReviewResponse, error) {
+	status, review, err := service.SubmitReview(ctx, uint(req.ItemId), uint(req.UserId), uint(req.Rating), req.Text, req.Photos)
+	if err != nil {
+		return &proto.SubmitReviewResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	return &proto.SubmitReviewResponse{
+		StatusCode: int32(status),
+		Review:     toReviewResponse(review),
+	}, nil
+}
+
+func (s *GRPCServer) ListItemReviews(ctx context.Context, req *proto.ListItemReviewsRequest) (*proto.ListReviewsResponse, error) {
+	status, reviews, err := service.ListItemReviews(uint(req.ItemId))
+	if err != nil {
+		return &proto.ListReviewsResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	return &proto.ListReviewsResponse{
+		StatusCode: int32(status),
+		Reviews:    toReviewResponses(reviews),
+	}, nil
+}
+
+func (s *GRPCServer) ListReviews(ctx context.Context, req *proto.ListReviewsRequest) (*proto.ListReviewsResponse, error) {
+	status, reviews, err := service.ListReviews(reviewStatusToModel[req.Status])
+	if err != nil {
+		return &proto.ListReviewsResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	return &proto.ListReviewsResponse{
+		StatusCode: int32(status),
+		Reviews:    toReviewResponses(reviews),
+	}, nil
+}
+
+func (s *GRPCServer) ModerateReview(ctx context.Context, req *proto.ModerateReviewRequest) (*proto.ModerateReviewResponse, error) {
+	status, review, err := service.ModerateReview(uint(req.Id), reviewStatusToModel[req.Status], req.Actor)
+	if err != nil {
+		return &proto.ModerateReviewResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	return &proto.ModerateReviewResponse{
+		StatusCode: int32(status),
+		Review:     toReviewResponse(review),
+	}, nil
+}
+
+func toItemTranslationResponse(translation *models.ItemTranslation) *proto.ItemTranslation {
+	return &proto.ItemTranslation{
+		ItemId:      int32(translation.ItemID),
+		Locale:      translation.Locale,
+		Name:        translation.Name,
+		Description: translation.Description,
+		UpdatedBy:   translation.UpdatedBy,
+		UpdatedAt:   translation.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func toCategoryTranslationResponse(translation *models.CategoryTranslation) *proto.CategoryTranslation {
+	return &proto.CategoryTranslation{
+		Category:  translation.Category,
+		Locale:    translation.Locale,
+		Name:      translation.Name,
+		UpdatedBy: translation.UpdatedBy,
+		UpdatedAt: translation.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func (s *GRPCServer) SetItemTranslation(ctx context.Context, req *proto.SetItemTranslationRequest) (*proto.SetItemTranslationResponse, error) {
+	status, translation, err := service.SetItemTranslation(uint(req.ItemId), req.Locale, req.Name, req.Description, req.Actor)
+	if err != nil {
+		return &proto.SetItemTranslationResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	return &proto.SetItemTranslationResponse{
+		StatusCode:  int32(status),
+		Translation: toItemTranslationResponse(translation),
+	}, nil
+}
+
+func (s *GRPCServer) DeleteItemTranslation(ctx context.Context, req *proto.DeleteItemTranslationRequest) (*proto.DeleteTranslationResponse, error) {
+	status, err := service.DeleteItemTranslation(uint(req.ItemId), req.Locale)
+	return &proto.DeleteTranslationResponse{
+		StatusCode: int32(status),
+	}, err
+}
+
+func (s *GRPCServer) ListItemTranslations(ctx context.Context, req *proto.ListItemTranslationsRequest) (*proto.ListItemTranslationsResponse, error) {
+	status, translations, err := service.ListItemTranslations(uint(req.ItemId))
+	if err != nil {
+		return &proto.ListItemTranslationsResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	response := &proto.ListItemTranslationsResponse{
+		StatusCode: int32(status),
+	}
+	for _, translation := range translations {
+		response.Translations = append(response.Translations, toItemTranslationResponse(translation))
+	}
+	return response, nil
+}
+
+func (s *GRPCServer) SetCategoryTranslation(ctx context.Context, req *proto.SetCategoryTranslationRequest) (*proto.SetCategoryTranslationResponse, error) {
+	status, translation, err := service.SetCategoryTranslation(req.Category, req.Locale, req.Name, req.Actor)
+	if err != nil {
+		return &proto.SetCategoryTranslationResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	return &proto.SetCategoryTranslationResponse{
+		StatusCode:  int32(status),
+		Translation: toCategoryTranslationResponse(translation),
+	}, nil
+}
+
+func (s *GRPCServer) DeleteCategoryTranslation(ctx context.Context, req *proto.DeleteCategoryTranslationRequest) (*proto.DeleteTranslationResponse, error) {
+	status, err := service.DeleteCategoryTranslation(req.Category, req.Locale)
+	return &proto.DeleteTranslationResponse{
+		StatusCode: int32(status),
+	}, err
+}
+
+func (s *GRPCServer) ListCategoryTranslations(ctx context.Context, req *proto.ListCategoryTranslationsRequest) (*proto.ListCategoryTranslationsResponse, error) {
+	status, translations, err := service.ListCategoryTranslations(req.Category)
+	if err != nil {
+		return &proto.ListCategoryTranslationsResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	response := &proto.ListCategoryTranslationsResponse{
+		StatusCode: int32(status),
+	}
+	for _, translation := range translations {
+		response.Translations = append(response.Translations, toCategoryTranslationResponse(translation))
+	}
+	return response, nil
+}
+
+func (s *GRPCServer) GetMissingTranslations(ctx context.Context, req *proto.GetMissingTranslationsRequest) (*proto.GetMissingTranslationsResponse, error) {
+	status, report, err := service.MissingTranslations(req.Locales)
+	if err != nil {
+		return &proto.GetMissingTranslationsResponse{
+			StatusCode: int32(status),
+		}, err
+	}
+	response := &proto.GetMissingTranslationsResponse{
+		StatusCode: int32(status),
+	}
+	for _, coverage := range report.Coverage {
+		response.Coverage = append(response.Coverage, &proto.LocaleCoverage{
+			Locale:               coverage.Locale,
+			Items:                uint32(coverage.Items),
+			ItemsTranslated:      uint32(coverage.ItemsTranslated),
+			Categories:           uint32(coverage.Categories),
+			CategoriesTranslated: uint32(coverage.CategoriesTranslated),
+		})
+	}
+	for _, missing := range report.Items {
+		response.Items = append(response.Items, &proto.MissingItemTranslation{
+			Locale: missing.Locale,
+			ItemId: int32(missing.ItemID),
+			Name:   missing.Name,
+			Fields: missing.Fields,
+		})
+	}
+	for _, missing := range report.Categories {
+		response.Categories = append(response.Categories, &proto.MissingCategoryTranslation{
+			Locale:   missing.Locale,
+			Category: missing.Category,
+		})
+	}
+	return response, nil
+}
+
+func (s *GRPCServer) WatchInventory(req *proto.WatchInventoryRequest, stream proto.InventoryService_WatchInventoryServer) error {
+	itemIDs := make([]uint, 0, len(req.ItemIds))
+	for _, id := range req.ItemIds {
+		itemIDs = append(itemIDs, uint(id))
+	}
+
+	return service.WatchInventory(stream.Context(), req.ResumeAfter, itemIDs, func(event *models.InventoryEvent) error {
+		return stream.Send(&proto.InventoryEvent{
+			Sequence:  event.Sequence,
+			Type:      eventTypes[event.Type],
+			ItemId:    int32(event.ItemID),
+			Name:      event.Name,
+			Quantity:  uint32(event.Quantity),
+			Price:     toMoneyProto(event.Price),
+			CreatedAt: event.CreatedAt.Format(time.RFC3339),
+		})
+	})
+}
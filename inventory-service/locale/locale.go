@@ -0,0 +1,108 @@
+// Package locale knows which locales the catalog is translated into. Item and category
+// text as stored on the item is in the default locale, and translations are kept for the
+// other supported ones.
+package locale
+
+import (
+	"inventory-service/errors"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// MetadataKey is the gRPC metadata key callers give the locale they want text in under.
+const MetadataKey = "locale"
+
+var (
+	defaultLocale = "en"
+	supported     = []string{"en"}
+)
+
+// Parse checks the locale is a well-formed language tag and returns it in canonical form,
+// so that "ta-in" and "ta-IN" are the same locale.
+func Parse(tag string) (string, error) {
+	parsed, err := language.Parse(strings.TrimSpace(tag))
+	if err != nil || parsed == language.Und {
+		return "", errors.ErrInvalidLocale
+	}
+	return parsed.String(), nil
+}
+
+// Configure sets the default locale and the others the catalog is translated into. The
+// default locale is always supported.
+func Configure(defaultTag string, tags []string) error {
+	canonicalDefault, err := Parse(defaultTag)
+	if err != nil {
+		return err
+	}
+	locales := []string{canonicalDefault}
+	for _, tag := range tags {
+		if strings.TrimSpace(tag) == "" {
+			continue
+		}
+		canonical, err := Parse(tag)
+		if err != nil {
+			return err
+		}
+		if !contains(locales, canonical) {
+			locales = append(locales, canonical)
+		}
+	}
+	defaultLocale, supported = canonicalDefault, locales
+	return nil
+}
+
+func Default() string {
+	return defaultLocale
+}
+
+// Supported returns every locale the catalog is translated into, the default first.
+func Supported() []string {
+	return append([]string(nil), supported...)
+}
+
+// Translated returns the supported locales other than the default.
+func Translated() []string {
+	return Supported()[1:]
+}
+
+// Check parses a locale translations are kept for, which is any supported one but the default.
+func Check(tag string) (string, error) {
+	canonical, err := Parse(tag)
+	if err != nil {
+		return "", err
+	}
+	if canonical == defaultLocale {
+		return "", errors.ErrDefaultLocale
+	}
+	if !contains(supported, canonical) {
+		return "", errors.ErrUnsupportedLocale
+	}
+	return canonical, nil
+}
+
+// Fallbacks returns the locales to look for translations in, best first, for text wanted in
+// the given one: the locale itself and then its language without the region, so Tamil as
+// spoken in India falls back to Tamil. Text is in the default locale when none of them has
+// a translation, so neither an empty nor an invalid locale has fallbacks.
+func Fallbacks(tag string) []string {
+	canonical, err := Parse(tag)
+	if err != nil || canonical == defaultLocale {
+		return nil
+	}
+	fallbacks := []string{canonical}
+	base, _ := language.Make(canonical).Base()
+	if base.String() != canonical && base.String() != defaultLocale {
+		fallbacks = append(fallbacks, base.String())
+	}
+	return fallbacks
+}
+
+func contains(locales []string, locale string) bool {
+	for _, candidate := range locales {
+		if candidate == locale {
+			return true
+		}
+	}
+	return false
+}
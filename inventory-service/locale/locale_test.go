@@ -0,0 +1,46 @@
+package locale
+
+import (
+	"inventory-service/errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigure(t *testing.T) {
+	defer Configure("en", nil)
+
+	assert.NoError(t, Configure("en", []string{"hi", " ta-in ", "", "en", "hi"}))
+	assert.Equal(t, "en", Default())
+	assert.Equal(t, []string{"en", "hi", "ta-IN"}, Supported())
+	assert.Equal(t, []string{"hi", "ta-IN"}, Translated())
+
+	assert.ErrorIs(t, Configure("english", nil), errors.ErrInvalidLocale)
+	assert.ErrorIs(t, Configure("en", []string{"hi", "not a locale"}), errors.ErrInvalidLocale)
+	assert.Equal(t, []string{"en", "hi", "ta-IN"}, Supported())
+}
+
+func TestCheck(t *testing.T) {
+	defer Configure("en", nil)
+	assert.NoError(t, Configure("en", []string{"hi", "ta"}))
+
+	locale, err := Check("HI")
+	assert.NoError(t, err)
+	assert.Equal(t, "hi", locale)
+
+	_, err = Check("en")
+	assert.ErrorIs(t, err, errors.ErrDefaultLocale)
+	_, err = Check("fr")
+	assert.ErrorIs(t, err, errors.ErrUnsupportedLocale)
+	_, err = Check("")
+	assert.ErrorIs(t, err, errors.ErrInvalidLocale)
+}
+
+func TestFallbacks(t *testing.T) {
+	assert.Equal(t, []string{"ta-IN", "ta"}, Fallbacks("ta-in"))
+	assert.Equal(t, []string{"hi"}, Fallbacks("hi"))
+	assert.Nil(t, Fallbacks("en"))
+	assert.Equal(t, []string{"en-GB"}, Fallbacks("en-GB"))
+	assert.Nil(t, Fallbacks(""))
+	assert.Nil(t, Fallbacks("???"))
+}
@@ -1,25 +1,75 @@
 package main
 
 import (
+	"inventory-service/blobstore"
 	"inventory-service/inventoryServer"
 	"inventory-service/config"
 	"inventory-service/database"
+	"inventory-service/locale"
+	"inventory-service/money"
+	"inventory-service/notifier"
+	"inventory-service/orderClient"
 	"inventory-service/proto/inventorypb"
+	"inventory-service/service"
 	"net"
+	"strings"
+	"time"
 
 	logger "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
 	"google.golang.org/grpc"
 )
 
 func main() {
 	config.Load()
 
+	if currency := viper.GetString("CURRENCY"); currency != "" {
+		if err := money.SetDefaultCurrency(currency); err != nil {
+			logger.WithField("currency", currency).Error("Unknown default currency, keeping " + money.DefaultCurrency())
+		}
+	}
+
+	defaultLocale := viper.GetString("DEFAULT_LOCALE")
+	if defaultLocale == "" {
+		defaultLocale = locale.Default()
+	}
+	if err := locale.Configure(defaultLocale, strings.Split(viper.GetString("LOCALES"), ",")); err != nil {
+		logger.WithField("locales", viper.GetString("LOCALES")).Error("Invalid locales, keeping " + strings.Join(locale.Supported(), ","))
+	}
+
 	if err := database.InitDB(); err != nil {
 		logger.WithField("error", err).Error("Error connecting to database")
 		return
 	}
 	defer database.Close()
 
+	service.SetNotifier(notifier.New(viper.GetString("ALERT_SINK"), viper.GetString("ALERT_FILE")))
+
+	orderClient.InitGRPCClient()
+	service.SetOrderService(orderClient.OrderServiceClient)
+
+	imageDir := viper.GetString("IMAGE_DIR")
+	if imageDir == "" {
+		imageDir = "images"
+	}
+	imageBaseURL := viper.GetString("IMAGE_BASE_URL")
+	if imageBaseURL == "" {
+		imageBaseURL = "/inventory/images"
+	}
+	maxImageSize := viper.GetInt("IMAGE_MAX_BYTES")
+	if maxImageSize <= 0 {
+		maxImageSize = service.DefaultMaxImageSize
+	}
+	service.SetImageStore(blobstore.NewLocalStore(imageDir, imageBaseURL), maxImageSize)
+
+	lotExpiryInterval := viper.GetDuration("LOT_EXPIRY_INTERVAL")
+	if lotExpiryInterval <= 0 {
+		lotExpiryInterval = time.Hour
+	}
+	stopLotExpiry := make(chan struct{})
+	defer close(stopLotExpiry)
+	go service.WriteOffExpiredLotsEvery(lotExpiryInterval, stopLotExpiry)
+
 	gRPCServer := grpc.NewServer()
 
 	inventorypb.RegisterInventoryServiceServer(gRPCServer, &inventoryServer.GRPCServer{})
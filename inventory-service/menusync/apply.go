@@ -0,0 +1,146 @@
+package menusync
+
+import (
+	"context"
+	"fmt"
+	proto "inventory-service/proto/inventorypb"
+	"inventory-service/proto/moneypb"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Reference is what stock corrections made to match a menu are recorded against.
+const Reference = "menu-sync"
+
+// updatePaths are the fields changed through UpdateItem.
+var updatePaths = []string{"name", "description", "category", "price", "unit"}
+
+// PlanChanges works out what has to change in the catalog for it to match the menu. Prices
+// are compared with items' regular prices, so a promotion or scheduled price running at the
+// moment does not show as a change.
+func PlanChanges(ctx context.Context, client proto.InventoryServiceClient, menu *Menu) (*Plan, error) {
+	live, err := client.GetAllItems(ctx, &proto.GetAllItemsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	archived, err := client.ListArchivedItems(ctx, &proto.ListArchivedItemsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return Diff(menu, live.Items, archived.Items)
+}
+
+// Apply makes the changes in the plan, stopping at the first that fails. As a plan is worked
+// out afresh from the catalog, planning and applying again after a failure picks up where it
+// stopped, and a menu the catalog already matches changes nothing.
+func Apply(ctx context.Context, client proto.InventoryServiceClient, plan *Plan, actor string) error {
+	for _, change := range plan.Changes {
+		var err error
+		switch change.Action {
+		case ActionCreate:
+			err = create(ctx, client, change.item)
+		case ActionRestore:
+			if _, err = client.RestoreItem(ctx, &proto.RestoreItemRequest{Id: change.ItemID}); err == nil {
+				err = update(ctx, client, change, actor)
+			}
+		case ActionUpdate:
+			err = update(ctx, client, change, actor)
+		case ActionArchive:
+			_, err = client.DeleteItem(ctx, &proto.DeleteItemRequest{Id: change.ItemID})
+		}
+		if err != nil {
+			return fmt.Errorf("failed to %s %s: %w", change.Action, change.Name, err)
+		}
+	}
+	return nil
+}
+
+func create(ctx context.Context, client proto.InventoryServiceClient, item *MenuItem) error {
+	request := &proto.AddItemRequest{
+		Name:        item.Name,
+		Description: item.Description,
+		Price:       &moneypb.Money{MinorUnits: item.price.MinorUnits, Currency: item.price.Currency},
+		Sku:         item.SKU,
+		Category:    item.Category,
+		Unit:        item.unit.Name,
+		Amount:      item.unit.Format(*item.stock),
+	}
+	if item.ReorderLevel != nil {
+		request.ReorderLevel = uint32(*item.ReorderLevel)
+	}
+	if item.ReorderQuantity != nil {
+		request.ReorderQuantity = uint32(*item.ReorderQuantity)
+	}
+	_, err := client.AddItem(ctx, request)
+	return err
+}
+
+// update changes the item's details, then its reorder levels and finally its stock, which
+// is corrected by however much it is off from the menu.
+func update(ctx context.Context, client proto.InventoryServiceClient, change *Change, actor string) error {
+	item, live := change.item, change.live
+
+	paths := []string{}
+	for _, path := range updatePaths {
+		if change.changed(path) {
+			paths = append(paths, path)
+		}
+	}
+	if len(paths) > 0 {
+		_, err := client.UpdateItem(ctx, &proto.UpdateItemRequest{
+			Id:          change.ItemID,
+			Name:        item.Name,
+			Description: item.Description,
+			Category:    item.Category,
+			Price:       &moneypb.Money{MinorUnits: item.price.MinorUnits, Currency: item.price.Currency},
+			Unit:        item.unit.Name,
+			UpdateMask:  &fieldmaskpb.FieldMask{Paths: paths},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if change.changed("reorder_level") || change.changed("reorder_quantity") {
+		request := &proto.SetReorderLevelRequest{
+			Id:              change.ItemID,
+			ReorderLevel:    live.ReorderLevel,
+			ReorderQuantity: live.ReorderQuantity,
+		}
+		if item.ReorderLevel != nil {
+			request.ReorderLevel = uint32(*item.ReorderLevel)
+		}
+		if item.ReorderQuantity != nil {
+			request.ReorderQuantity = uint32(*item.ReorderQuantity)
+		}
+		if _, err := client.SetReorderLevel(ctx, request); err != nil {
+			return err
+		}
+	}
+
+	if !change.changed("stock") {
+		return nil
+	}
+	base := item.unit.Base()
+	have, want := uint(live.Quantity), *item.stock
+	if want > have {
+		_, err := client.AddQuantity(ctx, &proto.AddQuantityRequest{
+			Id:        change.ItemID,
+			Amount:    base.Format(want - have),
+			Unit:      base.Name,
+			Reason:    proto.MovementReason_REASON_CORRECTION,
+			Reference: Reference,
+			Actor:     actor,
+		})
+		return err
+	}
+	_, err := client.LowerQuantity(ctx, &proto.LowerQuantityRequest{
+		Id:        change.ItemID,
+		Amount:    base.Format(have - want),
+		Unit:      base.Name,
+		Reason:    proto.MovementReason_REASON_CORRECTION,
+		Reference: Reference,
+		Actor:     actor,
+	})
+	return err
+}
@@ -0,0 +1,111 @@
+// Package menusync keeps the inventory catalog in line with a menu kept as a YAML or JSON
+// file. It works out what has to change through the inventory gRPC API, so that a change
+// to the menu can be reviewed as a plan before it is applied.
+package menusync
+
+import (
+	"bytes"
+	"fmt"
+	"inventory-service/errors"
+	"inventory-service/money"
+	"inventory-service/units"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Menu is the catalog as it should be. Items are found in the catalog by SKU when they have
+// one and by name otherwise, and catalog items that are not on the menu are archived.
+type Menu struct {
+	Currency string      `yaml:"currency"`
+	Items    []*MenuItem `yaml:"items"`
+}
+
+// MenuItem is an item as it should be. The price is a decimal in major units of the item's
+// currency or the menu's, and the stock a decimal amount of the item's unit. Stock and
+// reorder levels are left as they are when not given. The SKU is only used to find the
+// item and is set when it is added.
+type MenuItem struct {
+	Name            string `yaml:"name"`
+	SKU             string `yaml:"sku"`
+	Description     string `yaml:"description"`
+	Category        string `yaml:"category"`
+	Price           string `yaml:"price"`
+	Currency        string `yaml:"currency"`
+	Unit            string `yaml:"unit"`
+	Stock           string `yaml:"stock"`
+	ReorderLevel    *uint  `yaml:"reorder_level"`
+	ReorderQuantity *uint  `yaml:"reorder_quantity"`
+
+	price money.Money
+	unit  units.Unit
+	stock *uint
+}
+
+// Load reads a menu and checks every item on it. JSON is read as the YAML it also is, and
+// unknown fields are refused so that a misspelt one is not silently ignored.
+func Load(data []byte) (*Menu, error) {
+	menu := &Menu{}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(menu); err != nil {
+		return nil, fmt.Errorf("%w: %s", errors.ErrInvalidMenu, err.Error())
+	}
+
+	names := map[string]bool{}
+	skus := map[string]bool{}
+	for i, item := range menu.Items {
+		if item == nil {
+			return nil, fmt.Errorf("%w: item %d is empty", errors.ErrInvalidMenu, i+1)
+		}
+		if err := item.read(menu.Currency); err != nil {
+			return nil, fmt.Errorf("%w: item %d: %s", errors.ErrInvalidMenu, i+1, err.Error())
+		}
+		if names[item.Name] {
+			return nil, fmt.Errorf("%w: %q is on the menu twice", errors.ErrInvalidMenu, item.Name)
+		}
+		names[item.Name] = true
+		if item.SKU != "" {
+			if skus[item.SKU] {
+				return nil, fmt.Errorf("%w: SKU %q is on the menu twice", errors.ErrInvalidMenu, item.SKU)
+			}
+			skus[item.SKU] = true
+		}
+	}
+	return menu, nil
+}
+
+// read tidies the item and works out its price, unit and stock in the form the catalog
+// keeps them.
+func (item *MenuItem) read(currency string) error {
+	item.Name = strings.TrimSpace(item.Name)
+	item.SKU = strings.TrimSpace(item.SKU)
+	item.Description = strings.TrimSpace(item.Description)
+	item.Category = strings.TrimSpace(item.Category)
+	if item.Name == "" || item.Description == "" || item.Price == "" {
+		return fmt.Errorf("name, description and price are required")
+	}
+	if item.Currency == "" {
+		item.Currency = currency
+	}
+
+	var err error
+	if item.price, err = money.Parse(item.Price, item.Currency); err != nil {
+		return fmt.Errorf("price %q: %s", item.Price, err.Error())
+	}
+	if item.Unit == "" {
+		item.Unit = units.Default
+	}
+	if item.unit, err = units.Lookup(item.Unit); err != nil {
+		return err
+	}
+	item.Unit = item.unit.Name
+	if item.Stock != "" {
+		stock, err := item.unit.Parse(item.Stock)
+		if err != nil {
+			return fmt.Errorf("stock %q: %s", item.Stock, err.Error())
+		}
+		item.stock = &stock
+	}
+	return nil
+}
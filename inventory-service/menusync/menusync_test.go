@@ -0,0 +1,299 @@
+package menusync
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"inventory-service/errors"
+	"inventory-service/inventoryServer"
+	"inventory-service/models"
+	"inventory-service/money"
+	proto "inventory-service/proto/inventorypb"
+	"inventory-service/proto/moneypb"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type MenuSyncTestSuite struct {
+	suite.Suite
+	db     *gorm.DB
+	server *grpc.Server
+	conn   *grpc.ClientConn
+	client proto.InventoryServiceClient
+}
+
+func (suite *MenuSyncTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		suite.FailNow("failed to connect database")
+	}
+	suite.db = db
+	models.InitInventoryModels(db)
+
+	listener := bufconn.Listen(1024 * 1024)
+	suite.server = grpc.NewServer()
+	proto.RegisterInventoryServiceServer(suite.server, &inventoryServer.GRPCServer{})
+	go suite.server.Serve(listener)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		suite.FailNow("failed to connect to inventory server")
+	}
+	suite.conn = conn
+	suite.client = proto.NewInventoryServiceClient(conn)
+}
+
+func (suite *MenuSyncTestSuite) TearDownTest() {
+	suite.conn.Close()
+	suite.server.Stop()
+	sql, _ := suite.db.DB()
+	sql.Close()
+}
+
+func TestMenuSyncTestSuite(t *testing.T) {
+	suite.Run(t, new(MenuSyncTestSuite))
+}
+
+// findItem returns the item in the catalog with the given name.
+func (suite *MenuSyncTestSuite) findItem(t *testing.T, name string) *proto.GetItemResponse {
+	items, err := suite.client.GetAllItems(context.Background(), &proto.GetAllItemsRequest{})
+	assert.NoError(t, err)
+	for _, item := range items.Items {
+		if item.Name == name {
+			return item
+		}
+	}
+	t.Fatalf("%s is not in the catalog", name)
+	return nil
+}
+
+func (suite *MenuSyncTestSuite) TestLoad() {
+	t := suite.T()
+
+	t.Run("Read a YAML menu in the form the catalog keeps it", func(t *testing.T) {
+		menu, err := Load([]byte(`
+currency: EUR
+items:
+  - name: " dosa "
+    sku: DOSA-1
+    description: rice crepe
+    price: 8.50
+    stock: 20
+  - name: flour
+    description: wheat
+    price: "3"
+    currency: USD
+    unit: kg
+    stock: 2.5
+`))
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(menu.Items))
+		assert.Equal(t, "dosa", menu.Items[0].Name)
+		assert.Equal(t, int64(850), menu.Items[0].price.MinorUnits)
+		assert.Equal(t, "EUR", menu.Items[0].price.Currency)
+		assert.Equal(t, "each", menu.Items[0].Unit)
+		assert.Equal(t, uint(20), *menu.Items[0].stock)
+		assert.Equal(t, "USD", menu.Items[1].price.Currency)
+		assert.Equal(t, uint(2500), *menu.Items[1].stock)
+	})
+
+	t.Run("Read a JSON menu", func(t *testing.T) {
+		menu, err := Load([]byte(`{"currency": "EUR", "items": [{"name": "dosa", "description": "rice crepe", "price": "8.00"}]}`))
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(menu.Items))
+		assert.Nil(t, menu.Items[0].stock)
+	})
+
+	t.Run("Refuse menus that cannot be synced", func(t *testing.T) {
+		for name, data := range map[string]string{
+			"unknown field":  "items:\n  - name: dosa\n    description: rice crepe\n    price: 8\n    colour: gold\n",
+			"missing price":  "items:\n  - name: dosa\n    description: rice crepe\n",
+			"invalid price":  "items:\n  - name: dosa\n    description: rice crepe\n    price: cheap\n",
+			"unknown unit":   "items:\n  - name: dosa\n    description: rice crepe\n    price: 8\n    unit: bushel\n",
+			"invalid stock":  "items:\n  - name: dosa\n    description: rice crepe\n    price: 8\n    stock: lots\n",
+			"duplicate name": "items:\n  - {name: dosa, description: rice crepe, price: 8}\n  - {name: dosa, description: rice crepe, price: 9}\n",
+			"duplicate sku":  "items:\n  - {name: dosa, sku: A, description: rice crepe, price: 8}\n  - {name: idli, sku: A, description: rice cakes, price: 6}\n",
+		} {
+			_, err := Load([]byte(data))
+			assert.ErrorIs(t, err, errors.ErrInvalidMenu, name)
+		}
+	})
+}
+
+func (suite *MenuSyncTestSuite) TestDiff() {
+	t := suite.T()
+
+	live := []*proto.GetItemResponse{
+		{Id: 1, Name: "dosa", Sku: "DOSA-1", Description: "rice crepe", Price: &moneypb.Money{MinorUnits: 800, Currency: "EUR"}, Quantity: 10, Unit: "each"},
+		{Id: 2, Name: "vada", Description: "lentil fritter", Price: &moneypb.Money{MinorUnits: 500, Currency: "EUR"}, Quantity: 4, Unit: "each"},
+	}
+	archived := []*proto.GetItemResponse{
+		{Id: 3, Name: "idli", Description: "steamed rice cakes", Price: &moneypb.Money{MinorUnits: 600, Currency: "EUR"}, Quantity: 0, Unit: "each", ArchivedAt: "2021-02-01T00:00:00Z"},
+	}
+
+	t.Run("Plan to create, update, restore and archive items", func(t *testing.T) {
+		menu, err := Load([]byte(`
+currency: EUR
+items:
+  - {name: masala dosa, sku: DOSA-1, description: rice crepe, price: 8.50}
+  - {name: idli, description: steamed rice cakes, price: 6, stock: 12}
+  - {name: uttapam, description: thick pancake, price: 7, stock: 5}
+`))
+		assert.NoError(t, err)
+
+		plan, err := Diff(menu, live, archived)
+		assert.NoError(t, err)
+
+		var out bytes.Buffer
+		assert.NoError(t, plan.Write(&out))
+		assert.Equal(t, `~ update masala dosa
+    name: dosa -> masala dosa
+    price: 8.00 EUR -> 8.50 EUR
+^ restore idli
+    stock: 0 each -> 12 each
++ create uttapam
+    description: thick pancake
+    price: 7.00 EUR
+    stock: 5 each
+- archive vada
+
+Plan: 1 to create, 1 to update, 1 to restore, 1 to archive.
+`, out.String())
+	})
+
+	t.Run("Plan nothing when the catalog matches the menu", func(t *testing.T) {
+		menu, err := Load([]byte(`
+currency: EUR
+items:
+  - {name: dosa, description: rice crepe, price: 8}
+  - {name: vada, description: lentil fritter, price: 5, stock: 4}
+`))
+		assert.NoError(t, err)
+
+		plan, err := Diff(menu, live, archived)
+		assert.NoError(t, err)
+		assert.True(t, plan.Empty())
+
+		var out bytes.Buffer
+		assert.NoError(t, plan.Write(&out))
+		assert.Equal(t, "No changes. The catalog matches the menu.\n", out.String())
+	})
+
+	t.Run("Refuse to add an item without stock", func(t *testing.T) {
+		menu, err := Load([]byte("items:\n  - {name: uttapam, description: thick pancake, price: 7}\n"))
+		assert.NoError(t, err)
+
+		_, err = Diff(menu, live, archived)
+		assert.ErrorIs(t, err, errors.ErrInvalidMenu)
+	})
+
+	t.Run("Refuse two menu items that are the same catalog item", func(t *testing.T) {
+		menu, err := Load([]byte(`
+items:
+  - {name: masala dosa, sku: DOSA-1, description: rice crepe, price: 8}
+  - {name: dosa, description: rice crepe, price: 8}
+`))
+		assert.NoError(t, err)
+
+		_, err = Diff(menu, live, archived)
+		assert.ErrorIs(t, err, errors.ErrInvalidMenu)
+	})
+}
+
+func (suite *MenuSyncTestSuite) TestApply() {
+	t := suite.T()
+	ctx := context.Background()
+
+	_, err := suite.client.AddItem(ctx, &proto.AddItemRequest{Name: "dosa", Description: "rice crepe", Price: &moneypb.Money{MinorUnits: 800, Currency: "EUR"}, Quantity: 10})
+	assert.NoError(t, err)
+	_, err = suite.client.AddItem(ctx, &proto.AddItemRequest{Name: "vada", Description: "lentil fritter", Price: &moneypb.Money{MinorUnits: 500, Currency: "EUR"}, Quantity: 4})
+	assert.NoError(t, err)
+
+	menu, err := Load([]byte(`
+currency: EUR
+items:
+  - {name: dosa, sku: DOSA-1, description: crisp rice crepe, category: mains, price: 8.50, stock: 6, reorder_level: 2}
+  - {name: flour, description: wheat, price: 3, unit: kg, stock: 2.5}
+`))
+	assert.NoError(t, err)
+
+	t.Run("Make the catalog match the menu", func(t *testing.T) {
+		plan, err := PlanChanges(ctx, suite.client, menu)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(plan.Changes))
+		assert.NoError(t, Apply(ctx, suite.client, plan, "tester"))
+
+		items, err := suite.client.GetAllItems(ctx, &proto.GetAllItemsRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(items.Items))
+		byName := map[string]*proto.GetItemResponse{}
+		for _, item := range items.Items {
+			byName[item.Name] = item
+		}
+		assert.Equal(t, "crisp rice crepe", byName["dosa"].Description)
+		assert.Equal(t, "mains", byName["dosa"].Category)
+		assert.Equal(t, int64(850), byName["dosa"].Price.MinorUnits)
+		assert.Equal(t, uint32(6), byName["dosa"].Quantity)
+		assert.Equal(t, uint32(2), byName["dosa"].ReorderLevel)
+		assert.Equal(t, "kg", byName["flour"].Unit)
+		assert.Equal(t, uint32(2500), byName["flour"].Quantity)
+
+		archived, err := suite.client.ListArchivedItems(ctx, &proto.ListArchivedItemsRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(archived.Items))
+		assert.Equal(t, "vada", archived.Items[0].Name)
+	})
+
+	t.Run("Change nothing when applied again", func(t *testing.T) {
+		plan, err := PlanChanges(ctx, suite.client, menu)
+		assert.NoError(t, err)
+		assert.True(t, plan.Empty())
+	})
+
+	t.Run("Leave a promotion running on an item alone", func(t *testing.T) {
+		dosa := suite.findItem(t, "dosa")
+		ends := time.Now().Add(time.Hour)
+		_, _, err = models.CreateItemPrice(&models.ItemPrice{ItemID: uint(dosa.Id), Price: money.New(700, "EUR"), StartsAt: time.Now().Add(-time.Hour), EndsAt: &ends})
+		assert.NoError(t, err)
+		before, err := suite.client.ListPriceHistory(ctx, &proto.ListPriceHistoryRequest{ItemId: dosa.Id})
+		assert.NoError(t, err)
+
+		plan, err := PlanChanges(ctx, suite.client, menu)
+		assert.NoError(t, err)
+		assert.True(t, plan.Empty())
+		assert.NoError(t, Apply(ctx, suite.client, plan, "tester"))
+
+		after, err := suite.client.ListPriceHistory(ctx, &proto.ListPriceHistoryRequest{ItemId: dosa.Id})
+		assert.NoError(t, err)
+		assert.Equal(t, len(before.Prices), len(after.Prices))
+		dosa = suite.findItem(t, "dosa")
+		assert.Equal(t, int64(700), dosa.Price.MinorUnits)
+		assert.Equal(t, int64(850), dosa.BasePrice.MinorUnits)
+	})
+
+	t.Run("Restore an archived item put back on the menu", func(t *testing.T) {
+		menu.Items = append(menu.Items, &MenuItem{Name: "vada", Description: "lentil fritter", Price: "5", Stock: "3"})
+		assert.NoError(t, menu.Items[2].read("EUR"))
+
+		plan, err := PlanChanges(ctx, suite.client, menu)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(plan.Changes))
+		assert.Equal(t, ActionRestore, plan.Changes[0].Action)
+		assert.NoError(t, Apply(ctx, suite.client, plan, "tester"))
+
+		plan, err = PlanChanges(ctx, suite.client, menu)
+		assert.NoError(t, err)
+		assert.True(t, plan.Empty())
+	})
+}
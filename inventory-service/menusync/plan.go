@@ -0,0 +1,236 @@
+package menusync
+
+import (
+	"fmt"
+	"inventory-service/errors"
+	"inventory-service/money"
+	proto "inventory-service/proto/inventorypb"
+	"inventory-service/units"
+	"io"
+	"sort"
+	"strconv"
+)
+
+const (
+	ActionCreate  = "create"
+	ActionUpdate  = "update"
+	ActionRestore = "restore"
+	ActionArchive = "archive"
+)
+
+var actionSymbols = map[string]string{
+	ActionCreate:  "+",
+	ActionUpdate:  "~",
+	ActionRestore: "^",
+	ActionArchive: "-",
+}
+
+// FieldChange is a field of an item the menu sets to something else. From is empty for
+// items that are to be added.
+type FieldChange struct {
+	Field string
+	From  string
+	To    string
+}
+
+// Change is what has to happen to one item for the catalog to match the menu. Items that
+// are restored can have fields changed as well.
+type Change struct {
+	Action string
+	Name   string
+	ItemID int32
+	Fields []FieldChange
+
+	item *MenuItem
+	live *proto.GetItemResponse
+}
+
+// changed reports whether the change sets the field.
+func (change *Change) changed(field string) bool {
+	for _, fieldChange := range change.Fields {
+		if fieldChange.Field == field {
+			return true
+		}
+	}
+	return false
+}
+
+// Plan is every change needed for the catalog to match the menu: items to add, update and
+// restore in the order of the menu, then items to archive by name.
+type Plan struct {
+	Changes []*Change
+}
+
+// Empty reports whether the catalog already matches the menu.
+func (plan *Plan) Empty() bool {
+	return len(plan.Changes) == 0
+}
+
+// Diff works out how the catalog, given as the items in it and those archived, differs from
+// the menu.
+func Diff(menu *Menu, live []*proto.GetItemResponse, archived []*proto.GetItemResponse) (*Plan, error) {
+	catalog := newCatalog(live, false)
+	archive := newCatalog(archived, true)
+
+	plan := &Plan{}
+	matched := map[int32]*MenuItem{}
+	for _, item := range menu.Items {
+		change := &Change{Action: ActionUpdate, Name: item.Name, item: item, live: catalog.find(item)}
+		if change.live == nil {
+			change.Action, change.live = ActionRestore, archive.find(item)
+		}
+		if change.live == nil {
+			change.Action = ActionCreate
+			if item.stock == nil || *item.stock == 0 {
+				return nil, fmt.Errorf("%w: %q needs stock to be added", errors.ErrInvalidMenu, item.Name)
+			}
+			change.Fields = createFields(item)
+			plan.Changes = append(plan.Changes, change)
+			continue
+		}
+
+		if other, ok := matched[change.live.Id]; ok {
+			return nil, fmt.Errorf("%w: %q and %q are both item %d", errors.ErrInvalidMenu, other.Name, item.Name, change.live.Id)
+		}
+		matched[change.live.Id] = item
+		change.ItemID = change.live.Id
+		change.Fields = updateFields(item, change.live)
+		if change.Action == ActionRestore || len(change.Fields) > 0 {
+			plan.Changes = append(plan.Changes, change)
+		}
+	}
+
+	unmatched := append([]*proto.GetItemResponse(nil), live...)
+	sort.Slice(unmatched, func(i, j int) bool { return unmatched[i].Name < unmatched[j].Name })
+	for _, item := range unmatched {
+		if _, ok := matched[item.Id]; !ok {
+			plan.Changes = append(plan.Changes, &Change{Action: ActionArchive, Name: item.Name, ItemID: item.Id, live: item})
+		}
+	}
+	return plan, nil
+}
+
+// catalog looks items up by SKU and by name.
+type catalog struct {
+	bySKU  map[string]*proto.GetItemResponse
+	byName map[string]*proto.GetItemResponse
+}
+
+// newCatalog indexes items. Several archived items can have the same name, in which case
+// the most recently archived is the one found.
+func newCatalog(items []*proto.GetItemResponse, archived bool) *catalog {
+	c := &catalog{bySKU: map[string]*proto.GetItemResponse{}, byName: map[string]*proto.GetItemResponse{}}
+	for _, item := range items {
+		if existing, ok := c.byName[item.Name]; !ok || !archived || item.ArchivedAt > existing.ArchivedAt {
+			c.byName[item.Name] = item
+		}
+		if item.Sku != "" {
+			c.bySKU[item.Sku] = item
+		}
+	}
+	return c
+}
+
+func (c *catalog) find(item *MenuItem) *proto.GetItemResponse {
+	if found, ok := c.bySKU[item.SKU]; ok && item.SKU != "" {
+		return found
+	}
+	return c.byName[item.Name]
+}
+
+func createFields(item *MenuItem) []FieldChange {
+	fields := []FieldChange{
+		{Field: "description", To: item.Description},
+		{Field: "price", To: formatPrice(item.price)},
+	}
+	if item.SKU != "" {
+		fields = append(fields, FieldChange{Field: "sku", To: item.SKU})
+	}
+	if item.Category != "" {
+		fields = append(fields, FieldChange{Field: "category", To: item.Category})
+	}
+	fields = append(fields, FieldChange{Field: "stock", To: formatStock(item.unit, *item.stock)})
+	if item.ReorderLevel != nil {
+		fields = append(fields, FieldChange{Field: "reorder_level", To: strconv.FormatUint(uint64(*item.ReorderLevel), 10)})
+	}
+	if item.ReorderQuantity != nil {
+		fields = append(fields, FieldChange{Field: "reorder_quantity", To: strconv.FormatUint(uint64(*item.ReorderQuantity), 10)})
+	}
+	return fields
+}
+
+func updateFields(item *MenuItem, live *proto.GetItemResponse) []FieldChange {
+	fields := []FieldChange{}
+	compare := func(field string, from string, to string) {
+		if from != to {
+			fields = append(fields, FieldChange{Field: field, From: from, To: to})
+		}
+	}
+
+	liveUnit, err := units.Lookup(live.Unit)
+	if err != nil {
+		liveUnit, _ = units.Lookup(units.Default)
+	}
+	// the regular price is what the menu sets; promotions and scheduled prices are left to
+	// run their course
+	base := live.BasePrice
+	if base == nil {
+		base = live.Price
+	}
+	livePrice := money.New(base.GetMinorUnits(), base.GetCurrency())
+
+	compare("name", live.Name, item.Name)
+	compare("description", live.Description, item.Description)
+	compare("category", live.Category, item.Category)
+	if livePrice != item.price {
+		compare("price", formatPrice(livePrice), formatPrice(item.price))
+	}
+	compare("unit", liveUnit.Name, item.unit.Name)
+	if item.stock != nil && uint(live.Quantity) != *item.stock {
+		compare("stock", formatStock(item.unit, uint(live.Quantity)), formatStock(item.unit, *item.stock))
+	}
+	if item.ReorderLevel != nil {
+		compare("reorder_level", strconv.FormatUint(uint64(live.ReorderLevel), 10), strconv.FormatUint(uint64(*item.ReorderLevel), 10))
+	}
+	if item.ReorderQuantity != nil {
+		compare("reorder_quantity", strconv.FormatUint(uint64(live.ReorderQuantity), 10), strconv.FormatUint(uint64(*item.ReorderQuantity), 10))
+	}
+	return fields
+}
+
+func formatPrice(price money.Money) string {
+	return price.String() + " " + price.Currency
+}
+
+func formatStock(unit units.Unit, base uint) string {
+	return unit.Format(base) + " " + unit.Name
+}
+
+// Write prints the plan for review, one item to a line followed by the fields it changes,
+// and a count of each kind of change.
+func (plan *Plan) Write(w io.Writer) error {
+	if plan.Empty() {
+		_, err := fmt.Fprintln(w, "No changes. The catalog matches the menu.")
+		return err
+	}
+
+	counts := map[string]int{}
+	for _, change := range plan.Changes {
+		counts[change.Action]++
+		if _, err := fmt.Fprintf(w, "%s %s %s\n", actionSymbols[change.Action], change.Action, change.Name); err != nil {
+			return err
+		}
+		for _, field := range change.Fields {
+			line := fmt.Sprintf("    %s: %s -> %s\n", field.Field, field.From, field.To)
+			if change.Action == ActionCreate {
+				line = fmt.Sprintf("    %s: %s\n", field.Field, field.To)
+			}
+			if _, err := io.WriteString(w, line); err != nil {
+				return err
+			}
+		}
+	}
+	_, err := fmt.Fprintf(w, "\nPlan: %d to create, %d to update, %d to restore, %d to archive.\n",
+		counts[ActionCreate], counts[ActionUpdate], counts[ActionRestore], counts[ActionArchive])
+	return err
+}
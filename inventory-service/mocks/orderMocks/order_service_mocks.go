@@ -0,0 +1,231 @@
+// Code generated by mockery v2.27.1. DO NOT EDIT.
+
+package orderMocks
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+
+	mock "github.com/stretchr/testify/mock"
+
+	orderpb "inventory-service/proto/orderpb"
+)
+
+// OrderServiceClient is an autogenerated mock type for the OrderServiceClient type
+type OrderServiceClient struct {
+	mock.Mock
+}
+
+// CountOpenOrders provides a mock function with given fields: ctx, in, opts
+func (_m *OrderServiceClient) CountOpenOrders(ctx context.Context, in *orderpb.CountOpenOrdersRequest, opts ...grpc.CallOption) (*orderpb.CountOpenOrdersResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *orderpb.CountOpenOrdersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *orderpb.CountOpenOrdersRequest, ...grpc.CallOption) (*orderpb.CountOpenOrdersResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *orderpb.CountOpenOrdersRequest, ...grpc.CallOption) *orderpb.CountOpenOrdersResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*orderpb.CountOpenOrdersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *orderpb.CountOpenOrdersRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllOrders provides a mock function with given fields: ctx, in, opts
+func (_m *OrderServiceClient) GetAllOrders(ctx context.Context, in *orderpb.GetAllOrdersRequest, opts ...grpc.CallOption) (*orderpb.GetAllOrdersResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *orderpb.GetAllOrdersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *orderpb.GetAllOrdersRequest, ...grpc.CallOption) (*orderpb.GetAllOrdersResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *orderpb.GetAllOrdersRequest, ...grpc.CallOption) *orderpb.GetAllOrdersResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*orderpb.GetAllOrdersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *orderpb.GetAllOrdersRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrder provides a mock function with given fields: ctx, in, opts
+func (_m *OrderServiceClient) GetOrder(ctx context.Context, in *orderpb.GetOrderRequest, opts ...grpc.CallOption) (*orderpb.GetOrderResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *orderpb.GetOrderResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *orderpb.GetOrderRequest, ...grpc.CallOption) (*orderpb.GetOrderResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *orderpb.GetOrderRequest, ...grpc.CallOption) *orderpb.GetOrderResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*orderpb.GetOrderResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *orderpb.GetOrderRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HasDeliveredOrder provides a mock function with given fields: ctx, in, opts
+func (_m *OrderServiceClient) HasDeliveredOrder(ctx context.Context, in *orderpb.HasDeliveredOrderRequest, opts ...grpc.CallOption) (*orderpb.HasDeliveredOrderResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *orderpb.HasDeliveredOrderResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *orderpb.HasDeliveredOrderRequest, ...grpc.CallOption) (*orderpb.HasDeliveredOrderResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *orderpb.HasDeliveredOrderRequest, ...grpc.CallOption) *orderpb.HasDeliveredOrderResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*orderpb.HasDeliveredOrderResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *orderpb.HasDeliveredOrderRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkOrderDelivered provides a mock function with given fields: ctx, in, opts
+func (_m *OrderServiceClient) MarkOrderDelivered(ctx context.Context, in *orderpb.MarkOrderDeliveredRequest, opts ...grpc.CallOption) (*orderpb.MarkOrderDeliveredResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *orderpb.MarkOrderDeliveredResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *orderpb.MarkOrderDeliveredRequest, ...grpc.CallOption) (*orderpb.MarkOrderDeliveredResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *orderpb.MarkOrderDeliveredRequest, ...grpc.CallOption) *orderpb.MarkOrderDeliveredResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*orderpb.MarkOrderDeliveredResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *orderpb.MarkOrderDeliveredRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PlaceOrder provides a mock function with given fields: ctx, in, opts
+func (_m *OrderServiceClient) PlaceOrder(ctx context.Context, in *orderpb.PlaceOrderRequest, opts ...grpc.CallOption) (*orderpb.PlaceOrderResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *orderpb.PlaceOrderResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *orderpb.PlaceOrderRequest, ...grpc.CallOption) (*orderpb.PlaceOrderResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *orderpb.PlaceOrderRequest, ...grpc.CallOption) *orderpb.PlaceOrderResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*orderpb.PlaceOrderResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *orderpb.PlaceOrderRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewOrderServiceClient interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewOrderServiceClient creates a new instance of OrderServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewOrderServiceClient(t mockConstructorTestingTNewOrderServiceClient) *OrderServiceClient {
+	mock := &OrderServiceClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
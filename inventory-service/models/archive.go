@@ -0,0 +1,260 @@
+package models
+
+import (
+	"inventory-service/errors"
+	"inventory-service/money"
+	"net/http"
+	"sort"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// purgedItemRows are the rows that only describe an item or its stock, by the column naming
+// the item. They go when the item is purged; its events stay in the inventory feed and its
+// movements in the stock ledger, which only ever grow. Rows that name the item only through
+// another row, the options of its option groups and the photos on its reviews, are deleted
+// before these.
+var purgedItemRows = []struct {
+	model   interface{}
+	itemKey string
+}{
+	{&ItemBarcode{}, "item_id"},
+	{&ItemImage{}, "item_id"},
+	{&ItemPrice{}, "item_id"},
+	{&AvailabilityWindow{}, "item_id"},
+	{&LocationStock{}, "item_id"},
+	{&Lot{}, "item_id"},
+	{&StockCount{}, "item_id"},
+	{&SupplierItem{}, "item_id"},
+	{&RecipeLine{}, "dish_id"},
+	{&BundleComponent{}, "bundle_id"},
+	{&Review{}, "item_id"},
+	{&ItemTranslation{}, "item_id"},
+}
+
+// PurgedItem is what is kept of an item once it is purged: enough to name it and read its
+// quantities where the stock movements it leaves behind are reported, and to show what it
+// was on the orders placed for it.
+type PurgedItem struct {
+	ID         uint        `gorm:"primaryKey; column:id; autoIncrement:false; not null"`
+	Name       string      `gorm:"column:name; not null"`
+	Unit       string      `gorm:"column:unit; not null; default:each"`
+	SKU        *string     `gorm:"column:sku"`
+	Price      money.Money `gorm:"embedded; embeddedPrefix:price_"`
+	ArchivedAt time.Time   `gorm:"column:archived_at"`
+	PurgedAt   time.Time   `gorm:"column:purged_at; not null"`
+}
+
+// asItem returns what is known of the purged item as a deleted item.
+func (tombstone *PurgedItem) asItem() *Item {
+	archivedAt := tombstone.ArchivedAt
+	if archivedAt.IsZero() {
+		archivedAt = tombstone.PurgedAt
+	}
+	item := &Item{ID: tombstone.ID, Name: tombstone.Name, Unit: tombstone.Unit, SKU: tombstone.SKU, Price: tombstone.Price}
+	item.DeletedAt = gorm.DeletedAt{Time: archivedAt, Valid: true}
+	return item
+}
+
+// GetPurgedItems returns the items that have been purged, as far as they are still known.
+func GetPurgedItems() (uint32, []*Item, error) {
+	purged := []*PurgedItem{}
+	if err := db.Order("id").Find(&purged).Error; err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	items := make([]*Item, 0, len(purged))
+	for _, tombstone := range purged {
+		items = append(items, tombstone.asItem())
+	}
+	return http.StatusOK, items, nil
+}
+
+// GetItemIncludingArchived returns the item whether or not it has been deleted, so that
+// records made while it was on sale, such as orders, can still show what it was. An item
+// that has since been purged is returned as its tombstone records it.
+func GetItemIncludingArchived(id uint) (uint32, *Item, error) {
+	item := &Item{}
+	err := db.Unscoped().Where("id = ?", id).First(item).Error
+	if err == gorm.ErrRecordNotFound {
+		tombstone := &PurgedItem{}
+		err = db.Where("id = ?", id).First(tombstone).Error
+		if err == gorm.ErrRecordNotFound {
+			return http.StatusNotFound, nil, errors.ErrItemNotFound
+		} else if err != nil {
+			logger.WithField("error", err.Error()).Error(err.Error())
+			return http.StatusInternalServerError, nil, err
+		}
+		return http.StatusOK, tombstone.asItem(), nil
+	} else if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, item, nil
+}
+
+// GetItemsByIDsIncludingArchived is GetItemsByIDs for items that may have been deleted,
+// falling back to the tombstones of those that have since been purged.
+func GetItemsByIDsIncludingArchived(ids []uint) (uint32, []*Item, error) {
+	items := []*Item{}
+	err := db.Unscoped().Where("id IN ?", ids).Order("id").Find(&items).Error
+	if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+
+	found := map[uint]bool{}
+	for _, item := range items {
+		found[item.ID] = true
+	}
+	missing := []uint{}
+	for _, id := range ids {
+		if !found[id] {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) == 0 {
+		return http.StatusOK, items, nil
+	}
+	purged := []*PurgedItem{}
+	if err := db.Where("id IN ?", missing).Find(&purged).Error; err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	for _, tombstone := range purged {
+		items = append(items, tombstone.asItem())
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].ID < items[j].ID })
+	return http.StatusOK, items, nil
+}
+
+// GetArchivedItems returns the deleted items, most recently deleted first.
+func GetArchivedItems() (uint32, []*Item, error) {
+	items := []*Item{}
+	err := db.Unscoped().Where("deleted_at IS NOT NULL").Order("deleted_at DESC, id").Find(&items).Error
+	if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, items, nil
+}
+
+// getArchivedItem returns the item if it exists and has been deleted.
+func getArchivedItem(tx *gorm.DB, id uint) (*Item, error) {
+	item := &Item{}
+	err := tx.Unscoped().Where("id = ?", id).First(item).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, errors.ErrItemNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	if !item.DeletedAt.Valid {
+		return nil, errors.ErrItemNotArchived
+	}
+	return item, nil
+}
+
+// RestoreItem puts a deleted item back in the catalog as it was. Its name, SKU and barcodes
+// stayed reserved while it was archived, so nothing can have taken them in the meantime.
+func RestoreItem(id uint) (uint32, *Item, error) {
+	var item *Item
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		if item, err = getArchivedItem(tx, id); err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Model(item).Update("deleted_at", nil).Error; err != nil {
+			return err
+		}
+		item.DeletedAt = gorm.DeletedAt{}
+		return nil
+	})
+	if err == errors.ErrItemNotFound {
+		return http.StatusNotFound, nil, err
+	} else if err == errors.ErrItemNotArchived {
+		return http.StatusConflict, nil, err
+	} else if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, item, nil
+}
+
+// PurgeItem removes a deleted item for good, along with everything recorded about it and
+// its stock but the stock ledger, which is kept with a tombstone naming the item. Only
+// archived items can be purged, and not while a recipe, bundle or purchase order still
+// refers to it. The images and review photos that were removed are returned, so that what
+// was stored of them can be dropped too.
+func PurgeItem(id uint) (uint32, []*ItemImage, []*ReviewPhoto, error) {
+	images := []*ItemImage{}
+	photos := []*ReviewPhoto{}
+	err := db.Transaction(func(tx *gorm.DB) error {
+		item, err := getArchivedItem(tx, id)
+		if err != nil {
+			return err
+		}
+		var references int64
+		for _, reference := range []struct {
+			model   interface{}
+			itemKey string
+		}{
+			{&RecipeLine{}, "ingredient_id"},
+			{&BundleComponent{}, "component_id"},
+			{&PurchaseOrderLine{}, "item_id"},
+		} {
+			if err := tx.Model(reference.model).Where(reference.itemKey+" = ?", id).Count(&references).Error; err != nil {
+				return err
+			}
+			if references > 0 {
+				return errors.ErrItemInUse
+			}
+		}
+
+		if err := tx.Where("item_id = ?", id).Find(&images).Error; err != nil {
+			return err
+		}
+		reviews := tx.Model(&Review{}).Select("id").Where("item_id = ?", id)
+		if err := tx.Where("review_id IN (?)", reviews).Find(&photos).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("review_id IN (?)", reviews).Delete(&ReviewPhoto{}).Error; err != nil {
+			return err
+		}
+		groups := tx.Model(&OptionGroup{}).Select("id").Where("item_id = ?", id)
+		if err := tx.Where("option_group_id IN (?)", groups).Delete(&Option{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("item_id = ?", id).Delete(&OptionGroup{}).Error; err != nil {
+			return err
+		}
+		for _, rows := range purgedItemRows {
+			if err := tx.Where(rows.itemKey+" = ?", id).Delete(rows.model).Error; err != nil {
+				return err
+			}
+		}
+		tombstone := &PurgedItem{
+			ID:         item.ID,
+			Name:       item.Name,
+			Unit:       item.Unit,
+			SKU:        item.SKU,
+			Price:      item.Price,
+			ArchivedAt: item.DeletedAt.Time,
+			PurgedAt:   time.Now(),
+		}
+		if err := tx.Save(tombstone).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(item).Error
+	})
+	if err == errors.ErrItemNotFound {
+		return http.StatusNotFound, nil, nil, err
+	} else if err == errors.ErrItemNotArchived || err == errors.ErrItemInUse {
+		return http.StatusConflict, nil, nil, err
+	} else if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, nil, err
+	}
+	return http.StatusOK, images, photos, nil
+}
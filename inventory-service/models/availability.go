@@ -0,0 +1,75 @@
+package models
+
+import (
+	"inventory-service/errors"
+	"net/http"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+// AvailabilityWindow is a weekly period during which an item, or every item in a category,
+// can be ordered. Days is a bit set of the weekdays the window opens on, with Sunday as
+// bit 0. Start and end are minutes after midnight in the window's timezone; a window that
+// ends before it starts runs past midnight into the next day.
+type AvailabilityWindow struct {
+	ID          uint   `gorm:"primaryKey; column:id; autoIncrement; not null"`
+	ItemID      uint   `gorm:"column:item_id; index; not null; default:0"`
+	Category    string `gorm:"column:category; index; not null; default:''"`
+	Days        uint8  `gorm:"column:days; not null"`
+	StartMinute uint   `gorm:"column:start_minute; not null"`
+	EndMinute   uint   `gorm:"column:end_minute; not null"`
+	Timezone    string `gorm:"column:timezone; not null"`
+}
+
+func CreateAvailabilityWindow(window *AvailabilityWindow) (uint32, *AvailabilityWindow, error) {
+	if window.ItemID != 0 {
+		status, _, err := GetItem(window.ItemID)
+		if err != nil {
+			return status, nil, err
+		}
+	}
+	if err := db.Create(window).Error; err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusCreated, window, nil
+}
+
+// GetAvailabilityWindows returns the windows set on any of the given items or categories.
+func GetAvailabilityWindows(itemIDs []uint, categories []string) (uint32, []*AvailabilityWindow, error) {
+	windows := []*AvailabilityWindow{}
+	query := db.Where("item_id IN ?", itemIDs)
+	if len(categories) > 0 {
+		query = query.Or("category IN ?", categories)
+	}
+	if err := query.Order("id").Find(&windows).Error; err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, windows, nil
+}
+
+func DeleteAvailabilityWindow(id uint) (uint32, error) {
+	result := db.Delete(&AvailabilityWindow{}, id)
+	if result.Error != nil {
+		logger.WithField("error", result.Error.Error()).Error(result.Error.Error())
+		return http.StatusInternalServerError, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return http.StatusNotFound, errors.ErrAvailabilityWindowNotFound
+	}
+	return http.StatusOK, nil
+}
+
+// SetItemUnavailable marks an item as unavailable, or available again, without touching its quantity.
+func SetItemUnavailable(id uint, unavailable bool) (uint32, *Item, error) {
+	status, item, err := GetItem(id)
+	if err != nil {
+		return status, nil, err
+	}
+	if err := db.Model(item).Update("unavailable", unavailable).Error; err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, item, nil
+}
@@ -0,0 +1,68 @@
+package models
+
+import (
+	"inventory-service/errors"
+	"net/http"
+
+	logger "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// ItemBarcode is a barcode printed on an item, for instance one per pack size or supplier.
+// Codes are stored normalised, so each one can belong to a single item only.
+type ItemBarcode struct {
+	ID     uint   `gorm:"primaryKey; column:id; autoIncrement; not null"`
+	ItemID uint   `gorm:"column:item_id; index; not null"`
+	Code   string `gorm:"column:code; uniqueIndex; not null"`
+}
+
+func CreateItemBarcode(barcode *ItemBarcode) (uint32, *ItemBarcode, error) {
+	status, _, err := GetItem(barcode.ItemID)
+	if err != nil {
+		return status, nil, err
+	}
+	err = db.Create(barcode).Error
+	if isUniqueViolation(err, "code") {
+		return http.StatusUnprocessableEntity, nil, errors.ErrBarcodeExists
+	} else if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusCreated, barcode, nil
+}
+
+func DeleteItemBarcode(itemID uint, code string) (uint32, error) {
+	result := db.Where("item_id = ? AND code = ?", itemID, code).Delete(&ItemBarcode{})
+	if result.Error != nil {
+		logger.WithField("error", result.Error.Error()).Error(result.Error.Error())
+		return http.StatusInternalServerError, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return http.StatusNotFound, errors.ErrBarcodeNotFound
+	}
+	return http.StatusOK, nil
+}
+
+// GetItemBarcodes returns the barcodes of the given items in the order they were added.
+func GetItemBarcodes(itemIDs []uint) (uint32, []*ItemBarcode, error) {
+	barcodes := []*ItemBarcode{}
+	if err := db.Where("item_id IN ?", itemIDs).Order("id").Find(&barcodes).Error; err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, barcodes, nil
+}
+
+func GetItemByBarcode(code string) (uint32, *Item, error) {
+	item := &Item{}
+	err := db.Joins("JOIN item_barcodes ON item_barcodes.item_id = items.id").
+		Where("item_barcodes.code = ?", code).
+		First(item).Error
+	if err == gorm.ErrRecordNotFound {
+		return http.StatusNotFound, nil, errors.ErrItemNotFound
+	} else if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, item, nil
+}
@@ -0,0 +1,166 @@
+package models
+
+import (
+	"inventory-service/errors"
+	"inventory-service/money"
+	"net/http"
+
+	logger "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// BundleComponent is one item of a bundle such as a meal deal, and how many of it a single
+// bundle holds. A bundle keeps no stock of its own: selling it lowers its components.
+type BundleComponent struct {
+	ID          uint `gorm:"primaryKey; column:id; autoIncrement; not null"`
+	BundleID    uint `gorm:"column:bundle_id; index; not null"`
+	ComponentID uint `gorm:"column:component_id; index; not null"`
+	Quantity    uint `gorm:"column:quantity; not null"`
+}
+
+// LoweredStock is an item whose stock a bundle sale lowered, and by how much.
+type LoweredStock struct {
+	Item     *Item
+	Quantity uint
+}
+
+// ReplaceBundle swaps the bundle's components for the given ones and sets its price, in one
+// transaction. No components turns the bundle back into an ordinary item at that price. A new
+// price is added to the bundle's price history, like any other change of an item's price.
+func ReplaceBundle(bundleID uint, components []*BundleComponent, price money.Money) (uint32, *Item, []*BundleComponent, error) {
+	bundle := &Item{}
+	status := uint32(http.StatusOK)
+	err := db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("id = ?", bundleID).First(bundle).Error
+		if err == gorm.ErrRecordNotFound {
+			status = http.StatusNotFound
+			return errors.ErrItemNotFound
+		} else if err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+		if err := tx.Where("bundle_id = ?", bundleID).Delete(&BundleComponent{}).Error; err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+		if len(components) > 0 {
+			for _, component := range components {
+				component.ID = 0
+				component.BundleID = bundleID
+			}
+			if err := tx.Create(&components).Error; err != nil {
+				status = http.StatusInternalServerError
+				return err
+			}
+		}
+		if bundle.Price == price {
+			return nil
+		}
+		err = tx.Model(bundle).Updates(map[string]interface{}{
+			"price_minor_units": price.MinorUnits,
+			"price_currency":    price.Currency,
+		}).Error
+		if err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+		bundle.Price = price
+		return recordItemPrice(tx, bundle.ID, price, bundle.UpdatedAt, "")
+	})
+	if err != nil {
+		if status == http.StatusOK || status == http.StatusInternalServerError {
+			logger.WithField("error", err.Error()).Error(err.Error())
+			status = http.StatusInternalServerError
+		}
+		return status, nil, nil, err
+	}
+	return http.StatusOK, bundle, components, nil
+}
+
+// GetBundleComponents returns the components of the given bundles, or of every bundle when bundleIDs is nil.
+func GetBundleComponents(bundleIDs []uint) (uint32, []*BundleComponent, error) {
+	components := []*BundleComponent{}
+	query := db.Order("bundle_id, id")
+	if bundleIDs != nil {
+		query = query.Where("bundle_id IN ?", bundleIDs)
+	}
+	if err := query.Find(&components).Error; err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, components, nil
+}
+
+// IsBundleComponent reports whether the item is part of any bundle.
+func IsBundleComponent(itemID uint) (uint32, bool, error) {
+	var count int64
+	if err := db.Model(&BundleComponent{}).Where("component_id = ?", itemID).Count(&count).Error; err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, false, err
+	}
+	return http.StatusOK, count > 0, nil
+}
+
+// ApplyBundleMovement lowers the stock of each of the bundle's components by its quantity
+// times the bundles in movement.Delta, along with any selected options that track stock, all
+// in one transaction. A component with a recipe in recipes is taken out of its ingredients
+// instead. Each lowered item gets its own ledger entry carrying the movement's reason,
+// reference and location; the bundle's own stock is left alone.
+func ApplyBundleMovement(movement *StockMovement, components []*BundleComponent, recipes map[uint][]*RecipeLine, options []*Option) (uint32, *Item, []*LoweredStock, error) {
+	bundle := &Item{}
+	lowered := []*LoweredStock{}
+	status := uint32(http.StatusOK)
+	err := db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("id = ?", movement.ItemID).First(bundle).Error
+		if err == gorm.ErrRecordNotFound {
+			status = http.StatusNotFound
+			return errors.ErrItemNotFound
+		} else if err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+
+		for _, component := range components {
+			componentMovement := &StockMovement{
+				ItemID:     component.ComponentID,
+				LocationID: movement.LocationID,
+				Delta:      movement.Delta * int64(component.Quantity),
+				Reason:     movement.Reason,
+				Reference:  movement.Reference,
+				Actor:      movement.Actor,
+				CreatedAt:  movement.CreatedAt,
+			}
+			taken := uint(-componentMovement.Delta)
+
+			if lines, ok := recipes[component.ComponentID]; ok {
+				var ingredients []*Item
+				status, ingredients, err = applyRecipeLines(tx, componentMovement, lines)
+				if err != nil {
+					return err
+				}
+				for i, ingredient := range ingredients {
+					lowered = append(lowered, &LoweredStock{Item: ingredient, Quantity: taken * lines[i].Quantity})
+				}
+				continue
+			}
+
+			item := &Item{}
+			status, err = applyStockMovement(tx, item, componentMovement)
+			if err != nil {
+				return err
+			}
+			lowered = append(lowered, &LoweredStock{Item: item, Quantity: taken})
+		}
+
+		status, err = lowerOptionStock(tx, options, movement.Delta)
+		return err
+	})
+	if err != nil {
+		if status == http.StatusOK || status == http.StatusInternalServerError {
+			logger.WithField("error", err.Error()).Error(err.Error())
+			status = http.StatusInternalServerError
+		}
+		return status, nil, nil, err
+	}
+	return http.StatusOK, bundle, lowered, nil
+}
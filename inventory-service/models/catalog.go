@@ -0,0 +1,120 @@
+package models
+
+import (
+	"inventory-service/errors"
+	"net/http"
+
+	logger "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// ImportedItem pairs an item read from an import file with the catalog item it updates,
+// or a nil Existing when the item is new.
+type ImportedItem struct {
+	Item     *Item
+	Existing *Item
+}
+
+func GetItemByName(name string) (uint32, *Item, error) {
+	item := &Item{}
+	err := db.Where("name = ?", name).First(item).Error
+	if err == gorm.ErrRecordNotFound {
+		return http.StatusNotFound, nil, errors.ErrItemNotFound
+	} else if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, item, nil
+}
+
+func GetItemBySKU(sku string) (uint32, *Item, error) {
+	item := &Item{}
+	err := db.Where("sku = ?", sku).First(item).Error
+	if err == gorm.ErrRecordNotFound {
+		return http.StatusNotFound, nil, errors.ErrItemNotFound
+	} else if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, item, nil
+}
+
+// SaveImportedItems creates or updates every imported item and returns one error, or nil,
+// per item. When atomic is set all items are written in a single transaction and the
+// first failure rolls back the whole import, which is reported by the second return value.
+func SaveImportedItems(imported []*ImportedItem, atomic bool, actor string) ([]error, error) {
+	rowErrors := make([]error, len(imported))
+
+	if atomic {
+		err := db.Transaction(func(tx *gorm.DB) error {
+			for i, entry := range imported {
+				if err := saveImportedItem(tx, entry, actor); err != nil {
+					rowErrors[i] = translateItemError(err)
+					return rowErrors[i]
+				}
+			}
+			return nil
+		})
+		return rowErrors, err
+	}
+
+	for i, entry := range imported {
+		rowErrors[i] = db.Transaction(func(tx *gorm.DB) error {
+			return saveImportedItem(tx, entry, actor)
+		})
+		rowErrors[i] = translateItemError(rowErrors[i])
+	}
+	return rowErrors, nil
+}
+
+func saveImportedItem(tx *gorm.DB, entry *ImportedItem, actor string) error {
+	if entry.Existing == nil {
+		return createItem(tx, entry.Item, "import", actor)
+	}
+
+	existing := entry.Existing
+	previous := existing.Price
+	err := tx.Model(existing).Updates(map[string]interface{}{
+		"name":              entry.Item.Name,
+		"description":       entry.Item.Description,
+		"price_minor_units": entry.Item.Price.MinorUnits,
+		"price_currency":    entry.Item.Price.Currency,
+		"reorder_level":     entry.Item.ReorderLevel,
+		"reorder_quantity":  entry.Item.ReorderQuantity,
+		"sku":               entry.Item.SKU,
+	}).Error
+	if err != nil {
+		return err
+	}
+	if existing.Price != previous {
+		if err := recordItemPrice(tx, existing.ID, existing.Price, existing.UpdatedAt, actor); err != nil {
+			return err
+		}
+	}
+
+	delta := int64(entry.Item.Quantity) - int64(existing.Quantity)
+	if delta != 0 {
+		_, err = applyStockMovement(tx, existing, &StockMovement{
+			ItemID:    existing.ID,
+			Delta:     delta,
+			Reason:    ReasonCorrection,
+			Reference: "import",
+			Actor:     actor,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	entry.Item.ID = existing.ID
+	return nil
+}
+
+// translateItemError maps unique constraint failures to the errors callers can report.
+func translateItemError(err error) error {
+	if isUniqueViolation(err, "name") {
+		return errors.ErrItemExists
+	} else if isUniqueViolation(err, "sku") {
+		return errors.ErrSKUExists
+	}
+	return err
+}
@@ -0,0 +1,87 @@
+package models
+
+import (
+	"inventory-service/errors"
+)
+
+// Allergens are the 14 allergens EU food law requires a menu to declare. An item's
+// allergens are stored as a bit set, with each allergen's bit given by its place here,
+// so new allergens must only ever be added at the end.
+var Allergens = []string{
+	"celery",
+	"gluten",
+	"crustaceans",
+	"eggs",
+	"fish",
+	"lupin",
+	"milk",
+	"molluscs",
+	"mustard",
+	"nuts",
+	"peanuts",
+	"sesame",
+	"soy",
+	"sulphites",
+}
+
+// DietaryLabels are the diets an item can be marked suitable for, stored the same way as allergens.
+var DietaryLabels = []string{
+	"veg",
+	"vegan",
+	"jain",
+	"halal",
+}
+
+func namesToMask(names []string, known []string) (uint32, bool) {
+	var mask uint32
+	for _, name := range names {
+		found := false
+		for bit, candidate := range known {
+			if candidate == name {
+				mask |= 1 << uint(bit)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0, false
+		}
+	}
+	return mask, true
+}
+
+func maskToNames(mask uint32, known []string) []string {
+	names := []string{}
+	for bit, name := range known {
+		if mask&(1<<uint(bit)) != 0 {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// AllergenMask turns allergen names into the bit set stored on an item.
+func AllergenMask(names []string) (uint32, error) {
+	mask, ok := namesToMask(names, Allergens)
+	if !ok {
+		return 0, errors.ErrUnknownAllergen
+	}
+	return mask, nil
+}
+
+func AllergenNames(mask uint32) []string {
+	return maskToNames(mask, Allergens)
+}
+
+// DietaryMask turns dietary label names into the bit set stored on an item.
+func DietaryMask(names []string) (uint32, error) {
+	mask, ok := namesToMask(names, DietaryLabels)
+	if !ok {
+		return 0, errors.ErrUnknownDietaryLabel
+	}
+	return mask, nil
+}
+
+func DietaryNames(mask uint32) []string {
+	return maskToNames(mask, DietaryLabels)
+}
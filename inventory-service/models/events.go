@@ -0,0 +1,55 @@
+package models
+
+import (
+	"inventory-service/money"
+	"net/http"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const (
+	EventCreated         = "CREATED"
+	EventUpdated         = "UPDATED"
+	EventDeleted         = "DELETED"
+	EventRestored        = "RESTORED"
+	EventQuantityChanged = "QUANTITY_CHANGED"
+)
+
+// InventoryEvent is an entry in the change feed watched by kitchen displays and the
+// storefront. Sequence numbers only grow, so a client that reconnects can ask for
+// everything after the last sequence it saw.
+type InventoryEvent struct {
+	Sequence  uint64      `gorm:"primaryKey; column:sequence; autoIncrement; not null"`
+	Type      string      `gorm:"column:type; not null"`
+	ItemID    uint        `gorm:"column:item_id; index; not null"`
+	Name      string      `gorm:"column:name; not null"`
+	Quantity  uint        `gorm:"column:quantity; not null"`
+	Price     money.Money `gorm:"embedded; embeddedPrefix:price_"`
+	CreatedAt time.Time   `gorm:"column:created_at; not null"`
+}
+
+func CreateInventoryEvent(event *InventoryEvent) (uint32, error) {
+	if err := db.Create(event).Error; err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, err
+	}
+	return http.StatusCreated, nil
+}
+
+// GetInventoryEventsAfter returns up to limit events with a sequence above the given one,
+// oldest first, optionally only for the given items.
+func GetInventoryEventsAfter(sequence uint64, itemIDs []uint, limit int) (uint32, []*InventoryEvent, error) {
+	events := []*InventoryEvent{}
+	query := db.Where("sequence > ?", sequence)
+	if len(itemIDs) > 0 {
+		query = query.Where("item_id IN ?", itemIDs)
+	}
+	err := query.Order("sequence").Limit(limit).Find(&events).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, events, nil
+}
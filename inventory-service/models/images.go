@@ -0,0 +1,63 @@
+package models
+
+import (
+	"inventory-service/errors"
+	"net/http"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+// ItemImage is a picture of an item. The image itself and its thumbnails are kept in a
+// blob store under keys derived from the item and image ids; only their details are here.
+type ItemImage struct {
+	ID          uint      `gorm:"primaryKey; column:id; autoIncrement; not null"`
+	ItemID      uint      `gorm:"column:item_id; index; not null"`
+	ContentType string    `gorm:"column:content_type; not null"`
+	Width       uint      `gorm:"column:width; not null"`
+	Height      uint      `gorm:"column:height; not null"`
+	Size        uint      `gorm:"column:size; not null"`
+	CreatedAt   time.Time `gorm:"column:created_at"`
+}
+
+func CreateItemImage(image *ItemImage) (uint32, *ItemImage, error) {
+	status, _, err := GetItem(image.ItemID)
+	if err != nil {
+		return status, nil, err
+	}
+	if err := db.Create(image).Error; err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusCreated, image, nil
+}
+
+func GetItemImage(itemID uint, id uint) (uint32, *ItemImage, error) {
+	image := &ItemImage{}
+	err := db.Where("id = ? AND item_id = ?", id, itemID).First(image).Error
+	if err != nil && err.Error() == "record not found" {
+		return http.StatusNotFound, nil, errors.ErrImageNotFound
+	} else if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, image, nil
+}
+
+// GetItemImages returns the images of the given items in the order they were uploaded.
+func GetItemImages(itemIDs []uint) (uint32, []*ItemImage, error) {
+	images := []*ItemImage{}
+	if err := db.Where("item_id IN ?", itemIDs).Order("id").Find(&images).Error; err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, images, nil
+}
+
+func DeleteItemImage(id uint) (uint32, error) {
+	if err := db.Delete(&ItemImage{}, id).Error; err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, err
+	}
+	return http.StatusOK, nil
+}
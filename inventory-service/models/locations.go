@@ -0,0 +1,209 @@
+package models
+
+import (
+	"inventory-service/errors"
+	"net/http"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	LocationKitchen   = "kitchen"
+	LocationWarehouse = "warehouse"
+
+	// DefaultLocationName is the location stock is kept at when a caller does not name one.
+	DefaultLocationName = "main"
+)
+
+// defaultLocationID is the id of the default location, set up by InitInventoryModels.
+var defaultLocationID uint
+
+// Location is a kitchen or warehouse that holds stock.
+type Location struct {
+	ID   uint   `gorm:"primaryKey; column:id; autoIncrement; not null"`
+	Name string `gorm:"column:name; unique; not null"`
+	Kind string `gorm:"column:kind; not null"`
+}
+
+// LocationStock is how much of an item is held at a location. The stock of an item across
+// all its locations always adds up to the item's quantity.
+type LocationStock struct {
+	ItemID     uint `gorm:"primaryKey; column:item_id; not null"`
+	LocationID uint `gorm:"primaryKey; column:location_id; not null"`
+	Quantity   uint `gorm:"column:quantity; not null"`
+}
+
+// initDefaultLocation creates the default location on first start and places any stock
+// recorded before locations existed there, archived items' included, so every unit of stock
+// has a location.
+func initDefaultLocation() error {
+	location := &Location{Name: DefaultLocationName, Kind: LocationKitchen}
+	if err := db.Where("name = ?", DefaultLocationName).FirstOrCreate(location).Error; err != nil {
+		return err
+	}
+	defaultLocationID = location.ID
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec(`INSERT INTO location_stocks (item_id, location_id, quantity)
+			SELECT id, ?, quantity FROM items
+			WHERE quantity > 0
+			AND NOT EXISTS (SELECT 1 FROM location_stocks WHERE location_stocks.item_id = items.id)`, defaultLocationID).Error
+		if err != nil {
+			return err
+		}
+		return tx.Model(&StockMovement{}).Where("location_id = 0").UpdateColumn("location_id", defaultLocationID).Error
+	})
+}
+
+func DefaultLocationID() uint {
+	return defaultLocationID
+}
+
+func CreateLocation(location *Location) (uint32, *Location, error) {
+	err := db.Create(location).Error
+	if isUniqueViolation(err, "name") {
+		return http.StatusUnprocessableEntity, nil, errors.ErrLocationExists
+	} else if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusCreated, location, nil
+}
+
+func GetLocations() (uint32, []*Location, error) {
+	locations := []*Location{}
+	if err := db.Order("id").Find(&locations).Error; err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, locations, nil
+}
+
+// GetItemStock returns the stock an item holds at each location, leaving out empty locations.
+func GetItemStock(itemID uint) (uint32, []*LocationStock, error) {
+	status, _, err := GetItem(itemID)
+	if err != nil {
+		return status, nil, err
+	}
+	levels := []*LocationStock{}
+	err = db.Where("item_id = ? AND quantity > 0", itemID).Order("location_id").Find(&levels).Error
+	if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, levels, nil
+}
+
+// applyLocationStock changes the item's stock at the movement's location, refusing to take
+// it below zero or to correct it while the location is being counted, and fills in the
+// default location when none is given.
+func applyLocationStock(tx *gorm.DB, movement *StockMovement) (uint32, error) {
+	if movement.LocationID == 0 {
+		movement.LocationID = defaultLocationID
+	}
+	if status, err := checkNoStockTake(tx, movement); err != nil {
+		return status, err
+	}
+	err := tx.Where("id = ?", movement.LocationID).First(&Location{}).Error
+	if err == gorm.ErrRecordNotFound {
+		return http.StatusNotFound, errors.ErrLocationNotFound
+	} else if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	if movement.Delta > 0 {
+		err := tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&LocationStock{ItemID: movement.ItemID, LocationID: movement.LocationID}).Error
+		if err != nil {
+			return http.StatusInternalServerError, err
+		}
+	}
+
+	result := tx.Model(&LocationStock{}).
+		Where("item_id = ? AND location_id = ? AND quantity + ? >= 0", movement.ItemID, movement.LocationID, movement.Delta).
+		UpdateColumn("quantity", gorm.Expr("quantity + ?", movement.Delta))
+	if result.Error != nil {
+		return http.StatusInternalServerError, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return http.StatusConflict, errors.ErrInsufficientQuantity
+	}
+	return http.StatusOK, nil
+}
+
+// TransferStock moves quantity of an item from one location to another. The transfer is
+// recorded as a pair of movements, the second pointing back at the first, and leaves the
+// item's total quantity unchanged. Lots unexpired at the given time move first-expiring-first,
+// keeping their dates.
+func TransferStock(itemID uint, fromLocationID uint, toLocationID uint, quantity uint, reference string, actor string, at time.Time) (uint32, []*StockMovement, error) {
+	item := &Item{}
+	out := &StockMovement{
+		ItemID:     itemID,
+		LocationID: fromLocationID,
+		Delta:      -int64(quantity),
+		Reason:     ReasonTransfer,
+		Reference:  reference,
+		Actor:      actor,
+		CreatedAt:  at,
+	}
+	in := &StockMovement{
+		ItemID:     itemID,
+		LocationID: toLocationID,
+		Delta:      int64(quantity),
+		Reason:     ReasonTransfer,
+		Reference:  reference,
+		Actor:      actor,
+		CreatedAt:  at,
+	}
+
+	status := uint32(http.StatusOK)
+	err := db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("id = ?", itemID).First(item).Error
+		if err == gorm.ErrRecordNotFound {
+			status = http.StatusNotFound
+			return errors.ErrItemNotFound
+		} else if err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+
+		if status, err = applyLocationStock(tx, out); err != nil {
+			return err
+		}
+		var taken []lotTake
+		if status, taken, err = takeFromLots(tx, itemID, out.LocationID, 0, quantity, out.CreatedAt); err != nil {
+			return err
+		}
+		out.Balance = item.Quantity
+		if err = tx.Create(out).Error; err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+
+		if status, err = applyLocationStock(tx, in); err != nil {
+			return err
+		}
+		if err = moveLots(tx, taken, in.LocationID); err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+		in.Balance = item.Quantity
+		in.PairedWith = out.ID
+		if err = tx.Create(in).Error; err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		if status == http.StatusOK || status == http.StatusInternalServerError {
+			logger.WithField("error", err.Error()).Error(err.Error())
+			status = http.StatusInternalServerError
+		}
+		return status, nil, err
+	}
+	return http.StatusOK, []*StockMovement{out, in}, nil
+}
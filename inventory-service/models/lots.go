@@ -0,0 +1,231 @@
+package models
+
+import (
+	"inventory-service/errors"
+	"inventory-service/money"
+	"net/http"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// Lot is a batch of a perishable item received at a location with an expiry date. Stock
+// received without an expiry is not tracked in lots, so the lots of an item at a location
+// never add up to more than its stock there. Lots are consumed first-expiring-first, and
+// expired lots can only leave the stock by being written off. UnitCost is what the lot cost
+// per unit the item is stocked in.
+type Lot struct {
+	ID         uint        `gorm:"primaryKey; column:id; autoIncrement; not null"`
+	ItemID     uint        `gorm:"column:item_id; index; not null"`
+	LocationID uint        `gorm:"column:location_id; index; not null"`
+	Received   uint        `gorm:"column:received; not null"`
+	Quantity   uint        `gorm:"column:quantity; not null"`
+	ReceivedAt time.Time   `gorm:"column:received_at; not null"`
+	ExpiresAt  time.Time   `gorm:"column:expires_at; index; not null"`
+	Reference  string      `gorm:"column:reference"`
+	UnitCost   money.Money `gorm:"embedded; embeddedPrefix:unit_cost_"`
+}
+
+// ReceiveLot adds stock as a new lot expiring at expiresAt and records the receipt in the
+// ledger against the lot.
+func ReceiveLot(movement *StockMovement, expiresAt time.Time) (uint32, *Item, *Lot, error) {
+	if movement == nil || movement.Delta <= 0 || !IsValidReason(movement.Reason) {
+		return http.StatusBadRequest, nil, nil, errors.ErrInvalidMovement
+	}
+	if movement.LocationID == 0 {
+		movement.LocationID = defaultLocationID
+	}
+	if movement.CreatedAt.IsZero() {
+		movement.CreatedAt = time.Now()
+	}
+
+	item := &Item{}
+	var lot *Lot
+	status := uint32(http.StatusOK)
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		status, lot, err = receiveLot(tx, item, movement, expiresAt)
+		return err
+	})
+	if err != nil {
+		if status == http.StatusOK || status == http.StatusInternalServerError {
+			logger.WithField("error", err.Error()).Error(err.Error())
+			status = http.StatusInternalServerError
+		}
+		return status, nil, nil, err
+	}
+	return http.StatusCreated, item, lot, nil
+}
+
+// receiveLot creates the lot for a receipt and applies the receipt against it.
+func receiveLot(tx *gorm.DB, item *Item, movement *StockMovement, expiresAt time.Time) (uint32, *Lot, error) {
+	lot := &Lot{
+		ItemID:     movement.ItemID,
+		LocationID: movement.LocationID,
+		Received:   uint(movement.Delta),
+		Quantity:   uint(movement.Delta),
+		ReceivedAt: movement.CreatedAt,
+		ExpiresAt:  expiresAt,
+		Reference:  movement.Reference,
+		UnitCost:   movement.UnitCost,
+	}
+	if err := tx.Create(lot).Error; err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+	movement.LotID = lot.ID
+	status, err := applyStockMovement(tx, item, movement)
+	if err != nil {
+		return status, nil, err
+	}
+	// a receipt without a cost of its own is valued at the item's cost price
+	if lot.UnitCost != movement.UnitCost {
+		lot.UnitCost = movement.UnitCost
+		if err := tx.Save(lot).Error; err != nil {
+			return http.StatusInternalServerError, nil, err
+		}
+	}
+	return http.StatusOK, lot, nil
+}
+
+// GetLots returns the lots of an item that still hold stock, first-expiring first.
+func GetLots(itemID uint) (uint32, []*Lot, error) {
+	status, _, err := GetItem(itemID)
+	if err != nil {
+		return status, nil, err
+	}
+	lots := []*Lot{}
+	err = db.Where("item_id = ? AND quantity > 0", itemID).Order("expires_at, id").Find(&lots).Error
+	if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, lots, nil
+}
+
+// GetExpiredLots returns every lot that still holds stock and expired at or before the given time.
+func GetExpiredLots(at time.Time) (uint32, []*Lot, error) {
+	lots := []*Lot{}
+	err := db.Where("quantity > 0 AND expires_at <= ?", at).Order("expires_at, id").Find(&lots).Error
+	if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, lots, nil
+}
+
+// GetExpiredQuantities sums the stock held in expired lots for each of the given items.
+// Items with no expired stock are left out.
+func GetExpiredQuantities(itemIDs []uint, at time.Time) (uint32, map[uint]uint, error) {
+	rows := []struct {
+		ItemID   uint
+		Quantity uint
+	}{}
+	err := db.Model(&Lot{}).
+		Select("item_id, SUM(quantity) AS quantity").
+		Where("item_id IN ? AND quantity > 0 AND expires_at <= ?", itemIDs, at).
+		Group("item_id").
+		Scan(&rows).Error
+	if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	expired := map[uint]uint{}
+	for _, row := range rows {
+		expired[row.ItemID] = row.Quantity
+	}
+	return http.StatusOK, expired, nil
+}
+
+// lotTake is how much of a lot one movement took.
+type lotTake struct {
+	lot      *Lot
+	quantity uint
+}
+
+// takeFromLots removes quantity of an item from its lots at a location. A lotID takes it
+// from that lot alone; otherwise unexpired lots are drawn on first-expiring-first and the
+// rest comes from stock held outside lots. It must run after the location's stock has been
+// lowered, and returns what was taken from each lot in order.
+func takeFromLots(tx *gorm.DB, itemID uint, locationID uint, lotID uint, quantity uint, at time.Time) (uint32, []lotTake, error) {
+	if lotID != 0 {
+		lot := &Lot{}
+		err := tx.Where("id = ? AND item_id = ? AND location_id = ?", lotID, itemID, locationID).First(lot).Error
+		if err == gorm.ErrRecordNotFound {
+			return http.StatusNotFound, nil, errors.ErrLotNotFound
+		} else if err != nil {
+			return http.StatusInternalServerError, nil, err
+		}
+		if lot.Quantity < quantity {
+			return http.StatusConflict, nil, errors.ErrInsufficientQuantity
+		}
+		if err := tx.Model(lot).UpdateColumn("quantity", lot.Quantity-quantity).Error; err != nil {
+			return http.StatusInternalServerError, nil, err
+		}
+		return http.StatusOK, []lotTake{{lot: lot, quantity: quantity}}, nil
+	}
+
+	lots := []*Lot{}
+	err := tx.Where("item_id = ? AND location_id = ? AND quantity > 0", itemID, locationID).Order("expires_at, id").Find(&lots).Error
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+	if len(lots) == 0 {
+		return http.StatusOK, nil, nil
+	}
+
+	stock := &LocationStock{}
+	err = tx.Where("item_id = ? AND location_id = ?", itemID, locationID).First(stock).Error
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+	// the location's stock before it was lowered, less what is held in lots
+	untracked := int64(stock.Quantity) + int64(quantity)
+	for _, lot := range lots {
+		untracked -= int64(lot.Quantity)
+	}
+
+	taken := []lotTake{}
+	remaining := quantity
+	for _, lot := range lots {
+		if remaining == 0 {
+			break
+		}
+		if !lot.ExpiresAt.After(at) {
+			continue
+		}
+		take := lot.Quantity
+		if take > remaining {
+			take = remaining
+		}
+		if err := tx.Model(lot).UpdateColumn("quantity", lot.Quantity-take).Error; err != nil {
+			return http.StatusInternalServerError, nil, err
+		}
+		taken = append(taken, lotTake{lot: lot, quantity: take})
+		remaining -= take
+	}
+	if int64(remaining) > untracked {
+		return http.StatusConflict, nil, errors.ErrInsufficientQuantity
+	}
+	return http.StatusOK, taken, nil
+}
+
+// moveLots recreates the lots taken from one location at another, keeping their dates.
+func moveLots(tx *gorm.DB, taken []lotTake, toLocationID uint) error {
+	for _, take := range taken {
+		lot, quantity := take.lot, take.quantity
+		moved := &Lot{
+			ItemID:     lot.ItemID,
+			LocationID: toLocationID,
+			Received:   quantity,
+			Quantity:   quantity,
+			ReceivedAt: lot.ReceivedAt,
+			ExpiresAt:  lot.ExpiresAt,
+			Reference:  lot.Reference,
+		}
+		if err := tx.Create(moved).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -2,7 +2,9 @@ package models
 
 import (
 	"inventory-service/errors"
+	"inventory-service/money"
 	"net/http"
+	"strings"
 
 	logger "github.com/sirupsen/logrus"
 	"gorm.io/gorm"
@@ -10,18 +12,63 @@ import (
 
 var db *gorm.DB
 
+// Item is something on the menu or in the store room. CostPrice is what a unit of it, in the
+// unit it is stocked in, costs to buy or make; stock received without a cost of its own is
+// valued at it.
 type Item struct {
 	gorm.Model
-	ID          uint    `gorm:"primaryKey; column:id; autoIncrement; not null"`
-	Name        string  `gorm:"column:name; unique; not null"`
-	Description string  `gorm:"column:description; not null"`
-	Price       float32 `gorm:"column:price; not null"`
-	Quantity    uint    `gorm:"column:quantity; not null"`
+	ID              uint        `gorm:"primaryKey; column:id; autoIncrement; not null"`
+	Name            string      `gorm:"column:name; unique; not null"`
+	Description     string      `gorm:"column:description; not null"`
+	Price           money.Money `gorm:"embedded; embeddedPrefix:price_"`
+	CostPrice       money.Money `gorm:"embedded; embeddedPrefix:cost_price_"`
+	Quantity        uint        `gorm:"column:quantity; not null"`
+	ReorderLevel    uint        `gorm:"column:reorder_level; not null; default:0"`
+	ReorderQuantity uint        `gorm:"column:reorder_quantity; not null; default:0"`
+	SKU             *string     `gorm:"column:sku; uniqueIndex"`
+	Category        string      `gorm:"column:category; index; not null; default:''"`
+	Unavailable     bool        `gorm:"column:unavailable; not null; default:false"`
+	Allergens       uint32      `gorm:"column:allergens; not null; default:0"`
+	DietaryLabels   uint32      `gorm:"column:dietary_labels; not null; default:0"`
+	Kcal            *float32    `gorm:"column:kcal"`
+	Protein         *float32    `gorm:"column:protein"`
+	Carbohydrates   *float32    `gorm:"column:carbohydrates"`
+	Fat             *float32    `gorm:"column:fat"`
+	Unit            string      `gorm:"column:unit; not null; default:each"`
+	SaleUnit        string      `gorm:"column:sale_unit; not null; default:''"`
+}
+
+// SellingUnit returns the unit the item is sold in, which is the unit it is stocked in
+// unless a separate sale unit is set. Quantity, like every stored quantity, is a whole
+// number of the base unit of both (pieces, grams or millilitres).
+func (item *Item) SellingUnit() string {
+	if item.SaleUnit == "" {
+		return item.Unit
+	}
+	return item.SaleUnit
 }
 
 func InitInventoryModels(database *gorm.DB) {
 	db = database
-	db.AutoMigrate(&Item{})
+	legacyUnits := db.Migrator().HasTable(&Item{}) && !db.Migrator().HasColumn(&Item{}, "sale_unit")
+	db.AutoMigrate(&Item{}, &StockMovement{}, &OptionGroup{}, &Option{}, &InventoryEvent{}, &AvailabilityWindow{}, &Location{}, &LocationStock{}, &Lot{}, &RecipeLine{}, &BundleComponent{}, &Supplier{}, &SupplierItem{}, &PurchaseOrder{}, &PurchaseOrderLine{}, &StockTake{}, &StockCount{}, &ItemPrice{}, &ItemImage{}, &ItemBarcode{}, &Review{}, &ReviewPhoto{}, &ItemTranslation{}, &CategoryTranslation{}, &PurgedItem{})
+	if err := migrateMoneyColumns(); err != nil {
+		logger.WithField("error", err.Error()).Error("failed to move amounts to minor units")
+	}
+	if legacyUnits {
+		if err := migrateUnitQuantities(); err != nil {
+			logger.WithField("error", err.Error()).Error("failed to move quantities to base units")
+		}
+	}
+	if err := initDefaultLocation(); err != nil {
+		logger.WithField("error", err.Error()).Error("failed to set up the default location")
+	}
+	if err := backfillOpeningMovements(); err != nil {
+		logger.WithField("error", err.Error()).Error("failed to backfill opening stock movements")
+	}
+	if err := backfillOpeningPrices(); err != nil {
+		logger.WithField("error", err.Error()).Error("failed to backfill opening prices")
+	}
 }
 
 func CreateItem(item *Item) (uint32, *Item, error) {
@@ -29,9 +76,13 @@ func CreateItem(item *Item) (uint32, *Item, error) {
 		logger.WithField("error", errors.ErrInvalidItem.Error()).Error(errors.ErrInvalidItem.Error())
 		return http.StatusBadRequest, nil, errors.ErrInvalidItem
 	}
-	err := db.Create(item).Error
+	err := db.Transaction(func(tx *gorm.DB) error {
+		return createItem(tx, item, "", "")
+	})
 	if err != nil && err.Error() == "UNIQUE constraint failed: items.name" {
 		return http.StatusUnprocessableEntity, nil, errors.ErrItemExists
+	} else if isUniqueViolation(err, "sku") {
+		return http.StatusUnprocessableEntity, nil, errors.ErrSKUExists
 	} else if err != nil {
 		logger.WithField("error", err.Error()).Error(err.Error())
 		return http.StatusBadRequest, nil, err
@@ -39,6 +90,45 @@ func CreateItem(item *Item) (uint32, *Item, error) {
 	return http.StatusCreated, item, nil
 }
 
+// createItem inserts the item and records its opening quantity in the ledger.
+func createItem(tx *gorm.DB, item *Item, reference string, actor string) error {
+	if err := tx.Create(item).Error; err != nil {
+		return err
+	}
+	if err := recordItemPrice(tx, item.ID, item.Price, item.CreatedAt, actor); err != nil {
+		return err
+	}
+	if item.Quantity == 0 {
+		return nil
+	}
+	movement := &StockMovement{
+		ItemID:    item.ID,
+		Delta:     int64(item.Quantity),
+		Balance:   item.Quantity,
+		Reason:    ReasonInitialStock,
+		Reference: reference,
+		Actor:     actor,
+		UnitCost:  item.CostPrice,
+	}
+	if _, err := applyLocationStock(tx, movement); err != nil {
+		return err
+	}
+	return tx.Create(movement).Error
+}
+
+// isUniqueViolation reports whether err is a unique constraint failure on the given column,
+// as worded by either sqlite or postgres.
+func isUniqueViolation(err error, column string) bool {
+	if err == nil {
+		return false
+	}
+	message := err.Error()
+	if !strings.Contains(message, "UNIQUE constraint failed") && !strings.Contains(message, "duplicate key value") {
+		return false
+	}
+	return strings.Contains(message, column)
+}
+
 func GetItem(id uint) (uint32, *Item, error) {
 	item := &Item{}
 	err := db.Where("id = ?", id).First(item).Error
@@ -60,15 +150,106 @@ func GetAllItems() (uint32, []*Item, error) {
 	return http.StatusOK, items, nil
 }
 
+// GetItemsByIDs returns the items with the given ids in a single query. Ids that do not
+// exist are simply absent from the result.
+func GetItemsByIDs(ids []uint) (uint32, []*Item, error) {
+	items := []*Item{}
+	err := db.Where("id IN ?", ids).Order("id").Find(&items).Error
+	if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, items, nil
+}
+
+// FindItems returns the items that contain none of the excluded allergens and carry every
+// one of the required dietary labels, both given as bit sets.
+func FindItems(excludeAllergens uint32, dietaryLabels uint32) (uint32, []*Item, error) {
+	items := []*Item{}
+	query := db.Order("id")
+	if excludeAllergens != 0 {
+		query = query.Where("allergens & ? = 0", excludeAllergens)
+	}
+	if dietaryLabels != 0 {
+		query = query.Where("dietary_labels & ? = ?", dietaryLabels, dietaryLabels)
+	}
+	if err := query.Find(&items).Error; err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, items, nil
+}
+
+// GetLowStockItems returns the items that have a reorder level set and are at or below it.
+func GetLowStockItems() (uint32, []*Item, error) {
+	items := []*Item{}
+	err := db.Where("reorder_level > 0 AND quantity <= reorder_level").Order("id").Find(&items).Error
+	if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, items, nil
+}
+
+func UpdateItemReorderLevel(id uint, reorderLevel uint, reorderQuantity uint) (uint32, *Item, error) {
+	status, item, err := GetItem(id)
+	if err != nil {
+		return status, nil, err
+	}
+	err = db.Model(item).Updates(map[string]interface{}{
+		"reorder_level":    reorderLevel,
+		"reorder_quantity": reorderQuantity,
+	}).Error
+	if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, item, nil
+}
+
+// UpdateItemDetails writes the given columns of the item, keeping its ID so that orders
+// referencing it stay valid. A new price is added to the item's price history.
+func UpdateItemDetails(id uint, updates map[string]interface{}) (uint32, *Item, error) {
+	status, item, err := GetItem(id)
+	if err != nil {
+		return status, nil, err
+	}
+	previous := item.Price
+	err = db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(item).Updates(updates).Error; err != nil {
+			return err
+		}
+		if item.Price == previous {
+			return nil
+		}
+		return recordItemPrice(tx, item.ID, item.Price, item.UpdatedAt, "")
+	})
+	if isUniqueViolation(err, "name") {
+		return http.StatusUnprocessableEntity, nil, errors.ErrItemExists
+	} else if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, item, nil
+}
+
+// UpdateItemQuantity overwrites the item's quantity, recording the difference as a correction.
 func UpdateItemQuantity(id uint, quantity uint) (uint32, error) {
 	status, item, err := GetItem(id)
 	if err != nil {
 		return status, err
 	}
-	item.Quantity = quantity
-	err = db.Save(item).Error
+	delta := int64(quantity) - int64(item.Quantity)
+	if delta == 0 {
+		return http.StatusOK, nil
+	}
+	status, _, err = ApplyStockMovement(&StockMovement{
+		ItemID: item.ID,
+		Delta:  delta,
+		Reason: ReasonCorrection,
+	})
 	if err != nil {
-		return http.StatusInternalServerError, err
+		return status, err
 	}
 	return http.StatusOK, nil
 }
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"inventory-service/money"
 	"net/http"
 	"testing"
 
@@ -48,7 +49,7 @@ func (suite *InventoryModelsTestSuite) TestModels_CreateItem() {
 		testItem := &Item{
 			Name:        "testitem1",
 			Description: "testitem1.desc",
-			Price:       100,
+			Price:       money.New(10000, "EUR"),
 			Quantity:    100,
 		}
 
@@ -80,7 +81,7 @@ func (suite *InventoryModelsTestSuite) TestModels_CreateItem() {
 		testItem1 := &Item{
 			Name:        "testitem2",
 			Description: "testitem2.desc",
-			Price:       100,
+			Price:       money.New(10000, "EUR"),
 			Quantity:    100,
 		}
 
@@ -92,7 +93,7 @@ func (suite *InventoryModelsTestSuite) TestModels_CreateItem() {
 		testItem2 := &Item{
 			Name:        "testitem2",
 			Description: "testitem2.desc",
-			Price:       100,
+			Price:       money.New(10000, "EUR"),
 			Quantity:    100,
 		}
 
@@ -114,7 +115,7 @@ func (suite *InventoryModelsTestSuite) TestModels_GetItem() {
 		testItem := &Item{
 			Name:        "testitem3",
 			Description: "testitem3.desc",
-			Price:       100,
+			Price:       money.New(10000, "EUR"),
 			Quantity:    10,
 		}
 
@@ -155,7 +156,7 @@ func (suite *InventoryModelsTestSuite) TestModels_GetAllItems() {
 		testItem1 := &Item{
 			Name:        "testitem4",
 			Description: "testitem4.desc",
-			Price:       100,
+			Price:       money.New(10000, "EUR"),
 			Quantity:    10,
 		}
 
@@ -167,7 +168,7 @@ func (suite *InventoryModelsTestSuite) TestModels_GetAllItems() {
 		testItem2 := &Item{
 			Name:        "testitem5",
 			Description: "testitem5.desc",
-			Price:       100,
+			Price:       money.New(10000, "EUR"),
 			Quantity:    10,
 		}
 
@@ -195,7 +196,7 @@ func (suite *InventoryModelsTestSuite) TestModels_UpdateItemQuantity() {
 		testItem := &Item{
 			Name:        "testitem6",
 			Description: "testitem6.desc",
-			Price:       100,
+			Price:       money.New(10000, "EUR"),
 			Quantity:    10,
 		}
 
@@ -236,7 +237,7 @@ func (suite *InventoryModelsTestSuite) TestModels_DeleteItem() {
 		testItem := &Item{
 			Name:        "testitem7",
 			Description: "testitem7.desc",
-			Price:       100,
+			Price:       money.New(10000, "EUR"),
 			Quantity:    10,
 		}
 
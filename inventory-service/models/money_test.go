@@ -0,0 +1,53 @@
+package models
+
+import (
+	"inventory-service/money"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// legacyItem is the items table as it was while prices were kept as floats.
+type legacyItem struct {
+	gorm.Model
+	Name        string  `gorm:"column:name; unique; not null"`
+	Description string  `gorm:"column:description; not null"`
+	Price       float32 `gorm:"column:price; not null"`
+	Quantity    uint    `gorm:"column:quantity; not null"`
+}
+
+func (legacyItem) TableName() string {
+	return "items"
+}
+
+func TestMigrateMoneyColumns(t *testing.T) {
+	database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	assert.NoError(t, err)
+	defer func() {
+		sql, _ := database.DB()
+		sql.Close()
+	}()
+
+	assert.NoError(t, database.AutoMigrate(&legacyItem{}))
+	legacy := []*legacyItem{
+		{Name: "espresso", Description: "espresso.desc", Price: 12.99, Quantity: 1},
+		{Name: "biscotti", Description: "biscotti.desc", Price: 0.1, Quantity: 1},
+	}
+	assert.NoError(t, database.Create(&legacy).Error)
+
+	InitInventoryModels(database)
+
+	assert.False(t, database.Migrator().HasColumn(&Item{}, "price"))
+
+	status, item, err := GetItem(legacy[0].ID)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(http.StatusOK), status)
+	assert.Equal(t, money.Money{MinorUnits: 1299, Currency: "EUR"}, item.Price)
+
+	_, item, err = GetItem(legacy[1].ID)
+	assert.NoError(t, err)
+	assert.Equal(t, money.Money{MinorUnits: 10, Currency: "EUR"}, item.Price)
+}
@@ -0,0 +1,202 @@
+package models
+
+import (
+	"inventory-service/errors"
+	"inventory-service/money"
+	"net/http"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const (
+	ReasonInitialStock = "INITIAL_STOCK"
+	ReasonRestock      = "RESTOCK"
+	ReasonOrder        = "ORDER"
+	ReasonWastage      = "WASTAGE"
+	ReasonCorrection   = "CORRECTION"
+	ReasonTransfer     = "TRANSFER"
+	ReasonStockTake    = "STOCK_TAKE"
+)
+
+// openingBalanceReference marks the opening movements backfilled for items stocked before the
+// ledger was kept.
+const openingBalanceReference = "opening balance"
+
+// StockMovement is an append-only ledger entry, one per change of an item's stock at a
+// location. Rows are never updated or deleted, not even when the item is purged, so the sum
+// of deltas for an item must always equal its current quantity. Balance is the item's total
+// quantity after the movement; the two halves of a transfer leave it unchanged, and the
+// second half names the first in PairedWith. LotID names the lot a movement received stock
+// into or wrote off. UnitCost is what each unit of stock coming in cost, in the unit the item
+// is stocked in: the purchase order's price for receipts against one, the given cost or else
+// the item's cost price for other stock received, and nothing for stock going out or moving
+// between locations.
+type StockMovement struct {
+	ID         uint        `gorm:"primaryKey; column:id; autoIncrement; not null"`
+	ItemID     uint        `gorm:"column:item_id; index; not null"`
+	LocationID uint        `gorm:"column:location_id; index; not null; default:0"`
+	Delta      int64       `gorm:"column:delta; not null"`
+	Balance    uint        `gorm:"column:balance; not null"`
+	Reason     string      `gorm:"column:reason; not null"`
+	Reference  string      `gorm:"column:reference"`
+	Actor      string      `gorm:"column:actor"`
+	PairedWith uint        `gorm:"column:paired_with; not null; default:0"`
+	LotID      uint        `gorm:"column:lot_id; index; not null; default:0"`
+	UnitCost   money.Money `gorm:"embedded; embeddedPrefix:unit_cost_"`
+	CreatedAt  time.Time   `gorm:"column:created_at; not null"`
+}
+
+// StockLedgerTotal is the quantity recorded on an item next to the quantity its ledger adds up to.
+type StockLedgerTotal struct {
+	ItemID         uint
+	Name           string
+	Quantity       uint
+	LedgerQuantity int64
+}
+
+func IsValidReason(reason string) bool {
+	switch reason {
+	case ReasonInitialStock, ReasonRestock, ReasonOrder, ReasonWastage, ReasonCorrection, ReasonTransfer, ReasonStockTake:
+		return true
+	}
+	return false
+}
+
+// ApplyStockMovement changes the item's quantity by movement.Delta and appends the movement
+// to the ledger in the same transaction.
+func ApplyStockMovement(movement *StockMovement) (uint32, *Item, error) {
+	if movement == nil || movement.Delta == 0 || !IsValidReason(movement.Reason) {
+		return http.StatusBadRequest, nil, errors.ErrInvalidMovement
+	}
+
+	item := &Item{}
+	status := uint32(http.StatusOK)
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		status, err = applyStockMovement(tx, item, movement)
+		return err
+	})
+	if err != nil {
+		if status == http.StatusOK || status == http.StatusInternalServerError {
+			logger.WithField("error", err.Error()).Error(err.Error())
+			status = http.StatusInternalServerError
+		}
+		return status, nil, err
+	}
+	return http.StatusOK, item, nil
+}
+
+func applyStockMovement(tx *gorm.DB, item *Item, movement *StockMovement) (uint32, error) {
+	if movement.CreatedAt.IsZero() {
+		movement.CreatedAt = time.Now()
+	}
+	err := tx.Where("id = ?", movement.ItemID).First(item).Error
+	if err == gorm.ErrRecordNotFound {
+		return http.StatusNotFound, errors.ErrItemNotFound
+	} else if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	if movement.Delta > 0 && movement.Reason != ReasonTransfer && movement.UnitCost == (money.Money{}) {
+		movement.UnitCost = item.CostPrice
+	}
+
+	if status, err := applyLocationStock(tx, movement); err != nil {
+		return status, err
+	}
+	if movement.Delta < 0 {
+		if status, _, err := takeFromLots(tx, movement.ItemID, movement.LocationID, movement.LotID, uint(-movement.Delta), movement.CreatedAt); err != nil {
+			return status, err
+		}
+	}
+
+	// the guard on the current quantity keeps concurrent decrements from going below zero
+	result := tx.Model(&Item{}).
+		Where("id = ? AND quantity + ? >= 0", item.ID, movement.Delta).
+		UpdateColumn("quantity", gorm.Expr("quantity + ?", movement.Delta))
+	if result.Error != nil {
+		return http.StatusInternalServerError, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return http.StatusConflict, errors.ErrInsufficientQuantity
+	}
+
+	if err := tx.Where("id = ?", item.ID).First(item).Error; err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	movement.Balance = item.Quantity
+	if err := tx.Create(movement).Error; err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return http.StatusOK, nil
+}
+
+func GetStockMovements(itemID uint, page int, pageSize int) (uint32, []*StockMovement, int64, error) {
+	var total int64
+	err := db.Model(&StockMovement{}).Where("item_id = ?", itemID).Count(&total).Error
+	if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, 0, err
+	}
+
+	movements := []*StockMovement{}
+	err = db.Where("item_id = ?", itemID).
+		Order("id desc").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&movements).Error
+	if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, 0, err
+	}
+	return http.StatusOK, movements, total, nil
+}
+
+// GetLedgerBefore returns every movement made before the given time, item by item in the
+// order they happened, for replaying what each item's stock cost.
+func GetLedgerBefore(before time.Time) (uint32, []*StockMovement, error) {
+	movements := []*StockMovement{}
+	err := db.Where("created_at < ?", before).Order("item_id, id").Find(&movements).Error
+	if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, movements, nil
+}
+
+// backfillOpeningMovements gives every item that has no ledger rows, as items stocked before
+// the ledger was kept have none, an opening movement for the quantity it holds, so its ledger
+// adds up to its quantity. Archived items get one too, as initDefaultLocation places their
+// stock, so both still agree when they are restored. Items with any movement are left alone,
+// which makes it safe to run on every start.
+func backfillOpeningMovements() error {
+	return db.Exec(`INSERT INTO stock_movements (item_id, location_id, delta, balance, reason, reference, actor, paired_with, lot_id, unit_cost_minor_units, unit_cost_currency, created_at)
+		SELECT id, ?, quantity, quantity, ?, ?, '', 0, 0, cost_price_minor_units, cost_price_currency, ? FROM items
+		WHERE quantity > 0
+		AND NOT EXISTS (SELECT 1 FROM stock_movements WHERE stock_movements.item_id = items.id)`,
+		defaultLocationID, ReasonInitialStock, openingBalanceReference, time.Now()).Error
+}
+
+// GetStockLedgerTotals sums the ledger of every item, or of a single item when itemID is non-zero.
+func GetStockLedgerTotals(itemID uint) (uint32, []*StockLedgerTotal, error) {
+	totals := []*StockLedgerTotal{}
+	query := db.Table("items").
+		Select("items.id AS item_id, items.name AS name, items.quantity AS quantity, COALESCE(SUM(stock_movements.delta), 0) AS ledger_quantity").
+		Joins("LEFT JOIN stock_movements ON stock_movements.item_id = items.id").
+		Where("items.deleted_at IS NULL").
+		Group("items.id, items.name, items.quantity").
+		Order("items.id")
+	if itemID != 0 {
+		query = query.Where("items.id = ?", itemID)
+	}
+	if err := query.Scan(&totals).Error; err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	if itemID != 0 && len(totals) == 0 {
+		return http.StatusNotFound, nil, errors.ErrItemNotFound
+	}
+	return http.StatusOK, totals, nil
+}
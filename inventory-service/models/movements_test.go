@@ -0,0 +1,151 @@
+package models
+
+import (
+	"inventory-service/money"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type MovementModelsTestSuite struct {
+	suite.Suite
+	db *gorm.DB
+}
+
+func (suite *MovementModelsTestSuite) SetupSuite() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	assert.NoError(suite.T(), err)
+	suite.db = db
+	InitInventoryModels(db)
+}
+
+func (suite *MovementModelsTestSuite) TearDownSuite() {
+	_ = suite.db.Migrator().DropTable(&Item{}, &StockMovement{})
+	sql, _ := suite.db.DB()
+	sql.Close()
+}
+
+func TestMovementModelsTestSuite(t *testing.T) {
+	suite.Run(t, new(MovementModelsTestSuite))
+}
+
+func (suite *MovementModelsTestSuite) TestModels_ApplyStockMovement() {
+	t := suite.T()
+
+	status, item, err := CreateItem(&Item{
+		Name:        "movementitem1",
+		Description: "movementitem1.desc",
+		Price:       money.New(10000, "EUR"),
+		Quantity:    10,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(http.StatusCreated), status)
+
+	t.Run("Apply movement successfully", func(t *testing.T) {
+		//Act
+		status, got, err := ApplyStockMovement(&StockMovement{
+			ItemID:    item.ID,
+			Delta:     -4,
+			Reason:    ReasonWastage,
+			Reference: "spoiled",
+		})
+
+		//Assert
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.Equal(t, uint(6), got.Quantity)
+
+		_, movements, total, err := GetStockMovements(item.ID, 1, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		assert.Equal(t, uint(6), movements[0].Balance)
+	})
+
+	t.Run("Apply movement below zero", func(t *testing.T) {
+		//Arrange and Act
+		status, got, err := ApplyStockMovement(&StockMovement{ItemID: item.ID, Delta: -7, Reason: ReasonOrder})
+
+		//Assert
+		assert.Error(t, err)
+		assert.Equal(t, uint32(http.StatusConflict), status)
+		assert.Nil(t, got)
+	})
+
+	t.Run("Apply movement with invalid reason", func(t *testing.T) {
+		//Arrange and Act
+		status, got, err := ApplyStockMovement(&StockMovement{ItemID: item.ID, Delta: 1, Reason: "GIFT"})
+
+		//Assert
+		assert.Error(t, err)
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Nil(t, got)
+	})
+
+	t.Run("Apply movement with invalid id", func(t *testing.T) {
+		//Arrange and Act
+		status, got, err := ApplyStockMovement(&StockMovement{ItemID: 0, Delta: 1, Reason: ReasonRestock})
+
+		//Assert
+		assert.Error(t, err)
+		assert.Equal(t, uint32(http.StatusNotFound), status)
+		assert.Nil(t, got)
+	})
+
+	t.Run("Overwriting the quantity is recorded as a correction", func(t *testing.T) {
+		//Act
+		status, err := UpdateItemQuantity(item.ID, 20)
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(http.StatusOK), status)
+
+		//Assert
+		_, movements, _, err := GetStockMovements(item.ID, 1, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, ReasonCorrection, movements[0].Reason)
+		assert.Equal(t, int64(14), movements[0].Delta)
+
+		_, totals, err := GetStockLedgerTotals(item.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(20), totals[0].LedgerQuantity)
+	})
+}
+
+func (suite *MovementModelsTestSuite) TestModels_BackfillOpeningMovements() {
+	t := suite.T()
+
+	// Arrange
+	legacy := &Item{Name: "movementlegacy1", Description: "stocked before the ledger", Price: money.New(500, "EUR"), CostPrice: money.New(200, "EUR"), Quantity: 7}
+	assert.NoError(t, suite.db.Create(legacy).Error)
+	empty := &Item{Name: "movementlegacy2", Description: "never stocked", Price: money.New(500, "EUR")}
+	assert.NoError(t, suite.db.Create(empty).Error)
+	_, stocked, err := CreateItem(&Item{Name: "movementlegacy3", Description: "stocked with the ledger", Price: money.New(500, "EUR"), Quantity: 3})
+	assert.NoError(t, err)
+
+	// Act
+	assert.NoError(t, backfillOpeningMovements())
+	assert.NoError(t, backfillOpeningMovements())
+
+	// Assert
+	_, totals, err := GetStockLedgerTotals(legacy.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), totals[0].LedgerQuantity)
+
+	_, movements, _, err := GetStockMovements(legacy.ID, 1, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(movements))
+	assert.Equal(t, ReasonInitialStock, movements[0].Reason)
+	assert.Equal(t, uint(7), movements[0].Balance)
+	assert.Equal(t, DefaultLocationID(), movements[0].LocationID)
+	assert.Equal(t, money.New(200, "EUR"), movements[0].UnitCost)
+
+	_, movements, _, err = GetStockMovements(empty.ID, 1, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(movements))
+
+	_, movements, _, err = GetStockMovements(stocked.ID, 1, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(movements))
+}
@@ -0,0 +1,151 @@
+package models
+
+import (
+	"inventory-service/errors"
+	"inventory-service/money"
+	"net/http"
+
+	logger "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const (
+	SelectionSingle = "single"
+	SelectionMulti  = "multi"
+)
+
+// OptionGroup is a set of choices offered with an item, such as its size or its add-ons.
+type OptionGroup struct {
+	ID            uint      `gorm:"primaryKey; column:id; autoIncrement; not null"`
+	ItemID        uint      `gorm:"column:item_id; index; not null"`
+	Name          string    `gorm:"column:name; not null"`
+	SelectionType string    `gorm:"column:selection_type; not null"`
+	MinSelections uint      `gorm:"column:min_selections; not null"`
+	MaxSelections uint      `gorm:"column:max_selections; not null"`
+	Required      bool      `gorm:"column:required; not null"`
+	Options       []*Option `gorm:"foreignKey:OptionGroupID"`
+}
+
+// Option is a single choice within a group. Its price delta is added to the item's price
+// when it is selected, and options that track stock are decremented along with the item.
+type Option struct {
+	ID            uint        `gorm:"primaryKey; column:id; autoIncrement; not null"`
+	OptionGroupID uint        `gorm:"column:option_group_id; index; not null"`
+	Name          string      `gorm:"column:name; not null"`
+	PriceDelta    money.Money `gorm:"embedded; embeddedPrefix:price_delta_"`
+	TrackStock    bool        `gorm:"column:track_stock; not null"`
+	Quantity      uint        `gorm:"column:quantity; not null"`
+}
+
+func CreateOptionGroup(group *OptionGroup) (uint32, *OptionGroup, error) {
+	status, _, err := GetItem(group.ItemID)
+	if err != nil {
+		return status, nil, err
+	}
+	if err := db.Create(group).Error; err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusCreated, group, nil
+}
+
+// GetOptionGroups returns the option groups of an item with their options, in the order they were added.
+func GetOptionGroups(itemID uint) (uint32, []*OptionGroup, error) {
+	groups := []*OptionGroup{}
+	err := db.Where("item_id = ?", itemID).
+		Preload("Options", func(tx *gorm.DB) *gorm.DB { return tx.Order("id") }).
+		Order("id").
+		Find(&groups).Error
+	if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, groups, nil
+}
+
+func DeleteOptionGroup(id uint) (uint32, error) {
+	err := db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&OptionGroup{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.ErrOptionGroupNotFound
+		}
+		return tx.Where("option_group_id = ?", id).Delete(&Option{}).Error
+	})
+	if err == errors.ErrOptionGroupNotFound {
+		return http.StatusNotFound, err
+	} else if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, err
+	}
+	return http.StatusOK, nil
+}
+
+func UpdateOptionStock(id uint, trackStock bool, quantity uint) (uint32, *Option, error) {
+	option := &Option{}
+	err := db.Where("id = ?", id).First(option).Error
+	if err == gorm.ErrRecordNotFound {
+		return http.StatusNotFound, nil, errors.ErrOptionNotFound
+	} else if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	err = db.Model(option).Updates(map[string]interface{}{
+		"track_stock": trackStock,
+		"quantity":    quantity,
+	}).Error
+	if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, option, nil
+}
+
+// ApplyStockMovementWithOptions lowers the item's quantity like ApplyStockMovement and, in
+// the same transaction, the quantity of every selected option that tracks its own stock.
+func ApplyStockMovementWithOptions(movement *StockMovement, options []*Option) (uint32, *Item, error) {
+	if movement == nil || movement.Delta >= 0 || !IsValidReason(movement.Reason) {
+		return http.StatusBadRequest, nil, errors.ErrInvalidMovement
+	}
+
+	item := &Item{}
+	status := uint32(http.StatusOK)
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		status, err = applyStockMovement(tx, item, movement)
+		if err != nil {
+			return err
+		}
+		status, err = lowerOptionStock(tx, options, movement.Delta)
+		return err
+	})
+	if err != nil {
+		if status == http.StatusOK || status == http.StatusInternalServerError {
+			logger.WithField("error", err.Error()).Error(err.Error())
+			status = http.StatusInternalServerError
+		}
+		return status, nil, err
+	}
+	return http.StatusOK, item, nil
+}
+
+// lowerOptionStock changes the quantity of every option that tracks its own stock by delta.
+func lowerOptionStock(tx *gorm.DB, options []*Option, delta int64) (uint32, error) {
+	for _, option := range options {
+		if !option.TrackStock {
+			continue
+		}
+		result := tx.Model(&Option{}).
+			Where("id = ? AND quantity + ? >= 0", option.ID, delta).
+			UpdateColumn("quantity", gorm.Expr("quantity + ?", delta))
+		if result.Error != nil {
+			return http.StatusInternalServerError, result.Error
+		}
+		if result.RowsAffected == 0 {
+			return http.StatusConflict, errors.ErrInsufficientOptionQuantity
+		}
+	}
+	return http.StatusOK, nil
+}
@@ -0,0 +1,90 @@
+package models
+
+import (
+	"inventory-service/money"
+	"net/http"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// ItemPrice is an entry in an item's price history. A price applies from StartsAt until a
+// later one starts; a promotional price has an end and takes precedence while it runs, so
+// the price it covered applies again once it is over. Prices set on the item directly are
+// recorded here too, and an item with no entry in effect sells at its own price.
+type ItemPrice struct {
+	ID        uint        `gorm:"primaryKey; column:id; autoIncrement; not null"`
+	ItemID    uint        `gorm:"column:item_id; index; not null"`
+	Price     money.Money `gorm:"embedded; embeddedPrefix:price_"`
+	StartsAt  time.Time   `gorm:"column:starts_at; index; not null"`
+	EndsAt    *time.Time  `gorm:"column:ends_at"`
+	Actor     string      `gorm:"column:actor; not null; default:''"`
+	CreatedAt time.Time   `gorm:"column:created_at"`
+}
+
+func CreateItemPrice(price *ItemPrice) (uint32, *ItemPrice, error) {
+	status, _, err := GetItem(price.ItemID)
+	if err != nil {
+		return status, nil, err
+	}
+	if err := db.Create(price).Error; err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusCreated, price, nil
+}
+
+// backfillOpeningPrices gives every item with no price history, as items created before it
+// was kept have none, an entry for its price from when it was created, so that looking up
+// what it cost before its first price change does not turn up the new price. Items with any
+// entry are left alone, which makes it safe to run on every start.
+func backfillOpeningPrices() error {
+	now := time.Now()
+	return db.Exec(`INSERT INTO item_prices (item_id, price_minor_units, price_currency, starts_at, actor, created_at)
+		SELECT id, price_minor_units, price_currency, COALESCE(created_at, ?), '', ? FROM items
+		WHERE NOT EXISTS (SELECT 1 FROM item_prices WHERE item_prices.item_id = items.id)`,
+		now, now).Error
+}
+
+// recordItemPrice adds a price set on the item directly to its history.
+func recordItemPrice(tx *gorm.DB, itemID uint, price money.Money, startsAt time.Time, actor string) error {
+	return tx.Create(&ItemPrice{
+		ItemID:   itemID,
+		Price:    price,
+		StartsAt: startsAt,
+		Actor:    actor,
+	}).Error
+}
+
+// GetItemPrices returns the item's price history, scheduled prices included, in the order
+// the prices start.
+func GetItemPrices(itemID uint) (uint32, []*ItemPrice, error) {
+	prices := []*ItemPrice{}
+	err := db.Where("item_id = ?", itemID).Order("starts_at").Order("id").Find(&prices).Error
+	if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, prices, nil
+}
+
+// GetPricesInEffect returns the price in effect at the given time for each of the items
+// that has one, keyed by item id.
+func GetPricesInEffect(itemIDs []uint, at time.Time) (uint32, map[uint]*ItemPrice, error) {
+	prices := []*ItemPrice{}
+	err := db.Where("item_id IN ? AND starts_at <= ? AND (ends_at IS NULL OR ends_at > ?)", itemIDs, at, at).
+		Order("ends_at IS NULL").Order("starts_at DESC").Order("id DESC").
+		Find(&prices).Error
+	if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	inEffect := map[uint]*ItemPrice{}
+	for _, price := range prices {
+		if _, ok := inEffect[price.ItemID]; !ok {
+			inEffect[price.ItemID] = price
+		}
+	}
+	return http.StatusOK, inEffect, nil
+}
@@ -0,0 +1,281 @@
+package models
+
+import (
+	"fmt"
+	"inventory-service/errors"
+	"inventory-service/money"
+	"net/http"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	PurchaseOrderDraft             = "draft"
+	PurchaseOrderSent              = "sent"
+	PurchaseOrderPartiallyReceived = "partially_received"
+	PurchaseOrderReceived          = "received"
+)
+
+// Supplier is a business stock is bought from. LeadTimeDays is how long its deliveries
+// usually take to arrive after an order is sent.
+type Supplier struct {
+	ID           uint   `gorm:"primaryKey; column:id; autoIncrement; not null"`
+	Name         string `gorm:"column:name; unique; not null"`
+	Contact      string `gorm:"column:contact; not null; default:''"`
+	Email        string `gorm:"column:email; not null; default:''"`
+	Phone        string `gorm:"column:phone; not null; default:''"`
+	LeadTimeDays uint   `gorm:"column:lead_time_days; not null; default:0"`
+}
+
+// SupplierItem is an item a supplier sells and what it costs per unit from them.
+type SupplierItem struct {
+	SupplierID uint        `gorm:"primaryKey; column:supplier_id; not null"`
+	ItemID     uint        `gorm:"primaryKey; column:item_id; not null"`
+	CostPrice  money.Money `gorm:"embedded; embeddedPrefix:cost_price_"`
+}
+
+// PurchaseOrder is an order for stock placed with a supplier. It starts as a draft, is sent
+// to the supplier, and is partially received until every line has arrived in full.
+type PurchaseOrder struct {
+	ID         uint                 `gorm:"primaryKey; column:id; autoIncrement; not null"`
+	SupplierID uint                 `gorm:"column:supplier_id; index; not null"`
+	Status     string               `gorm:"column:status; index; not null"`
+	Reference  string               `gorm:"column:reference"`
+	Actor      string               `gorm:"column:actor"`
+	CreatedAt  time.Time            `gorm:"column:created_at; not null"`
+	SentAt     *time.Time           `gorm:"column:sent_at"`
+	ExpectedAt *time.Time           `gorm:"column:expected_at"`
+	ReceivedAt *time.Time           `gorm:"column:received_at"`
+	Lines      []*PurchaseOrderLine `gorm:"foreignKey:PurchaseOrderID"`
+}
+
+// PurchaseOrderLine is the quantity of one item ordered at a cost price, and how much of it
+// has been received so far.
+type PurchaseOrderLine struct {
+	ID              uint        `gorm:"primaryKey; column:id; autoIncrement; not null"`
+	PurchaseOrderID uint        `gorm:"column:purchase_order_id; index; not null"`
+	ItemID          uint        `gorm:"column:item_id; not null"`
+	Quantity        uint        `gorm:"column:quantity; not null"`
+	Received        uint        `gorm:"column:received; not null; default:0"`
+	CostPrice       money.Money `gorm:"embedded; embeddedPrefix:cost_price_"`
+}
+
+// PurchaseOrderReference is the reference receipts against a purchase order carry in the ledger.
+func PurchaseOrderReference(id uint) string {
+	return fmt.Sprintf("PO-%d", id)
+}
+
+func CreateSupplier(supplier *Supplier) (uint32, *Supplier, error) {
+	err := db.Create(supplier).Error
+	if isUniqueViolation(err, "name") {
+		return http.StatusUnprocessableEntity, nil, errors.ErrSupplierExists
+	} else if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusCreated, supplier, nil
+}
+
+func GetSupplier(id uint) (uint32, *Supplier, error) {
+	supplier := &Supplier{}
+	err := db.Where("id = ?", id).First(supplier).Error
+	if err == gorm.ErrRecordNotFound {
+		return http.StatusNotFound, nil, errors.ErrSupplierNotFound
+	} else if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, supplier, nil
+}
+
+func GetSuppliers() (uint32, []*Supplier, error) {
+	suppliers := []*Supplier{}
+	if err := db.Order("id").Find(&suppliers).Error; err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, suppliers, nil
+}
+
+// SaveSupplierItem records that the supplier sells the item, or changes its cost price if
+// it already did.
+func SaveSupplierItem(supplierItem *SupplierItem) (uint32, *SupplierItem, error) {
+	err := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "supplier_id"}, {Name: "item_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"cost_price_minor_units", "cost_price_currency"}),
+	}).Create(supplierItem).Error
+	if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, supplierItem, nil
+}
+
+func GetSupplierItems(supplierID uint) (uint32, []*SupplierItem, error) {
+	supplierItems := []*SupplierItem{}
+	if err := db.Where("supplier_id = ?", supplierID).Order("item_id").Find(&supplierItems).Error; err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, supplierItems, nil
+}
+
+// CreatePurchaseOrder stores a new draft purchase order together with its lines.
+func CreatePurchaseOrder(order *PurchaseOrder) (uint32, *PurchaseOrder, error) {
+	order.Status = PurchaseOrderDraft
+	if order.CreatedAt.IsZero() {
+		order.CreatedAt = time.Now()
+	}
+	if err := db.Create(order).Error; err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusCreated, order, nil
+}
+
+func GetPurchaseOrder(id uint) (uint32, *PurchaseOrder, error) {
+	order := &PurchaseOrder{}
+	err := db.Preload("Lines", func(tx *gorm.DB) *gorm.DB { return tx.Order("id") }).Where("id = ?", id).First(order).Error
+	if err == gorm.ErrRecordNotFound {
+		return http.StatusNotFound, nil, errors.ErrPurchaseOrderNotFound
+	} else if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, order, nil
+}
+
+// GetPurchaseOrders returns purchase orders newest first, narrowed to a supplier and a
+// status when those are given.
+func GetPurchaseOrders(supplierID uint, status string) (uint32, []*PurchaseOrder, error) {
+	orders := []*PurchaseOrder{}
+	query := db.Preload("Lines", func(tx *gorm.DB) *gorm.DB { return tx.Order("id") }).Order("id DESC")
+	if supplierID != 0 {
+		query = query.Where("supplier_id = ?", supplierID)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if err := query.Find(&orders).Error; err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, orders, nil
+}
+
+// MarkPurchaseOrderSent moves a draft purchase order to sent, recording when it was sent
+// and when it is expected to arrive.
+func MarkPurchaseOrderSent(id uint, sentAt time.Time, expectedAt time.Time) (uint32, *PurchaseOrder, error) {
+	result := db.Model(&PurchaseOrder{}).
+		Where("id = ? AND status = ?", id, PurchaseOrderDraft).
+		Updates(map[string]interface{}{"status": PurchaseOrderSent, "sent_at": sentAt, "expected_at": expectedAt})
+	if result.Error != nil {
+		logger.WithField("error", result.Error.Error()).Error(result.Error.Error())
+		return http.StatusInternalServerError, nil, result.Error
+	}
+	status, order, err := GetPurchaseOrder(id)
+	if err != nil {
+		return status, nil, err
+	}
+	if result.RowsAffected == 0 {
+		return http.StatusConflict, nil, errors.ErrPurchaseOrderState
+	}
+	return http.StatusOK, order, nil
+}
+
+// ReceivePurchaseOrderLine takes delivery of movement.Delta units of one line of a sent
+// purchase order. The stock goes in through the same ledger path as any other restock, at
+// the line's cost price, and into a new lot when expiresAt is given. The order becomes
+// received once every line has arrived in full. Receiving more than is outstanding on the
+// line is refused.
+func ReceivePurchaseOrderLine(orderID uint, lineID uint, movement *StockMovement, expiresAt *time.Time) (uint32, *Item, *PurchaseOrder, error) {
+	if movement == nil || movement.Delta <= 0 {
+		return http.StatusBadRequest, nil, nil, errors.ErrInvalidMovement
+	}
+	if movement.LocationID == 0 {
+		movement.LocationID = defaultLocationID
+	}
+	if movement.CreatedAt.IsZero() {
+		movement.CreatedAt = time.Now()
+	}
+
+	item := &Item{}
+	order := &PurchaseOrder{}
+	status := uint32(http.StatusOK)
+	err := db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Preload("Lines", func(tx *gorm.DB) *gorm.DB { return tx.Order("id") }).Where("id = ?", orderID).First(order).Error
+		if err == gorm.ErrRecordNotFound {
+			status = http.StatusNotFound
+			return errors.ErrPurchaseOrderNotFound
+		} else if err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+		if order.Status != PurchaseOrderSent && order.Status != PurchaseOrderPartiallyReceived {
+			status = http.StatusConflict
+			return errors.ErrPurchaseOrderState
+		}
+
+		var line *PurchaseOrderLine
+		for _, candidate := range order.Lines {
+			if candidate.ID == lineID {
+				line = candidate
+			}
+		}
+		if line == nil {
+			status = http.StatusNotFound
+			return errors.ErrPurchaseOrderLineNotFound
+		}
+		if line.Received+uint(movement.Delta) > line.Quantity {
+			status = http.StatusConflict
+			return errors.ErrOverReceipt
+		}
+
+		movement.ItemID = line.ItemID
+		movement.Reason = ReasonRestock
+		movement.Reference = PurchaseOrderReference(order.ID)
+		movement.UnitCost = line.CostPrice
+		if expiresAt != nil {
+			status, _, err = receiveLot(tx, item, movement, *expiresAt)
+		} else {
+			status, err = applyStockMovement(tx, item, movement)
+		}
+		if err != nil {
+			return err
+		}
+
+		line.Received += uint(movement.Delta)
+		if err := tx.Model(line).UpdateColumn("received", line.Received).Error; err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+
+		order.Status = PurchaseOrderReceived
+		for _, candidate := range order.Lines {
+			if candidate.Received < candidate.Quantity {
+				order.Status = PurchaseOrderPartiallyReceived
+			}
+		}
+		updates := map[string]interface{}{"status": order.Status}
+		if order.Status == PurchaseOrderReceived {
+			order.ReceivedAt = &movement.CreatedAt
+			updates["received_at"] = movement.CreatedAt
+		}
+		if err := tx.Model(order).Updates(updates).Error; err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		if status == http.StatusOK || status == http.StatusInternalServerError {
+			logger.WithField("error", err.Error()).Error(err.Error())
+			status = http.StatusInternalServerError
+		}
+		return status, nil, nil, err
+	}
+	return http.StatusOK, item, order, nil
+}
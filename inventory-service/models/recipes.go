@@ -0,0 +1,123 @@
+package models
+
+import (
+	"inventory-service/errors"
+	"net/http"
+
+	logger "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// RecipeLine is one ingredient of a dish: how much of the ingredient, in the base unit of
+// the ingredient's unit, goes into a single portion. A dish with a recipe is made to order, so ordering
+// it lowers the stock of its ingredients rather than its own.
+type RecipeLine struct {
+	ID           uint `gorm:"primaryKey; column:id; autoIncrement; not null"`
+	DishID       uint `gorm:"column:dish_id; index; not null"`
+	IngredientID uint `gorm:"column:ingredient_id; index; not null"`
+	Quantity     uint `gorm:"column:quantity; not null"`
+}
+
+// ReplaceRecipe swaps the dish's recipe for the given lines. No lines removes the recipe.
+func ReplaceRecipe(dishID uint, lines []*RecipeLine) (uint32, []*RecipeLine, error) {
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("dish_id = ?", dishID).Delete(&RecipeLine{}).Error; err != nil {
+			return err
+		}
+		for _, line := range lines {
+			line.ID = 0
+			line.DishID = dishID
+		}
+		if len(lines) == 0 {
+			return nil
+		}
+		return tx.Create(&lines).Error
+	})
+	if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, lines, nil
+}
+
+// GetRecipeLines returns the recipe lines of the given dishes, or of every dish when dishIDs is nil.
+func GetRecipeLines(dishIDs []uint) (uint32, []*RecipeLine, error) {
+	lines := []*RecipeLine{}
+	query := db.Order("dish_id, id")
+	if dishIDs != nil {
+		query = query.Where("dish_id IN ?", dishIDs)
+	}
+	if err := query.Find(&lines).Error; err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, lines, nil
+}
+
+// IsIngredient reports whether the item is used in any dish's recipe.
+func IsIngredient(itemID uint) (uint32, bool, error) {
+	var count int64
+	if err := db.Model(&RecipeLine{}).Where("ingredient_id = ?", itemID).Count(&count).Error; err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, false, err
+	}
+	return http.StatusOK, count > 0, nil
+}
+
+// ApplyRecipeMovement lowers the stock of each of the dish's ingredients by the recipe
+// quantity times the portions in movement.Delta, along with any selected options that
+// track stock, all in one transaction. Each ingredient gets its own ledger entry carrying
+// the movement's reason, reference and location. The dish's own stock is left alone.
+func ApplyRecipeMovement(movement *StockMovement, lines []*RecipeLine, options []*Option) (uint32, *Item, []*Item, error) {
+	dish := &Item{}
+	ingredients := []*Item{}
+	status := uint32(http.StatusOK)
+	err := db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("id = ?", movement.ItemID).First(dish).Error
+		if err == gorm.ErrRecordNotFound {
+			status = http.StatusNotFound
+			return errors.ErrItemNotFound
+		} else if err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+
+		status, ingredients, err = applyRecipeLines(tx, movement, lines)
+		if err != nil {
+			return err
+		}
+
+		status, err = lowerOptionStock(tx, options, movement.Delta)
+		return err
+	})
+	if err != nil {
+		if status == http.StatusOK || status == http.StatusInternalServerError {
+			logger.WithField("error", err.Error()).Error(err.Error())
+			status = http.StatusInternalServerError
+		}
+		return status, nil, nil, err
+	}
+	return http.StatusOK, dish, ingredients, nil
+}
+
+// applyRecipeLines records a movement of each ingredient for movement.Delta portions of the dish.
+func applyRecipeLines(tx *gorm.DB, movement *StockMovement, lines []*RecipeLine) (uint32, []*Item, error) {
+	ingredients := []*Item{}
+	for _, line := range lines {
+		ingredient := &Item{}
+		status, err := applyStockMovement(tx, ingredient, &StockMovement{
+			ItemID:     line.IngredientID,
+			LocationID: movement.LocationID,
+			Delta:      movement.Delta * int64(line.Quantity),
+			Reason:     movement.Reason,
+			Reference:  movement.Reference,
+			Actor:      movement.Actor,
+			CreatedAt:  movement.CreatedAt,
+		})
+		if err != nil {
+			return status, nil, err
+		}
+		ingredients = append(ingredients, ingredient)
+	}
+	return http.StatusOK, ingredients, nil
+}
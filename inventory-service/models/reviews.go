@@ -0,0 +1,157 @@
+package models
+
+import (
+	"inventory-service/errors"
+	"net/http"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const (
+	ReviewPending  = "PENDING"
+	ReviewApproved = "APPROVED"
+	ReviewRejected = "REJECTED"
+)
+
+// Review is a customer's rating of an item, from 1 to 5, with what they had to say about it.
+// Reviews wait for an admin to approve them before they are shown or count towards the
+// item's rating, and each customer can review an item once.
+type Review struct {
+	ID          uint           `gorm:"primaryKey; column:id; autoIncrement; not null"`
+	ItemID      uint           `gorm:"column:item_id; uniqueIndex:idx_reviews_item_user; not null"`
+	UserID      uint           `gorm:"column:user_id; uniqueIndex:idx_reviews_item_user; not null"`
+	Rating      uint           `gorm:"column:rating; not null"`
+	Text        string         `gorm:"column:text; not null; default:''"`
+	Status      string         `gorm:"column:status; index; not null; default:PENDING"`
+	ModeratedBy string         `gorm:"column:moderated_by"`
+	ModeratedAt *time.Time     `gorm:"column:moderated_at"`
+	Photos      []*ReviewPhoto `gorm:"foreignKey:ReviewID"`
+	CreatedAt   time.Time      `gorm:"column:created_at"`
+}
+
+// ReviewPhoto is a picture added to a review. Like item images, the picture and its
+// thumbnails are kept in the blob store and only their details are here.
+type ReviewPhoto struct {
+	ID          uint      `gorm:"primaryKey; column:id; autoIncrement; not null"`
+	ReviewID    uint      `gorm:"column:review_id; index; not null"`
+	ContentType string    `gorm:"column:content_type; not null"`
+	Width       uint      `gorm:"column:width; not null"`
+	Height      uint      `gorm:"column:height; not null"`
+	Size        uint      `gorm:"column:size; not null"`
+	CreatedAt   time.Time `gorm:"column:created_at"`
+}
+
+// Rating is the average of an item's approved reviews and how many there are.
+type Rating struct {
+	ItemID  uint
+	Average float64
+	Count   uint
+}
+
+// CreateReview adds a review, without its photos, to an item that is in the catalog.
+func CreateReview(review *Review) (uint32, *Review, error) {
+	status, _, err := GetItem(review.ItemID)
+	if err != nil {
+		return status, nil, err
+	}
+	err = db.Omit("Photos").Create(review).Error
+	if isUniqueViolation(err, "user") {
+		return http.StatusConflict, nil, errors.ErrReviewExists
+	} else if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusCreated, review, nil
+}
+
+func CreateReviewPhoto(photo *ReviewPhoto) (uint32, *ReviewPhoto, error) {
+	if err := db.Create(photo).Error; err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusCreated, photo, nil
+}
+
+// DeleteReview removes a review and its photos, returning the photos so that what was
+// stored of them can be dropped too.
+func DeleteReview(id uint) (uint32, []*ReviewPhoto, error) {
+	photos := []*ReviewPhoto{}
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("review_id = ?", id).Find(&photos).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("review_id = ?", id).Delete(&ReviewPhoto{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&Review{}, id).Error
+	})
+	if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, photos, nil
+}
+
+func GetReview(id uint) (uint32, *Review, error) {
+	review := &Review{}
+	err := db.Preload("Photos").Where("id = ?", id).First(review).Error
+	if err == gorm.ErrRecordNotFound {
+		return http.StatusNotFound, nil, errors.ErrReviewNotFound
+	} else if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, review, nil
+}
+
+// GetReviews returns the reviews in the given state, of a single item when itemID is
+// non-zero, newest first.
+func GetReviews(itemID uint, status string) (uint32, []*Review, error) {
+	reviews := []*Review{}
+	query := db.Preload("Photos", func(tx *gorm.DB) *gorm.DB {
+		return tx.Order("id")
+	}).Where("status = ?", status)
+	if itemID != 0 {
+		query = query.Where("item_id = ?", itemID)
+	}
+	if err := query.Order("created_at DESC, id DESC").Find(&reviews).Error; err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, reviews, nil
+}
+
+// SetReviewStatus records an admin's decision on a review.
+func SetReviewStatus(id uint, status string, actor string) (uint32, *Review, error) {
+	now := time.Now()
+	result := db.Model(&Review{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status":       status,
+		"moderated_by": actor,
+		"moderated_at": now,
+	})
+	if result.Error != nil {
+		logger.WithField("error", result.Error.Error()).Error(result.Error.Error())
+		return http.StatusInternalServerError, nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return http.StatusNotFound, nil, errors.ErrReviewNotFound
+	}
+	return GetReview(id)
+}
+
+// GetRatings returns the ratings of those of the given items that have approved reviews.
+func GetRatings(itemIDs []uint) (uint32, []*Rating, error) {
+	ratings := []*Rating{}
+	err := db.Model(&Review{}).
+		Select("item_id, AVG(rating) AS average, COUNT(*) AS count").
+		Where("item_id IN ? AND status = ?", itemIDs, ReviewApproved).
+		Group("item_id").
+		Scan(&ratings).Error
+	if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, ratings, nil
+}
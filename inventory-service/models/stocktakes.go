@@ -0,0 +1,349 @@
+package models
+
+import (
+	"fmt"
+	"inventory-service/errors"
+	"net/http"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	StockTakeOpen      = "open"
+	StockTakeApproved  = "approved"
+	StockTakeCancelled = "cancelled"
+)
+
+// StockTake is a physical count of stock. A stock-take with a LocationID covers that location
+// only, and one without covers them all. While a stock-take is open, corrections and transfers
+// at the locations it covers are refused so the count is not adjusted under the counters' feet;
+// orders and deliveries carry on as usual.
+type StockTake struct {
+	ID         uint          `gorm:"primaryKey; column:id; autoIncrement; not null"`
+	LocationID uint          `gorm:"column:location_id; index; not null; default:0"`
+	Status     string        `gorm:"column:status; index; not null"`
+	Reference  string        `gorm:"column:reference"`
+	Actor      string        `gorm:"column:actor"`
+	CreatedAt  time.Time     `gorm:"column:created_at; not null"`
+	ClosedAt   *time.Time    `gorm:"column:closed_at"`
+	ClosedBy   string        `gorm:"column:closed_by"`
+	Counts     []*StockCount `gorm:"foreignKey:StockTakeID"`
+}
+
+// StockCount is the quantity of an item counted at a location during a stock-take, next to
+// the quantity the system held there when the count was recorded. The variance between the
+// two is what approving the stock-take applies, so stock sold or received after the count
+// does not distort it.
+type StockCount struct {
+	ID          uint      `gorm:"primaryKey; column:id; autoIncrement; not null"`
+	StockTakeID uint      `gorm:"column:stock_take_id; uniqueIndex:idx_stock_count; not null"`
+	ItemID      uint      `gorm:"column:item_id; uniqueIndex:idx_stock_count; not null"`
+	LocationID  uint      `gorm:"column:location_id; uniqueIndex:idx_stock_count; not null"`
+	Counted     uint      `gorm:"column:counted; not null"`
+	Expected    uint      `gorm:"column:expected; not null"`
+	CountedBy   string    `gorm:"column:counted_by"`
+	CountedAt   time.Time `gorm:"column:counted_at; not null"`
+}
+
+// StockTakeReference is the reference the adjustments of a stock-take carry in the ledger.
+func StockTakeReference(id uint) string {
+	return fmt.Sprintf("stock-take %d", id)
+}
+
+// Variance is how far the count was above (positive) or below (negative) the system quantity.
+func (count *StockCount) Variance() int64 {
+	return int64(count.Counted) - int64(count.Expected)
+}
+
+// openStockTakeCovering finds an open stock-take that covers the location, or one that covers
+// every location when locationID is 0.
+func openStockTakeCovering(tx *gorm.DB, locationID uint) (*StockTake, error) {
+	take := &StockTake{}
+	query := tx.Where("status = ?", StockTakeOpen)
+	if locationID != 0 {
+		query = query.Where("location_id = 0 OR location_id = ?", locationID)
+	}
+	err := query.First(take).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return take, nil
+}
+
+// checkNoStockTake refuses a correction or transfer at a location that is being counted.
+func checkNoStockTake(tx *gorm.DB, movement *StockMovement) (uint32, error) {
+	if movement.Reason != ReasonCorrection && movement.Reason != ReasonTransfer {
+		return http.StatusOK, nil
+	}
+	take, err := openStockTakeCovering(tx, movement.LocationID)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	if take != nil {
+		return http.StatusConflict, errors.ErrStockTakeInProgress
+	}
+	return http.StatusOK, nil
+}
+
+// OpenStockTake starts a stock-take, refusing to when another open one covers any of the same
+// locations.
+func OpenStockTake(take *StockTake) (uint32, *StockTake, error) {
+	take.Status = StockTakeOpen
+	if take.CreatedAt.IsZero() {
+		take.CreatedAt = time.Now()
+	}
+	status := uint32(http.StatusCreated)
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if take.LocationID != 0 {
+			err := tx.Where("id = ?", take.LocationID).First(&Location{}).Error
+			if err == gorm.ErrRecordNotFound {
+				status = http.StatusNotFound
+				return errors.ErrLocationNotFound
+			} else if err != nil {
+				status = http.StatusInternalServerError
+				return err
+			}
+		}
+		open, err := openStockTakeCovering(tx, take.LocationID)
+		if err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+		if open != nil {
+			status = http.StatusConflict
+			return errors.ErrStockTakeInProgress
+		}
+		if err := tx.Create(take).Error; err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		if status == http.StatusInternalServerError {
+			logger.WithField("error", err.Error()).Error(err.Error())
+		}
+		return status, nil, err
+	}
+	return http.StatusCreated, take, nil
+}
+
+func GetStockTake(id uint) (uint32, *StockTake, error) {
+	take := &StockTake{}
+	err := db.Preload("Counts", func(tx *gorm.DB) *gorm.DB { return tx.Order("item_id, location_id") }).Where("id = ?", id).First(take).Error
+	if err == gorm.ErrRecordNotFound {
+		return http.StatusNotFound, nil, errors.ErrStockTakeNotFound
+	} else if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, take, nil
+}
+
+// GetStockTakes returns stock-takes newest first, narrowed to a status when one is given.
+func GetStockTakes(status string) (uint32, []*StockTake, error) {
+	takes := []*StockTake{}
+	query := db.Order("id DESC")
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if err := query.Find(&takes).Error; err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, takes, nil
+}
+
+// RecordStockCount stores the quantity counted of an item at a location in an open stock-take
+// along with the system quantity there at that moment, replacing any earlier count of the
+// same item and location. A count without a location is for the stock-take's location, or the
+// default location when the stock-take covers them all.
+func RecordStockCount(count *StockCount) (uint32, *StockCount, error) {
+	if count.CountedAt.IsZero() {
+		count.CountedAt = time.Now()
+	}
+	status := uint32(http.StatusOK)
+	err := db.Transaction(func(tx *gorm.DB) error {
+		take := &StockTake{}
+		err := tx.Where("id = ?", count.StockTakeID).First(take).Error
+		if err == gorm.ErrRecordNotFound {
+			status = http.StatusNotFound
+			return errors.ErrStockTakeNotFound
+		} else if err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+		if take.Status != StockTakeOpen {
+			status = http.StatusConflict
+			return errors.ErrStockTakeClosed
+		}
+
+		if count.LocationID == 0 {
+			count.LocationID = take.LocationID
+		}
+		if count.LocationID == 0 {
+			count.LocationID = defaultLocationID
+		}
+		if take.LocationID != 0 && count.LocationID != take.LocationID {
+			status = http.StatusBadRequest
+			return errors.ErrInvalidLocation
+		}
+		err = tx.Where("id = ?", count.LocationID).First(&Location{}).Error
+		if err == gorm.ErrRecordNotFound {
+			status = http.StatusNotFound
+			return errors.ErrLocationNotFound
+		} else if err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+		err = tx.Where("id = ?", count.ItemID).First(&Item{}).Error
+		if err == gorm.ErrRecordNotFound {
+			status = http.StatusNotFound
+			return errors.ErrItemNotFound
+		} else if err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+
+		level := &LocationStock{}
+		err = tx.Where("item_id = ? AND location_id = ?", count.ItemID, count.LocationID).First(level).Error
+		if err != nil && err != gorm.ErrRecordNotFound {
+			status = http.StatusInternalServerError
+			return err
+		}
+		count.Expected = level.Quantity
+
+		count.ID = 0
+		err = tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "stock_take_id"}, {Name: "item_id"}, {Name: "location_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"counted", "expected", "counted_by", "counted_at"}),
+		}).Create(count).Error
+		if err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		if status == http.StatusOK || status == http.StatusInternalServerError {
+			logger.WithField("error", err.Error()).Error(err.Error())
+			status = http.StatusInternalServerError
+		}
+		return status, nil, err
+	}
+	return http.StatusOK, count, nil
+}
+
+// ApproveStockTake closes an open stock-take and applies the variance of each count as a
+// STOCK_TAKE movement, all in one transaction. An adjustment that would take stock below zero,
+// because more has been sold since the count than was found, stops at zero. It returns the
+// adjusted items with the movements applied to them.
+func ApproveStockTake(id uint, actor string, at time.Time) (uint32, *StockTake, []*Item, []*StockMovement, error) {
+	take := &StockTake{}
+	items := []*Item{}
+	movements := []*StockMovement{}
+	status := uint32(http.StatusOK)
+	err := db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Preload("Counts", func(tx *gorm.DB) *gorm.DB { return tx.Order("item_id, location_id") }).Where("id = ?", id).First(take).Error
+		if err == gorm.ErrRecordNotFound {
+			status = http.StatusNotFound
+			return errors.ErrStockTakeNotFound
+		} else if err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+		if take.Status != StockTakeOpen {
+			status = http.StatusConflict
+			return errors.ErrStockTakeClosed
+		}
+
+		for _, count := range take.Counts {
+			delta := count.Variance()
+			if delta == 0 {
+				continue
+			}
+			if delta < 0 {
+				level := &LocationStock{}
+				err := tx.Where("item_id = ? AND location_id = ?", count.ItemID, count.LocationID).First(level).Error
+				if err != nil && err != gorm.ErrRecordNotFound {
+					status = http.StatusInternalServerError
+					return err
+				}
+				if int64(level.Quantity)+delta < 0 {
+					delta = -int64(level.Quantity)
+				}
+				if delta == 0 {
+					continue
+				}
+			}
+
+			item := &Item{}
+			movement := &StockMovement{
+				ItemID:     count.ItemID,
+				LocationID: count.LocationID,
+				Delta:      delta,
+				Reason:     ReasonStockTake,
+				Reference:  StockTakeReference(take.ID),
+				Actor:      actor,
+				CreatedAt:  at,
+			}
+			status, err = applyStockMovement(tx, item, movement)
+			if err != nil {
+				return err
+			}
+			items = append(items, item)
+			movements = append(movements, movement)
+		}
+
+		return closeStockTake(tx, take, StockTakeApproved, actor, at)
+	})
+	if err != nil {
+		if status == http.StatusOK || status == http.StatusInternalServerError {
+			logger.WithField("error", err.Error()).Error(err.Error())
+			status = http.StatusInternalServerError
+		}
+		return status, nil, nil, nil, err
+	}
+	return http.StatusOK, take, items, movements, nil
+}
+
+// CancelStockTake closes an open stock-take without touching stock.
+func CancelStockTake(id uint, actor string, at time.Time) (uint32, *StockTake, error) {
+	take := &StockTake{}
+	status := uint32(http.StatusOK)
+	err := db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("id = ?", id).First(take).Error
+		if err == gorm.ErrRecordNotFound {
+			status = http.StatusNotFound
+			return errors.ErrStockTakeNotFound
+		} else if err != nil {
+			status = http.StatusInternalServerError
+			return err
+		}
+		if take.Status != StockTakeOpen {
+			status = http.StatusConflict
+			return errors.ErrStockTakeClosed
+		}
+		return closeStockTake(tx, take, StockTakeCancelled, actor, at)
+	})
+	if err != nil {
+		if status == http.StatusOK || status == http.StatusInternalServerError {
+			logger.WithField("error", err.Error()).Error(err.Error())
+			status = http.StatusInternalServerError
+		}
+		return status, nil, err
+	}
+	return http.StatusOK, take, nil
+}
+
+func closeStockTake(tx *gorm.DB, take *StockTake, status string, actor string, at time.Time) error {
+	take.Status = status
+	take.ClosedAt = &at
+	take.ClosedBy = actor
+	return tx.Model(take).Updates(map[string]interface{}{"status": status, "closed_at": at, "closed_by": actor}).Error
+}
@@ -0,0 +1,176 @@
+package models
+
+import (
+	"inventory-service/errors"
+	"net/http"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+	"gorm.io/gorm/clause"
+)
+
+// ItemTranslation is an item's name and description in a locale other than the default. An
+// empty description leaves the item's own in place.
+type ItemTranslation struct {
+	ID          uint      `gorm:"primaryKey; column:id; autoIncrement; not null"`
+	ItemID      uint      `gorm:"column:item_id; uniqueIndex:idx_item_translations_item_locale; not null"`
+	Locale      string    `gorm:"column:locale; uniqueIndex:idx_item_translations_item_locale; not null"`
+	Name        string    `gorm:"column:name; not null"`
+	Description string    `gorm:"column:description; not null; default:''"`
+	UpdatedBy   string    `gorm:"column:updated_by"`
+	UpdatedAt   time.Time `gorm:"column:updated_at"`
+}
+
+// CategoryTranslation is the name of a category in a locale other than the default.
+// Categories are only text on items, so translations are kept by that text.
+type CategoryTranslation struct {
+	ID        uint      `gorm:"primaryKey; column:id; autoIncrement; not null"`
+	Category  string    `gorm:"column:category; uniqueIndex:idx_category_translations_category_locale; not null"`
+	Locale    string    `gorm:"column:locale; uniqueIndex:idx_category_translations_category_locale; not null"`
+	Name      string    `gorm:"column:name; not null"`
+	UpdatedBy string    `gorm:"column:updated_by"`
+	UpdatedAt time.Time `gorm:"column:updated_at"`
+}
+
+// SaveItemTranslation adds the item's translation into its locale, or replaces the one there is.
+func SaveItemTranslation(translation *ItemTranslation) (uint32, *ItemTranslation, error) {
+	err := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "item_id"}, {Name: "locale"}},
+		DoUpdates: clause.AssignmentColumns([]string{"name", "description", "updated_by", "updated_at"}),
+	}).Create(translation).Error
+	if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	saved := &ItemTranslation{}
+	if err := db.Where("item_id = ? AND locale = ?", translation.ItemID, translation.Locale).First(saved).Error; err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, saved, nil
+}
+
+func DeleteItemTranslation(itemID uint, locale string) (uint32, error) {
+	result := db.Where("item_id = ? AND locale = ?", itemID, locale).Delete(&ItemTranslation{})
+	if result.Error != nil {
+		logger.WithField("error", result.Error.Error()).Error(result.Error.Error())
+		return http.StatusInternalServerError, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return http.StatusNotFound, errors.ErrTranslationNotFound
+	}
+	return http.StatusOK, nil
+}
+
+// GetItemTranslations returns the translations of the items into any of the locales, by
+// item and then locale.
+func GetItemTranslations(itemIDs []uint, locales []string) (uint32, map[uint]map[string]*ItemTranslation, error) {
+	translations := map[uint]map[string]*ItemTranslation{}
+	if len(itemIDs) == 0 || len(locales) == 0 {
+		return http.StatusOK, translations, nil
+	}
+	found := []*ItemTranslation{}
+	err := db.Where("item_id IN ? AND locale IN ?", itemIDs, locales).Find(&found).Error
+	if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	for _, translation := range found {
+		if translations[translation.ItemID] == nil {
+			translations[translation.ItemID] = map[string]*ItemTranslation{}
+		}
+		translations[translation.ItemID][translation.Locale] = translation
+	}
+	return http.StatusOK, translations, nil
+}
+
+// ListItemTranslations returns every translation of the item, by locale.
+func ListItemTranslations(itemID uint) (uint32, []*ItemTranslation, error) {
+	translations := []*ItemTranslation{}
+	err := db.Where("item_id = ?", itemID).Order("locale").Find(&translations).Error
+	if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, translations, nil
+}
+
+// SaveCategoryTranslation adds the category's translation into its locale, or replaces the
+// one there is.
+func SaveCategoryTranslation(translation *CategoryTranslation) (uint32, *CategoryTranslation, error) {
+	err := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "category"}, {Name: "locale"}},
+		DoUpdates: clause.AssignmentColumns([]string{"name", "updated_by", "updated_at"}),
+	}).Create(translation).Error
+	if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	saved := &CategoryTranslation{}
+	if err := db.Where("category = ? AND locale = ?", translation.Category, translation.Locale).First(saved).Error; err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, saved, nil
+}
+
+func DeleteCategoryTranslation(category string, locale string) (uint32, error) {
+	result := db.Where("category = ? AND locale = ?", category, locale).Delete(&CategoryTranslation{})
+	if result.Error != nil {
+		logger.WithField("error", result.Error.Error()).Error(result.Error.Error())
+		return http.StatusInternalServerError, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return http.StatusNotFound, errors.ErrTranslationNotFound
+	}
+	return http.StatusOK, nil
+}
+
+// GetCategoryTranslations returns the translations of the categories into any of the
+// locales, by category and then locale.
+func GetCategoryTranslations(categories []string, locales []string) (uint32, map[string]map[string]*CategoryTranslation, error) {
+	translations := map[string]map[string]*CategoryTranslation{}
+	if len(categories) == 0 || len(locales) == 0 {
+		return http.StatusOK, translations, nil
+	}
+	found := []*CategoryTranslation{}
+	err := db.Where("category IN ? AND locale IN ?", categories, locales).Find(&found).Error
+	if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	for _, translation := range found {
+		if translations[translation.Category] == nil {
+			translations[translation.Category] = map[string]*CategoryTranslation{}
+		}
+		translations[translation.Category][translation.Locale] = translation
+	}
+	return http.StatusOK, translations, nil
+}
+
+// ListCategoryTranslations returns the translations of the category, or of every category
+// when none is given, by category and locale.
+func ListCategoryTranslations(category string) (uint32, []*CategoryTranslation, error) {
+	translations := []*CategoryTranslation{}
+	query := db.Order("category").Order("locale")
+	if category != "" {
+		query = query.Where("category = ?", category)
+	}
+	if err := query.Find(&translations).Error; err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, translations, nil
+}
+
+// ListCategories returns the categories items in the catalog are in, leaving out items
+// without one.
+func ListCategories() (uint32, []string, error) {
+	categories := []string{}
+	err := db.Model(&Item{}).Where("category <> ''").Distinct().Order("category").Pluck("category", &categories).Error
+	if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, categories, nil
+}
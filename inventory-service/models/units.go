@@ -0,0 +1,62 @@
+package models
+
+import (
+	"inventory-service/units"
+
+	"gorm.io/gorm"
+)
+
+// unitQuantityColumns are the stored quantities of an item, by the column naming the item.
+var unitQuantityColumns = []struct {
+	model   interface{}
+	itemKey string
+	columns []string
+}{
+	{&LocationStock{}, "item_id", []string{"quantity"}},
+	{&Lot{}, "item_id", []string{"received", "quantity"}},
+	{&StockMovement{}, "item_id", []string{"delta", "balance"}},
+	{&RecipeLine{}, "ingredient_id", []string{"quantity"}},
+	{&BundleComponent{}, "component_id", []string{"quantity"}},
+	{&PurchaseOrderLine{}, "item_id", []string{"quantity", "received"}},
+	{&StockCount{}, "item_id", []string{"counted", "expected"}},
+	{&InventoryEvent{}, "item_id", []string{"quantity"}},
+	{&Item{}, "id", []string{"quantity", "reorder_level", "reorder_quantity"}},
+}
+
+// migrateUnitQuantities moves the stock of items created before units of measure, when an
+// item's unit was free text and its stock a whole number of it, into base units. Units
+// that are not known become each, and the stock of an item counted in kg, l or dozens is
+// multiplied out into grams, millilitres or pieces.
+func migrateUnitQuantities() error {
+	var names []string
+	if err := db.Unscoped().Model(&Item{}).Distinct().Pluck("unit", &names).Error; err != nil {
+		return err
+	}
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, name := range names {
+			unit, err := units.Lookup(name)
+			if err != nil {
+				unit, _ = units.Lookup(units.Default)
+			}
+			if unit.Size > 1 {
+				for _, stored := range unitQuantityColumns {
+					updates := map[string]interface{}{}
+					for _, column := range stored.columns {
+						updates[column] = gorm.Expr(column+" * ?", unit.Size)
+					}
+					ids := tx.Unscoped().Model(&Item{}).Select("id").Where("unit = ?", name)
+					err := tx.Unscoped().Model(stored.model).Where(stored.itemKey+" IN (?)", ids).UpdateColumns(updates).Error
+					if err != nil {
+						return err
+					}
+				}
+			}
+			if unit.Name != name {
+				if err := tx.Unscoped().Model(&Item{}).Where("unit = ?", name).UpdateColumn("unit", unit.Name).Error; err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,70 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// legacyUnitItem is the items table as it was while units were free text.
+type legacyUnitItem struct {
+	gorm.Model
+	Name         string  `gorm:"column:name; unique; not null"`
+	Description  string  `gorm:"column:description; not null"`
+	Quantity     uint    `gorm:"column:quantity; not null"`
+	ReorderLevel uint    `gorm:"column:reorder_level; not null; default:0"`
+	SKU          *string `gorm:"column:sku; uniqueIndex"`
+	Unit         string  `gorm:"column:unit; not null; default:each"`
+}
+
+func (legacyUnitItem) TableName() string {
+	return "items"
+}
+
+func TestMigrateUnitQuantities(t *testing.T) {
+	database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	assert.NoError(t, err)
+	defer func() {
+		sql, _ := database.DB()
+		sql.Close()
+	}()
+
+	assert.NoError(t, database.AutoMigrate(&legacyUnitItem{}, &StockMovement{}))
+	legacy := []*legacyUnitItem{
+		{Name: "flour", Description: "flour.desc", Quantity: 3, ReorderLevel: 1, Unit: "KG"},
+		{Name: "ghee", Description: "ghee.desc", Quantity: 500, Unit: "g"},
+		{Name: "samosa", Description: "samosa.desc", Quantity: 4, Unit: "portion"},
+	}
+	assert.NoError(t, database.Create(&legacy).Error)
+	assert.NoError(t, database.Create(&StockMovement{ItemID: legacy[0].ID, Delta: 3, Balance: 3, Reason: ReasonInitialStock}).Error)
+
+	InitInventoryModels(database)
+
+	_, flour, err := GetItem(legacy[0].ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "kg", flour.Unit)
+	assert.Equal(t, uint(3000), flour.Quantity)
+	assert.Equal(t, uint(1000), flour.ReorderLevel)
+	_, movements, _, err := GetStockMovements(flour.ID, 1, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3000), movements[0].Delta)
+	assert.Equal(t, uint(3000), movements[0].Balance)
+
+	_, ghee, err := GetItem(legacy[1].ID)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(500), ghee.Quantity)
+
+	_, samosa, err := GetItem(legacy[2].ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "each", samosa.Unit)
+	assert.Equal(t, uint(4), samosa.Quantity)
+	assert.Equal(t, "each", samosa.SellingUnit())
+
+	// a database that already has sale units is left alone
+	InitInventoryModels(database)
+	_, flour, err = GetItem(legacy[0].ID)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(3000), flour.Quantity)
+}
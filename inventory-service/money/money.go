@@ -0,0 +1,149 @@
+package money
+
+import (
+	"inventory-service/errors"
+	"strconv"
+	"strings"
+)
+
+// Money is an exact amount: a whole number of the currency's minor units (cents for EUR,
+// yen for JPY) and the currency's ISO 4217 code. Stored in a model it is embedded with a
+// prefix, so a Price is kept in price_minor_units and price_currency.
+type Money struct {
+	MinorUnits int64  `gorm:"column:minor_units; not null; default:0"`
+	Currency   string `gorm:"column:currency; not null; default:''"`
+}
+
+// exponents is the number of minor-unit digits of each supported currency.
+var exponents = map[string]int{
+	"AUD": 2,
+	"BHD": 3,
+	"CAD": 2,
+	"CHF": 2,
+	"CNY": 2,
+	"CZK": 2,
+	"DKK": 2,
+	"EUR": 2,
+	"GBP": 2,
+	"HUF": 2,
+	"INR": 2,
+	"JPY": 0,
+	"KRW": 0,
+	"KWD": 3,
+	"NOK": 2,
+	"NZD": 2,
+	"PLN": 2,
+	"SEK": 2,
+	"USD": 2,
+}
+
+var defaultCurrency = "EUR"
+
+// SetDefaultCurrency changes the currency amounts given without one are taken to be in.
+func SetDefaultCurrency(currency string) error {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if _, ok := exponents[currency]; !ok {
+		return errors.ErrInvalidCurrency
+	}
+	defaultCurrency = currency
+	return nil
+}
+
+func DefaultCurrency() string {
+	return defaultCurrency
+}
+
+// New returns minorUnits of the currency, or of the default currency when none is given.
+func New(minorUnits int64, currency string) Money {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" {
+		currency = defaultCurrency
+	}
+	return Money{MinorUnits: minorUnits, Currency: currency}
+}
+
+// Exponent returns the number of minor-unit digits of the currency.
+func Exponent(currency string) (int, bool) {
+	exponent, ok := exponents[currency]
+	return exponent, ok
+}
+
+// Valid reports whether the amount is in a supported currency.
+func (m Money) Valid() bool {
+	_, ok := exponents[m.Currency]
+	return ok
+}
+
+// Add returns the sum of two amounts in the same currency. The zero Money has no currency
+// and adds to an amount in any currency, so it can start a total.
+func (m Money) Add(other Money) (Money, error) {
+	if m == (Money{}) {
+		return other, nil
+	}
+	if other == (Money{}) {
+		return m, nil
+	}
+	if m.Currency != other.Currency {
+		return Money{}, errors.ErrCurrencyMismatch
+	}
+	return Money{MinorUnits: m.MinorUnits + other.MinorUnits, Currency: m.Currency}, nil
+}
+
+// Times returns the amount multiplied by a whole number, such as a quantity.
+func (m Money) Times(n int64) Money {
+	return Money{MinorUnits: m.MinorUnits * n, Currency: m.Currency}
+}
+
+// Parse reads a decimal amount in major units, such as "12.50", without going through
+// floating point. It may not carry more decimals than the currency has minor units.
+func Parse(value string, currency string) (Money, error) {
+	amount := New(0, currency)
+	exponent, ok := exponents[amount.Currency]
+	if !ok {
+		return Money{}, errors.ErrInvalidCurrency
+	}
+
+	value = strings.TrimSpace(value)
+	negative := strings.HasPrefix(value, "-")
+	value = strings.TrimPrefix(value, "-")
+	whole, fraction, _ := strings.Cut(value, ".")
+	if whole == "" && fraction == "" || len(fraction) > exponent {
+		return Money{}, errors.ErrInvalidAmount
+	}
+	fraction += strings.Repeat("0", exponent-len(fraction))
+
+	digits := whole + fraction
+	for _, digit := range digits {
+		if digit < '0' || digit > '9' {
+			return Money{}, errors.ErrInvalidAmount
+		}
+	}
+	minorUnits, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return Money{}, errors.ErrInvalidAmount
+	}
+	if negative {
+		minorUnits = -minorUnits
+	}
+	amount.MinorUnits = minorUnits
+	return amount, nil
+}
+
+// String formats the amount in major units, such as "12.50", the form Parse reads.
+func (m Money) String() string {
+	exponent := exponents[m.Currency]
+	minorUnits := m.MinorUnits
+	sign := ""
+	if minorUnits < 0 {
+		sign = "-"
+		minorUnits = -minorUnits
+	}
+	digits := strconv.FormatInt(minorUnits, 10)
+	if exponent == 0 {
+		return sign + digits
+	}
+	if len(digits) <= exponent {
+		digits = strings.Repeat("0", exponent-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-exponent] + "." + digits[len(digits)-exponent:]
+}
@@ -0,0 +1,68 @@
+package money
+
+import (
+	"inventory-service/errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	amount, err := Parse("12.5", "eur")
+	assert.NoError(t, err)
+	assert.Equal(t, Money{MinorUnits: 1250, Currency: "EUR"}, amount)
+
+	amount, err = Parse("0.10", "")
+	assert.NoError(t, err)
+	assert.Equal(t, Money{MinorUnits: 10, Currency: "EUR"}, amount)
+
+	amount, err = Parse("-3", "JPY")
+	assert.NoError(t, err)
+	assert.Equal(t, Money{MinorUnits: -3, Currency: "JPY"}, amount)
+
+	_, err = Parse("1.005", "EUR")
+	assert.ErrorIs(t, err, errors.ErrInvalidAmount)
+
+	_, err = Parse("1.5", "JPY")
+	assert.ErrorIs(t, err, errors.ErrInvalidAmount)
+
+	_, err = Parse("12,50", "EUR")
+	assert.ErrorIs(t, err, errors.ErrInvalidAmount)
+
+	_, err = Parse("1", "XXX")
+	assert.ErrorIs(t, err, errors.ErrInvalidCurrency)
+}
+
+func TestString(t *testing.T) {
+	assert.Equal(t, "12.50", Money{MinorUnits: 1250, Currency: "EUR"}.String())
+	assert.Equal(t, "0.05", Money{MinorUnits: 5, Currency: "EUR"}.String())
+	assert.Equal(t, "-0.05", Money{MinorUnits: -5, Currency: "EUR"}.String())
+	assert.Equal(t, "1.250", Money{MinorUnits: 1250, Currency: "KWD"}.String())
+	assert.Equal(t, "500", Money{MinorUnits: 500, Currency: "JPY"}.String())
+}
+
+func TestAdd(t *testing.T) {
+	total, err := Money{}.Add(Money{MinorUnits: 10, Currency: "EUR"})
+	assert.NoError(t, err)
+
+	// ten cents added thirty times is exactly three euros, which float32 never was
+	for i := 0; i < 29; i++ {
+		total, err = total.Add(Money{MinorUnits: 10, Currency: "EUR"})
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, Money{MinorUnits: 300, Currency: "EUR"}, total)
+	assert.Equal(t, total, Money{MinorUnits: 10, Currency: "EUR"}.Times(30))
+
+	_, err = total.Add(Money{MinorUnits: 10, Currency: "USD"})
+	assert.ErrorIs(t, err, errors.ErrCurrencyMismatch)
+}
+
+func TestSetDefaultCurrency(t *testing.T) {
+	defer SetDefaultCurrency("EUR")
+
+	assert.ErrorIs(t, SetDefaultCurrency("XXX"), errors.ErrInvalidCurrency)
+	assert.Equal(t, "EUR", DefaultCurrency())
+
+	assert.NoError(t, SetDefaultCurrency("usd"))
+	assert.Equal(t, Money{MinorUnits: 1, Currency: "USD"}, New(1, ""))
+}
@@ -0,0 +1,83 @@
+package notifier
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+const (
+	SinkLog  = "log"
+	SinkFile = "file"
+)
+
+// LowStockAlert is raised when an item's quantity drops to or below its reorder level.
+type LowStockAlert struct {
+	ItemID          uint      `json:"item_id"`
+	Name            string    `json:"name"`
+	Quantity        uint      `json:"quantity"`
+	ReorderLevel    uint      `json:"reorder_level"`
+	ReorderQuantity uint      `json:"reorder_quantity"`
+	RaisedAt        time.Time `json:"raised_at"`
+}
+
+type Notifier interface {
+	Notify(alert *LowStockAlert) error
+}
+
+// New returns the notifier for the configured sink, falling back to the log sink.
+func New(sink string, path string) Notifier {
+	if sink == SinkFile && path != "" {
+		return NewFileNotifier(path)
+	}
+	return NewLogNotifier()
+}
+
+type LogNotifier struct{}
+
+func NewLogNotifier() *LogNotifier {
+	return &LogNotifier{}
+}
+
+func (n *LogNotifier) Notify(alert *LowStockAlert) error {
+	logger.WithFields(logger.Fields{
+		"item_id":          alert.ItemID,
+		"name":             alert.Name,
+		"quantity":         alert.Quantity,
+		"reorder_level":    alert.ReorderLevel,
+		"reorder_quantity": alert.ReorderQuantity,
+	}).Warn("item is low on stock")
+	return nil
+}
+
+// FileNotifier appends every alert as a JSON line to the file at path.
+type FileNotifier struct {
+	mu   sync.Mutex
+	path string
+}
+
+func NewFileNotifier(path string) *FileNotifier {
+	return &FileNotifier{path: path}
+}
+
+func (n *FileNotifier) Notify(alert *LowStockAlert) error {
+	line, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	file, err := os.OpenFile(n.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.Write(append(line, '\n'))
+	return err
+}
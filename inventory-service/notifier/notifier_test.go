@@ -0,0 +1,35 @@
+package notifier
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileNotifier_Notify(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "alerts.log")
+	n := New(SinkFile, path)
+
+	assert.NoError(t, n.Notify(&LowStockAlert{ItemID: 1, Name: "milk", Quantity: 2, ReorderLevel: 5}))
+	assert.NoError(t, n.Notify(&LowStockAlert{ItemID: 2, Name: "bread", Quantity: 0, ReorderLevel: 3}))
+
+	content, err := os.ReadFile(path)
+	assert.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	assert.Equal(t, 2, len(lines))
+
+	alert := &LowStockAlert{}
+	assert.NoError(t, json.Unmarshal([]byte(lines[1]), alert))
+	assert.Equal(t, uint(2), alert.ItemID)
+	assert.Equal(t, "bread", alert.Name)
+}
+
+func TestNew_FallsBackToLog(t *testing.T) {
+	assert.IsType(t, &LogNotifier{}, New("", ""))
+	assert.IsType(t, &LogNotifier{}, New(SinkFile, ""))
+}
@@ -0,0 +1,24 @@
+package orderClient
+
+import (
+	proto "inventory-service/proto/orderpb"
+
+	logger "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var OrderServiceClient proto.OrderServiceClient
+
+// InitGRPCClient connects to the order service without waiting for it, since the order
+// service waits for this one when it starts.
+func InitGRPCClient() {
+	conn, err := grpc.Dial("localhost:33003", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		logger.WithField("error", err).Fatal("Failed to connect to order service")
+		return
+	}
+
+	logger.Info("Connecting to order service server...")
+	OrderServiceClient = proto.NewOrderServiceClient(conn)
+}
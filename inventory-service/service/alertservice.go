@@ -0,0 +1,57 @@
+package service
+
+import (
+	"inventory-service/errors"
+	"inventory-service/models"
+	"inventory-service/notifier"
+	"net/http"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+var alertNotifier notifier.Notifier = notifier.NewLogNotifier()
+
+// SetNotifier replaces the sink low-stock alerts are delivered to.
+func SetNotifier(n notifier.Notifier) {
+	alertNotifier = n
+}
+
+// checkReorderLevel raises an alert when a decrement takes the item from above its
+// reorder level to at or below it, so an item sitting below the level alerts only once.
+func checkReorderLevel(item *models.Item, previousQuantity uint) {
+	if item.ReorderLevel == 0 || previousQuantity <= item.ReorderLevel || item.Quantity > item.ReorderLevel {
+		return
+	}
+
+	alert := &notifier.LowStockAlert{
+		ItemID:          item.ID,
+		Name:            item.Name,
+		Quantity:        item.Quantity,
+		ReorderLevel:    item.ReorderLevel,
+		ReorderQuantity: item.ReorderQuantity,
+		RaisedAt:        time.Now(),
+	}
+	if err := alertNotifier.Notify(alert); err != nil {
+		logger.WithField("error", err.Error()).Error("failed to deliver low stock alert")
+	}
+}
+
+func ListLowStockItems() (uint32, []*models.Item, error) {
+	status, items, err := models.GetLowStockItems()
+	if err != nil {
+		return status, nil, err
+	}
+	return http.StatusOK, items, nil
+}
+
+func SetReorderLevel(id uint, reorderLevel uint, reorderQuantity uint) (uint32, *models.Item, error) {
+	if reorderQuantity > 0 && reorderLevel == 0 {
+		return http.StatusBadRequest, nil, errors.ErrInvalidReorderLevel
+	}
+	status, item, err := models.UpdateItemReorderLevel(id, reorderLevel, reorderQuantity)
+	if err != nil {
+		return status, nil, err
+	}
+	return http.StatusOK, item, nil
+}
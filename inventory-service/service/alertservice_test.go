@@ -0,0 +1,134 @@
+package service
+
+import (
+	"net/http"
+	"testing"
+
+	"inventory-service/models"
+	"inventory-service/money"
+	"inventory-service/notifier"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type recordingNotifier struct {
+	alerts []*notifier.LowStockAlert
+}
+
+func (n *recordingNotifier) Notify(alert *notifier.LowStockAlert) error {
+	n.alerts = append(n.alerts, alert)
+	return nil
+}
+
+type AlertServiceTestSuite struct {
+	suite.Suite
+	db       *gorm.DB
+	notifier *recordingNotifier
+}
+
+func (suite *AlertServiceTestSuite) SetupSuite() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		suite.FailNow("failed to connect database")
+	}
+	suite.db = db
+	models.InitInventoryModels(db)
+}
+
+func (suite *AlertServiceTestSuite) SetupTest() {
+	suite.notifier = &recordingNotifier{}
+	SetNotifier(suite.notifier)
+}
+
+func (suite *AlertServiceTestSuite) TearDownSuite() {
+	SetNotifier(notifier.NewLogNotifier())
+	_ = suite.db.Migrator().DropTable(&models.Item{}, &models.StockMovement{})
+	sql, _ := suite.db.DB()
+	sql.Close()
+}
+
+func TestAlertServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(AlertServiceTestSuite))
+}
+
+func (suite *AlertServiceTestSuite) TestService_LowerQuantityRaisesAlert() {
+	t := suite.T()
+
+	status, item, err := AddItem(&models.Item{
+		Name:            "alertitem1",
+		Description:     "alertitem1.desc",
+		Price:           money.New(10000, "EUR"),
+		Quantity:        10,
+		ReorderLevel:    5,
+		ReorderQuantity: 20,
+	})
+	assert.Equal(t, uint32(http.StatusCreated), status)
+	assert.NoError(t, err)
+
+	t.Run("No alert while above the reorder level", func(t *testing.T) {
+		_, _, err := LowerQuantity(item.ID, 4, models.ReasonOrder, "", "")
+		assert.NoError(t, err)
+		assert.Empty(t, suite.notifier.alerts)
+	})
+
+	t.Run("Alert when crossing the reorder level", func(t *testing.T) {
+		_, _, err := LowerQuantity(item.ID, 2, models.ReasonOrder, "", "")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(suite.notifier.alerts))
+		assert.Equal(t, item.ID, suite.notifier.alerts[0].ItemID)
+		assert.Equal(t, uint(4), suite.notifier.alerts[0].Quantity)
+		assert.Equal(t, uint(20), suite.notifier.alerts[0].ReorderQuantity)
+	})
+
+	t.Run("No repeated alert while already below the reorder level", func(t *testing.T) {
+		_, _, err := LowerQuantity(item.ID, 1, models.ReasonOrder, "", "")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(suite.notifier.alerts))
+	})
+}
+
+func (suite *AlertServiceTestSuite) TestService_ListLowStockItems() {
+	t := suite.T()
+
+	status, item, err := AddItem(&models.Item{
+		Name:        "alertitem2",
+		Description: "alertitem2.desc",
+		Price:       money.New(10000, "EUR"),
+		Quantity:    3,
+	})
+	assert.Equal(t, uint32(http.StatusCreated), status)
+	assert.NoError(t, err)
+
+	t.Run("Item without reorder level is not listed", func(t *testing.T) {
+		status, items, err := ListLowStockItems()
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		for _, got := range items {
+			assert.NotEqual(t, item.ID, got.ID)
+		}
+	})
+
+	t.Run("Item is listed once a reorder level is set", func(t *testing.T) {
+		status, _, err := SetReorderLevel(item.ID, 5, 10)
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+
+		_, items, err := ListLowStockItems()
+		assert.NoError(t, err)
+		ids := []uint{}
+		for _, got := range items {
+			ids = append(ids, got.ID)
+		}
+		assert.Contains(t, ids, item.ID)
+	})
+
+	t.Run("expect error with reorder quantity but no reorder level", func(t *testing.T) {
+		status, got, err := SetReorderLevel(item.ID, 0, 10)
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+}
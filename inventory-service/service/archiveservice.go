@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"inventory-service/models"
+)
+
+// GetItemIncludingArchived looks up an item even if it has been deleted, for showing what
+// past orders were for.
+func GetItemIncludingArchived(id uint) (uint32, *models.Item, error) {
+	return models.GetItemIncludingArchived(id)
+}
+
+func ListArchivedItems() (uint32, []*models.Item, error) {
+	return models.GetArchivedItems()
+}
+
+// RestoreItem brings a deleted item back, telling watchers of the inventory feed it returned.
+func RestoreItem(id uint) (uint32, *models.Item, error) {
+	status, item, err := models.RestoreItem(id)
+	if err != nil {
+		return status, nil, err
+	}
+	publishItemEvent(models.EventRestored, item)
+	return status, item, nil
+}
+
+// PurgeItem removes a deleted item for good, along with its stored images and the photos
+// on its reviews. Nothing is removed while the order service has orders for the item that
+// have not been delivered.
+func PurgeItem(ctx context.Context, id uint) (uint32, error) {
+	if status, err := checkNoOpenOrders(ctx, id); err != nil {
+		return status, err
+	}
+	status, images, photos, err := models.PurgeItem(id)
+	if err != nil {
+		return status, err
+	}
+	for _, itemImage := range images {
+		deleteImageBlobs(itemImage)
+	}
+	for _, photo := range photos {
+		deletePhotoBlobs(photo)
+	}
+	return status, nil
+}
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"inventory-service/blobstore"
+	"inventory-service/errors"
+	orderMocks "inventory-service/mocks/orderMocks"
+	"inventory-service/models"
+	"inventory-service/money"
+	"inventory-service/proto/orderpb"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type ArchiveServiceTestSuite struct {
+	suite.Suite
+	db     *gorm.DB
+	store  *blobstore.LocalStore
+	orders *orderMocks.OrderServiceClient
+}
+
+func (suite *ArchiveServiceTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		suite.FailNow("failed to connect database")
+	}
+	suite.db = db
+	models.InitInventoryModels(db)
+	suite.store = blobstore.NewLocalStore(suite.T().TempDir(), "/inventory/images")
+	SetImageStore(suite.store, DefaultMaxImageSize)
+	suite.orders = &orderMocks.OrderServiceClient{}
+	SetOrderService(suite.orders)
+}
+
+func (suite *ArchiveServiceTestSuite) TearDownTest() {
+	SetImageStore(blobstore.NewLocalStore("images", "/inventory/images"), DefaultMaxImageSize)
+	SetOrderService(nil)
+	_ = suite.db.Migrator().DropTable(&models.Item{}, &models.StockMovement{}, &models.ItemImage{}, &models.RecipeLine{})
+	sql, _ := suite.db.DB()
+	sql.Close()
+}
+
+func TestArchiveServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ArchiveServiceTestSuite))
+}
+
+func (suite *ArchiveServiceTestSuite) TestService_RestoreItem() {
+	t := suite.T()
+
+	_, dosa, err := AddItem(&models.Item{Name: "dosa", Description: "rice crepe", Price: money.New(800, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+	_, idli, err := AddItem(&models.Item{Name: "idli", Description: "steamed rice cakes", Price: money.New(600, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+	_, err = DeleteItem(dosa.ID)
+	assert.NoError(t, err)
+
+	t.Run("List deleted items and still find them for order history", func(t *testing.T) {
+		status, archived, err := ListArchivedItems()
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(archived))
+		assert.Equal(t, "dosa", archived[0].Name)
+		assert.True(t, archived[0].DeletedAt.Valid)
+
+		status, _, err = GetItem(dosa.ID)
+		assert.Equal(t, uint32(http.StatusNotFound), status)
+		assert.Equal(t, errors.ErrItemNotFound, err)
+
+		_, item, err := GetItemIncludingArchived(dosa.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "dosa", item.Name)
+
+		_, items, missing, err := BatchGetItems([]uint{dosa.ID, idli.ID}, true)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(items))
+		assert.Empty(t, missing)
+	})
+
+	t.Run("Refuse to restore an item that was never deleted", func(t *testing.T) {
+		status, _, err := RestoreItem(idli.ID)
+		assert.Equal(t, uint32(http.StatusConflict), status)
+		assert.Equal(t, errors.ErrItemNotArchived, err)
+
+		status, _, err = RestoreItem(99)
+		assert.Equal(t, uint32(http.StatusNotFound), status)
+		assert.Equal(t, errors.ErrItemNotFound, err)
+	})
+
+	t.Run("Put the item back in the catalog", func(t *testing.T) {
+		status, restored, err := RestoreItem(dosa.ID)
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.False(t, restored.DeletedAt.Valid)
+		assert.Equal(t, uint(10), restored.Quantity)
+
+		_, item, err := GetItem(dosa.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "dosa", item.Name)
+
+		_, archived, err := ListArchivedItems()
+		assert.NoError(t, err)
+		assert.Empty(t, archived)
+	})
+}
+
+func (suite *ArchiveServiceTestSuite) TestService_PurgeItem() {
+	t := suite.T()
+
+	_, dosa, err := AddItem(&models.Item{Name: "dosa", Description: "rice crepe", Price: money.New(800, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+	_, batter, err := AddItem(&models.Item{Name: "batter", Description: "fermented", Price: money.New(200, "EUR"), Quantity: 5000, Unit: "g"})
+	assert.NoError(t, err)
+	_, _, err = SetRecipe(dosa.ID, []*RecipeIngredient{{IngredientID: batter.ID, Quantity: 120}})
+	assert.NoError(t, err)
+	_, image, err := UploadItemImage(dosa.ID, testImage(t, 300, 300, false))
+	assert.NoError(t, err)
+	ctx := context.Background()
+	suite.orders.On("CountOpenOrders", ctx, mock.Anything).Return(&orderpb.CountOpenOrdersResponse{StatusCode: http.StatusOK}, nil)
+	suite.orders.On("HasDeliveredOrder", ctx, mock.Anything).Return(&orderpb.HasDeliveredOrderResponse{StatusCode: http.StatusOK, Delivered: true}, nil)
+	_, review, err := SubmitReview(ctx, dosa.ID, 7, 5, "crisp", [][]byte{testImage(t, 400, 400, true), testImage(t, 300, 200, false)})
+	assert.NoError(t, err)
+	_, idli, err := AddItem(&models.Item{Name: "idli", Description: "steamed rice cakes", Price: money.New(600, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+	_, otherReview, err := SubmitReview(ctx, idli.ID, 7, 4, "", [][]byte{testImage(t, 300, 300, false)})
+	assert.NoError(t, err)
+
+	t.Run("Refuse to purge an item still in the catalog", func(t *testing.T) {
+		status, err := PurgeItem(ctx, dosa.ID)
+		assert.Equal(t, uint32(http.StatusConflict), status)
+		assert.Equal(t, errors.ErrItemNotArchived, err)
+	})
+
+	t.Run("Refuse to purge an ingredient of a recipe", func(t *testing.T) {
+		_, err := DeleteItem(batter.ID)
+		assert.NoError(t, err)
+
+		status, err := PurgeItem(ctx, batter.ID)
+		assert.Equal(t, uint32(http.StatusConflict), status)
+		assert.Equal(t, errors.ErrItemInUse, err)
+	})
+
+	t.Run("Refuse to purge an item on orders that have not been delivered", func(t *testing.T) {
+		_, err := DeleteItem(dosa.ID)
+		assert.NoError(t, err)
+		orders := &orderMocks.OrderServiceClient{}
+		orders.On("CountOpenOrders", ctx, &orderpb.CountOpenOrdersRequest{ItemId: uint32(dosa.ID)}).Return(&orderpb.CountOpenOrdersResponse{StatusCode: http.StatusOK, Count: 2}, nil).Once()
+		SetOrderService(orders)
+		defer SetOrderService(suite.orders)
+
+		status, err := PurgeItem(ctx, dosa.ID)
+		assert.Equal(t, uint32(http.StatusConflict), status)
+		assert.Equal(t, errors.ErrItemHasOpenOrders, err)
+		_, archived, err := GetItemIncludingArchived(dosa.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, dosa.ID, archived.ID)
+		orders.AssertExpectations(t)
+	})
+
+	t.Run("Refuse to purge an item when the order service cannot be asked", func(t *testing.T) {
+		orders := &orderMocks.OrderServiceClient{}
+		orders.On("CountOpenOrders", ctx, mock.Anything).Return(nil, assert.AnError).Once()
+		SetOrderService(orders)
+		defer SetOrderService(suite.orders)
+
+		status, err := PurgeItem(ctx, dosa.ID)
+		assert.Equal(t, uint32(http.StatusServiceUnavailable), status)
+		assert.Equal(t, errors.ErrOrderServiceUnavailable, err)
+		_, _, err = GetItemIncludingArchived(dosa.ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Remove the item with its recipe, stock and images but keep its ledger", func(t *testing.T) {
+		status, err := PurgeItem(ctx, dosa.ID)
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+
+		status, _, err = GetItem(dosa.ID)
+		assert.Equal(t, uint32(http.StatusNotFound), status)
+		assert.Equal(t, errors.ErrItemNotFound, err)
+		_, movements, _, err := models.GetStockMovements(dosa.ID, 1, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(movements))
+		assert.Equal(t, models.ReasonInitialStock, movements[0].Reason)
+		var photos int64
+		assert.NoError(t, suite.db.Model(&models.ReviewPhoto{}).Where("review_id = ?", review.ID).Count(&photos).Error)
+		assert.Equal(t, int64(0), photos)
+		for _, photo := range review.Photos {
+			for _, variant := range imageVariants() {
+				_, err = suite.store.Get(reviewPhotoKey(photo, variant))
+				assert.Error(t, err, variant)
+			}
+		}
+		// the photos on other items' reviews stay
+		assert.NoError(t, suite.db.Model(&models.ReviewPhoto{}).Where("review_id = ?", otherReview.ID).Count(&photos).Error)
+		assert.Equal(t, int64(1), photos)
+		_, err = suite.store.Get(reviewPhotoKey(otherReview.Photos[0], originalImage))
+		assert.NoError(t, err)
+
+		_, purged, err := models.GetPurgedItems()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(purged))
+		assert.Equal(t, "dosa", purged[0].Name)
+		_, err = suite.store.Get(imageKey(image, originalImage))
+		assert.Error(t, err)
+
+		// orders for the item still show what it was
+		_, gone, err := GetItemIncludingArchived(dosa.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "dosa", gone.Name)
+		assert.Equal(t, money.New(800, "EUR"), gone.Price)
+		assert.True(t, gone.DeletedAt.Valid)
+		_, found, missing, err := BatchGetItems([]uint{idli.ID, dosa.ID}, true)
+		assert.NoError(t, err)
+		assert.Empty(t, missing)
+		assert.Equal(t, 2, len(found))
+		assert.Equal(t, "dosa", found[1].Name)
+		assert.Equal(t, money.New(800, "EUR"), found[1].Price)
+		_, _, missing, err = BatchGetItems([]uint{dosa.ID}, false)
+		assert.NoError(t, err)
+		assert.Equal(t, []uint{dosa.ID}, missing)
+
+		// with the recipe gone the ingredient can go too
+		status, err = PurgeItem(ctx, batter.ID)
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+	})
+}
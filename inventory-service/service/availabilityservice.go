@@ -0,0 +1,194 @@
+package service
+
+import (
+	"inventory-service/errors"
+	"inventory-service/models"
+	"net/http"
+	"time"
+)
+
+const (
+	minutesPerDay = 24 * 60
+	allDays       = 1<<7 - 1
+)
+
+// clock is the source of the current time for availability checks, so tests can fix it.
+var clock = time.Now
+
+func validateAvailabilityWindow(window *models.AvailabilityWindow) error {
+	if (window.ItemID == 0) == (window.Category == "") {
+		return errors.ErrInvalidAvailabilityWindow
+	}
+	if window.Days == 0 || window.Days > allDays {
+		return errors.ErrInvalidAvailabilityWindow
+	}
+	if window.StartMinute >= minutesPerDay || window.EndMinute >= minutesPerDay || window.StartMinute == window.EndMinute {
+		return errors.ErrInvalidAvailabilityWindow
+	}
+	if _, err := time.LoadLocation(window.Timezone); err != nil {
+		return errors.ErrInvalidAvailabilityWindow
+	}
+	return nil
+}
+
+// AddAvailabilityWindow limits when an item, or every item in a category, can be ordered.
+// A window belongs to either an item or a category, never both, and an empty timezone means UTC.
+func AddAvailabilityWindow(window *models.AvailabilityWindow) (uint32, *models.AvailabilityWindow, error) {
+	if window == nil {
+		return http.StatusBadRequest, nil, errors.ErrInvalidAvailabilityWindow
+	}
+	if window.Timezone == "" {
+		window.Timezone = "UTC"
+	}
+	if err := validateAvailabilityWindow(window); err != nil {
+		return http.StatusBadRequest, nil, err
+	}
+	return models.CreateAvailabilityWindow(window)
+}
+
+// GetAvailabilityWindows returns the windows set on the item and on its category.
+func GetAvailabilityWindows(itemID uint) (uint32, []*models.AvailabilityWindow, error) {
+	status, item, err := models.GetItem(itemID)
+	if err != nil {
+		return status, nil, err
+	}
+	return models.GetAvailabilityWindows([]uint{item.ID}, categoriesOf([]*models.Item{item}))
+}
+
+func DeleteAvailabilityWindow(id uint) (uint32, error) {
+	if id == 0 {
+		return http.StatusBadRequest, errors.ErrEmptyField
+	}
+	return models.DeleteAvailabilityWindow(id)
+}
+
+// SetItemUnavailable takes an item off the menu until it is set available again, for
+// when the kitchen runs out of something its quantity does not track.
+func SetItemUnavailable(id uint, unavailable bool) (uint32, *models.Item, error) {
+	status, item, err := models.SetItemUnavailable(id, unavailable)
+	if err != nil {
+		return status, nil, err
+	}
+	publishItemEvent(models.EventUpdated, item)
+	return status, item, nil
+}
+
+// ItemsAvailableNow reports which of the items can be ordered at the moment: those with
+// stock left to sell, not marked unavailable and inside one of their availability windows.
+// A bundle also needs each of its components to be on the menu.
+func ItemsAvailableNow(items []*models.Item) (uint32, map[uint]bool, error) {
+	status, open, err := itemsOpen(items, clock())
+	if err != nil {
+		return status, nil, err
+	}
+	ids := []uint{}
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	status, components, err := models.GetBundleComponents(ids)
+	if err != nil {
+		return status, nil, err
+	}
+	if len(components) > 0 {
+		status, open, err = bundlesOpen(components, open)
+		if err != nil {
+			return status, nil, err
+		}
+	}
+	status, quantities, err := AvailableQuantities(items)
+	if err != nil {
+		return status, nil, err
+	}
+	available := map[uint]bool{}
+	for _, item := range items {
+		available[item.ID] = open[item.ID] && quantities[item.ID] > 0
+	}
+	return http.StatusOK, available, nil
+}
+
+// itemsOpen reports which of the items are on the menu at the given time, ignoring stock.
+// An item's own windows take precedence over its category's, and an item with no windows
+// at all is always on the menu unless marked unavailable.
+func itemsOpen(items []*models.Item, now time.Time) (uint32, map[uint]bool, error) {
+	open := map[uint]bool{}
+	if len(items) == 0 {
+		return http.StatusOK, open, nil
+	}
+
+	ids := make([]uint, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	status, windows, err := models.GetAvailabilityWindows(ids, categoriesOf(items))
+	if err != nil {
+		return status, nil, err
+	}
+
+	itemWindows := map[uint][]*models.AvailabilityWindow{}
+	categoryWindows := map[string][]*models.AvailabilityWindow{}
+	for _, window := range windows {
+		if window.ItemID != 0 {
+			itemWindows[window.ItemID] = append(itemWindows[window.ItemID], window)
+		} else {
+			categoryWindows[window.Category] = append(categoryWindows[window.Category], window)
+		}
+	}
+
+	for _, item := range items {
+		if item.Unavailable {
+			open[item.ID] = false
+			continue
+		}
+		applicable, ok := itemWindows[item.ID]
+		if !ok && item.Category != "" {
+			applicable, ok = categoryWindows[item.Category]
+		}
+		if !ok {
+			open[item.ID] = true
+			continue
+		}
+		for _, window := range applicable {
+			if windowContains(window, now) {
+				open[item.ID] = true
+				break
+			}
+		}
+	}
+	return http.StatusOK, open, nil
+}
+
+// windowContains reports whether the time falls inside the window, in the window's timezone.
+func windowContains(window *models.AvailabilityWindow, now time.Time) bool {
+	location, err := time.LoadLocation(window.Timezone)
+	if err != nil {
+		return false
+	}
+	local := now.In(location)
+	minute := uint(local.Hour()*60 + local.Minute())
+	today := local.Weekday()
+	yesterday := (today + 6) % 7
+
+	if window.StartMinute < window.EndMinute {
+		return opensOn(window, today) && minute >= window.StartMinute && minute < window.EndMinute
+	}
+	// the window runs past midnight, so it is open late on the days it opens and early on
+	// the mornings after
+	return (opensOn(window, today) && minute >= window.StartMinute) ||
+		(opensOn(window, yesterday) && minute < window.EndMinute)
+}
+
+func opensOn(window *models.AvailabilityWindow, day time.Weekday) bool {
+	return window.Days&(1<<uint(day)) != 0
+}
+
+func categoriesOf(items []*models.Item) []string {
+	categories := []string{}
+	seen := map[string]bool{}
+	for _, item := range items {
+		if item.Category != "" && !seen[item.Category] {
+			seen[item.Category] = true
+			categories = append(categories, item.Category)
+		}
+	}
+	return categories
+}
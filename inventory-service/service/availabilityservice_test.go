@@ -0,0 +1,200 @@
+package service
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"inventory-service/errors"
+	"inventory-service/models"
+	"inventory-service/money"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type AvailabilityServiceTestSuite struct {
+	suite.Suite
+	db *gorm.DB
+}
+
+func (suite *AvailabilityServiceTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		suite.FailNow("failed to connect database")
+	}
+	suite.db = db
+	models.InitInventoryModels(db)
+}
+
+func (suite *AvailabilityServiceTestSuite) TearDownTest() {
+	clock = time.Now
+	_ = suite.db.Migrator().DropTable(&models.Item{}, &models.StockMovement{}, &models.AvailabilityWindow{})
+	sql, _ := suite.db.DB()
+	sql.Close()
+}
+
+func TestAvailabilityServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(AvailabilityServiceTestSuite))
+}
+
+// fixClock makes the service see the given UTC time as now.
+func fixClock(value string) {
+	now, _ := time.Parse(time.RFC3339, value)
+	clock = func() time.Time { return now }
+}
+
+func weekdays(days ...time.Weekday) uint8 {
+	var set uint8
+	for _, day := range days {
+		set |= 1 << uint(day)
+	}
+	return set
+}
+
+func (suite *AvailabilityServiceTestSuite) TestService_WindowContains() {
+	t := suite.T()
+
+	// 2023-06-02 is a Friday
+	lunch := &models.AvailabilityWindow{Days: weekdays(time.Friday), StartMinute: 12 * 60, EndMinute: 15 * 60, Timezone: "UTC"}
+	lateNight := &models.AvailabilityWindow{Days: weekdays(time.Friday), StartMinute: 22 * 60, EndMinute: 2 * 60, Timezone: "UTC"}
+	breakfast := &models.AvailabilityWindow{Days: allDays, StartMinute: 7 * 60, EndMinute: 11 * 60, Timezone: "Asia/Kolkata"}
+
+	cases := []struct {
+		name   string
+		window *models.AvailabilityWindow
+		at     string
+		open   bool
+	}{
+		{"inside a daytime window", lunch, "2023-06-02T13:00:00Z", true},
+		{"at the end of a daytime window", lunch, "2023-06-02T15:00:00Z", false},
+		{"on a day the window does not open", lunch, "2023-06-03T13:00:00Z", false},
+		{"before midnight in an overnight window", lateNight, "2023-06-02T23:30:00Z", true},
+		{"after midnight in an overnight window", lateNight, "2023-06-03T01:00:00Z", true},
+		{"the morning after a day it does not open", lateNight, "2023-06-02T01:00:00Z", false},
+		{"in the window's own timezone", breakfast, "2023-06-02T02:00:00Z", true},
+		{"outside the window in its own timezone", breakfast, "2023-06-02T07:00:00Z", false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			now, _ := time.Parse(time.RFC3339, c.at)
+			assert.Equal(t, c.open, windowContains(c.window, now))
+		})
+	}
+}
+
+func (suite *AvailabilityServiceTestSuite) TestService_AddAvailabilityWindow() {
+	t := suite.T()
+
+	_, item, err := AddItem(&models.Item{Name: "poha", Description: "flattened rice", Price: money.New(4000, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+
+	t.Run("Add a window for an item in UTC by default", func(t *testing.T) {
+		status, window, err := AddAvailabilityWindow(&models.AvailabilityWindow{ItemID: item.ID, Days: allDays, StartMinute: 420, EndMinute: 660})
+		assert.Equal(t, uint32(http.StatusCreated), status)
+		assert.NoError(t, err)
+		assert.Equal(t, "UTC", window.Timezone)
+	})
+
+	invalid := []struct {
+		name   string
+		window *models.AvailabilityWindow
+	}{
+		{"for both an item and a category", &models.AvailabilityWindow{ItemID: item.ID, Category: "breakfast", Days: allDays, StartMinute: 420, EndMinute: 660}},
+		{"for neither an item nor a category", &models.AvailabilityWindow{Days: allDays, StartMinute: 420, EndMinute: 660}},
+		{"with no days", &models.AvailabilityWindow{Category: "breakfast", StartMinute: 420, EndMinute: 660}},
+		{"with the same start and end", &models.AvailabilityWindow{Category: "breakfast", Days: allDays, StartMinute: 420, EndMinute: 420}},
+		{"with a time past midnight", &models.AvailabilityWindow{Category: "breakfast", Days: allDays, StartMinute: 420, EndMinute: 1440}},
+		{"with an unknown timezone", &models.AvailabilityWindow{Category: "breakfast", Days: allDays, StartMinute: 420, EndMinute: 660, Timezone: "Mars/Olympus"}},
+	}
+	for _, c := range invalid {
+		t.Run("Reject a window "+c.name, func(t *testing.T) {
+			status, _, err := AddAvailabilityWindow(c.window)
+			assert.Equal(t, uint32(http.StatusBadRequest), status)
+			assert.Equal(t, errors.ErrInvalidAvailabilityWindow, err)
+		})
+	}
+
+	t.Run("Reject a window for a missing item", func(t *testing.T) {
+		status, _, err := AddAvailabilityWindow(&models.AvailabilityWindow{ItemID: 99, Days: allDays, StartMinute: 420, EndMinute: 660})
+		assert.Equal(t, uint32(http.StatusNotFound), status)
+		assert.Equal(t, errors.ErrItemNotFound, err)
+	})
+}
+
+func (suite *AvailabilityServiceTestSuite) TestService_ItemsAvailableNow() {
+	t := suite.T()
+
+	_, upma, err := AddItem(&models.Item{Name: "upma", Description: "semolina", Price: money.New(4000, "EUR"), Quantity: 10, Category: "breakfast"})
+	assert.NoError(t, err)
+	_, chai, err := AddItem(&models.Item{Name: "chai", Description: "tea", Price: money.New(2000, "EUR"), Quantity: 10, Category: "breakfast"})
+	assert.NoError(t, err)
+	_, thali, err := AddItem(&models.Item{Name: "thali", Description: "set meal", Price: money.New(15000, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+	_, kheer, err := AddItem(&models.Item{Name: "kheer", Description: "rice pudding", Price: money.New(6000, "EUR"), Quantity: 1})
+	assert.NoError(t, err)
+	_, kheer, err = LowerQuantity(kheer.ID, 1, models.ReasonWastage, "", "")
+	assert.NoError(t, err)
+
+	_, _, err = AddAvailabilityWindow(&models.AvailabilityWindow{Category: "breakfast", Days: allDays, StartMinute: 7 * 60, EndMinute: 11 * 60})
+	assert.NoError(t, err)
+	// chai is served all day, overriding the breakfast window
+	_, _, err = AddAvailabilityWindow(&models.AvailabilityWindow{ItemID: chai.ID, Days: allDays, StartMinute: 6 * 60, EndMinute: 23 * 60})
+	assert.NoError(t, err)
+
+	t.Run("Follow category windows unless the item has its own", func(t *testing.T) {
+		fixClock("2023-06-02T21:00:00Z")
+		_, available, err := ItemsAvailableNow([]*models.Item{upma, chai, thali, kheer})
+		assert.NoError(t, err)
+		assert.False(t, available[upma.ID])
+		assert.True(t, available[chai.ID])
+		assert.True(t, available[thali.ID])
+		// out of stock
+		assert.False(t, available[kheer.ID])
+	})
+
+	t.Run("Mark an item unavailable without touching its quantity", func(t *testing.T) {
+		fixClock("2023-06-02T21:00:00Z")
+		status, item, err := SetItemUnavailable(thali.ID, true)
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.Equal(t, uint(10), item.Quantity)
+
+		_, available, err := ItemsAvailableNow([]*models.Item{item})
+		assert.NoError(t, err)
+		assert.False(t, available[thali.ID])
+
+		_, item, err = SetItemUnavailable(thali.ID, false)
+		assert.NoError(t, err)
+		_, available, err = ItemsAvailableNow([]*models.Item{item})
+		assert.NoError(t, err)
+		assert.True(t, available[thali.ID])
+	})
+
+	t.Run("Refuse orders outside the item's windows", func(t *testing.T) {
+		fixClock("2023-06-02T21:00:00Z")
+		status, _, err := LowerQuantity(upma.ID, 1, models.ReasonOrder, "", "")
+		assert.Equal(t, uint32(http.StatusConflict), status)
+		assert.Equal(t, errors.ErrItemUnavailable, err)
+
+		// stock can still be written off
+		_, _, err = LowerQuantity(upma.ID, 1, models.ReasonWastage, "", "")
+		assert.NoError(t, err)
+
+		fixClock("2023-06-02T08:00:00Z")
+		_, item, err := LowerQuantity(upma.ID, 1, models.ReasonOrder, "", "")
+		assert.NoError(t, err)
+		assert.Equal(t, uint(8), item.Quantity)
+	})
+
+	t.Run("Move an item between categories", func(t *testing.T) {
+		fixClock("2023-06-02T21:00:00Z")
+		_, item, err := UpdateItem(upma.ID, &models.Item{Category: ""}, []string{"category"})
+		assert.NoError(t, err)
+		_, available, err := ItemsAvailableNow([]*models.Item{item})
+		assert.NoError(t, err)
+		assert.True(t, available[upma.ID])
+	})
+}
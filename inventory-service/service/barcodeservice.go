@@ -0,0 +1,116 @@
+package service
+
+import (
+	"inventory-service/errors"
+	"inventory-service/models"
+	"net/http"
+	"strings"
+)
+
+// NormalizeBarcode checks that code is an EAN-13, EAN-8 or UPC-A barcode with a valid check
+// digit and returns it in the form it is stored in. A UPC-A code is the EAN-13 code with a
+// leading zero, which is what many scanners read it as, so it is stored that way.
+func NormalizeBarcode(code string) (string, error) {
+	code = strings.TrimSpace(code)
+	if len(code) != 8 && len(code) != 12 && len(code) != 13 {
+		return "", errors.ErrInvalidBarcode
+	}
+	for _, digit := range code {
+		if digit < '0' || digit > '9' {
+			return "", errors.ErrInvalidBarcode
+		}
+	}
+	if len(code) == 12 {
+		code = "0" + code
+	}
+
+	// Digits are weighted 3 and 1 alternately, starting with 3 from the one next to the
+	// check digit, and the check digit brings the sum to a multiple of ten.
+	sum := 0
+	for i := len(code) - 2; i >= 0; i-- {
+		weight := 1
+		if (len(code)-2-i)%2 == 0 {
+			weight = 3
+		}
+		sum += int(code[i]-'0') * weight
+	}
+	if (10-sum%10)%10 != int(code[len(code)-1]-'0') {
+		return "", errors.ErrInvalidBarcode
+	}
+	return code, nil
+}
+
+// AddItemBarcode adds a barcode to an item and returns it as stored.
+func AddItemBarcode(itemID uint, code string) (uint32, string, error) {
+	code, err := NormalizeBarcode(code)
+	if err != nil {
+		return http.StatusBadRequest, "", err
+	}
+	status, barcode, err := models.CreateItemBarcode(&models.ItemBarcode{ItemID: itemID, Code: code})
+	if err != nil {
+		return status, "", err
+	}
+	return status, barcode.Code, nil
+}
+
+func RemoveItemBarcode(itemID uint, code string) (uint32, error) {
+	code, err := NormalizeBarcode(code)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	return models.DeleteItemBarcode(itemID, code)
+}
+
+func GetItemBySKU(sku string) (uint32, *models.Item, error) {
+	if strings.TrimSpace(sku) == "" {
+		return http.StatusBadRequest, nil, errors.ErrEmptyField
+	}
+	return models.GetItemBySKU(strings.TrimSpace(sku))
+}
+
+// GetItemByBarcode finds the item a scanned barcode belongs to. The barcode may be given in
+// either its UPC-A or its EAN-13 form.
+func GetItemByBarcode(code string) (uint32, *models.Item, error) {
+	code, err := NormalizeBarcode(code)
+	if err != nil {
+		return http.StatusBadRequest, nil, err
+	}
+	return models.GetItemByBarcode(code)
+}
+
+// ResolveItemID returns the id of the item a request addresses. The id is used when it is
+// set; otherwise the item is looked up by its SKU or, failing that, by its barcode.
+func ResolveItemID(id uint, sku string, barcode string) (uint32, uint, error) {
+	if id != 0 || (sku == "" && barcode == "") {
+		return http.StatusOK, id, nil
+	}
+	var status uint32
+	var item *models.Item
+	var err error
+	if sku != "" {
+		status, item, err = GetItemBySKU(sku)
+	} else {
+		status, item, err = GetItemByBarcode(barcode)
+	}
+	if err != nil {
+		return status, 0, err
+	}
+	return http.StatusOK, item.ID, nil
+}
+
+// ItemBarcodes returns the barcodes of each of the items, keyed by item id.
+func ItemBarcodes(items []*models.Item) (uint32, map[uint][]string, error) {
+	ids := []uint{}
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	status, barcodes, err := models.GetItemBarcodes(ids)
+	if err != nil {
+		return status, nil, err
+	}
+	byItem := map[uint][]string{}
+	for _, barcode := range barcodes {
+		byItem[barcode.ItemID] = append(byItem[barcode.ItemID], barcode.Code)
+	}
+	return http.StatusOK, byItem, nil
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"net/http"
+	"testing"
+
+	"inventory-service/errors"
+	"inventory-service/models"
+	"inventory-service/money"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type BarcodeServiceTestSuite struct {
+	suite.Suite
+	db *gorm.DB
+}
+
+func (suite *BarcodeServiceTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		suite.FailNow("failed to connect database")
+	}
+	suite.db = db
+	models.InitInventoryModels(db)
+}
+
+func (suite *BarcodeServiceTestSuite) TearDownTest() {
+	_ = suite.db.Migrator().DropTable(&models.Item{}, &models.StockMovement{}, &models.ItemBarcode{})
+	sql, _ := suite.db.DB()
+	sql.Close()
+}
+
+func TestBarcodeServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(BarcodeServiceTestSuite))
+}
+
+func TestNormalizeBarcode(t *testing.T) {
+	for code, expected := range map[string]string{
+		"4006381333931":  "4006381333931",
+		"036000291452":   "0036000291452",
+		"96385074":       "96385074",
+		" 4006381333931": "4006381333931",
+	} {
+		normalized, err := NormalizeBarcode(code)
+		assert.NoError(t, err, code)
+		assert.Equal(t, expected, normalized)
+	}
+
+	for _, code := range []string{"", "4006381333932", "036000291453", "96385075", "40063813339", "40063813339a1"} {
+		_, err := NormalizeBarcode(code)
+		assert.Equal(t, errors.ErrInvalidBarcode, err, code)
+	}
+}
+
+func (suite *BarcodeServiceTestSuite) TestService_ItemBarcodes() {
+	t := suite.T()
+
+	sku := "DRK-001"
+	_, item, err := AddItem(&models.Item{Name: "lassi", Description: "mango", Price: money.New(450, "EUR"), Quantity: 10, SKU: &sku})
+	assert.NoError(t, err)
+	_, other, err := AddItem(&models.Item{Name: "chai", Description: "masala", Price: money.New(300, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+
+	t.Run("Add barcodes and find the item by any of them", func(t *testing.T) {
+		status, code, err := AddItemBarcode(item.ID, "036000291452")
+		assert.Equal(t, uint32(http.StatusCreated), status)
+		assert.NoError(t, err)
+		assert.Equal(t, "0036000291452", code)
+		_, _, err = AddItemBarcode(item.ID, "96385074")
+		assert.NoError(t, err)
+
+		for _, scanned := range []string{"036000291452", "0036000291452", "96385074"} {
+			status, found, err := GetItemByBarcode(scanned)
+			assert.Equal(t, uint32(http.StatusOK), status)
+			assert.NoError(t, err)
+			assert.Equal(t, item.ID, found.ID)
+		}
+
+		_, barcodes, err := ItemBarcodes([]*models.Item{item, other})
+		assert.NoError(t, err)
+		assert.Equal(t, map[uint][]string{item.ID: {"0036000291452", "96385074"}}, barcodes)
+	})
+
+	t.Run("Refuse a barcode with a wrong check digit", func(t *testing.T) {
+		status, _, err := AddItemBarcode(item.ID, "4006381333932")
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrInvalidBarcode, err)
+	})
+
+	t.Run("Refuse a barcode that belongs to another item", func(t *testing.T) {
+		status, _, err := AddItemBarcode(other.ID, "0036000291452")
+		assert.Equal(t, uint32(http.StatusUnprocessableEntity), status)
+		assert.Equal(t, errors.ErrBarcodeExists, err)
+	})
+
+	t.Run("Remove a barcode", func(t *testing.T) {
+		status, err := RemoveItemBarcode(item.ID, "96385074")
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+
+		status, _, err = GetItemByBarcode("96385074")
+		assert.Equal(t, uint32(http.StatusNotFound), status)
+		assert.Equal(t, errors.ErrItemNotFound, err)
+
+		status, err = RemoveItemBarcode(item.ID, "96385074")
+		assert.Equal(t, uint32(http.StatusNotFound), status)
+		assert.Equal(t, errors.ErrBarcodeNotFound, err)
+	})
+
+	t.Run("Resolve an item from its id, SKU or barcode", func(t *testing.T) {
+		_, id, err := ResolveItemID(other.ID, sku, "")
+		assert.NoError(t, err)
+		assert.Equal(t, other.ID, id)
+
+		_, id, err = ResolveItemID(0, sku, "")
+		assert.NoError(t, err)
+		assert.Equal(t, item.ID, id)
+
+		_, id, err = ResolveItemID(0, "", "036000291452")
+		assert.NoError(t, err)
+		assert.Equal(t, item.ID, id)
+
+		status, _, err := ResolveItemID(0, "DRK-404", "")
+		assert.Equal(t, uint32(http.StatusNotFound), status)
+		assert.Equal(t, errors.ErrItemNotFound, err)
+	})
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"net/http"
+	"testing"
+
+	"inventory-service/errors"
+	"inventory-service/models"
+	"inventory-service/money"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type BatchGetItemsServiceTestSuite struct {
+	suite.Suite
+	db *gorm.DB
+}
+
+func (suite *BatchGetItemsServiceTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		suite.FailNow("failed to connect database")
+	}
+	suite.db = db
+	models.InitInventoryModels(db)
+}
+
+func (suite *BatchGetItemsServiceTestSuite) TearDownTest() {
+	_ = suite.db.Migrator().DropTable(&models.Item{}, &models.StockMovement{})
+	sql, _ := suite.db.DB()
+	sql.Close()
+}
+
+func TestBatchGetItemsServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(BatchGetItemsServiceTestSuite))
+}
+
+func (suite *BatchGetItemsServiceTestSuite) TestService_BatchGetItems() {
+	t := suite.T()
+
+	_, idli, err := AddItem(&models.Item{Name: "idli", Description: "steamed rice cakes", Price: money.New(6000, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+	_, vada, err := AddItem(&models.Item{Name: "vada", Description: "lentil fritters", Price: money.New(5000, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+
+	t.Run("Return found items in request order and the missing ids", func(t *testing.T) {
+		status, items, missing, err := BatchGetItems([]uint{vada.ID, 99, idli.ID, vada.ID}, false)
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(items))
+		assert.Equal(t, "vada", items[0].Name)
+		assert.Equal(t, "idli", items[1].Name)
+		assert.Equal(t, []uint{99}, missing)
+	})
+
+	t.Run("Return nothing for an empty request", func(t *testing.T) {
+		status, items, missing, err := BatchGetItems(nil, false)
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.Empty(t, items)
+		assert.Empty(t, missing)
+	})
+
+	t.Run("Reject requests for too many items", func(t *testing.T) {
+		ids := make([]uint, maxBatchSize+1)
+		for i := range ids {
+			ids[i] = uint(i + 1)
+		}
+		status, _, _, err := BatchGetItems(ids, false)
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrTooManyItems, err)
+	})
+}
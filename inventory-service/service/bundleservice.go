@@ -0,0 +1,202 @@
+package service
+
+import (
+	"inventory-service/errors"
+	"inventory-service/models"
+	"inventory-service/money"
+	"net/http"
+)
+
+// BundleItem is a component of a bundle together with the item it names.
+type BundleItem struct {
+	ComponentID uint
+	Name        string
+	Price       money.Money
+	Quantity    uint
+}
+
+// SetBundle makes the item a bundle of the given components, and no components makes it an
+// ordinary item again. Every component must exist, appear once and take a quantity above
+// zero. Bundles are one level deep and do not mix with recipes: a component cannot be a
+// bundle, a bundle cannot be a component or an ingredient, and a dish with a recipe cannot
+// become a bundle. The bundle is sold at price when one is given, and otherwise at the sum
+// of its components' prices, which must then share a currency.
+func SetBundle(bundleID uint, components []*BundleItem, price *money.Money) (uint32, *models.Item, []*BundleItem, error) {
+	status, bundle, err := models.GetItem(bundleID)
+	if err != nil {
+		return status, nil, nil, err
+	}
+	if price != nil && price.MinorUnits <= 0 {
+		return http.StatusBadRequest, nil, nil, errors.ErrInvalidBundle
+	}
+	if price != nil && !price.Valid() {
+		return http.StatusBadRequest, nil, nil, errors.ErrInvalidCurrency
+	}
+	if len(components) > 0 {
+		status, component, err := models.IsBundleComponent(bundleID)
+		if err != nil {
+			return status, nil, nil, err
+		}
+		status, ingredient, err := models.IsIngredient(bundleID)
+		if err != nil {
+			return status, nil, nil, err
+		}
+		status, lines, err := models.GetRecipeLines([]uint{bundleID})
+		if err != nil {
+			return status, nil, nil, err
+		}
+		if component || ingredient || len(lines) > 0 {
+			return http.StatusBadRequest, nil, nil, errors.ErrInvalidBundle
+		}
+	}
+
+	ids := []uint{}
+	seen := map[uint]bool{}
+	for _, component := range components {
+		if component.Quantity == 0 || component.ComponentID == bundleID || seen[component.ComponentID] {
+			return http.StatusBadRequest, nil, nil, errors.ErrInvalidBundle
+		}
+		seen[component.ComponentID] = true
+		ids = append(ids, component.ComponentID)
+	}
+
+	total := bundle.Price
+	if len(ids) > 0 {
+		status, found, err := models.GetItemsByIDs(ids)
+		if err != nil {
+			return status, nil, nil, err
+		}
+		if len(found) != len(ids) {
+			return http.StatusNotFound, nil, nil, errors.ErrComponentNotFound
+		}
+		status, nested, err := models.GetBundleComponents(ids)
+		if err != nil {
+			return status, nil, nil, err
+		}
+		if len(nested) > 0 {
+			return http.StatusBadRequest, nil, nil, errors.ErrInvalidBundle
+		}
+
+		prices := map[uint]money.Money{}
+		for _, item := range found {
+			prices[item.ID] = item.Price
+		}
+		total = money.Money{}
+		for _, component := range components {
+			total, err = total.Add(prices[component.ComponentID].Times(int64(component.Quantity)))
+			if err != nil && price == nil {
+				return http.StatusBadRequest, nil, nil, err
+			}
+		}
+	}
+	if price != nil {
+		total = *price
+	}
+
+	stored := []*models.BundleComponent{}
+	for _, component := range components {
+		stored = append(stored, &models.BundleComponent{
+			ComponentID: component.ComponentID,
+			Quantity:    component.Quantity,
+		})
+	}
+	status, bundle, _, err = models.ReplaceBundle(bundleID, stored, total)
+	if err != nil {
+		return status, nil, nil, err
+	}
+	publishItemEvent(models.EventUpdated, bundle)
+
+	status, components, err = GetBundle(bundleID)
+	if err != nil {
+		return status, nil, nil, err
+	}
+	return http.StatusOK, bundle, components, nil
+}
+
+// GetBundle returns the bundle's components, which are empty for items that are not bundles.
+func GetBundle(bundleID uint) (uint32, []*BundleItem, error) {
+	status, _, err := models.GetItem(bundleID)
+	if err != nil {
+		return status, nil, err
+	}
+	status, stored, err := models.GetBundleComponents([]uint{bundleID})
+	if err != nil {
+		return status, nil, err
+	}
+
+	ids := []uint{}
+	for _, component := range stored {
+		ids = append(ids, component.ComponentID)
+	}
+	byID := map[uint]*models.Item{}
+	if len(ids) > 0 {
+		status, found, err := models.GetItemsByIDs(ids)
+		if err != nil {
+			return status, nil, err
+		}
+		for _, item := range found {
+			byID[item.ID] = item
+		}
+	}
+
+	components := []*BundleItem{}
+	for _, component := range stored {
+		bundleItem := &BundleItem{ComponentID: component.ComponentID, Quantity: component.Quantity}
+		if item, ok := byID[component.ComponentID]; ok {
+			bundleItem.Name = item.Name
+			bundleItem.Price = item.Price
+		}
+		components = append(components, bundleItem)
+	}
+	return http.StatusOK, components, nil
+}
+
+// bundlesOpen narrows open down to the bundles whose components are all on the menu too,
+// so a meal deal comes off with the fries.
+func bundlesOpen(components []*models.BundleComponent, open map[uint]bool) (uint32, map[uint]bool, error) {
+	ids := []uint{}
+	for _, component := range components {
+		ids = append(ids, component.ComponentID)
+	}
+	status, items, err := models.GetItemsByIDs(ids)
+	if err != nil {
+		return status, nil, err
+	}
+	status, componentsOpen, err := itemsOpen(items, clock())
+	if err != nil {
+		return status, nil, err
+	}
+	for _, component := range components {
+		if !componentsOpen[component.ComponentID] {
+			open[component.BundleID] = false
+		}
+	}
+	return http.StatusOK, open, nil
+}
+
+// lowerComponents takes an order for a bundle out of its components' stock, or their
+// ingredients' for components made to order.
+func lowerComponents(movement *models.StockMovement, components []*models.BundleComponent, options []*models.Option) (uint32, *models.Item, error) {
+	ids := []uint{}
+	for _, component := range components {
+		ids = append(ids, component.ComponentID)
+	}
+	status, lines, err := models.GetRecipeLines(ids)
+	if err != nil {
+		return status, nil, err
+	}
+	recipes := map[uint][]*models.RecipeLine{}
+	for _, line := range lines {
+		recipes[line.DishID] = append(recipes[line.DishID], line)
+	}
+
+	status, bundle, lowered, err := models.ApplyBundleMovement(movement, components, recipes, options)
+	if err != nil {
+		return status, nil, err
+	}
+	for _, stock := range lowered {
+		publishItemEvent(models.EventQuantityChanged, stock.Item)
+		checkReorderLevel(stock.Item, stock.Item.Quantity+stock.Quantity)
+	}
+	return http.StatusOK, bundle, nil
+}
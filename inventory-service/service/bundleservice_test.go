@@ -0,0 +1,223 @@
+package service
+
+import (
+	"net/http"
+	"testing"
+
+	"inventory-service/errors"
+	"inventory-service/models"
+	"inventory-service/money"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type BundleServiceTestSuite struct {
+	suite.Suite
+	db *gorm.DB
+}
+
+func (suite *BundleServiceTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		suite.FailNow("failed to connect database")
+	}
+	suite.db = db
+	models.InitInventoryModels(db)
+}
+
+func (suite *BundleServiceTestSuite) TearDownTest() {
+	_ = suite.db.Migrator().DropTable(&models.Item{}, &models.StockMovement{}, &models.Location{}, &models.LocationStock{}, &models.RecipeLine{}, &models.BundleComponent{})
+	sql, _ := suite.db.DB()
+	sql.Close()
+}
+
+func TestBundleServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(BundleServiceTestSuite))
+}
+
+func (suite *BundleServiceTestSuite) TestService_SetBundle() {
+	t := suite.T()
+
+	_, combo, err := AddItem(&models.Item{Name: "burger meal", Description: "burger, fries and a drink", Price: money.New(100, "EUR"), Quantity: 1})
+	assert.NoError(t, err)
+	_, burger, err := AddItem(&models.Item{Name: "burger", Description: "beef burger", Price: money.New(800, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+	_, fries, err := AddItem(&models.Item{Name: "fries", Description: "salted fries", Price: money.New(300, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+
+	t.Run("Price a bundle at the sum of its components", func(t *testing.T) {
+		status, bundle, components, err := SetBundle(combo.ID, []*BundleItem{
+			{ComponentID: burger.ID, Quantity: 1},
+			{ComponentID: fries.ID, Quantity: 2},
+		}, nil)
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.Equal(t, money.New(1400, "EUR"), bundle.Price)
+		assert.Equal(t, []*BundleItem{
+			{ComponentID: burger.ID, Name: "burger", Price: money.New(800, "EUR"), Quantity: 1},
+			{ComponentID: fries.ID, Name: "fries", Price: money.New(300, "EUR"), Quantity: 2},
+		}, components)
+
+		_, stored, err := GetBundle(combo.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, components, stored)
+	})
+
+	t.Run("Use the bundle price when one is given", func(t *testing.T) {
+		price := money.New(1150, "EUR")
+		_, bundle, _, err := SetBundle(combo.ID, []*BundleItem{
+			{ComponentID: burger.ID, Quantity: 1},
+			{ComponentID: fries.ID, Quantity: 1},
+		}, &price)
+		assert.NoError(t, err)
+		assert.Equal(t, price, bundle.Price)
+
+		_, stored, err := GetItem(combo.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, price, stored.Price)
+
+		// orders are priced from the price history, so the new price has to be in it
+		_, current, err := CurrentPrices([]*models.Item{stored})
+		assert.NoError(t, err)
+		assert.Equal(t, price, current[combo.ID])
+		_, history, err := GetPriceHistory(combo.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, price, history[len(history)-1].Price)
+	})
+
+	t.Run("Reject components that are empty, repeated or the bundle itself", func(t *testing.T) {
+		for _, components := range [][]*BundleItem{
+			{{ComponentID: fries.ID, Quantity: 0}},
+			{{ComponentID: fries.ID, Quantity: 1}, {ComponentID: fries.ID, Quantity: 1}},
+			{{ComponentID: combo.ID, Quantity: 1}},
+		} {
+			status, _, _, err := SetBundle(combo.ID, components, nil)
+			assert.Equal(t, uint32(http.StatusBadRequest), status)
+			assert.Equal(t, errors.ErrInvalidBundle, err)
+		}
+	})
+
+	t.Run("Reject an unknown component", func(t *testing.T) {
+		status, _, _, err := SetBundle(combo.ID, []*BundleItem{{ComponentID: 99, Quantity: 1}}, nil)
+		assert.Equal(t, uint32(http.StatusNotFound), status)
+		assert.Equal(t, errors.ErrComponentNotFound, err)
+	})
+
+	t.Run("Refuse to add up components priced in different currencies", func(t *testing.T) {
+		_, juice, err := AddItem(&models.Item{Name: "juice", Description: "orange juice", Price: money.New(400, "USD"), Quantity: 10})
+		assert.NoError(t, err)
+		status, _, _, err := SetBundle(combo.ID, []*BundleItem{{ComponentID: burger.ID, Quantity: 1}, {ComponentID: juice.ID, Quantity: 1}}, nil)
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrCurrencyMismatch, err)
+	})
+
+	t.Run("Keep bundles one level deep", func(t *testing.T) {
+		// a component cannot become a bundle, and a bundle cannot be a component
+		status, _, _, err := SetBundle(fries.ID, []*BundleItem{{ComponentID: burger.ID, Quantity: 1}}, nil)
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrInvalidBundle, err)
+
+		_, drink, err := AddItem(&models.Item{Name: "cola", Description: "cold drink", Price: money.New(200, "EUR"), Quantity: 10})
+		assert.NoError(t, err)
+		status, _, _, err = SetBundle(drink.ID, []*BundleItem{{ComponentID: combo.ID, Quantity: 1}}, nil)
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrInvalidBundle, err)
+	})
+
+	t.Run("Keep bundles out of recipes", func(t *testing.T) {
+		status, _, err := SetRecipe(combo.ID, []*RecipeIngredient{{IngredientID: fries.ID, Quantity: 1}})
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrInvalidRecipe, err)
+	})
+
+	t.Run("Remove a bundle with no components", func(t *testing.T) {
+		_, bundle, components, err := SetBundle(combo.ID, nil, nil)
+		assert.NoError(t, err)
+		assert.Empty(t, components)
+		assert.Equal(t, money.New(1150, "EUR"), bundle.Price)
+	})
+
+	t.Run("Apply a price given with no components", func(t *testing.T) {
+		price := money.New(900, "EUR")
+		_, bundle, _, err := SetBundle(combo.ID, nil, &price)
+		assert.NoError(t, err)
+		assert.Equal(t, price, bundle.Price)
+
+		_, current, err := CurrentPrices([]*models.Item{bundle})
+		assert.NoError(t, err)
+		assert.Equal(t, price, current[combo.ID])
+	})
+}
+
+func (suite *BundleServiceTestSuite) TestService_OrderBundle() {
+	t := suite.T()
+
+	_, combo, err := AddItem(&models.Item{Name: "burger meal", Description: "burger, fries and a drink", Price: money.New(1200, "EUR"), Quantity: 1})
+	assert.NoError(t, err)
+	_, burger, err := AddItem(&models.Item{Name: "burger", Description: "beef burger", Price: money.New(800, "EUR"), Quantity: 1})
+	assert.NoError(t, err)
+	_, patty, err := AddItem(&models.Item{Name: "beef patty", Description: "150g patty", Price: money.New(100, "EUR"), Quantity: 5})
+	assert.NoError(t, err)
+	_, fries, err := AddItem(&models.Item{Name: "fries", Description: "salted fries", Price: money.New(300, "EUR"), Quantity: 3, ReorderLevel: 1})
+	assert.NoError(t, err)
+	_, drink, err := AddItem(&models.Item{Name: "cola", Description: "cold drink", Price: money.New(200, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+	_, _, err = SetRecipe(burger.ID, []*RecipeIngredient{{IngredientID: patty.ID, Quantity: 1}})
+	assert.NoError(t, err)
+	price := money.New(1200, "EUR")
+	_, _, _, err = SetBundle(combo.ID, []*BundleItem{
+		{ComponentID: burger.ID, Quantity: 1},
+		{ComponentID: fries.ID, Quantity: 1},
+		{ComponentID: drink.ID, Quantity: 1},
+	}, &price)
+	assert.NoError(t, err)
+
+	t.Run("Work out bundles from the scarcest component", func(t *testing.T) {
+		_, available, err := AvailableQuantities([]*models.Item{combo})
+		assert.NoError(t, err)
+		assert.Equal(t, uint(3), available[combo.ID])
+	})
+
+	t.Run("Take an order out of the components", func(t *testing.T) {
+		_, updated, err := LowerQuantity(combo.ID, 2, models.ReasonOrder, "ORD-1", "3")
+		assert.NoError(t, err)
+		assert.Equal(t, uint(1), updated.Quantity)
+
+		for id, quantity := range map[uint]uint{burger.ID: 1, patty.ID: 3, fries.ID: 1, drink.ID: 8} {
+			_, stored, err := GetItem(id)
+			assert.NoError(t, err)
+			assert.Equal(t, quantity, stored.Quantity)
+		}
+		_, movements, _, err := ListStockMovements(fries.ID, 1, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(-2), movements[0].Delta)
+		assert.Equal(t, "ORD-1", movements[0].Reference)
+	})
+
+	t.Run("Refuse an order the components cannot cover", func(t *testing.T) {
+		status, _, err := LowerQuantity(combo.ID, 2, models.ReasonOrder, "ORD-2", "3")
+		assert.Equal(t, uint32(http.StatusConflict), status)
+		assert.Equal(t, errors.ErrInsufficientQuantity, err)
+
+		// nothing is taken when one component falls short
+		_, stored, err := GetItem(drink.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, uint(8), stored.Quantity)
+	})
+
+	t.Run("Take the bundle off the menu with a component", func(t *testing.T) {
+		_, _, err := SetItemUnavailable(fries.ID, true)
+		assert.NoError(t, err)
+
+		_, available, err := ItemsAvailableNow([]*models.Item{combo})
+		assert.NoError(t, err)
+		assert.False(t, available[combo.ID])
+
+		status, _, err := LowerQuantity(combo.ID, 1, models.ReasonOrder, "ORD-3", "3")
+		assert.Equal(t, uint32(http.StatusConflict), status)
+		assert.Equal(t, errors.ErrItemUnavailable, err)
+	})
+}
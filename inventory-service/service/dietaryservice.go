@@ -0,0 +1,61 @@
+package service
+
+import (
+	"inventory-service/errors"
+	"inventory-service/models"
+	"math"
+	"net/http"
+)
+
+// excludedAllergens lists, for each dietary label, the allergens an item carrying that
+// label cannot contain. Jain food is vegetarian and also leaves out eggs.
+var excludedAllergens = map[string][]string{
+	"veg":   {"fish", "crustaceans", "molluscs"},
+	"vegan": {"fish", "crustaceans", "molluscs", "milk", "eggs"},
+	"jain":  {"fish", "crustaceans", "molluscs", "eggs"},
+}
+
+// validateDietary checks that the item's allergens and dietary labels are known and do
+// not contradict each other, so a dish with milk in it cannot be labelled vegan.
+func validateDietary(item *models.Item) error {
+	if item.Allergens>>uint(len(models.Allergens)) != 0 {
+		return errors.ErrUnknownAllergen
+	}
+	if item.DietaryLabels>>uint(len(models.DietaryLabels)) != 0 {
+		return errors.ErrUnknownDietaryLabel
+	}
+	for _, label := range models.DietaryNames(item.DietaryLabels) {
+		excluded, _ := models.AllergenMask(excludedAllergens[label])
+		if item.Allergens&excluded != 0 {
+			return errors.ErrConflictingDietaryLabels
+		}
+	}
+	return nil
+}
+
+// validateNutrition checks the nutrition facts that are given; any of them may be left out.
+func validateNutrition(item *models.Item) error {
+	for _, value := range []*float32{item.Kcal, item.Protein, item.Carbohydrates, item.Fat} {
+		if value == nil {
+			continue
+		}
+		if *value < 0 || math.IsNaN(float64(*value)) || math.IsInf(float64(*value), 0) {
+			return errors.ErrInvalidNutrition
+		}
+	}
+	return nil
+}
+
+// FilterItems returns the items free of all the excluded allergens and suitable for every
+// one of the given diets. With no filters it returns every item.
+func FilterItems(excludeAllergens []string, dietaryLabels []string) (uint32, []*models.Item, error) {
+	allergens, err := models.AllergenMask(excludeAllergens)
+	if err != nil {
+		return http.StatusBadRequest, nil, err
+	}
+	labels, err := models.DietaryMask(dietaryLabels)
+	if err != nil {
+		return http.StatusBadRequest, nil, err
+	}
+	return models.FindItems(allergens, labels)
+}
@@ -0,0 +1,177 @@
+package service
+
+import (
+	"net/http"
+	"testing"
+
+	"inventory-service/errors"
+	"inventory-service/models"
+	"inventory-service/money"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type DietaryServiceTestSuite struct {
+	suite.Suite
+	db *gorm.DB
+}
+
+func (suite *DietaryServiceTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		suite.FailNow("failed to connect database")
+	}
+	suite.db = db
+	models.InitInventoryModels(db)
+}
+
+func (suite *DietaryServiceTestSuite) TearDownTest() {
+	_ = suite.db.Migrator().DropTable(&models.Item{}, &models.StockMovement{})
+	sql, _ := suite.db.DB()
+	sql.Close()
+}
+
+func TestDietaryServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(DietaryServiceTestSuite))
+}
+
+func allergens(names ...string) uint32 {
+	mask, _ := models.AllergenMask(names)
+	return mask
+}
+
+func diets(names ...string) uint32 {
+	mask, _ := models.DietaryMask(names)
+	return mask
+}
+
+func grams(value float32) *float32 {
+	return &value
+}
+
+func (suite *DietaryServiceTestSuite) TestService_AddItemDietary() {
+	t := suite.T()
+
+	t.Run("Add an item with allergens, labels and partial nutrition", func(t *testing.T) {
+		status, item, err := AddItem(&models.Item{
+			Name: "kaju katli", Description: "cashew fudge", Price: money.New(12000, "EUR"), Quantity: 10,
+			Allergens: allergens("nuts", "milk"), DietaryLabels: diets("veg", "jain"),
+			Kcal: grams(160),
+		})
+		assert.Equal(t, uint32(http.StatusCreated), status)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"milk", "nuts"}, models.AllergenNames(item.Allergens))
+		assert.Equal(t, []string{"veg", "jain"}, models.DietaryNames(item.DietaryLabels))
+		assert.Equal(t, float32(160), *item.Kcal)
+		assert.Nil(t, item.Fat)
+	})
+
+	invalid := []struct {
+		name string
+		item *models.Item
+		err  error
+	}{
+		{"labelled vegan but containing milk", &models.Item{Allergens: allergens("milk"), DietaryLabels: diets("vegan")}, errors.ErrConflictingDietaryLabels},
+		{"labelled veg but containing fish", &models.Item{Allergens: allergens("fish"), DietaryLabels: diets("veg")}, errors.ErrConflictingDietaryLabels},
+		{"labelled jain but containing eggs", &models.Item{Allergens: allergens("eggs"), DietaryLabels: diets("jain")}, errors.ErrConflictingDietaryLabels},
+		{"with an unknown allergen bit", &models.Item{Allergens: 1 << 20}, errors.ErrUnknownAllergen},
+		{"with an unknown dietary label bit", &models.Item{DietaryLabels: 1 << 10}, errors.ErrUnknownDietaryLabel},
+		{"with negative nutrition", &models.Item{Protein: grams(-1)}, errors.ErrInvalidNutrition},
+	}
+	for _, c := range invalid {
+		t.Run("Reject an item "+c.name, func(t *testing.T) {
+			c.item.Name, c.item.Description, c.item.Price, c.item.Quantity = "dish", "a dish", money.New(10000, "EUR"), 1
+			status, _, err := AddItem(c.item)
+			assert.Equal(t, uint32(http.StatusBadRequest), status)
+			assert.Equal(t, c.err, err)
+		})
+	}
+}
+
+func (suite *DietaryServiceTestSuite) TestService_FilterItems() {
+	t := suite.T()
+
+	_, dal, err := AddItem(&models.Item{Name: "dal tadka", Description: "lentils", Price: money.New(15000, "EUR"), Quantity: 10, Allergens: allergens("mustard"), DietaryLabels: diets("veg", "vegan", "halal")})
+	assert.NoError(t, err)
+	_, naan, err := AddItem(&models.Item{Name: "butter naan", Description: "bread", Price: money.New(5000, "EUR"), Quantity: 10, Allergens: allergens("gluten", "milk"), DietaryLabels: diets("veg")})
+	assert.NoError(t, err)
+	_, curry, err := AddItem(&models.Item{Name: "fish curry", Description: "coastal curry", Price: money.New(30000, "EUR"), Quantity: 10, Allergens: allergens("fish", "mustard")})
+	assert.NoError(t, err)
+
+	names := func(items []*models.Item) []string {
+		result := []string{}
+		for _, item := range items {
+			result = append(result, item.Name)
+		}
+		return result
+	}
+
+	t.Run("Return everything without filters", func(t *testing.T) {
+		status, items, err := FilterItems(nil, nil)
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{dal.Name, naan.Name, curry.Name}, names(items))
+	})
+
+	t.Run("Exclude items with any of the allergens", func(t *testing.T) {
+		_, items, err := FilterItems([]string{"gluten", "fish"}, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{dal.Name}, names(items))
+	})
+
+	t.Run("Keep only items suitable for every diet", func(t *testing.T) {
+		_, items, err := FilterItems(nil, []string{"veg"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{dal.Name, naan.Name}, names(items))
+
+		_, items, err = FilterItems([]string{"milk"}, []string{"veg", "halal"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{dal.Name}, names(items))
+	})
+
+	t.Run("Reject unknown filters", func(t *testing.T) {
+		status, _, err := FilterItems([]string{"chocolate"}, nil)
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrUnknownAllergen, err)
+
+		status, _, err = FilterItems(nil, []string{"keto"})
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrUnknownDietaryLabel, err)
+	})
+}
+
+func (suite *DietaryServiceTestSuite) TestService_UpdateItemDietary() {
+	t := suite.T()
+
+	_, item, err := AddItem(&models.Item{Name: "raita", Description: "yoghurt", Price: money.New(4000, "EUR"), Quantity: 10, Allergens: allergens("milk"), DietaryLabels: diets("veg")})
+	assert.NoError(t, err)
+
+	t.Run("Reject a label that conflicts with the item's allergens", func(t *testing.T) {
+		status, _, err := UpdateItem(item.ID, &models.Item{DietaryLabels: diets("vegan")}, []string{"dietary_labels"})
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrConflictingDietaryLabels, err)
+	})
+
+	t.Run("Change allergens and labels together", func(t *testing.T) {
+		status, updated, err := UpdateItem(item.ID, &models.Item{DietaryLabels: diets("vegan")}, []string{"allergens", "dietary_labels"})
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(0), updated.Allergens)
+		assert.Equal(t, []string{"vegan"}, models.DietaryNames(updated.DietaryLabels))
+	})
+
+	t.Run("Replace nutrition facts as a whole", func(t *testing.T) {
+		_, updated, err := UpdateItem(item.ID, &models.Item{Kcal: grams(90), Protein: grams(4)}, []string{"nutrition"})
+		assert.NoError(t, err)
+		assert.Equal(t, float32(90), *updated.Kcal)
+		assert.Equal(t, float32(4), *updated.Protein)
+
+		_, updated, err = UpdateItem(item.ID, &models.Item{Kcal: grams(95)}, []string{"nutrition"})
+		assert.NoError(t, err)
+		assert.Equal(t, float32(95), *updated.Kcal)
+		assert.Nil(t, updated.Protein)
+	})
+}
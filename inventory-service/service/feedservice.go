@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"inventory-service/errors"
+	"inventory-service/models"
+	"sync"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+const (
+	// feedBacklogPageSize is how many stored events are replayed per query when a watcher resumes.
+	feedBacklogPageSize = 100
+	// watcherBufferSize is how many live events a watcher may fall behind by before it is dropped.
+	watcherBufferSize = 64
+)
+
+// inventoryFeed hands every recorded event to the watchers connected at the time. Events
+// are recorded and broadcast under one lock, so watchers see them in sequence order.
+type inventoryFeed struct {
+	mu       sync.Mutex
+	watchers map[chan *models.InventoryEvent]bool
+}
+
+var feed = &inventoryFeed{
+	watchers: map[chan *models.InventoryEvent]bool{},
+}
+
+// subscribeAfter subscribes a watcher that has replayed every stored event up to sequence.
+// It returns nil when events were stored after sequence in the meantime, so the watcher can
+// replay those first instead of falling behind on live events while it does. Holding the lock
+// means nothing is published between the check and the subscription.
+func (f *inventoryFeed) subscribeAfter(sequence uint64, itemIDs []uint) (chan *models.InventoryEvent, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if sequence > 0 {
+		_, events, err := models.GetInventoryEventsAfter(sequence, itemIDs, 1)
+		if err != nil {
+			return nil, err
+		}
+		if len(events) > 0 {
+			return nil, nil
+		}
+	}
+	watcher := make(chan *models.InventoryEvent, watcherBufferSize)
+	f.watchers[watcher] = true
+	return watcher, nil
+}
+
+func (f *inventoryFeed) unsubscribe(watcher chan *models.InventoryEvent) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.watchers[watcher] {
+		delete(f.watchers, watcher)
+		close(watcher)
+	}
+}
+
+// publish records the event and passes it on. A watcher whose buffer is full is closed
+// instead of blocking everyone else; it can resume from the last sequence it received.
+func (f *inventoryFeed) publish(event *models.InventoryEvent) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, err := models.CreateInventoryEvent(event); err != nil {
+		logger.WithField("error", err.Error()).Error("failed to record inventory event")
+		return
+	}
+	for watcher := range f.watchers {
+		select {
+		case watcher <- event:
+		default:
+			delete(f.watchers, watcher)
+			close(watcher)
+		}
+	}
+}
+
+// publishItemEvent adds a change to an item to the inventory feed.
+func publishItemEvent(eventType string, item *models.Item) {
+	feed.publish(&models.InventoryEvent{
+		Type:      eventType,
+		ItemID:    item.ID,
+		Name:      item.Name,
+		Quantity:  item.Quantity,
+		Price:     item.Price,
+		CreatedAt: time.Now(),
+	})
+}
+
+// WatchInventory calls send for every inventory event after resumeAfter, first replaying
+// stored events and then following live ones, until ctx is done or send fails. A
+// resumeAfter of 0 starts from live events only. When itemIDs is not empty only events
+// for those items are sent.
+func WatchInventory(ctx context.Context, resumeAfter uint64, itemIDs []uint, send func(*models.InventoryEvent) error) error {
+	wanted := map[uint]bool{}
+	for _, id := range itemIDs {
+		wanted[id] = true
+	}
+
+	// the backlog is replayed before subscribing, so live events cannot pile up in the
+	// watcher's buffer however far behind it resumes
+	last := resumeAfter
+	var watcher chan *models.InventoryEvent
+	for watcher == nil {
+		for last > 0 {
+			_, events, err := models.GetInventoryEventsAfter(last, itemIDs, feedBacklogPageSize)
+			if err != nil {
+				return err
+			}
+			for _, event := range events {
+				if err := send(event); err != nil {
+					return err
+				}
+				last = event.Sequence
+			}
+			if len(events) < feedBacklogPageSize {
+				break
+			}
+		}
+		var err error
+		if watcher, err = feed.subscribeAfter(last, itemIDs); err != nil {
+			return err
+		}
+	}
+	defer feed.unsubscribe(watcher)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher:
+			if !ok {
+				return errors.ErrWatcherTooSlow
+			}
+			if event.Sequence <= last || (len(wanted) > 0 && !wanted[event.ItemID]) {
+				continue
+			}
+			if err := send(event); err != nil {
+				return err
+			}
+			last = event.Sequence
+		}
+	}
+}
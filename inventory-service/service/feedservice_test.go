@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"inventory-service/errors"
+	"inventory-service/models"
+	"inventory-service/money"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type FeedServiceTestSuite struct {
+	suite.Suite
+	db *gorm.DB
+}
+
+func (suite *FeedServiceTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		suite.FailNow("failed to connect database")
+	}
+	suite.db = db
+	models.InitInventoryModels(db)
+}
+
+func (suite *FeedServiceTestSuite) TearDownTest() {
+	_ = suite.db.Migrator().DropTable(&models.Item{}, &models.StockMovement{}, &models.InventoryEvent{})
+	sql, _ := suite.db.DB()
+	sql.Close()
+}
+
+func TestFeedServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(FeedServiceTestSuite))
+}
+
+// waitForWatchers blocks until count watchers are subscribed to the feed.
+func waitForWatchers(t *testing.T, count int) {
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		feed.mu.Lock()
+		subscribed := len(feed.watchers)
+		feed.mu.Unlock()
+		if subscribed >= count {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatal("watcher did not subscribe")
+}
+
+func (suite *FeedServiceTestSuite) TestService_WatchInventoryReplaysFromSequence() {
+	t := suite.T()
+
+	_, tea, err := AddItem(&models.Item{Name: "tea", Description: "masala chai", Price: money.New(4000, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+	_, coffee, err := AddItem(&models.Item{Name: "coffee", Description: "filter coffee", Price: money.New(5000, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+	_, _, err = LowerQuantity(tea.ID, 2, models.ReasonOrder, "", "")
+	assert.NoError(t, err)
+	_, _, err = UpdateItem(coffee.ID, &models.Item{Price: money.New(6000, "EUR")}, []string{"price"})
+	assert.NoError(t, err)
+	_, err = DeleteItem(tea.ID)
+	assert.NoError(t, err)
+
+	t.Run("Replays every event after the sequence in order", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		received := []*models.InventoryEvent{}
+		err := WatchInventory(ctx, 1, nil, func(event *models.InventoryEvent) error {
+			received = append(received, event)
+			if len(received) == 4 {
+				cancel()
+			}
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 4, len(received))
+		assert.Equal(t, uint64(2), received[0].Sequence)
+		assert.Equal(t, models.EventCreated, received[0].Type)
+		assert.Equal(t, models.EventQuantityChanged, received[1].Type)
+		assert.Equal(t, uint(8), received[1].Quantity)
+		assert.Equal(t, models.EventUpdated, received[2].Type)
+		assert.Equal(t, money.New(6000, "EUR"), received[2].Price)
+		assert.Equal(t, models.EventDeleted, received[3].Type)
+	})
+
+	t.Run("Replays only the requested items", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		received := []*models.InventoryEvent{}
+		err := WatchInventory(ctx, 1, []uint{coffee.ID}, func(event *models.InventoryEvent) error {
+			received = append(received, event)
+			if len(received) == 2 {
+				cancel()
+			}
+			return nil
+		})
+		assert.NoError(t, err)
+		for _, event := range received {
+			assert.Equal(t, coffee.ID, event.ItemID)
+		}
+	})
+}
+
+func (suite *FeedServiceTestSuite) TestService_WatchInventoryFollowsLiveEvents() {
+	t := suite.T()
+
+	_, item, err := AddItem(&models.Item{Name: "samosa", Description: "potato pastry", Price: money.New(2500, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	received := make(chan *models.InventoryEvent, 10)
+	done := make(chan error)
+	go func() {
+		done <- WatchInventory(ctx, 0, []uint{item.ID}, func(event *models.InventoryEvent) error {
+			received <- event
+			return nil
+		})
+	}()
+	waitForWatchers(t, 1)
+
+	_, _, err = AddQuantity(item.ID, 5, models.ReasonRestock, "", "")
+	assert.NoError(t, err)
+
+	select {
+	case event := <-received:
+		assert.Equal(t, models.EventQuantityChanged, event.Type)
+		assert.Equal(t, uint(15), event.Quantity)
+		// the creation happened before the watcher started from live events
+		assert.Equal(t, uint64(2), event.Sequence)
+	case <-time.After(time.Second):
+		t.Fatal("no event received")
+	}
+
+	cancel()
+	assert.NoError(t, <-done)
+}
+
+func (suite *FeedServiceTestSuite) TestService_WatchInventoryResumesFromFarBehind() {
+	t := suite.T()
+
+	_, item, err := AddItem(&models.Item{Name: "jalebi", Description: "fried syrup spirals", Price: money.New(3000, "EUR"), Quantity: 1})
+	assert.NoError(t, err)
+	for i := 0; i < feedBacklogPageSize+watcherBufferSize; i++ {
+		_, _, err := AddQuantity(item.ID, 1, models.ReasonRestock, "", "")
+		assert.NoError(t, err)
+	}
+	stored := uint64(1 + feedBacklogPageSize + watcherBufferSize)
+	live := uint64(watcherBufferSize + 2)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	received := []uint64{}
+	done := make(chan error)
+	go func() {
+		done <- WatchInventory(ctx, 1, nil, func(event *models.InventoryEvent) error {
+			if len(received) == 0 {
+				// more events come in while the backlog is replayed than a watcher can buffer
+				for i := uint64(0); i < live; i++ {
+					if _, _, err := AddQuantity(item.ID, 1, models.ReasonRestock, "", ""); err != nil {
+						return err
+					}
+				}
+			}
+			received = append(received, event.Sequence)
+			if event.Sequence == stored+live+1 {
+				cancel()
+			}
+			return nil
+		})
+	}()
+	waitForWatchers(t, 1)
+
+	_, _, err = AddQuantity(item.ID, 1, models.ReasonRestock, "", "")
+	assert.NoError(t, err)
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("watcher did not catch up")
+	}
+	assert.Equal(t, int(stored+live), len(received))
+	for i, sequence := range received {
+		assert.Equal(t, uint64(i+2), sequence)
+	}
+}
+
+func (suite *FeedServiceTestSuite) TestService_WatchInventoryDropsSlowWatcher() {
+	t := suite.T()
+
+	_, item, err := AddItem(&models.Item{Name: "lassi", Description: "sweet yoghurt", Price: money.New(6000, "EUR"), Quantity: 1})
+	assert.NoError(t, err)
+
+	release := make(chan bool)
+	done := make(chan error)
+	go func() {
+		done <- WatchInventory(context.Background(), 0, nil, func(event *models.InventoryEvent) error {
+			<-release
+			return nil
+		})
+	}()
+	waitForWatchers(t, 1)
+
+	for i := 0; i < watcherBufferSize+2; i++ {
+		_, _, err := AddQuantity(item.ID, 1, models.ReasonRestock, "", "")
+		assert.NoError(t, err)
+	}
+	close(release)
+
+	select {
+	case err := <-done:
+		assert.Equal(t, errors.ErrWatcherTooSlow, err)
+	case <-time.After(time.Second):
+		t.Fatal("slow watcher was not dropped")
+	}
+}
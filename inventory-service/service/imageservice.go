@@ -0,0 +1,293 @@
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"inventory-service/blobstore"
+	"inventory-service/errors"
+	"inventory-service/models"
+	"net/http"
+	"path"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultMaxImageSize is the largest upload accepted unless configured otherwise. It stays
+	// under gRPC's default message limit so uploads can reach the service.
+	DefaultMaxImageSize = 2 << 20
+	// maxImagePixels bounds the decoded size of an image, so a small file cannot expand
+	// into an enormous bitmap.
+	maxImagePixels = 40_000_000
+	originalImage  = "original"
+)
+
+var imageStore blobstore.BlobStore = blobstore.NewLocalStore("images", "/inventory/images")
+
+var maxImageSize = DefaultMaxImageSize
+
+// thumbnailSizes are the longest edges, in pixels, of the thumbnails made of every image.
+var thumbnailSizes = map[string]int{
+	"small":  160,
+	"medium": 640,
+}
+
+// imageExtensions are the types of image accepted, with the extension they are stored under.
+var imageExtensions = map[string]string{
+	"image/jpeg": "jpg",
+	"image/png":  "png",
+	"image/gif":  "gif",
+}
+
+// SetImageStore replaces where item images are kept and how large an upload may be.
+func SetImageStore(store blobstore.BlobStore, maxSize int) {
+	imageStore = store
+	maxImageSize = maxSize
+}
+
+// imageKey is the key an image, or one of its thumbnails, is stored under.
+func imageKey(image *models.ItemImage, variant string) string {
+	return variantKey(fmt.Sprintf("items/%d/%d", image.ItemID, image.ID), image.ContentType, variant)
+}
+
+// variantKey is the key under prefix that an image of the given type, or one of its
+// thumbnails, is stored under. Thumbnails of JPEG images are JPEGs; all others are PNGs so
+// they keep their transparency.
+func variantKey(prefix string, contentType string, variant string) string {
+	extension := imageExtensions[contentType]
+	if variant != originalImage && extension != "jpg" {
+		extension = "png"
+	}
+	return fmt.Sprintf("%s/%s.%s", prefix, variant, extension)
+}
+
+// imageVariants are the original image and the names of its thumbnails.
+func imageVariants() []string {
+	variants := []string{originalImage}
+	for name := range thumbnailSizes {
+		variants = append(variants, name)
+	}
+	return variants
+}
+
+// ImageURL returns where the image itself can be fetched.
+func ImageURL(image *models.ItemImage) string {
+	return imageStore.URL(imageKey(image, originalImage))
+}
+
+// ThumbnailURLs returns where each thumbnail of the image can be fetched, keyed by size name.
+func ThumbnailURLs(image *models.ItemImage) map[string]string {
+	return thumbnailURLs(func(variant string) string { return imageKey(image, variant) })
+}
+
+func thumbnailURLs(keyOf func(variant string) string) map[string]string {
+	urls := map[string]string{}
+	for name := range thumbnailSizes {
+		urls[name] = imageStore.URL(keyOf(name))
+	}
+	return urls
+}
+
+// uploadedImage is an upload that has been checked to be an image that is accepted.
+type uploadedImage struct {
+	data        []byte
+	contentType string
+	config      image.Config
+	decoded     image.Image
+}
+
+// readImage checks an upload is a JPEG, PNG or GIF image up to the configured size and
+// decodes it. The type is taken from the content rather than from what the client claims.
+func readImage(data []byte) (uint32, *uploadedImage, error) {
+	if len(data) > maxImageSize {
+		return http.StatusRequestEntityTooLarge, nil, errors.ErrImageTooLarge
+	}
+	contentType := http.DetectContentType(data)
+	if _, ok := imageExtensions[contentType]; !ok {
+		return http.StatusUnsupportedMediaType, nil, errors.ErrInvalidImage
+	}
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return http.StatusBadRequest, nil, errors.ErrInvalidImage
+	}
+	if config.Width*config.Height > maxImagePixels {
+		return http.StatusRequestEntityTooLarge, nil, errors.ErrImageTooLarge
+	}
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return http.StatusBadRequest, nil, errors.ErrInvalidImage
+	}
+	return http.StatusOK, &uploadedImage{data: data, contentType: contentType, config: config, decoded: decoded}, nil
+}
+
+// storeImage keeps an image along with a thumbnail of each size, under the keys keyOf gives.
+func storeImage(upload *uploadedImage, keyOf func(variant string) string) error {
+	blobs := map[string][]byte{originalImage: upload.data}
+	for name, size := range thumbnailSizes {
+		thumbnail, err := encodeThumbnail(scaleDown(upload.decoded, size), upload.contentType)
+		if err != nil {
+			return err
+		}
+		blobs[name] = thumbnail
+	}
+	for variant, blob := range blobs {
+		if err := imageStore.Put(keyOf(variant), blob); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteBlobs drops a stored image and its thumbnails, logging any that cannot be deleted.
+func deleteBlobs(keyOf func(variant string) string) {
+	for _, variant := range imageVariants() {
+		if err := imageStore.Delete(keyOf(variant)); err != nil {
+			logger.WithField("error", err.Error()).Error("failed to delete image")
+		}
+	}
+}
+
+// UploadItemImage adds an image to an item, keeping it along with a thumbnail of each size.
+// Only JPEG, PNG and GIF images up to the configured size are accepted; the type is taken
+// from the content rather than from what the client claims.
+func UploadItemImage(itemID uint, data []byte) (uint32, *models.ItemImage, error) {
+	status, upload, err := readImage(data)
+	if err != nil {
+		return status, nil, err
+	}
+
+	status, itemImage, err := models.CreateItemImage(&models.ItemImage{
+		ItemID:      itemID,
+		ContentType: upload.contentType,
+		Width:       uint(upload.config.Width),
+		Height:      uint(upload.config.Height),
+		Size:        uint(len(data)),
+	})
+	if err != nil {
+		return status, nil, err
+	}
+
+	if err := storeImage(upload, func(variant string) string { return imageKey(itemImage, variant) }); err != nil {
+		logger.WithField("error", err.Error()).Error("failed to store item image")
+		removeImage(itemImage)
+		return http.StatusInternalServerError, nil, err
+	}
+	return status, itemImage, nil
+}
+
+// DeleteItemImage removes one of an item's images along with its thumbnails.
+func DeleteItemImage(itemID uint, imageID uint) (uint32, error) {
+	status, itemImage, err := models.GetItemImage(itemID, imageID)
+	if err != nil {
+		return status, err
+	}
+	return removeImage(itemImage)
+}
+
+// removeImage forgets the image and drops whatever was stored of it. Blobs that cannot be
+// deleted are only logged, since nothing refers to them any more.
+func removeImage(itemImage *models.ItemImage) (uint32, error) {
+	status, err := models.DeleteItemImage(itemImage.ID)
+	if err != nil {
+		return status, err
+	}
+	deleteImageBlobs(itemImage)
+	return http.StatusOK, nil
+}
+
+// deleteImageBlobs drops the stored image and its thumbnails, logging any that cannot be deleted.
+func deleteImageBlobs(itemImage *models.ItemImage) {
+	deleteBlobs(func(variant string) string { return imageKey(itemImage, variant) })
+}
+
+// GetImage returns a stored image or thumbnail and its content type, for serving the
+// URLs of a store that does not serve them itself.
+func GetImage(key string) (uint32, []byte, string, error) {
+	data, err := imageStore.Get(key)
+	if err == blobstore.ErrNotFound || err == blobstore.ErrInvalidKey {
+		return http.StatusNotFound, nil, "", errors.ErrImageNotFound
+	} else if err != nil {
+		logger.WithField("error", err.Error()).Error(err.Error())
+		return http.StatusInternalServerError, nil, "", err
+	}
+	for contentType, extension := range imageExtensions {
+		if path.Ext(key) == "."+extension {
+			return http.StatusOK, data, contentType, nil
+		}
+	}
+	return http.StatusOK, data, http.DetectContentType(data), nil
+}
+
+// ItemImages returns the images of each of the items, keyed by item id.
+func ItemImages(items []*models.Item) (uint32, map[uint][]*models.ItemImage, error) {
+	ids := []uint{}
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	status, images, err := models.GetItemImages(ids)
+	if err != nil {
+		return status, nil, err
+	}
+	byItem := map[uint][]*models.ItemImage{}
+	for _, itemImage := range images {
+		byItem[itemImage.ItemID] = append(byItem[itemImage.ItemID], itemImage)
+	}
+	return http.StatusOK, byItem, nil
+}
+
+func encodeThumbnail(thumbnail image.Image, contentType string) ([]byte, error) {
+	var buf bytes.Buffer
+	var err error
+	if contentType == "image/jpeg" {
+		err = jpeg.Encode(&buf, thumbnail, &jpeg.Options{Quality: 85})
+	} else {
+		err = png.Encode(&buf, thumbnail)
+	}
+	return buf.Bytes(), err
+}
+
+// scaleDown shrinks img to fit within a square of the given size, keeping its aspect ratio.
+// Each pixel of the result is the average of the pixels it covers. Images that already fit
+// are returned as they are.
+func scaleDown(img image.Image, size int) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width <= size && height <= size {
+		return img
+	}
+	scaledWidth, scaledHeight := size, size
+	if width > height {
+		scaledHeight = height * size / width
+	} else {
+		scaledWidth = width * size / height
+	}
+	if scaledWidth == 0 {
+		scaledWidth = 1
+	}
+	if scaledHeight == 0 {
+		scaledHeight = 1
+	}
+
+	scaled := image.NewRGBA64(image.Rect(0, 0, scaledWidth, scaledHeight))
+	for y := 0; y < scaledHeight; y++ {
+		top, bottom := bounds.Min.Y+y*height/scaledHeight, bounds.Min.Y+(y+1)*height/scaledHeight
+		for x := 0; x < scaledWidth; x++ {
+			left, right := bounds.Min.X+x*width/scaledWidth, bounds.Min.X+(x+1)*width/scaledWidth
+			var r, g, b, a, n uint64
+			for sy := top; sy < bottom; sy++ {
+				for sx := left; sx < right; sx++ {
+					pr, pg, pb, pa := img.At(sx, sy).RGBA()
+					r, g, b, a = r+uint64(pr), g+uint64(pg), b+uint64(pb), a+uint64(pa)
+					n++
+				}
+			}
+			scaled.SetRGBA64(x, y, color.RGBA64{R: uint16(r / n), G: uint16(g / n), B: uint16(b / n), A: uint16(a / n)})
+		}
+	}
+	return scaled
+}
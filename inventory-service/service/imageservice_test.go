@@ -0,0 +1,194 @@
+package service
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"testing"
+
+	"inventory-service/blobstore"
+	"inventory-service/errors"
+	"inventory-service/models"
+	"inventory-service/money"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type ImageServiceTestSuite struct {
+	suite.Suite
+	db    *gorm.DB
+	store *blobstore.LocalStore
+}
+
+func (suite *ImageServiceTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		suite.FailNow("failed to connect database")
+	}
+	suite.db = db
+	models.InitInventoryModels(db)
+	suite.store = blobstore.NewLocalStore(suite.T().TempDir(), "/inventory/images")
+	SetImageStore(suite.store, DefaultMaxImageSize)
+}
+
+func (suite *ImageServiceTestSuite) TearDownTest() {
+	SetImageStore(blobstore.NewLocalStore("images", "/inventory/images"), DefaultMaxImageSize)
+	_ = suite.db.Migrator().DropTable(&models.Item{}, &models.StockMovement{}, &models.ItemImage{})
+	sql, _ := suite.db.DB()
+	sql.Close()
+}
+
+func TestImageServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ImageServiceTestSuite))
+}
+
+// testImage returns a plain image of the given size encoded as a PNG or, when asJPEG is set, a JPEG.
+func testImage(t *testing.T, width int, height int, asJPEG bool) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.RGBA{R: 200, G: 120, B: 40, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if asJPEG {
+		assert.NoError(t, jpeg.Encode(&buf, img, nil))
+	} else {
+		assert.NoError(t, png.Encode(&buf, img))
+	}
+	return buf.Bytes()
+}
+
+// storedSize decodes the stored blob and returns its dimensions.
+func (suite *ImageServiceTestSuite) storedSize(t *testing.T, key string) (int, int) {
+	data, err := suite.store.Get(key)
+	assert.NoError(t, err)
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	assert.NoError(t, err)
+	return config.Width, config.Height
+}
+
+func (suite *ImageServiceTestSuite) TestService_UploadItemImage() {
+	t := suite.T()
+
+	_, item, err := AddItem(&models.Item{Name: "thali", Description: "full meal", Price: money.New(1500, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+
+	t.Run("Store the image with a thumbnail of each size", func(t *testing.T) {
+		status, image, err := UploadItemImage(item.ID, testImage(t, 800, 400, false))
+		assert.Equal(t, uint32(http.StatusCreated), status)
+		assert.NoError(t, err)
+		assert.Equal(t, "image/png", image.ContentType)
+		assert.Equal(t, uint(800), image.Width)
+		assert.Equal(t, uint(400), image.Height)
+
+		width, height := suite.storedSize(t, imageKey(image, originalImage))
+		assert.Equal(t, []int{800, 400}, []int{width, height})
+		width, height = suite.storedSize(t, imageKey(image, "medium"))
+		assert.Equal(t, []int{640, 320}, []int{width, height})
+		width, height = suite.storedSize(t, imageKey(image, "small"))
+		assert.Equal(t, []int{160, 80}, []int{width, height})
+
+		assert.Equal(t, "/inventory/images/items/1/1/original.png", ImageURL(image))
+		assert.Equal(t, map[string]string{
+			"small":  "/inventory/images/items/1/1/small.png",
+			"medium": "/inventory/images/items/1/1/medium.png",
+		}, ThumbnailURLs(image))
+	})
+
+	t.Run("Keep JPEG thumbnails as JPEGs and never enlarge small images", func(t *testing.T) {
+		_, image, err := UploadItemImage(item.ID, testImage(t, 100, 300, true))
+		assert.NoError(t, err)
+		assert.Equal(t, "image/jpeg", image.ContentType)
+
+		width, height := suite.storedSize(t, imageKey(image, "small"))
+		assert.Equal(t, []int{53, 160}, []int{width, height})
+		width, height = suite.storedSize(t, imageKey(image, "medium"))
+		assert.Equal(t, []int{100, 300}, []int{width, height})
+
+		status, data, contentType, err := GetImage(imageKey(image, "small"))
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.Equal(t, "image/jpeg", contentType)
+		assert.NotEmpty(t, data)
+	})
+
+	t.Run("Refuse something that is not an image", func(t *testing.T) {
+		status, image, err := UploadItemImage(item.ID, []byte("<html><body>menu</body></html>"))
+		assert.Equal(t, uint32(http.StatusUnsupportedMediaType), status)
+		assert.Equal(t, errors.ErrInvalidImage, err)
+		assert.Nil(t, image)
+	})
+
+	t.Run("Refuse a corrupt image", func(t *testing.T) {
+		status, image, err := UploadItemImage(item.ID, testImage(t, 50, 50, false)[:40])
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrInvalidImage, err)
+		assert.Nil(t, image)
+	})
+
+	t.Run("Refuse an image over the size limit", func(t *testing.T) {
+		SetImageStore(suite.store, 64)
+		defer SetImageStore(suite.store, DefaultMaxImageSize)
+
+		status, image, err := UploadItemImage(item.ID, testImage(t, 200, 200, true))
+		assert.Equal(t, uint32(http.StatusRequestEntityTooLarge), status)
+		assert.Equal(t, errors.ErrImageTooLarge, err)
+		assert.Nil(t, image)
+	})
+
+	t.Run("Refuse an image for an unknown item", func(t *testing.T) {
+		status, image, err := UploadItemImage(99, testImage(t, 50, 50, false))
+		assert.Equal(t, uint32(http.StatusNotFound), status)
+		assert.Equal(t, errors.ErrItemNotFound, err)
+		assert.Nil(t, image)
+	})
+
+	t.Run("List the images of each item", func(t *testing.T) {
+		_, images, err := ItemImages([]*models.Item{item})
+		assert.NoError(t, err)
+		assert.Len(t, images[item.ID], 2)
+	})
+}
+
+func (suite *ImageServiceTestSuite) TestService_DeleteItemImage() {
+	t := suite.T()
+
+	_, item, err := AddItem(&models.Item{Name: "thali", Description: "full meal", Price: money.New(1500, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+	_, image, err := UploadItemImage(item.ID, testImage(t, 300, 300, false))
+	assert.NoError(t, err)
+
+	t.Run("Refuse to delete another item's image", func(t *testing.T) {
+		status, err := DeleteItemImage(item.ID+1, image.ID)
+		assert.Equal(t, uint32(http.StatusNotFound), status)
+		assert.Equal(t, errors.ErrImageNotFound, err)
+	})
+
+	t.Run("Delete the image and its thumbnails", func(t *testing.T) {
+		status, err := DeleteItemImage(item.ID, image.ID)
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+
+		for _, variant := range []string{originalImage, "small", "medium"} {
+			status, _, _, err := GetImage(imageKey(image, variant))
+			assert.Equal(t, uint32(http.StatusNotFound), status)
+			assert.Equal(t, errors.ErrImageNotFound, err)
+		}
+		_, images, err := ItemImages([]*models.Item{item})
+		assert.NoError(t, err)
+		assert.Empty(t, images[item.ID])
+	})
+
+	t.Run("Refuse to serve keys outside the store", func(t *testing.T) {
+		status, _, _, err := GetImage("../../etc/passwd")
+		assert.Equal(t, uint32(http.StatusNotFound), status)
+		assert.Equal(t, errors.ErrImageNotFound, err)
+	})
+}
@@ -0,0 +1,389 @@
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"inventory-service/errors"
+	"inventory-service/models"
+	"inventory-service/money"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatJSON = "json"
+
+	MatchOnName = "name"
+	MatchOnSKU  = "sku"
+
+	ImportActionCreate = "create"
+	ImportActionUpdate = "update"
+)
+
+// catalogColumns is the header of exported CSV files and the set of columns accepted on import.
+// Prices are decimals in major units, such as 12.50, in the row's currency or the default one.
+var catalogColumns = []string{"name", "description", "price", "quantity", "reorder_level", "reorder_quantity", "sku", "currency"}
+
+type ImportOptions struct {
+	Format       string
+	MatchOn      string
+	DryRun       bool
+	AllOrNothing bool
+	Actor        string
+}
+
+// ImportRow is the outcome of a single row of an import file. Row counts from 1 for JSON
+// arrays and matches the line number for CSV files, whose header is line 1.
+type ImportRow struct {
+	Row     int
+	Name    string
+	SKU     string
+	Action  string
+	ItemID  uint
+	Error   error
+	Applied bool
+}
+
+type catalogRecord struct {
+	Name            string      `json:"name"`
+	Description     string      `json:"description"`
+	Price           json.Number `json:"price"`
+	Quantity        uint        `json:"quantity"`
+	ReorderLevel    uint        `json:"reorder_level"`
+	ReorderQuantity uint        `json:"reorder_quantity"`
+	SKU             string      `json:"sku,omitempty"`
+	Currency        string      `json:"currency,omitempty"`
+}
+
+// toItem reads the record as an item. The price is read as a decimal, never as a float, so
+// 0.10 is exactly ten cents.
+func (record *catalogRecord) toItem() (*models.Item, error) {
+	price := money.New(0, record.Currency)
+	if record.Price != "" {
+		var err error
+		price, err = money.Parse(record.Price.String(), record.Currency)
+		if err == errors.ErrInvalidAmount {
+			return nil, fmt.Errorf("%w: invalid price %q", errors.ErrInvalidImportFile, record.Price)
+		} else if err != nil {
+			return nil, err
+		}
+	}
+	item := &models.Item{
+		Name:            strings.TrimSpace(record.Name),
+		Description:     strings.TrimSpace(record.Description),
+		Price:           price,
+		Quantity:        record.Quantity,
+		ReorderLevel:    record.ReorderLevel,
+		ReorderQuantity: record.ReorderQuantity,
+	}
+	if sku := strings.TrimSpace(record.SKU); sku != "" {
+		item.SKU = &sku
+	}
+	return item, nil
+}
+
+// ImportItems bulk upserts the items in data, matching existing items on name or SKU. Every
+// row is validated with the same rules as AddItem before anything is written. A dry run
+// only reports what would happen; an all-or-nothing import writes nothing unless every row
+// is valid, while a best-effort import applies the valid rows and reports the rest.
+func ImportItems(data []byte, options ImportOptions) (uint32, []*ImportRow, error) {
+	if options.MatchOn == "" {
+		options.MatchOn = MatchOnName
+	}
+	if options.MatchOn != MatchOnName && options.MatchOn != MatchOnSKU {
+		return http.StatusBadRequest, nil, errors.ErrInvalidImportFile
+	}
+
+	rows, records, err := parseCatalog(data, options.Format)
+	if err != nil {
+		return http.StatusBadRequest, nil, err
+	}
+
+	imported := []*models.ImportedItem{}
+	importedRows := []*ImportRow{}
+	seen := map[string]bool{}
+	for i, row := range rows {
+		if row.Error != nil {
+			continue
+		}
+		item, err := records[i].toItem()
+		if err != nil {
+			row.Error = err
+			continue
+		}
+		if err := validateItem(item); err != nil {
+			row.Error = err
+			continue
+		}
+
+		key := item.Name
+		if options.MatchOn == MatchOnSKU {
+			if item.SKU == nil {
+				row.Error = errors.ErrMissingSKU
+				continue
+			}
+			key = *item.SKU
+		}
+		if seen[key] {
+			row.Error = errors.ErrDuplicateImportRow
+			continue
+		}
+		seen[key] = true
+
+		existing, err := findImportMatch(item, options.MatchOn)
+		if err != nil {
+			row.Error = err
+			continue
+		}
+		row.Action = ImportActionCreate
+		if existing != nil {
+			row.Action = ImportActionUpdate
+			row.ItemID = existing.ID
+		}
+		imported = append(imported, &models.ImportedItem{Item: item, Existing: existing})
+		importedRows = append(importedRows, row)
+	}
+
+	invalid := len(importedRows) < len(rows)
+	if options.DryRun {
+		return http.StatusOK, rows, nil
+	}
+	if options.AllOrNothing && invalid {
+		return http.StatusUnprocessableEntity, rows, errors.ErrImportRejected
+	}
+
+	rowErrors, err := models.SaveImportedItems(imported, options.AllOrNothing, options.Actor)
+	for i, row := range importedRows {
+		row.Error = rowErrors[i]
+		row.Applied = err == nil && rowErrors[i] == nil
+		if row.Applied {
+			row.ItemID = imported[i].Item.ID
+			if row.Action == ImportActionCreate {
+				publishItemEvent(models.EventCreated, imported[i].Item)
+			} else {
+				publishItemEvent(models.EventUpdated, imported[i].Item)
+			}
+		}
+	}
+	if err != nil {
+		return http.StatusUnprocessableEntity, rows, errors.ErrImportRejected
+	}
+	return http.StatusOK, rows, nil
+}
+
+// findImportMatch looks up the item an imported row updates and checks that the row
+// does not take a name or SKU that already belongs to another item.
+func findImportMatch(item *models.Item, matchOn string) (*models.Item, error) {
+	var status uint32
+	var existing *models.Item
+	var err error
+	if matchOn == MatchOnSKU {
+		status, existing, err = models.GetItemBySKU(*item.SKU)
+	} else {
+		status, existing, err = models.GetItemByName(item.Name)
+	}
+	if err != nil && status != http.StatusNotFound {
+		return nil, err
+	}
+
+	if matchOn == MatchOnSKU {
+		_, other, err := models.GetItemByName(item.Name)
+		if err == nil && (existing == nil || other.ID != existing.ID) {
+			return nil, errors.ErrItemExists
+		}
+	} else if item.SKU != nil {
+		_, other, err := models.GetItemBySKU(*item.SKU)
+		if err == nil && (existing == nil || other.ID != existing.ID) {
+			return nil, errors.ErrSKUExists
+		}
+	}
+	return existing, nil
+}
+
+// parseCatalog reads the rows of a CSV or JSON file. Rows that cannot be read carry their
+// error so the remaining rows can still be reported on.
+func parseCatalog(data []byte, format string) ([]*ImportRow, []*catalogRecord, error) {
+	switch format {
+	case FormatCSV:
+		return parseCatalogCSV(data)
+	case FormatJSON:
+		return parseCatalogJSON(data)
+	}
+	return nil, nil, errors.ErrUnsupportedFormat
+}
+
+func parseCatalogJSON(data []byte) ([]*ImportRow, []*catalogRecord, error) {
+	raw := []json.RawMessage{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, nil, errors.ErrInvalidImportFile
+	}
+
+	rows := make([]*ImportRow, len(raw))
+	records := make([]*catalogRecord, len(raw))
+	for i, message := range raw {
+		record := &catalogRecord{}
+		decoder := json.NewDecoder(bytes.NewReader(message))
+		decoder.DisallowUnknownFields()
+		rows[i] = &ImportRow{Row: i + 1}
+		if err := decoder.Decode(record); err != nil {
+			rows[i].Error = fmt.Errorf("%w: %s", errors.ErrInvalidImportFile, err.Error())
+		}
+		rows[i].Name = record.Name
+		rows[i].SKU = record.SKU
+		records[i] = record
+	}
+	return rows, records, nil
+}
+
+func parseCatalogCSV(data []byte) ([]*ImportRow, []*catalogRecord, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, errors.ErrInvalidImportFile
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !isCatalogColumn(name) {
+			return nil, nil, fmt.Errorf("%w: unknown column %q", errors.ErrInvalidImportFile, name)
+		}
+		columns[name] = i
+	}
+	for _, required := range []string{"name", "description", "price", "quantity"} {
+		if _, ok := columns[required]; !ok {
+			return nil, nil, fmt.Errorf("%w: missing column %q", errors.ErrInvalidImportFile, required)
+		}
+	}
+
+	rows := []*ImportRow{}
+	records := []*catalogRecord{}
+	for line := 2; ; line++ {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		row := &ImportRow{Row: line}
+		record := &catalogRecord{}
+		if err != nil {
+			row.Error = fmt.Errorf("%w: %s", errors.ErrInvalidImportFile, err.Error())
+		} else {
+			row.Error = readCatalogFields(record, columns, fields)
+		}
+		row.Name = record.Name
+		row.SKU = record.SKU
+		rows = append(rows, row)
+		records = append(records, record)
+	}
+	return rows, records, nil
+}
+
+func readCatalogFields(record *catalogRecord, columns map[string]int, fields []string) error {
+	value := func(column string) string {
+		if i, ok := columns[column]; ok && i < len(fields) {
+			return strings.TrimSpace(fields[i])
+		}
+		return ""
+	}
+	parseUint := func(column string) (uint, error) {
+		if value(column) == "" {
+			return 0, nil
+		}
+		number, err := strconv.ParseUint(value(column), 10, 32)
+		if err != nil {
+			return 0, fmt.Errorf("%w: invalid %s %q", errors.ErrInvalidImportFile, column, value(column))
+		}
+		return uint(number), nil
+	}
+
+	record.Name = value("name")
+	record.Description = value("description")
+	record.SKU = value("sku")
+	record.Price = json.Number(value("price"))
+	record.Currency = value("currency")
+
+	var err error
+	if record.Quantity, err = parseUint("quantity"); err != nil {
+		return err
+	}
+	if record.ReorderLevel, err = parseUint("reorder_level"); err != nil {
+		return err
+	}
+	if record.ReorderQuantity, err = parseUint("reorder_quantity"); err != nil {
+		return err
+	}
+	return nil
+}
+
+func isCatalogColumn(name string) bool {
+	for _, column := range catalogColumns {
+		if column == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ExportItems writes the whole catalog in the format ImportItems reads.
+func ExportItems(format string) (uint32, []byte, error) {
+	if format != FormatCSV && format != FormatJSON {
+		return http.StatusBadRequest, nil, errors.ErrUnsupportedFormat
+	}
+
+	status, items, err := models.GetAllItems()
+	if err != nil {
+		return status, nil, err
+	}
+
+	records := make([]*catalogRecord, 0, len(items))
+	for _, item := range items {
+		record := &catalogRecord{
+			Name:            item.Name,
+			Description:     item.Description,
+			Price:           json.Number(item.Price.String()),
+			Quantity:        item.Quantity,
+			ReorderLevel:    item.ReorderLevel,
+			ReorderQuantity: item.ReorderQuantity,
+			Currency:        item.Price.Currency,
+		}
+		if item.SKU != nil {
+			record.SKU = *item.SKU
+		}
+		records = append(records, record)
+	}
+
+	if format == FormatJSON {
+		data, err := json.Marshal(records)
+		if err != nil {
+			return http.StatusInternalServerError, nil, err
+		}
+		return http.StatusOK, data, nil
+	}
+
+	buffer := &bytes.Buffer{}
+	writer := csv.NewWriter(buffer)
+	writer.Write(catalogColumns)
+	for _, record := range records {
+		writer.Write([]string{
+			record.Name,
+			record.Description,
+			record.Price.String(),
+			strconv.FormatUint(uint64(record.Quantity), 10),
+			strconv.FormatUint(uint64(record.ReorderLevel), 10),
+			strconv.FormatUint(uint64(record.ReorderQuantity), 10),
+			record.SKU,
+			record.Currency,
+		})
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, buffer.Bytes(), nil
+}
@@ -0,0 +1,210 @@
+package service
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"inventory-service/errors"
+	"inventory-service/models"
+	"inventory-service/money"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type ImportServiceTestSuite struct {
+	suite.Suite
+	db *gorm.DB
+}
+
+func (suite *ImportServiceTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		suite.FailNow("failed to connect database")
+	}
+	suite.db = db
+	models.InitInventoryModels(db)
+}
+
+func (suite *ImportServiceTestSuite) TearDownTest() {
+	_ = suite.db.Migrator().DropTable(&models.Item{}, &models.StockMovement{})
+	sql, _ := suite.db.DB()
+	sql.Close()
+}
+
+func TestImportServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ImportServiceTestSuite))
+}
+
+func (suite *ImportServiceTestSuite) TestService_ImportItemsCSV() {
+	t := suite.T()
+
+	status, existing, err := AddItem(&models.Item{
+		Name:        "paneer tikka",
+		Description: "grilled paneer",
+		Price:       money.New(25000, "EUR"),
+		Quantity:    10,
+	})
+	assert.Equal(t, uint32(http.StatusCreated), status)
+	assert.NoError(t, err)
+
+	data := []byte(strings.Join([]string{
+		"name,description,price,quantity,reorder_level",
+		"paneer tikka,grilled paneer with mint chutney,270,15,5",
+		"dal makhani,black lentils,180.10,20,",
+		"naan,,40,50,",
+		"lassi,sweet yoghurt drink,abc,10,",
+	}, "\n"))
+
+	t.Run("Dry run reports every row without writing", func(t *testing.T) {
+		status, rows, err := ImportItems(data, ImportOptions{Format: FormatCSV, DryRun: true})
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.Equal(t, 4, len(rows))
+
+		assert.Equal(t, 2, rows[0].Row)
+		assert.Equal(t, ImportActionUpdate, rows[0].Action)
+		assert.Equal(t, existing.ID, rows[0].ItemID)
+		assert.Equal(t, ImportActionCreate, rows[1].Action)
+		assert.Equal(t, errors.ErrEmptyField, rows[2].Error)
+		assert.ErrorIs(t, rows[3].Error, errors.ErrInvalidImportFile)
+		for _, row := range rows {
+			assert.False(t, row.Applied)
+		}
+
+		_, items, _ := GetAllItems()
+		assert.Equal(t, 1, len(items))
+	})
+
+	t.Run("All-or-nothing import is rejected when a row is invalid", func(t *testing.T) {
+		status, rows, err := ImportItems(data, ImportOptions{Format: FormatCSV, AllOrNothing: true})
+		assert.Equal(t, uint32(http.StatusUnprocessableEntity), status)
+		assert.Error(t, err)
+		assert.Equal(t, 4, len(rows))
+
+		_, items, _ := GetAllItems()
+		assert.Equal(t, 1, len(items))
+	})
+
+	t.Run("Best-effort import applies the valid rows", func(t *testing.T) {
+		status, rows, err := ImportItems(data, ImportOptions{Format: FormatCSV, Actor: "3"})
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.True(t, rows[0].Applied)
+		assert.True(t, rows[1].Applied)
+		assert.False(t, rows[2].Applied)
+		assert.False(t, rows[3].Applied)
+
+		_, item, err := GetItem(existing.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "grilled paneer with mint chutney", item.Description)
+		assert.Equal(t, money.New(27000, "EUR"), item.Price)
+		assert.Equal(t, uint(15), item.Quantity)
+		assert.Equal(t, uint(5), item.ReorderLevel)
+
+		// the quantity change is recorded in the ledger
+		_, drifts, err := ReconcileStock(0)
+		assert.NoError(t, err)
+		assert.Empty(t, drifts)
+
+		_, created, err := GetItem(rows[1].ItemID)
+		assert.NoError(t, err)
+		assert.Equal(t, "dal makhani", created.Name)
+		assert.Equal(t, money.New(18010, "EUR"), created.Price)
+	})
+
+	t.Run("expect error with unknown column", func(t *testing.T) {
+		status, rows, err := ImportItems([]byte("name,colour\nnaan,white"), ImportOptions{Format: FormatCSV})
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Error(t, err)
+		assert.Nil(t, rows)
+	})
+}
+
+func (suite *ImportServiceTestSuite) TestService_ImportItemsJSONBySKU() {
+	t := suite.T()
+
+	sku := "BEV-001"
+	_, existing, err := AddItem(&models.Item{
+		Name:        "masala chai",
+		Description: "spiced tea",
+		Price:       money.New(4000, "EUR"),
+		Quantity:    30,
+		SKU:         &sku,
+	})
+	assert.NoError(t, err)
+
+	data := []byte(`[
+		{"name": "masala chai (large)", "description": "spiced tea", "price": 60, "quantity": 30, "sku": "BEV-001"},
+		{"name": "filter coffee", "description": "south indian coffee", "price": 50, "quantity": 25, "sku": "BEV-002"},
+		{"name": "cold coffee", "description": "iced coffee", "price": 90, "quantity": 10},
+		{"name": "filter coffee again", "description": "duplicate", "price": 50, "quantity": 5, "sku": "BEV-002"}
+	]`)
+
+	status, rows, err := ImportItems(data, ImportOptions{Format: FormatJSON, MatchOn: MatchOnSKU})
+	assert.Equal(t, uint32(http.StatusOK), status)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(rows))
+
+	assert.Equal(t, ImportActionUpdate, rows[0].Action)
+	assert.True(t, rows[0].Applied)
+	assert.True(t, rows[1].Applied)
+	assert.Equal(t, errors.ErrMissingSKU, rows[2].Error)
+	assert.Equal(t, errors.ErrDuplicateImportRow, rows[3].Error)
+
+	_, renamed, err := GetItem(existing.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "masala chai (large)", renamed.Name)
+	assert.Equal(t, money.New(6000, "EUR"), renamed.Price)
+}
+
+func (suite *ImportServiceTestSuite) TestService_ExportItems() {
+	t := suite.T()
+
+	sku := "SNK-001"
+	_, _, err := AddItem(&models.Item{Name: "samosa", Description: "potato filled pastry", Price: money.New(2550, "EUR"), Quantity: 40, SKU: &sku})
+	assert.NoError(t, err)
+	_, _, err = AddItem(&models.Item{Name: "vada pav", Description: "spiced potato burger", Price: money.New(3000, "EUR"), Quantity: 20, ReorderLevel: 5})
+	assert.NoError(t, err)
+
+	t.Run("Export as CSV", func(t *testing.T) {
+		status, data, err := ExportItems(FormatCSV)
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.Equal(t, strings.Join([]string{
+			"name,description,price,quantity,reorder_level,reorder_quantity,sku,currency",
+			"samosa,potato filled pastry,25.50,40,0,0,SNK-001,EUR",
+			"vada pav,spiced potato burger,30.00,20,5,0,,EUR",
+			"",
+		}, "\n"), string(data))
+	})
+
+	t.Run("Export as JSON can be imported again", func(t *testing.T) {
+		status, data, err := ExportItems(FormatJSON)
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+
+		records := []map[string]interface{}{}
+		assert.NoError(t, json.Unmarshal(data, &records))
+		assert.Equal(t, 2, len(records))
+
+		status, rows, err := ImportItems(data, ImportOptions{Format: FormatJSON, DryRun: true})
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		for _, row := range rows {
+			assert.NoError(t, row.Error)
+			assert.Equal(t, ImportActionUpdate, row.Action)
+		}
+	})
+
+	t.Run("expect error with unsupported format", func(t *testing.T) {
+		status, data, err := ExportItems("xml")
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Error(t, err)
+		assert.Nil(t, data)
+	})
+}
@@ -3,23 +3,68 @@ package service
 import (
 	"inventory-service/errors"
 	"inventory-service/models"
+	"inventory-service/money"
+	"inventory-service/units"
 	"net/http"
 )
 
-func AddItem(name string, description string, price float32, quantity uint) (uint32, *models.Item, error) {
-	if name == "" || description == "" || price <= 0 || quantity == 0 {
-		return http.StatusBadRequest, nil, errors.ErrEmptyField
+// validateItem holds the rules every new or imported item must satisfy.
+func validateItem(item *models.Item) error {
+	if item == nil {
+		return errors.ErrInvalidItem
+	}
+	if item.Name == "" || item.Description == "" || item.Price.MinorUnits <= 0 || item.Quantity == 0 {
+		return errors.ErrEmptyField
+	}
+	if !item.Price.Valid() {
+		return errors.ErrInvalidCurrency
+	}
+	if item.SKU != nil && *item.SKU == "" {
+		return errors.ErrEmptyField
+	}
+	if item.ReorderQuantity > 0 && item.ReorderLevel == 0 {
+		return errors.ErrInvalidReorderLevel
+	}
+	if err := validateUnits(item); err != nil {
+		return err
+	}
+	if err := validateCost(item.CostPrice); err != nil {
+		return err
+	}
+	if err := validateDietary(item); err != nil {
+		return err
+	}
+	return validateNutrition(item)
+}
+
+func AddItem(item *models.Item) (uint32, *models.Item, error) {
+	if err := validateItem(item); err != nil {
+		return http.StatusBadRequest, nil, err
 	}
 	newItem := &models.Item{
-		Name:        name,
-		Description: description,
-		Price:       price,
-		Quantity:    quantity,
+		Name:            item.Name,
+		Description:     item.Description,
+		Price:           item.Price,
+		CostPrice:       item.CostPrice,
+		Quantity:        item.Quantity,
+		ReorderLevel:    item.ReorderLevel,
+		ReorderQuantity: item.ReorderQuantity,
+		SKU:             item.SKU,
+		Category:        item.Category,
+		Allergens:       item.Allergens,
+		DietaryLabels:   item.DietaryLabels,
+		Kcal:            item.Kcal,
+		Protein:         item.Protein,
+		Carbohydrates:   item.Carbohydrates,
+		Fat:             item.Fat,
+		Unit:            item.Unit,
+		SaleUnit:        item.SaleUnit,
 	}
 	status, newItem, err := models.CreateItem(newItem)
 	if err != nil {
 		return status, nil, err
 	}
+	publishItemEvent(models.EventCreated, newItem)
 	return status, newItem, nil
 }
 
@@ -39,39 +84,379 @@ func GetAllItems() (uint32, []*models.Item, error) {
 	return status, items, nil
 }
 
-func AddQuantity(id uint, quantity uint) (uint32, *models.Item, error) {
-	status, item, err := models.GetItem(uint(id))
+// maxBatchSize is the most items a single BatchGetItems call may ask for.
+const maxBatchSize = 100
+
+// BatchGetItems looks up several items at once. Items are returned in the order their ids
+// were first requested, and ids with no matching item are returned as missing. Deleted
+// items are only found when includeArchived is set.
+func BatchGetItems(ids []uint, includeArchived bool) (uint32, []*models.Item, []uint, error) {
+	if len(ids) > maxBatchSize {
+		return http.StatusBadRequest, nil, nil, errors.ErrTooManyItems
+	}
+	if len(ids) == 0 {
+		return http.StatusOK, []*models.Item{}, []uint{}, nil
+	}
+
+	lookup := models.GetItemsByIDs
+	if includeArchived {
+		lookup = models.GetItemsByIDsIncludingArchived
+	}
+	status, found, err := lookup(ids)
+	if err != nil {
+		return status, nil, nil, err
+	}
+
+	byID := map[uint]*models.Item{}
+	for _, item := range found {
+		byID[item.ID] = item
+	}
+
+	items := []*models.Item{}
+	missing := []uint{}
+	seen := map[uint]bool{}
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if item, ok := byID[id]; ok {
+			items = append(items, item)
+		} else {
+			missing = append(missing, id)
+		}
+	}
+	return http.StatusOK, items, missing, nil
+}
+
+func AddQuantity(id uint, quantity uint, reason string, reference string, actor string) (uint32, *models.Item, error) {
+	return AddQuantityAt(id, 0, quantity, money.Money{}, reason, reference, actor)
+}
+
+// AddQuantityAt adds stock of an item at a location; a locationID of 0 means the default
+// location. The stock is valued at unitCost, per unit the item is stocked in, or at the
+// item's cost price when no cost is given.
+func AddQuantityAt(id uint, locationID uint, quantity uint, unitCost money.Money, reason string, reference string, actor string) (uint32, *models.Item, error) {
+	if quantity == 0 {
+		return http.StatusBadRequest, nil, errors.ErrEmptyField
+	}
+	if err := validateCost(unitCost); err != nil {
+		return http.StatusBadRequest, nil, err
+	}
+	if reason == "" {
+		reason = models.ReasonRestock
+	}
+	if reason != models.ReasonRestock && reason != models.ReasonCorrection {
+		return http.StatusBadRequest, nil, errors.ErrInvalidReason
+	}
+	status, item, err := models.ApplyStockMovement(&models.StockMovement{
+		ItemID:     id,
+		LocationID: locationID,
+		Delta:      int64(quantity),
+		Reason:     reason,
+		Reference:  reference,
+		Actor:      actor,
+		UnitCost:   unitCost,
+	})
+	if err != nil {
+		return status, nil, err
+	}
+	publishItemEvent(models.EventQuantityChanged, item)
+	return http.StatusOK, item, nil
+}
+
+func LowerQuantity(id uint, quantity uint, reason string, reference string, actor string) (uint32, *models.Item, error) {
+	return LowerQuantityWithOptions(id, quantity, nil, reason, reference, actor)
+}
+
+// LowerQuantityWithOptions lowers the item's quantity for an order of the given options.
+// The selection is checked against the item's option groups, and options that track their
+// own stock are lowered by the same quantity. Only orders carry a selection.
+func LowerQuantityWithOptions(id uint, quantity uint, optionIDs []uint, reason string, reference string, actor string) (uint32, *models.Item, error) {
+	return LowerQuantityAt(id, 0, quantity, optionIDs, reason, reference, actor)
+}
+
+// LowerQuantityAt is LowerQuantityWithOptions for the stock held at one location; a
+// locationID of 0 means the default location. An order that names no location is taken from
+// the default location when it holds enough, and otherwise from the first location that
+// does, since what is available to sell counts the stock at every location. Stock in expired
+// lots is never taken, an order for a dish with a recipe takes its ingredients instead of the
+// dish itself, and an order for a bundle takes its components.
+func LowerQuantityAt(id uint, locationID uint, quantity uint, optionIDs []uint, reason string, reference string, actor string) (uint32, *models.Item, error) {
+	if quantity == 0 {
+		return http.StatusBadRequest, nil, errors.ErrEmptyField
+	}
+	if reason == "" {
+		reason = models.ReasonOrder
+	}
+	if reason != models.ReasonOrder && reason != models.ReasonWastage && reason != models.ReasonCorrection {
+		return http.StatusBadRequest, nil, errors.ErrInvalidReason
+	}
+	if reason != models.ReasonOrder && len(optionIDs) > 0 {
+		return http.StatusBadRequest, nil, errors.ErrInvalidOptionSelection
+	}
+
+	movement := &models.StockMovement{
+		ItemID:     id,
+		LocationID: locationID,
+		Delta:      -int64(quantity),
+		Reason:     reason,
+		Reference:  reference,
+		Actor:      actor,
+		CreatedAt:  clock(),
+	}
+
+	var status uint32
+	var item *models.Item
+	var err error
+	if reason == models.ReasonOrder {
+		var groups []*models.OptionGroup
+		var options []*models.Option
+		var open map[uint]bool
+		status, item, err = models.GetItem(id)
+		if err != nil {
+			return status, nil, err
+		}
+		status, open, err = itemsOpen([]*models.Item{item}, clock())
+		if err != nil {
+			return status, nil, err
+		}
+		var components []*models.BundleComponent
+		status, components, err = models.GetBundleComponents([]uint{id})
+		if err != nil {
+			return status, nil, err
+		}
+		if len(components) > 0 {
+			status, open, err = bundlesOpen(components, open)
+			if err != nil {
+				return status, nil, err
+			}
+		}
+		if !open[item.ID] {
+			return http.StatusConflict, nil, errors.ErrItemUnavailable
+		}
+		status, groups, err = models.GetOptionGroups(id)
+		if err != nil {
+			return status, nil, err
+		}
+		options, err = selectOptions(groups, optionIDs)
+		if err != nil {
+			return http.StatusBadRequest, nil, err
+		}
+		var lines []*models.RecipeLine
+		status, lines, err = models.GetRecipeLines([]uint{id})
+		if err != nil {
+			return status, nil, err
+		}
+		if locationID != 0 {
+			return lowerOrder(movement, lines, components, options, quantity)
+		}
+		return lowerOrderAnywhere(movement, lines, components, options, quantity)
+	}
+	status, item, err = models.ApplyStockMovement(movement)
 	if err != nil {
 		return status, nil, err
 	}
-	item.Quantity += quantity
-	status, err = models.UpdateItemQuantity(item.ID, item.Quantity)
+	publishItemEvent(models.EventQuantityChanged, item)
+	checkReorderLevel(item, item.Quantity+quantity)
+	return http.StatusOK, item, nil
+}
+
+// lowerOrderAnywhere takes an order from the default location, or when that does not hold
+// enough, from the first other location that does.
+func lowerOrderAnywhere(movement *models.StockMovement, lines []*models.RecipeLine, components []*models.BundleComponent, options []*models.Option, quantity uint) (uint32, *models.Item, error) {
+	status, locations, err := models.GetLocations()
+	if err != nil {
+		return status, nil, err
+	}
+	locationIDs := []uint{models.DefaultLocationID()}
+	for _, location := range locations {
+		if location.ID != models.DefaultLocationID() {
+			locationIDs = append(locationIDs, location.ID)
+		}
+	}
+
+	var firstStatus uint32
+	var firstErr error
+	for _, locationID := range locationIDs {
+		attempt := *movement
+		attempt.LocationID = locationID
+		status, item, err := lowerOrder(&attempt, lines, components, options, quantity)
+		if err != errors.ErrInsufficientQuantity {
+			return status, item, err
+		}
+		if firstErr == nil {
+			firstStatus, firstErr = status, err
+		}
+	}
+	return firstStatus, nil, firstErr
+}
+
+// lowerOrder takes an order out of the stock at the movement's location.
+func lowerOrder(movement *models.StockMovement, lines []*models.RecipeLine, components []*models.BundleComponent, options []*models.Option, quantity uint) (uint32, *models.Item, error) {
+	if len(lines) > 0 {
+		return lowerIngredients(movement, lines, options, quantity)
+	}
+	if len(components) > 0 {
+		return lowerComponents(movement, components, options)
+	}
+	status, item, err := models.ApplyStockMovementWithOptions(movement, options)
 	if err != nil {
 		return status, nil, err
 	}
-	return http.StatusOK, item , nil
+	publishItemEvent(models.EventQuantityChanged, item)
+	checkReorderLevel(item, item.Quantity+quantity)
+	return http.StatusOK, item, nil
 }
 
-func LowerQuantity(id uint, quantity uint) (uint32, *models.Item, error) {
-	status, item, err := models.GetItem(uint(id))
+// lowerIngredients takes an order for a dish with a recipe out of its ingredients' stock.
+func lowerIngredients(movement *models.StockMovement, lines []*models.RecipeLine, options []*models.Option, quantity uint) (uint32, *models.Item, error) {
+	status, dish, ingredients, err := models.ApplyRecipeMovement(movement, lines, options)
 	if err != nil {
 		return status, nil, err
 	}
-	if item.Quantity < quantity {
-		return http.StatusConflict, nil, errors.ErrInsufficientQuantity
+	for i, ingredient := range ingredients {
+		publishItemEvent(models.EventQuantityChanged, ingredient)
+		checkReorderLevel(ingredient, ingredient.Quantity+quantity*lines[i].Quantity)
+	}
+	return http.StatusOK, dish, nil
+}
+
+// UpdateItem changes the fields of the item named by paths, taking their new values from
+// item. Name, description, price, category, allergens, dietary labels, nutrition and units
+// can be changed this way; each is validated with the same rules as AddItem, and an empty
+// category clears it. Nutrition facts are replaced as a whole. Stock is kept in base units,
+// so a new unit has to measure the same thing as the old one, and an empty sale unit sells
+// the item in its stock unit again.
+func UpdateItem(id uint, item *models.Item, paths []string) (uint32, *models.Item, error) {
+	if item == nil {
+		return http.StatusBadRequest, nil, errors.ErrInvalidItem
+	}
+	if len(paths) == 0 {
+		return http.StatusBadRequest, nil, errors.ErrInvalidUpdateMask
+	}
+
+	updates := map[string]interface{}{}
+	for _, path := range paths {
+		switch path {
+		case "name":
+			if item.Name == "" {
+				return http.StatusBadRequest, nil, errors.ErrEmptyField
+			}
+			updates["name"] = item.Name
+		case "description":
+			if item.Description == "" {
+				return http.StatusBadRequest, nil, errors.ErrEmptyField
+			}
+			updates["description"] = item.Description
+		case "price":
+			if item.Price.MinorUnits <= 0 {
+				return http.StatusBadRequest, nil, errors.ErrEmptyField
+			}
+			if !item.Price.Valid() {
+				return http.StatusBadRequest, nil, errors.ErrInvalidCurrency
+			}
+			updates["price_minor_units"] = item.Price.MinorUnits
+			updates["price_currency"] = item.Price.Currency
+		case "cost_price":
+			if err := validateCost(item.CostPrice); err != nil {
+				return http.StatusBadRequest, nil, err
+			}
+			updates["cost_price_minor_units"] = item.CostPrice.MinorUnits
+			updates["cost_price_currency"] = item.CostPrice.Currency
+		case "category":
+			updates["category"] = item.Category
+		case "allergens":
+			updates["allergens"] = item.Allergens
+		case "dietary_labels":
+			updates["dietary_labels"] = item.DietaryLabels
+		case "nutrition":
+			if err := validateNutrition(item); err != nil {
+				return http.StatusBadRequest, nil, err
+			}
+			updates["kcal"] = item.Kcal
+			updates["protein"] = item.Protein
+			updates["carbohydrates"] = item.Carbohydrates
+			updates["fat"] = item.Fat
+		case "unit":
+			updates["unit"] = item.Unit
+		case "sale_unit":
+			updates["sale_unit"] = item.SaleUnit
+		default:
+			return http.StatusBadRequest, nil, errors.ErrInvalidUpdateMask
+		}
 	}
-	item.Quantity -= quantity
-	status, err = models.UpdateItemQuantity(item.ID, item.Quantity)
+
+	_, changesAllergens := updates["allergens"]
+	_, changesLabels := updates["dietary_labels"]
+	if changesAllergens || changesLabels {
+		// the new allergens have to agree with the labels the item keeps, and the other way round
+		status, current, err := models.GetItem(id)
+		if err != nil {
+			return status, nil, err
+		}
+		merged := *current
+		if changesAllergens {
+			merged.Allergens = item.Allergens
+		}
+		if changesLabels {
+			merged.DietaryLabels = item.DietaryLabels
+		}
+		if err := validateDietary(&merged); err != nil {
+			return http.StatusBadRequest, nil, err
+		}
+	}
+
+	_, changesUnit := updates["unit"]
+	_, changesSaleUnit := updates["sale_unit"]
+	if changesUnit || changesSaleUnit {
+		status, current, err := models.GetItem(id)
+		if err != nil {
+			return status, nil, err
+		}
+		merged := *current
+		if changesUnit {
+			if item.Unit == "" {
+				return http.StatusBadRequest, nil, errors.ErrEmptyField
+			}
+			merged.Unit = item.Unit
+		}
+		if changesSaleUnit {
+			merged.SaleUnit = item.SaleUnit
+		}
+		if err := validateUnits(&merged); err != nil {
+			return http.StatusBadRequest, nil, err
+		}
+		currentUnit, _ := units.Lookup(current.Unit)
+		newUnit, _ := units.Lookup(merged.Unit)
+		if !newUnit.Convertible(currentUnit) {
+			return http.StatusBadRequest, nil, errors.ErrUnitMismatch
+		}
+		if changesUnit {
+			updates["unit"] = merged.Unit
+		}
+		if changesSaleUnit {
+			updates["sale_unit"] = merged.SaleUnit
+		}
+	}
+
+	status, updated, err := models.UpdateItemDetails(id, updates)
 	if err != nil {
 		return status, nil, err
 	}
-	return status, item , nil
+	publishItemEvent(models.EventUpdated, updated)
+	return status, updated, nil
 }
 
 func DeleteItem(id uint) (uint32, error) {
-	status, err := models.DeleteItem(id)
+	status, item, err := models.GetItem(id)
+	if err != nil {
+		return status, err
+	}
+	status, err = models.DeleteItem(id)
 	if err != nil {
 		return status, err
 	}
+	publishItemEvent(models.EventDeleted, item)
 	return status, nil
 }
\ No newline at end of file
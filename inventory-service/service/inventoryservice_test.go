@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"inventory-service/models"
+	"inventory-service/money"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -41,7 +42,7 @@ func (suite *InventoryServiceTestSuite) TestService_AddItem() {
 	type args struct {
 		name        string
 		description string
-		price       float32
+		price       money.Money
 		quantity    uint
 	}
 	tests := []struct {
@@ -55,7 +56,7 @@ func (suite *InventoryServiceTestSuite) TestService_AddItem() {
 			args: args{
 				name:        "testitem1",
 				description: "testitem1.desc",
-				price:       100,
+				price:       money.New(10000, "EUR"),
 				quantity:    100,
 			},
 			wantErr: false,
@@ -66,7 +67,7 @@ func (suite *InventoryServiceTestSuite) TestService_AddItem() {
 			args: args{
 				name:        "",
 				description: "testitem1.desc",
-				price:       100,
+				price:       money.New(10000, "EUR"),
 				quantity:    100,
 			},
 			wantErr: true,
@@ -77,7 +78,7 @@ func (suite *InventoryServiceTestSuite) TestService_AddItem() {
 			args: args{
 				name:        "testitem1",
 				description: "",
-				price:       100,
+				price:       money.New(10000, "EUR"),
 				quantity:    100,
 			},
 			wantErr: true,
@@ -88,7 +89,7 @@ func (suite *InventoryServiceTestSuite) TestService_AddItem() {
 			args: args{
 				name:        "testitem1",
 				description: "testitem1.desc",
-				price:       0,
+				price:       money.New(0, "EUR"),
 				quantity:    100,
 			},
 			wantErr: true,
@@ -99,7 +100,18 @@ func (suite *InventoryServiceTestSuite) TestService_AddItem() {
 			args: args{
 				name:        "testitem1",
 				description: "testitem1.desc",
-				price:       -100,
+				price:       money.New(-10000, "EUR"),
+				quantity:    100,
+			},
+			wantErr: true,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Add item in an unknown currency",
+			args: args{
+				name:        "testitem1",
+				description: "testitem1.desc",
+				price:       money.New(10000, "XYZ"),
 				quantity:    100,
 			},
 			wantErr: true,
@@ -110,7 +122,7 @@ func (suite *InventoryServiceTestSuite) TestService_AddItem() {
 			args: args{
 				name:        "testitem1",
 				description: "testitem1.desc",
-				price:       100,
+				price:       money.New(10000, "EUR"),
 				quantity:    0,
 			},
 			wantErr: true,
@@ -121,7 +133,7 @@ func (suite *InventoryServiceTestSuite) TestService_AddItem() {
 			args: args{
 				name:        "testitem1",
 				description: "testitem1.desc",
-				price:       100,
+				price:       money.New(10000, "EUR"),
 				quantity:    100,
 			},
 			wantErr: true,
@@ -131,7 +143,12 @@ func (suite *InventoryServiceTestSuite) TestService_AddItem() {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			status, item, err := AddItem(tt.args.name, tt.args.description, tt.args.price, tt.args.quantity)
+			status, item, err := AddItem(&models.Item{
+				Name:        tt.args.name,
+				Description: tt.args.description,
+				Price:       tt.args.price,
+				Quantity:    tt.args.quantity,
+			})
 			if tt.wantErr {
 				assert.Equal(t, tt.wantStatus, status)
 				assert.Error(t, err)
@@ -156,11 +173,11 @@ func (suite *InventoryServiceTestSuite) TestService_GetItem() {
 		item := &models.Item{
 			Name:        "testitem2",
 			Description: "testitem1.desc",
-			Price:       100,
+			Price:       money.New(10000, "EUR"),
 			Quantity:    100,
 		}
 
-		status, newItem, err := AddItem(item.Name, item.Description, item.Price, item.Quantity)
+		status, newItem, err := AddItem(item)
 		assert.Equal(t, uint32(http.StatusCreated), status)
 		assert.NoError(t, err)
 		assert.NotNil(t, newItem)
@@ -194,11 +211,11 @@ func (suite *InventoryServiceTestSuite) TestService_GetAllITems() {
 		item := &models.Item{
 			Name:        "testitem3",
 			Description: "testitem1.desc",
-			Price:       100,
+			Price:       money.New(10000, "EUR"),
 			Quantity:    100,
 		}
 
-		status, newItem1, err := AddItem(item.Name, item.Description, item.Price, item.Quantity)
+		status, newItem1, err := AddItem(item)
 		assert.Equal(t, uint32(http.StatusCreated), status)
 		assert.NoError(t, err)
 		assert.NotNil(t, newItem1)
@@ -206,11 +223,11 @@ func (suite *InventoryServiceTestSuite) TestService_GetAllITems() {
 		item = &models.Item{
 			Name:        "testitem4",
 			Description: "testitem1.desc",
-			Price:       100,
+			Price:       money.New(10000, "EUR"),
 			Quantity:    100,
 		}
 
-		status, newItem2, err := AddItem(item.Name, item.Description, item.Price, item.Quantity)
+		status, newItem2, err := AddItem(item)
 		assert.Equal(t, uint32(http.StatusCreated), status)
 		assert.NoError(t, err)
 		assert.NotNil(t, newItem2)
@@ -230,16 +247,16 @@ func (suite *InventoryServiceTestSuite) TestService_AddQuantity() {
 		item := &models.Item{
 			Name:        "testitem5",
 			Description: "testitem1.desc",
-			Price:       100,
+			Price:       money.New(10000, "EUR"),
 			Quantity:    100,
 		}
 
-		status, newItem, err := AddItem(item.Name, item.Description, item.Price, item.Quantity)
+		status, newItem, err := AddItem(item)
 		assert.Equal(t, uint32(http.StatusCreated), status)
 		assert.NoError(t, err)
 		assert.NotNil(t, newItem)
 
-		status, newItem, err = AddQuantity(newItem.ID, 100)
+		status, newItem, err = AddQuantity(newItem.ID, 100, models.ReasonRestock, "", "")
 		assert.Equal(t, uint32(http.StatusOK), status)
 		assert.NoError(t, err)
 		assert.NotNil(t, newItem)
@@ -247,14 +264,14 @@ func (suite *InventoryServiceTestSuite) TestService_AddQuantity() {
 	})
 
 	t.Run("expect error with invalid id", func(t *testing.T) {
-		status, item, err := AddQuantity(0, 100)
+		status, item, err := AddQuantity(0, 100, models.ReasonRestock, "", "")
 		assert.Equal(t, uint32(http.StatusNotFound), status)
 		assert.Error(t, err)
 		assert.Nil(t, item)
 	})
 
 	t.Run("expect error with non-exist id", func(t *testing.T) {
-		status, item, err := AddQuantity(999, 100)
+		status, item, err := AddQuantity(999, 100, models.ReasonRestock, "", "")
 		assert.Equal(t, uint32(http.StatusNotFound), status)
 		assert.Error(t, err)
 		assert.Nil(t, item)
@@ -268,16 +285,16 @@ func (suite *InventoryServiceTestSuite) TestService_LowerQuantity() {
 		item := &models.Item{
 			Name:        "testitem6",
 			Description: "testitem1.desc",
-			Price:       100,
+			Price:       money.New(10000, "EUR"),
 			Quantity:    100,
 		}
 
-		status, newItem, err := AddItem(item.Name, item.Description, item.Price, item.Quantity)
+		status, newItem, err := AddItem(item)
 		assert.Equal(t, uint32(http.StatusCreated), status)
 		assert.NoError(t, err)
 		assert.NotNil(t, newItem)
 
-		status, newItem, err = LowerQuantity(newItem.ID, 100)
+		status, newItem, err = LowerQuantity(newItem.ID, 100, models.ReasonOrder, "", "")
 		assert.Equal(t, uint32(http.StatusOK), status)
 		assert.NoError(t, err)
 		assert.NotNil(t, newItem)
@@ -285,14 +302,14 @@ func (suite *InventoryServiceTestSuite) TestService_LowerQuantity() {
 	})
 
 	t.Run("expect error with invalid id", func(t *testing.T) {
-		status, item, err := LowerQuantity(0, 100)
+		status, item, err := LowerQuantity(0, 100, models.ReasonOrder, "", "")
 		assert.Equal(t, uint32(http.StatusNotFound), status)
 		assert.Error(t, err)
 		assert.Nil(t, item)
 	})
 
 	t.Run("expect error with non-exist id", func(t *testing.T) {
-		status, item, err := LowerQuantity(999, 100)
+		status, item, err := LowerQuantity(999, 100, models.ReasonOrder, "", "")
 		assert.Equal(t, uint32(http.StatusNotFound), status)
 		assert.Error(t, err)
 		assert.Nil(t, item)
@@ -302,16 +319,16 @@ func (suite *InventoryServiceTestSuite) TestService_LowerQuantity() {
 		item := &models.Item{
 			Name:        "testitem7",
 			Description: "testitem1.desc",
-			Price:       100,
+			Price:       money.New(10000, "EUR"),
 			Quantity:    100,
 		}
 
-		status, newItem, err := AddItem(item.Name, item.Description, item.Price, item.Quantity)
+		status, newItem, err := AddItem(item)
 		assert.Equal(t, uint32(http.StatusCreated), status)
 		assert.NoError(t, err)
 		assert.NotNil(t, newItem)
 
-		status, newItem, err = LowerQuantity(newItem.ID, 200)
+		status, newItem, err = LowerQuantity(newItem.ID, 200, models.ReasonOrder, "", "")
 		assert.Equal(t, uint32(http.StatusConflict), status)
 		assert.Error(t, err)
 		assert.Nil(t, newItem)
@@ -326,11 +343,11 @@ func (suite *InventoryServiceTestSuite) TestService_DeleteItem() {
 		item := &models.Item{
 			Name:        "testitem8",
 			Description: "testitem1.desc",
-			Price:       100,
+			Price:       money.New(10000, "EUR"),
 			Quantity:    100,
 		}
 
-		status, newItem, err := AddItem(item.Name, item.Description, item.Price, item.Quantity)
+		status, newItem, err := AddItem(item)
 		assert.Equal(t, uint32(http.StatusCreated), status)
 		assert.NoError(t, err)
 		assert.NotNil(t, newItem)
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"inventory-service/errors"
+	"inventory-service/models"
+	"net/http"
+)
+
+func AddLocation(location *models.Location) (uint32, *models.Location, error) {
+	if location == nil || location.Name == "" {
+		return http.StatusBadRequest, nil, errors.ErrInvalidLocation
+	}
+	if location.Kind == "" {
+		location.Kind = models.LocationKitchen
+	}
+	if location.Kind != models.LocationKitchen && location.Kind != models.LocationWarehouse {
+		return http.StatusBadRequest, nil, errors.ErrInvalidLocation
+	}
+	return models.CreateLocation(location)
+}
+
+func ListLocations() (uint32, []*models.Location, error) {
+	return models.GetLocations()
+}
+
+// GetItemStock returns how much of an item each location holds.
+func GetItemStock(itemID uint) (uint32, []*models.LocationStock, error) {
+	return models.GetItemStock(itemID)
+}
+
+// TransferStock moves stock of an item between two locations; a location of 0 means the
+// default location. The item's total quantity does not change.
+func TransferStock(itemID uint, fromLocationID uint, toLocationID uint, quantity uint, reference string, actor string) (uint32, []*models.StockMovement, error) {
+	if fromLocationID == 0 {
+		fromLocationID = models.DefaultLocationID()
+	}
+	if toLocationID == 0 {
+		toLocationID = models.DefaultLocationID()
+	}
+	if quantity == 0 || fromLocationID == toLocationID {
+		return http.StatusBadRequest, nil, errors.ErrInvalidTransfer
+	}
+	return models.TransferStock(itemID, fromLocationID, toLocationID, quantity, reference, actor, clock())
+}
@@ -0,0 +1,261 @@
+package service
+
+import (
+	"net/http"
+	"testing"
+
+	"inventory-service/errors"
+	"inventory-service/models"
+	"inventory-service/money"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type LocationServiceTestSuite struct {
+	suite.Suite
+	db *gorm.DB
+}
+
+func (suite *LocationServiceTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		suite.FailNow("failed to connect database")
+	}
+	suite.db = db
+	models.InitInventoryModels(db)
+}
+
+func (suite *LocationServiceTestSuite) TearDownTest() {
+	_ = suite.db.Migrator().DropTable(&models.Item{}, &models.StockMovement{}, &models.Location{}, &models.LocationStock{})
+	sql, _ := suite.db.DB()
+	sql.Close()
+}
+
+func TestLocationServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(LocationServiceTestSuite))
+}
+
+// stockAt returns how much of the item each location holds, keyed by location id.
+func stockAt(t *testing.T, itemID uint) map[uint]uint {
+	_, levels, err := GetItemStock(itemID)
+	assert.NoError(t, err)
+	stock := map[uint]uint{}
+	for _, level := range levels {
+		stock[level.LocationID] = level.Quantity
+	}
+	return stock
+}
+
+func (suite *LocationServiceTestSuite) TestService_AddLocation() {
+	t := suite.T()
+
+	t.Run("Add a warehouse", func(t *testing.T) {
+		status, location, err := AddLocation(&models.Location{Name: "central store", Kind: models.LocationWarehouse})
+		assert.Equal(t, uint32(http.StatusCreated), status)
+		assert.NoError(t, err)
+		assert.NotZero(t, location.ID)
+	})
+
+	t.Run("Add a kitchen by default", func(t *testing.T) {
+		_, location, err := AddLocation(&models.Location{Name: "koramangala"})
+		assert.NoError(t, err)
+		assert.Equal(t, models.LocationKitchen, location.Kind)
+	})
+
+	t.Run("Reject a location without a name or with an unknown kind", func(t *testing.T) {
+		status, _, err := AddLocation(&models.Location{})
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrInvalidLocation, err)
+
+		status, _, err = AddLocation(&models.Location{Name: "food truck", Kind: "truck"})
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrInvalidLocation, err)
+	})
+
+	t.Run("Reject a duplicate name", func(t *testing.T) {
+		status, _, err := AddLocation(&models.Location{Name: models.DefaultLocationName})
+		assert.Equal(t, uint32(http.StatusUnprocessableEntity), status)
+		assert.Equal(t, errors.ErrLocationExists, err)
+	})
+
+	t.Run("List the default location first", func(t *testing.T) {
+		_, locations, err := ListLocations()
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(locations))
+		assert.Equal(t, models.DefaultLocationName, locations[0].Name)
+	})
+}
+
+func (suite *LocationServiceTestSuite) TestService_StockAtLocations() {
+	t := suite.T()
+
+	main := models.DefaultLocationID()
+	_, kitchen, err := AddLocation(&models.Location{Name: "indiranagar"})
+	assert.NoError(t, err)
+	_, item, err := AddItem(&models.Item{Name: "vada pav", Description: "potato burger", Price: money.New(3000, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+
+	t.Run("Keep opening stock at the default location", func(t *testing.T) {
+		assert.Equal(t, map[uint]uint{main: 10}, stockAt(t, item.ID))
+	})
+
+	t.Run("Report the total across locations as the item's quantity", func(t *testing.T) {
+		_, updated, err := AddQuantityAt(item.ID, kitchen.ID, 5, money.Money{}, models.ReasonRestock, "", "")
+		assert.NoError(t, err)
+		assert.Equal(t, uint(15), updated.Quantity)
+		assert.Equal(t, map[uint]uint{main: 10, kitchen.ID: 5}, stockAt(t, item.ID))
+
+		_, fetched, err := GetItem(item.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, uint(15), fetched.Quantity)
+	})
+
+	t.Run("Refuse to take more than a location holds", func(t *testing.T) {
+		status, _, err := LowerQuantityAt(item.ID, kitchen.ID, 6, nil, models.ReasonOrder, "", "")
+		assert.Equal(t, uint32(http.StatusConflict), status)
+		assert.Equal(t, errors.ErrInsufficientQuantity, err)
+
+		_, updated, err := LowerQuantityAt(item.ID, kitchen.ID, 5, nil, models.ReasonOrder, "", "")
+		assert.NoError(t, err)
+		assert.Equal(t, uint(10), updated.Quantity)
+	})
+
+	t.Run("Reject an unknown location", func(t *testing.T) {
+		status, _, err := AddQuantityAt(item.ID, 99, 1, money.Money{}, models.ReasonRestock, "", "")
+		assert.Equal(t, uint32(http.StatusNotFound), status)
+		assert.Equal(t, errors.ErrLocationNotFound, err)
+	})
+}
+
+func (suite *LocationServiceTestSuite) TestService_OrderFromAnotherLocation() {
+	t := suite.T()
+
+	main := models.DefaultLocationID()
+	_, kitchen, err := AddLocation(&models.Location{Name: "bandra"})
+	assert.NoError(t, err)
+	_, item, err := AddItem(&models.Item{Name: "pav bhaji", Description: "buttered buns and curry", Price: money.New(8000, "EUR"), Quantity: 4})
+	assert.NoError(t, err)
+	_, _, err = TransferStock(item.ID, main, kitchen.ID, 4, "", "")
+	assert.NoError(t, err)
+	_, item, err = GetItem(item.ID)
+	assert.NoError(t, err)
+
+	t.Run("Count stock held only at another location as available", func(t *testing.T) {
+		_, available, err := AvailableQuantities([]*models.Item{item})
+		assert.NoError(t, err)
+		assert.Equal(t, uint(4), available[item.ID])
+	})
+
+	t.Run("Take an order from the location that holds the stock", func(t *testing.T) {
+		status, updated, err := LowerQuantityWithOptions(item.ID, 3, nil, models.ReasonOrder, "17", "5")
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.Equal(t, uint(1), updated.Quantity)
+		assert.Equal(t, map[uint]uint{kitchen.ID: 1}, stockAt(t, item.ID))
+
+		_, movements, _, err := ListStockMovements(item.ID, 1, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(-3), movements[0].Delta)
+		assert.Equal(t, kitchen.ID, movements[0].LocationID)
+	})
+
+	t.Run("Take an order from the default location first", func(t *testing.T) {
+		_, _, err := AddQuantityAt(item.ID, main, 2, money.Money{}, models.ReasonRestock, "", "")
+		assert.NoError(t, err)
+
+		_, _, err = LowerQuantityWithOptions(item.ID, 1, nil, models.ReasonOrder, "18", "5")
+		assert.NoError(t, err)
+		assert.Equal(t, map[uint]uint{main: 1, kitchen.ID: 1}, stockAt(t, item.ID))
+	})
+
+	t.Run("Refuse an order no single location can fill", func(t *testing.T) {
+		status, _, err := LowerQuantityWithOptions(item.ID, 2, nil, models.ReasonOrder, "19", "5")
+		assert.Equal(t, uint32(http.StatusConflict), status)
+		assert.Equal(t, errors.ErrInsufficientQuantity, err)
+		assert.Equal(t, map[uint]uint{main: 1, kitchen.ID: 1}, stockAt(t, item.ID))
+	})
+}
+
+func (suite *LocationServiceTestSuite) TestService_TransferStock() {
+	t := suite.T()
+
+	main := models.DefaultLocationID()
+	_, kitchen, err := AddLocation(&models.Location{Name: "hsr layout"})
+	assert.NoError(t, err)
+	_, item, err := AddItem(&models.Item{Name: "misal", Description: "spicy sprouts", Price: money.New(9000, "EUR"), Quantity: 8})
+	assert.NoError(t, err)
+
+	t.Run("Move stock as a pair of movements", func(t *testing.T) {
+		status, movements, err := TransferStock(item.ID, 0, kitchen.ID, 3, "TR-1", "7")
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(movements))
+		assert.Equal(t, int64(-3), movements[0].Delta)
+		assert.Equal(t, main, movements[0].LocationID)
+		assert.Equal(t, int64(3), movements[1].Delta)
+		assert.Equal(t, kitchen.ID, movements[1].LocationID)
+		assert.Equal(t, movements[0].ID, movements[1].PairedWith)
+		assert.Equal(t, uint(8), movements[1].Balance)
+
+		assert.Equal(t, map[uint]uint{main: 5, kitchen.ID: 3}, stockAt(t, item.ID))
+		_, drifts, err := ReconcileStock(item.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), drifts[0].Drift)
+	})
+
+	t.Run("Leave stock untouched when the source is short", func(t *testing.T) {
+		status, _, err := TransferStock(item.ID, kitchen.ID, main, 4, "", "")
+		assert.Equal(t, uint32(http.StatusConflict), status)
+		assert.Equal(t, errors.ErrInsufficientQuantity, err)
+		assert.Equal(t, map[uint]uint{main: 5, kitchen.ID: 3}, stockAt(t, item.ID))
+	})
+
+	t.Run("Reject a transfer to the same location or of nothing", func(t *testing.T) {
+		status, _, err := TransferStock(item.ID, main, 0, 1, "", "")
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrInvalidTransfer, err)
+
+		status, _, err = TransferStock(item.ID, main, kitchen.ID, 0, "", "")
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrInvalidTransfer, err)
+	})
+}
+
+func (suite *LocationServiceTestSuite) TestService_StockBeforeLocations() {
+	t := suite.T()
+
+	// an item stocked before locations existed has no location stock of its own
+	item := &models.Item{Name: "pav bhaji", Description: "vegetable mash", Price: money.New(11000, "EUR"), Quantity: 6}
+	assert.NoError(t, suite.db.Create(item).Error)
+
+	models.InitInventoryModels(suite.db)
+
+	assert.Equal(t, map[uint]uint{models.DefaultLocationID(): 6}, stockAt(t, item.ID))
+	_, updated, err := LowerQuantity(item.ID, 6, models.ReasonOrder, "", "")
+	assert.NoError(t, err)
+	assert.Equal(t, uint(0), updated.Quantity)
+}
+
+func (suite *LocationServiceTestSuite) TestService_ArchivedStockBeforeLocations() {
+	t := suite.T()
+
+	// an item archived before locations and the ledger existed has neither
+	item := &models.Item{Name: "sabudana vada", Description: "sago fritters", Price: money.New(7000, "EUR"), Quantity: 4}
+	assert.NoError(t, suite.db.Create(item).Error)
+	assert.NoError(t, suite.db.Delete(item).Error)
+
+	models.InitInventoryModels(suite.db)
+
+	_, _, err := RestoreItem(item.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, map[uint]uint{models.DefaultLocationID(): 4}, stockAt(t, item.ID))
+	_, drifts, err := ReconcileStock(item.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), drifts[0].Drift)
+	_, updated, err := LowerQuantity(item.ID, 4, models.ReasonOrder, "", "")
+	assert.NoError(t, err)
+	assert.Equal(t, uint(0), updated.Quantity)
+}
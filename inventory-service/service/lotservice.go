@@ -0,0 +1,203 @@
+package service
+
+import (
+	"fmt"
+	"inventory-service/errors"
+	"inventory-service/models"
+	"inventory-service/money"
+	"net/http"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+// ReceiveLot adds stock of a perishable item at a location as a lot expiring at expiresAt;
+// a locationID of 0 means the default location. The lot costs unitCost per unit the item is
+// stocked in, or the item's cost price when no cost is given.
+func ReceiveLot(id uint, locationID uint, quantity uint, unitCost money.Money, expiresAt time.Time, reference string, actor string) (uint32, *models.Item, *models.Lot, error) {
+	if quantity == 0 {
+		return http.StatusBadRequest, nil, nil, errors.ErrEmptyField
+	}
+	if err := validateCost(unitCost); err != nil {
+		return http.StatusBadRequest, nil, nil, err
+	}
+	now := clock()
+	if !expiresAt.After(now) {
+		return http.StatusBadRequest, nil, nil, errors.ErrInvalidExpiry
+	}
+	status, item, lot, err := models.ReceiveLot(&models.StockMovement{
+		ItemID:     id,
+		LocationID: locationID,
+		Delta:      int64(quantity),
+		Reason:     models.ReasonRestock,
+		Reference:  reference,
+		Actor:      actor,
+		CreatedAt:  now,
+		UnitCost:   unitCost,
+	}, expiresAt)
+	if err != nil {
+		return status, nil, nil, err
+	}
+	publishItemEvent(models.EventQuantityChanged, item)
+	return status, item, lot, nil
+}
+
+func ListLots(itemID uint) (uint32, []*models.Lot, error) {
+	status, lots, err := models.GetLots(itemID)
+	if err != nil {
+		return status, nil, err
+	}
+	return http.StatusOK, lots, nil
+}
+
+// ListExpiredLots returns the lots that have expired but still hold stock.
+func ListExpiredLots() (uint32, []*models.Lot, error) {
+	status, lots, err := models.GetExpiredLots(clock())
+	if err != nil {
+		return status, nil, err
+	}
+	return http.StatusOK, lots, nil
+}
+
+// AvailableQuantities returns how much of each item can still be sold: its stock less
+// whatever sits in expired lots, for a dish with a recipe how many portions the unexpired
+// stock of its ingredients makes, and for a bundle how many its components make up.
+func AvailableQuantities(items []*models.Item) (uint32, map[uint]uint, error) {
+	ids := []uint{}
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	status, lines, err := models.GetRecipeLines(ids)
+	if err != nil {
+		return status, nil, err
+	}
+	recipes := map[uint][]*models.RecipeLine{}
+	ingredientIDs := []uint{}
+	for _, line := range lines {
+		recipes[line.DishID] = append(recipes[line.DishID], line)
+		ingredientIDs = append(ingredientIDs, line.IngredientID)
+	}
+
+	stocked := items
+	if len(ingredientIDs) > 0 {
+		status, ingredients, err := models.GetItemsByIDs(ingredientIDs)
+		if err != nil {
+			return status, nil, err
+		}
+		stocked = append(append([]*models.Item{}, items...), ingredients...)
+		ids = append(ids, ingredientIDs...)
+	}
+	status, expired, err := models.GetExpiredQuantities(ids, clock())
+	if err != nil {
+		return status, nil, err
+	}
+	stock := map[uint]uint{}
+	for _, item := range stocked {
+		stock[item.ID] = 0
+		if expired[item.ID] < item.Quantity {
+			stock[item.ID] = item.Quantity - expired[item.ID]
+		}
+	}
+
+	available := map[uint]uint{}
+	for _, item := range items {
+		if recipe, ok := recipes[item.ID]; ok {
+			available[item.ID] = portions(recipe, stock)
+		} else {
+			available[item.ID] = stock[item.ID]
+		}
+	}
+	return bundlesAvailable(items, available)
+}
+
+// bundlesAvailable replaces the quantities of any bundles among items with how many of
+// each bundle the available quantities of its components make up.
+func bundlesAvailable(items []*models.Item, available map[uint]uint) (uint32, map[uint]uint, error) {
+	ids := []uint{}
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	status, components, err := models.GetBundleComponents(ids)
+	if err != nil {
+		return status, nil, err
+	}
+	if len(components) == 0 {
+		return http.StatusOK, available, nil
+	}
+
+	componentIDs := []uint{}
+	for _, component := range components {
+		componentIDs = append(componentIDs, component.ComponentID)
+	}
+	status, found, err := models.GetItemsByIDs(componentIDs)
+	if err != nil {
+		return status, nil, err
+	}
+	status, stock, err := AvailableQuantities(found)
+	if err != nil {
+		return status, nil, err
+	}
+
+	made := map[uint]bool{}
+	for _, component := range components {
+		count := stock[component.ComponentID] / component.Quantity
+		if !made[component.BundleID] || count < available[component.BundleID] {
+			available[component.BundleID] = count
+		}
+		made[component.BundleID] = true
+	}
+	return http.StatusOK, available, nil
+}
+
+// WriteOffExpiredLots takes the stock left in every expired lot out of the inventory as
+// wastage, one movement per lot. A lot that cannot be written off is logged and left for
+// the next run rather than holding up the others.
+func WriteOffExpiredLots(actor string) (uint32, []*models.StockMovement, error) {
+	now := clock()
+	status, lots, err := models.GetExpiredLots(now)
+	if err != nil {
+		return status, nil, err
+	}
+
+	movements := []*models.StockMovement{}
+	for _, lot := range lots {
+		movement := &models.StockMovement{
+			ItemID:     lot.ItemID,
+			LocationID: lot.LocationID,
+			LotID:      lot.ID,
+			Delta:      -int64(lot.Quantity),
+			Reason:     models.ReasonWastage,
+			Reference:  fmt.Sprintf("expired lot %d", lot.ID),
+			Actor:      actor,
+			CreatedAt:  now,
+		}
+		_, item, err := models.ApplyStockMovement(movement)
+		if err != nil {
+			logger.WithFields(logger.Fields{"lot": lot.ID, "error": err.Error()}).Error("failed to write off expired lot")
+			continue
+		}
+		movements = append(movements, movement)
+		publishItemEvent(models.EventQuantityChanged, item)
+		checkReorderLevel(item, item.Quantity+lot.Quantity)
+	}
+	return http.StatusOK, movements, nil
+}
+
+// WriteOffExpiredLotsEvery writes off expired lots once every interval until stop is closed.
+func WriteOffExpiredLotsEvery(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			_, movements, err := WriteOffExpiredLots("")
+			if err != nil {
+				logger.WithField("error", err.Error()).Error("failed to write off expired lots")
+			} else if len(movements) > 0 {
+				logger.WithField("lots", len(movements)).Info("wrote off expired lots")
+			}
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"inventory-service/errors"
+	"inventory-service/models"
+	"inventory-service/money"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type LotServiceTestSuite struct {
+	suite.Suite
+	db *gorm.DB
+}
+
+func (suite *LotServiceTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		suite.FailNow("failed to connect database")
+	}
+	suite.db = db
+	models.InitInventoryModels(db)
+	fixClock("2026-03-02T09:00:00Z")
+}
+
+func (suite *LotServiceTestSuite) TearDownTest() {
+	clock = time.Now
+	_ = suite.db.Migrator().DropTable(&models.Item{}, &models.StockMovement{}, &models.Location{}, &models.LocationStock{}, &models.Lot{})
+	sql, _ := suite.db.DB()
+	sql.Close()
+}
+
+func TestLotServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(LotServiceTestSuite))
+}
+
+// daysFromNow is the time the given number of days after the service's clock.
+func daysFromNow(days int) time.Time {
+	return clock().AddDate(0, 0, days)
+}
+
+// lotQuantities returns how much each lot of the item still holds, keyed by lot id.
+func lotQuantities(t *testing.T, itemID uint) map[uint]uint {
+	_, lots, err := ListLots(itemID)
+	assert.NoError(t, err)
+	quantities := map[uint]uint{}
+	for _, lot := range lots {
+		quantities[lot.ID] = lot.Quantity
+	}
+	return quantities
+}
+
+func (suite *LotServiceTestSuite) TestService_ReceiveLot() {
+	t := suite.T()
+
+	_, item, err := AddItem(&models.Item{Name: "milk", Description: "toned milk, 1l", Price: money.New(3000, "EUR"), Quantity: 4})
+	assert.NoError(t, err)
+
+	t.Run("Receive stock as a lot", func(t *testing.T) {
+		status, updated, lot, err := ReceiveLot(item.ID, 0, 6, money.Money{}, daysFromNow(3), "GRN-1", "7")
+		assert.Equal(t, uint32(http.StatusCreated), status)
+		assert.NoError(t, err)
+		assert.Equal(t, uint(10), updated.Quantity)
+		assert.Equal(t, uint(6), lot.Received)
+		assert.Equal(t, uint(6), lot.Quantity)
+		assert.Equal(t, models.DefaultLocationID(), lot.LocationID)
+		assert.Equal(t, clock(), lot.ReceivedAt)
+
+		_, movements, _, err := ListStockMovements(item.ID, 1, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, lot.ID, movements[0].LotID)
+		assert.Equal(t, models.ReasonRestock, movements[0].Reason)
+	})
+
+	t.Run("Reject a lot that has already expired", func(t *testing.T) {
+		status, _, _, err := ReceiveLot(item.ID, 0, 6, money.Money{}, clock(), "", "")
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrInvalidExpiry, err)
+	})
+
+	t.Run("Reject an empty lot", func(t *testing.T) {
+		status, _, _, err := ReceiveLot(item.ID, 0, 0, money.Money{}, daysFromNow(3), "", "")
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrEmptyField, err)
+	})
+}
+
+func (suite *LotServiceTestSuite) TestService_ConsumeLots() {
+	t := suite.T()
+
+	// four units without an expiry, then a late lot and an early lot
+	_, item, err := AddItem(&models.Item{Name: "curd", Description: "set curd, 500g", Price: money.New(4500, "EUR"), Quantity: 4})
+	assert.NoError(t, err)
+	_, _, late, err := ReceiveLot(item.ID, 0, 3, money.Money{}, daysFromNow(5), "", "")
+	assert.NoError(t, err)
+	_, _, _, err = ReceiveLot(item.ID, 0, 2, money.Money{}, daysFromNow(2), "", "")
+	assert.NoError(t, err)
+
+	t.Run("Consume the first-expiring lot first", func(t *testing.T) {
+		_, updated, err := LowerQuantity(item.ID, 3, models.ReasonOrder, "", "")
+		assert.NoError(t, err)
+		assert.Equal(t, uint(6), updated.Quantity)
+		assert.Equal(t, map[uint]uint{late.ID: 2}, lotQuantities(t, item.ID))
+	})
+
+	fixClock("2026-03-08T09:00:00Z")
+
+	t.Run("Leave expired lots out of the available quantity", func(t *testing.T) {
+		_, stored, err := GetItem(item.ID)
+		assert.NoError(t, err)
+		_, available, err := AvailableQuantities([]*models.Item{stored})
+		assert.NoError(t, err)
+		assert.Equal(t, uint(4), available[item.ID])
+	})
+
+	t.Run("Refuse to sell stock from an expired lot", func(t *testing.T) {
+		status, _, err := LowerQuantity(item.ID, 5, models.ReasonOrder, "", "")
+		assert.Equal(t, uint32(http.StatusConflict), status)
+		assert.Equal(t, errors.ErrInsufficientQuantity, err)
+
+		_, updated, err := LowerQuantity(item.ID, 4, models.ReasonOrder, "", "")
+		assert.NoError(t, err)
+		assert.Equal(t, uint(2), updated.Quantity)
+		assert.Equal(t, map[uint]uint{late.ID: 2}, lotQuantities(t, item.ID))
+	})
+
+	t.Run("Write off expired lots as wastage", func(t *testing.T) {
+		_, expired, err := ListExpiredLots()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(expired))
+		assert.Equal(t, late.ID, expired[0].ID)
+
+		status, movements, err := WriteOffExpiredLots("7")
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(movements))
+		assert.Equal(t, late.ID, movements[0].LotID)
+		assert.Equal(t, int64(-2), movements[0].Delta)
+		assert.Equal(t, models.ReasonWastage, movements[0].Reason)
+		assert.Equal(t, uint(0), movements[0].Balance)
+		assert.Empty(t, lotQuantities(t, item.ID))
+
+		_, drifts, err := ReconcileStock(item.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), drifts[0].Drift)
+
+		_, movements, err = WriteOffExpiredLots("7")
+		assert.NoError(t, err)
+		assert.Empty(t, movements)
+	})
+}
+
+func (suite *LotServiceTestSuite) TestService_TransferLots() {
+	t := suite.T()
+
+	_, kitchen, err := AddLocation(&models.Location{Name: "bakery"})
+	assert.NoError(t, err)
+	_, item, err := AddItem(&models.Item{Name: "pav", Description: "soft bread rolls", Price: money.New(500, "EUR"), Quantity: 2})
+	assert.NoError(t, err)
+	_, _, lot, err := ReceiveLot(item.ID, 0, 6, money.Money{}, daysFromNow(2), "", "")
+	assert.NoError(t, err)
+
+	_, _, err = TransferStock(item.ID, 0, kitchen.ID, 4, "", "")
+	assert.NoError(t, err)
+
+	_, lots, err := ListLots(item.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(lots))
+	assert.Equal(t, lot.ID, lots[0].ID)
+	assert.Equal(t, uint(2), lots[0].Quantity)
+	assert.Equal(t, kitchen.ID, lots[1].LocationID)
+	assert.Equal(t, uint(4), lots[1].Quantity)
+	assert.True(t, lot.ExpiresAt.Equal(lots[1].ExpiresAt))
+}
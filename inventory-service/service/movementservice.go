@@ -0,0 +1,72 @@
+package service
+
+import (
+	"inventory-service/errors"
+	"inventory-service/models"
+	"net/http"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// StockDrift reports an item whose recorded quantity disagrees with its ledger.
+type StockDrift struct {
+	ItemID         uint
+	Name           string
+	Quantity       uint
+	LedgerQuantity int64
+	Drift          int64
+}
+
+func ListStockMovements(itemID uint, page int, pageSize int) (uint32, []*models.StockMovement, int64, error) {
+	if itemID == 0 {
+		return http.StatusBadRequest, nil, 0, errors.ErrEmptyField
+	}
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	} else if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	status, _, err := models.GetItem(itemID)
+	if err != nil {
+		return status, nil, 0, err
+	}
+
+	status, movements, total, err := models.GetStockMovements(itemID, page, pageSize)
+	if err != nil {
+		return status, nil, 0, err
+	}
+	return http.StatusOK, movements, total, nil
+}
+
+// ReconcileStock recomputes quantities from the ledger for one item, or for every item when
+// itemID is zero, and reports the drift of each checked item. Only drifting items are
+// returned when the whole catalog is checked.
+func ReconcileStock(itemID uint) (uint32, []*StockDrift, error) {
+	status, totals, err := models.GetStockLedgerTotals(itemID)
+	if err != nil {
+		return status, nil, err
+	}
+
+	drifts := []*StockDrift{}
+	for _, total := range totals {
+		drift := int64(total.Quantity) - total.LedgerQuantity
+		if itemID == 0 && drift == 0 {
+			continue
+		}
+		drifts = append(drifts, &StockDrift{
+			ItemID:         total.ItemID,
+			Name:           total.Name,
+			Quantity:       total.Quantity,
+			LedgerQuantity: total.LedgerQuantity,
+			Drift:          drift,
+		})
+	}
+	return http.StatusOK, drifts, nil
+}
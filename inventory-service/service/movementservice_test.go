@@ -0,0 +1,160 @@
+package service
+
+import (
+	"net/http"
+	"testing"
+
+	"inventory-service/models"
+	"inventory-service/money"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type MovementServiceTestSuite struct {
+	suite.Suite
+	db *gorm.DB
+}
+
+func (suite *MovementServiceTestSuite) SetupSuite() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		suite.FailNow("failed to connect database")
+	}
+	suite.db = db
+	models.InitInventoryModels(db)
+}
+
+func (suite *MovementServiceTestSuite) TearDownSuite() {
+	_ = suite.db.Migrator().DropTable(&models.Item{}, &models.StockMovement{})
+	sql, _ := suite.db.DB()
+	sql.Close()
+}
+
+func TestMovementServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(MovementServiceTestSuite))
+}
+
+func (suite *MovementServiceTestSuite) TestService_QuantityChangesAreRecorded() {
+	t := suite.T()
+
+	status, item, err := AddItem(&models.Item{
+		Name:        "ledgeritem1",
+		Description: "ledgeritem1.desc",
+		Price:       money.New(10000, "EUR"),
+		Quantity:    10,
+	})
+	assert.Equal(t, uint32(http.StatusCreated), status)
+	assert.NoError(t, err)
+
+	t.Run("Restock and order are appended to the ledger", func(t *testing.T) {
+		status, _, err := AddQuantity(item.ID, 5, models.ReasonRestock, "invoice-12", "7")
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+
+		status, _, err = LowerQuantity(item.ID, 3, models.ReasonOrder, "42", "")
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+
+		status, movements, total, err := ListStockMovements(item.ID, 1, 10)
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		assert.Equal(t, 3, len(movements))
+
+		// newest first
+		assert.Equal(t, int64(-3), movements[0].Delta)
+		assert.Equal(t, models.ReasonOrder, movements[0].Reason)
+		assert.Equal(t, "42", movements[0].Reference)
+		assert.Equal(t, uint(12), movements[0].Balance)
+		assert.Equal(t, int64(5), movements[1].Delta)
+		assert.Equal(t, "7", movements[1].Actor)
+		assert.Equal(t, models.ReasonInitialStock, movements[2].Reason)
+	})
+
+	t.Run("Pages through the history", func(t *testing.T) {
+		status, movements, total, err := ListStockMovements(item.ID, 2, 2)
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		assert.Equal(t, 1, len(movements))
+		assert.Equal(t, models.ReasonInitialStock, movements[0].Reason)
+	})
+
+	t.Run("expect error with reason that does not match the direction", func(t *testing.T) {
+		status, got, err := AddQuantity(item.ID, 5, models.ReasonWastage, "", "")
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Error(t, err)
+		assert.Nil(t, got)
+
+		status, got, err = LowerQuantity(item.ID, 5, models.ReasonRestock, "", "")
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("Failed decrement is not recorded", func(t *testing.T) {
+		status, _, err := LowerQuantity(item.ID, 500, models.ReasonWastage, "", "")
+		assert.Equal(t, uint32(http.StatusConflict), status)
+		assert.Error(t, err)
+
+		_, _, total, err := ListStockMovements(item.ID, 1, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+	})
+
+	t.Run("expect error with non-exist id", func(t *testing.T) {
+		status, movements, _, err := ListStockMovements(999, 1, 10)
+		assert.Equal(t, uint32(http.StatusNotFound), status)
+		assert.Error(t, err)
+		assert.Nil(t, movements)
+	})
+}
+
+func (suite *MovementServiceTestSuite) TestService_ReconcileStock() {
+	t := suite.T()
+
+	status, item, err := AddItem(&models.Item{
+		Name:        "ledgeritem2",
+		Description: "ledgeritem2.desc",
+		Price:       money.New(10000, "EUR"),
+		Quantity:    10,
+	})
+	assert.Equal(t, uint32(http.StatusCreated), status)
+	assert.NoError(t, err)
+
+	t.Run("Reports no drift when quantity matches the ledger", func(t *testing.T) {
+		status, drifts, err := ReconcileStock(0)
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.Empty(t, drifts)
+
+		status, drifts, err = ReconcileStock(item.ID)
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(drifts))
+		assert.Equal(t, int64(0), drifts[0].Drift)
+	})
+
+	t.Run("Reports drift when quantity is changed outside the ledger", func(t *testing.T) {
+		err := suite.db.Model(&models.Item{}).Where("id = ?", item.ID).UpdateColumn("quantity", 4).Error
+		assert.NoError(t, err)
+
+		status, drifts, err := ReconcileStock(0)
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(drifts))
+		assert.Equal(t, item.ID, drifts[0].ItemID)
+		assert.Equal(t, int64(10), drifts[0].LedgerQuantity)
+		assert.Equal(t, int64(-6), drifts[0].Drift)
+	})
+
+	t.Run("expect error with non-exist id", func(t *testing.T) {
+		status, drifts, err := ReconcileStock(999)
+		assert.Equal(t, uint32(http.StatusNotFound), status)
+		assert.Error(t, err)
+		assert.Nil(t, drifts)
+	})
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"inventory-service/errors"
+	"inventory-service/models"
+	"net/http"
+)
+
+// AddOptionGroup validates a group of options and attaches it to its item. A single-select
+// group allows exactly one choice; a multi-select group defaults to allowing all of its
+// options. Required groups must be chosen from at least once. Price deltas are in the
+// item's currency, so they can be added to its price.
+func AddOptionGroup(group *models.OptionGroup) (uint32, *models.OptionGroup, error) {
+	if group == nil || group.Name == "" || len(group.Options) == 0 {
+		return http.StatusBadRequest, nil, errors.ErrInvalidOptionGroup
+	}
+	status, item, err := models.GetItem(group.ItemID)
+	if err != nil {
+		return status, nil, err
+	}
+
+	names := map[string]bool{}
+	for _, option := range group.Options {
+		if option == nil || option.Name == "" || names[option.Name] {
+			return http.StatusBadRequest, nil, errors.ErrInvalidOptionGroup
+		}
+		names[option.Name] = true
+		if option.PriceDelta.MinorUnits == 0 {
+			option.PriceDelta.Currency = item.Price.Currency
+		}
+		if option.PriceDelta.Currency != item.Price.Currency {
+			return http.StatusBadRequest, nil, errors.ErrCurrencyMismatch
+		}
+		if !option.TrackStock {
+			option.Quantity = 0
+		}
+	}
+
+	switch group.SelectionType {
+	case "", models.SelectionSingle:
+		group.SelectionType = models.SelectionSingle
+		if group.MaxSelections > 1 {
+			return http.StatusBadRequest, nil, errors.ErrInvalidOptionGroup
+		}
+		group.MaxSelections = 1
+	case models.SelectionMulti:
+		if group.MaxSelections == 0 {
+			group.MaxSelections = uint(len(group.Options))
+		}
+	default:
+		return http.StatusBadRequest, nil, errors.ErrInvalidOptionGroup
+	}
+
+	if group.Required && group.MinSelections == 0 {
+		group.MinSelections = 1
+	}
+	if group.MinSelections > group.MaxSelections || group.MaxSelections > uint(len(group.Options)) {
+		return http.StatusBadRequest, nil, errors.ErrInvalidOptionGroup
+	}
+
+	return models.CreateOptionGroup(group)
+}
+
+func GetOptionGroups(itemID uint) (uint32, []*models.OptionGroup, error) {
+	return models.GetOptionGroups(itemID)
+}
+
+func DeleteOptionGroup(id uint) (uint32, error) {
+	if id == 0 {
+		return http.StatusBadRequest, errors.ErrEmptyField
+	}
+	return models.DeleteOptionGroup(id)
+}
+
+func SetOptionStock(id uint, trackStock bool, quantity uint) (uint32, *models.Option, error) {
+	if id == 0 {
+		return http.StatusBadRequest, nil, errors.ErrEmptyField
+	}
+	if !trackStock {
+		quantity = 0
+	}
+	return models.UpdateOptionStock(id, trackStock, quantity)
+}
+
+// selectOptions checks a selection of option IDs against the item's option groups and
+// returns the selected options. Every ID must belong to the item, be chosen at most once,
+// and every group must end up within its minimum and maximum number of selections.
+// Optional groups may also be left out entirely.
+func selectOptions(groups []*models.OptionGroup, optionIDs []uint) ([]*models.Option, error) {
+	owners := map[uint]*models.OptionGroup{}
+	options := map[uint]*models.Option{}
+	for _, group := range groups {
+		for _, option := range group.Options {
+			owners[option.ID] = group
+			options[option.ID] = option
+		}
+	}
+
+	selected := []*models.Option{}
+	counts := map[uint]uint{}
+	seen := map[uint]bool{}
+	for _, id := range optionIDs {
+		group, ok := owners[id]
+		if !ok || seen[id] {
+			return nil, errors.ErrInvalidOptionSelection
+		}
+		seen[id] = true
+		counts[group.ID]++
+		selected = append(selected, options[id])
+	}
+
+	for _, group := range groups {
+		count := counts[group.ID]
+		if count == 0 && !group.Required {
+			continue
+		}
+		if count < group.MinSelections || count > group.MaxSelections {
+			return nil, errors.ErrInvalidOptionSelection
+		}
+	}
+	return selected, nil
+}
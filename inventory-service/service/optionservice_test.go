@@ -0,0 +1,243 @@
+package service
+
+import (
+	"net/http"
+	"testing"
+
+	"inventory-service/errors"
+	"inventory-service/models"
+	"inventory-service/money"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type OptionServiceTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	item *models.Item
+}
+
+func (suite *OptionServiceTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		suite.FailNow("failed to connect database")
+	}
+	suite.db = db
+	models.InitInventoryModels(db)
+
+	_, item, err := AddItem(&models.Item{Name: "pizza", Description: "margherita", Price: money.New(30000, "EUR"), Quantity: 20})
+	if err != nil {
+		suite.FailNow("failed to add item")
+	}
+	suite.item = item
+}
+
+func (suite *OptionServiceTestSuite) TearDownTest() {
+	_ = suite.db.Migrator().DropTable(&models.Item{}, &models.StockMovement{}, &models.OptionGroup{}, &models.Option{})
+	sql, _ := suite.db.DB()
+	sql.Close()
+}
+
+func TestOptionServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(OptionServiceTestSuite))
+}
+
+func (suite *OptionServiceTestSuite) addGroups() (*models.OptionGroup, *models.OptionGroup) {
+	_, size, err := AddOptionGroup(&models.OptionGroup{
+		ItemID:   suite.item.ID,
+		Name:     "size",
+		Required: true,
+		Options: []*models.Option{
+			{Name: "regular"},
+			{Name: "large", PriceDelta: money.New(12000, "EUR")},
+		},
+	})
+	suite.Require().NoError(err)
+
+	_, toppings, err := AddOptionGroup(&models.OptionGroup{
+		ItemID:        suite.item.ID,
+		Name:          "toppings",
+		SelectionType: models.SelectionMulti,
+		MaxSelections: 2,
+		Options: []*models.Option{
+			{Name: "extra cheese", PriceDelta: money.New(4000, "EUR"), TrackStock: true, Quantity: 3},
+			{Name: "olives", PriceDelta: money.New(3000, "EUR")},
+			{Name: "jalapenos", PriceDelta: money.New(3000, "EUR")},
+		},
+	})
+	suite.Require().NoError(err)
+	return size, toppings
+}
+
+func (suite *OptionServiceTestSuite) TestService_AddOptionGroup() {
+	t := suite.T()
+
+	t.Run("Single-select group allows exactly one option", func(t *testing.T) {
+		status, group, err := AddOptionGroup(&models.OptionGroup{
+			ItemID:   suite.item.ID,
+			Name:     "crust",
+			Required: true,
+			Options:  []*models.Option{{Name: "thin"}, {Name: "pan", PriceDelta: money.New(2000, "EUR")}},
+		})
+		assert.Equal(t, uint32(http.StatusCreated), status)
+		assert.NoError(t, err)
+		assert.Equal(t, models.SelectionSingle, group.SelectionType)
+		assert.Equal(t, uint(1), group.MinSelections)
+		assert.Equal(t, uint(1), group.MaxSelections)
+		assert.Equal(t, 2, len(group.Options))
+
+		_, groups, err := GetOptionGroups(suite.item.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(groups))
+		assert.Equal(t, "pan", groups[0].Options[1].Name)
+	})
+
+	t.Run("Multi-select group allows all options by default", func(t *testing.T) {
+		status, group, err := AddOptionGroup(&models.OptionGroup{
+			ItemID:        suite.item.ID,
+			Name:          "dips",
+			SelectionType: models.SelectionMulti,
+			Options:       []*models.Option{{Name: "garlic"}, {Name: "chipotle"}},
+		})
+		assert.Equal(t, uint32(http.StatusCreated), status)
+		assert.NoError(t, err)
+		assert.Equal(t, uint(0), group.MinSelections)
+		assert.Equal(t, uint(2), group.MaxSelections)
+	})
+
+	t.Run("expect error with invalid group", func(t *testing.T) {
+		invalid := []*models.OptionGroup{
+			{ItemID: suite.item.ID, Name: "empty"},
+			{ItemID: suite.item.ID, Name: "", Options: []*models.Option{{Name: "a"}}},
+			{ItemID: suite.item.ID, Name: "duplicate", Options: []*models.Option{{Name: "a"}, {Name: "a"}}},
+			{ItemID: suite.item.ID, Name: "single", MaxSelections: 2, Options: []*models.Option{{Name: "a"}, {Name: "b"}}},
+			{ItemID: suite.item.ID, Name: "too many", SelectionType: models.SelectionMulti, MaxSelections: 3, Options: []*models.Option{{Name: "a"}, {Name: "b"}}},
+			{ItemID: suite.item.ID, Name: "min above max", SelectionType: models.SelectionMulti, MinSelections: 2, MaxSelections: 1, Options: []*models.Option{{Name: "a"}, {Name: "b"}}},
+			{ItemID: suite.item.ID, Name: "unknown type", SelectionType: "any", Options: []*models.Option{{Name: "a"}}},
+		}
+		for _, group := range invalid {
+			status, got, err := AddOptionGroup(group)
+			assert.Equal(t, uint32(http.StatusBadRequest), status, group.Name)
+			assert.Equal(t, errors.ErrInvalidOptionGroup, err, group.Name)
+			assert.Nil(t, got)
+		}
+	})
+
+	t.Run("expect error with non-exist item", func(t *testing.T) {
+		status, group, err := AddOptionGroup(&models.OptionGroup{ItemID: 999, Name: "size", Options: []*models.Option{{Name: "a"}}})
+		assert.Equal(t, uint32(http.StatusNotFound), status)
+		assert.Error(t, err)
+		assert.Nil(t, group)
+	})
+
+	t.Run("expect error with a price delta in another currency", func(t *testing.T) {
+		status, group, err := AddOptionGroup(&models.OptionGroup{
+			ItemID:  suite.item.ID,
+			Name:    "sauce",
+			Options: []*models.Option{{Name: "truffle", PriceDelta: money.New(500, "USD")}},
+		})
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrCurrencyMismatch, err)
+		assert.Nil(t, group)
+	})
+}
+
+func (suite *OptionServiceTestSuite) TestService_LowerQuantityWithOptions() {
+	t := suite.T()
+	size, toppings := suite.addGroups()
+	regular, large := size.Options[0].ID, size.Options[1].ID
+	cheese, olives, jalapenos := toppings.Options[0].ID, toppings.Options[1].ID, toppings.Options[2].ID
+
+	t.Run("Valid selection lowers the item and tracked options", func(t *testing.T) {
+		status, item, err := LowerQuantityWithOptions(suite.item.ID, 2, []uint{large, cheese, olives}, models.ReasonOrder, "1", "")
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.Equal(t, uint(18), item.Quantity)
+
+		_, groups, err := GetOptionGroups(suite.item.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, uint(1), groups[1].Options[0].Quantity)
+		assert.Equal(t, uint(0), groups[1].Options[1].Quantity)
+	})
+
+	t.Run("expect error when a required group is left out", func(t *testing.T) {
+		status, item, err := LowerQuantity(suite.item.ID, 1, models.ReasonOrder, "", "")
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrInvalidOptionSelection, err)
+		assert.Nil(t, item)
+	})
+
+	t.Run("expect error with invalid selections", func(t *testing.T) {
+		invalid := [][]uint{
+			{regular, large},
+			{regular, olives, jalapenos, cheese},
+			{regular, regular},
+			{regular, 999},
+		}
+		for _, optionIDs := range invalid {
+			status, item, err := LowerQuantityWithOptions(suite.item.ID, 1, optionIDs, models.ReasonOrder, "", "")
+			assert.Equal(t, uint32(http.StatusBadRequest), status)
+			assert.Equal(t, errors.ErrInvalidOptionSelection, err)
+			assert.Nil(t, item)
+		}
+	})
+
+	t.Run("expect error when a tracked option runs out", func(t *testing.T) {
+		status, item, err := LowerQuantityWithOptions(suite.item.ID, 2, []uint{regular, cheese}, models.ReasonOrder, "", "")
+		assert.Equal(t, uint32(http.StatusConflict), status)
+		assert.Equal(t, errors.ErrInsufficientOptionQuantity, err)
+		assert.Nil(t, item)
+
+		// the item decrement is rolled back with the option
+		_, stored, err := GetItem(suite.item.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, uint(18), stored.Quantity)
+	})
+
+	t.Run("expect error with options on wastage", func(t *testing.T) {
+		status, item, err := LowerQuantityWithOptions(suite.item.ID, 1, []uint{regular}, models.ReasonWastage, "", "")
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Error(t, err)
+		assert.Nil(t, item)
+	})
+}
+
+func (suite *OptionServiceTestSuite) TestService_OptionStockAndDelete() {
+	t := suite.T()
+	size, toppings := suite.addGroups()
+
+	t.Run("Set option stock", func(t *testing.T) {
+		status, option, err := SetOptionStock(toppings.Options[1].ID, true, 10)
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.True(t, option.TrackStock)
+		assert.Equal(t, uint(10), option.Quantity)
+
+		status, option, err = SetOptionStock(999, true, 10)
+		assert.Equal(t, uint32(http.StatusNotFound), status)
+		assert.Error(t, err)
+		assert.Nil(t, option)
+	})
+
+	t.Run("Delete option group", func(t *testing.T) {
+		status, err := DeleteOptionGroup(size.ID)
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+
+		_, groups, err := GetOptionGroups(suite.item.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(groups))
+
+		// size is no longer required once its group is gone
+		status, _, err = LowerQuantity(suite.item.ID, 1, models.ReasonOrder, "", "")
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+
+		status, err = DeleteOptionGroup(size.ID)
+		assert.Equal(t, uint32(http.StatusNotFound), status)
+		assert.Error(t, err)
+	})
+}
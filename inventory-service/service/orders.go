@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"inventory-service/errors"
+	"inventory-service/proto/orderpb"
+	"net/http"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+var orderService orderpb.OrderServiceClient
+
+// SetOrderService replaces the client used to ask the order service about orders for items.
+func SetOrderService(client orderpb.OrderServiceClient) {
+	orderService = client
+}
+
+// checkNoOpenOrders fails when orders for the item have not been delivered yet, or when
+// the order service cannot say.
+func checkNoOpenOrders(ctx context.Context, itemID uint) (uint32, error) {
+	if orderService == nil {
+		return http.StatusServiceUnavailable, errors.ErrOrderServiceUnavailable
+	}
+	resp, err := orderService.CountOpenOrders(ctx, &orderpb.CountOpenOrdersRequest{ItemId: uint32(itemID)})
+	if err != nil {
+		logger.WithField("error", err.Error()).Error("failed to count open orders")
+		return http.StatusServiceUnavailable, errors.ErrOrderServiceUnavailable
+	}
+	if resp.Count > 0 {
+		return http.StatusConflict, errors.ErrItemHasOpenOrders
+	}
+	return http.StatusOK, nil
+}
+
+// checkDelivered fails unless the user has had an order for the item delivered, or when the
+// order service cannot say.
+func checkDelivered(ctx context.Context, userID uint, itemID uint) (uint32, error) {
+	if orderService == nil {
+		return http.StatusServiceUnavailable, errors.ErrOrderServiceUnavailable
+	}
+	resp, err := orderService.HasDeliveredOrder(ctx, &orderpb.HasDeliveredOrderRequest{UserId: uint32(userID), ItemId: uint32(itemID)})
+	if err != nil {
+		logger.WithField("error", err.Error()).Error("failed to check for delivered orders")
+		return http.StatusServiceUnavailable, errors.ErrOrderServiceUnavailable
+	}
+	if !resp.Delivered {
+		return http.StatusForbidden, errors.ErrNotDelivered
+	}
+	return http.StatusOK, nil
+}
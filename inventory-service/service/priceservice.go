@@ -0,0 +1,79 @@
+package service
+
+import (
+	"inventory-service/errors"
+	"inventory-service/models"
+	"inventory-service/money"
+	"net/http"
+	"time"
+)
+
+// SchedulePrice sets a price for the item from startsAt, or from now when startsAt is zero.
+// A price with an end is a promotion: it applies over the item's other prices until it ends,
+// after which they apply again. Prices can only be scheduled from now on, so the history of
+// what the item cost stays as it was.
+func SchedulePrice(itemID uint, price money.Money, startsAt time.Time, endsAt *time.Time, actor string) (uint32, *models.ItemPrice, error) {
+	if price.MinorUnits <= 0 {
+		return http.StatusBadRequest, nil, errors.ErrEmptyField
+	}
+	if !price.Valid() {
+		return http.StatusBadRequest, nil, errors.ErrInvalidCurrency
+	}
+	now := clock()
+	if startsAt.IsZero() {
+		startsAt = now
+	}
+	if startsAt.Before(now) || (endsAt != nil && !endsAt.After(startsAt)) {
+		return http.StatusBadRequest, nil, errors.ErrInvalidPriceSchedule
+	}
+
+	status, item, err := models.GetItem(itemID)
+	if err != nil {
+		return status, nil, err
+	}
+	if price.Currency != item.Price.Currency {
+		return http.StatusBadRequest, nil, errors.ErrCurrencyMismatch
+	}
+	return models.CreateItemPrice(&models.ItemPrice{
+		ItemID:   item.ID,
+		Price:    price,
+		StartsAt: startsAt,
+		EndsAt:   endsAt,
+		Actor:    actor,
+	})
+}
+
+// GetPriceHistory returns every price the item has had or is scheduled to have.
+func GetPriceHistory(itemID uint) (uint32, []*models.ItemPrice, error) {
+	status, item, err := models.GetItem(itemID)
+	if err != nil {
+		return status, nil, err
+	}
+	return models.GetItemPrices(item.ID)
+}
+
+// PricesAt returns what each of the items cost at the given time, keyed by item id.
+func PricesAt(items []*models.Item, at time.Time) (uint32, map[uint]money.Money, error) {
+	ids := []uint{}
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	status, inEffect, err := models.GetPricesInEffect(ids, at)
+	if err != nil {
+		return status, nil, err
+	}
+	prices := map[uint]money.Money{}
+	for _, item := range items {
+		if price, ok := inEffect[item.ID]; ok {
+			prices[item.ID] = price.Price
+		} else {
+			prices[item.ID] = item.Price
+		}
+	}
+	return http.StatusOK, prices, nil
+}
+
+// CurrentPrices returns what each of the items costs now, keyed by item id.
+func CurrentPrices(items []*models.Item) (uint32, map[uint]money.Money, error) {
+	return PricesAt(items, clock())
+}
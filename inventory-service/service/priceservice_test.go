@@ -0,0 +1,201 @@
+package service
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"inventory-service/errors"
+	"inventory-service/models"
+	"inventory-service/money"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type PriceServiceTestSuite struct {
+	suite.Suite
+	db *gorm.DB
+}
+
+func (suite *PriceServiceTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		suite.FailNow("failed to connect database")
+	}
+	suite.db = db
+	models.InitInventoryModels(db)
+	// items record their first price when they are created, so the clock has to stay ahead of them
+	now := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
+	clock = func() time.Time { return now }
+}
+
+func (suite *PriceServiceTestSuite) TearDownTest() {
+	clock = time.Now
+	_ = suite.db.Migrator().DropTable(&models.Item{}, &models.StockMovement{}, &models.ItemPrice{})
+	sql, _ := suite.db.DB()
+	sql.Close()
+}
+
+func TestPriceServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(PriceServiceTestSuite))
+}
+
+// priceAt returns what the item costs at the given time.
+func priceAt(t *testing.T, item *models.Item, at time.Time) money.Money {
+	_, prices, err := PricesAt([]*models.Item{item}, at)
+	assert.NoError(t, err)
+	return prices[item.ID]
+}
+
+func (suite *PriceServiceTestSuite) TestService_SchedulePrice() {
+	t := suite.T()
+
+	_, item, err := AddItem(&models.Item{Name: "masala dosa", Description: "rice crepe", Price: money.New(900, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+	later := daysFromNow(1)
+
+	t.Run("Schedule a price change", func(t *testing.T) {
+		status, price, err := SchedulePrice(item.ID, money.New(950, "EUR"), later, nil, "7")
+		assert.Equal(t, uint32(http.StatusCreated), status)
+		assert.NoError(t, err)
+		assert.Equal(t, item.ID, price.ItemID)
+		assert.Equal(t, later, price.StartsAt)
+		assert.Nil(t, price.EndsAt)
+		assert.Equal(t, "7", price.Actor)
+	})
+
+	t.Run("Start a price now when no start is given", func(t *testing.T) {
+		_, price, err := SchedulePrice(item.ID, money.New(920, "EUR"), time.Time{}, nil, "")
+		assert.NoError(t, err)
+		assert.Equal(t, clock(), price.StartsAt)
+	})
+
+	tests := []struct {
+		name     string
+		itemID   uint
+		price    money.Money
+		startsAt time.Time
+		endsAt   *time.Time
+		status   uint32
+		err      error
+	}{
+		{"Refuse a price of zero", item.ID, money.Money{}, later, nil, http.StatusBadRequest, errors.ErrEmptyField},
+		{"Refuse an unknown currency", item.ID, money.New(950, "XYZ"), later, nil, http.StatusBadRequest, errors.ErrInvalidCurrency},
+		{"Refuse a price in another currency than the item's", item.ID, money.New(950, "USD"), later, nil, http.StatusBadRequest, errors.ErrCurrencyMismatch},
+		{"Refuse to rewrite the past", item.ID, money.New(950, "EUR"), daysFromNow(-1), nil, http.StatusBadRequest, errors.ErrInvalidPriceSchedule},
+		{"Refuse a promotion that ends before it starts", item.ID, money.New(800, "EUR"), later, &later, http.StatusBadRequest, errors.ErrInvalidPriceSchedule},
+		{"Refuse a price for an unknown item", 99, money.New(950, "EUR"), later, nil, http.StatusNotFound, errors.ErrItemNotFound},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			status, price, err := SchedulePrice(test.itemID, test.price, test.startsAt, test.endsAt, "")
+			assert.Equal(t, test.status, status)
+			assert.Equal(t, test.err, err)
+			assert.Nil(t, price)
+		})
+	}
+}
+
+func (suite *PriceServiceTestSuite) TestService_PricesAt() {
+	t := suite.T()
+
+	_, item, err := AddItem(&models.Item{Name: "filter coffee", Description: "south indian coffee", Price: money.New(300, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+	_, other, err := AddItem(&models.Item{Name: "vada", Description: "lentil fritters", Price: money.New(400, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+
+	promotionEnds := daysFromNow(4)
+	_, _, err = SchedulePrice(item.ID, money.New(350, "EUR"), daysFromNow(2), nil, "")
+	assert.NoError(t, err)
+	_, _, err = SchedulePrice(item.ID, money.New(250, "EUR"), daysFromNow(1), &promotionEnds, "")
+	assert.NoError(t, err)
+
+	t.Run("Charge the item's own price until a scheduled price starts", func(t *testing.T) {
+		assert.Equal(t, money.New(300, "EUR"), priceAt(t, item, clock()))
+	})
+
+	t.Run("Charge a promotional price over the other prices while it runs", func(t *testing.T) {
+		assert.Equal(t, money.New(250, "EUR"), priceAt(t, item, daysFromNow(1)))
+		assert.Equal(t, money.New(250, "EUR"), priceAt(t, item, daysFromNow(3)))
+	})
+
+	t.Run("Go back to the regular price once a promotion ends", func(t *testing.T) {
+		assert.Equal(t, money.New(350, "EUR"), priceAt(t, item, promotionEnds))
+	})
+
+	t.Run("Charge the item's own price before its history starts", func(t *testing.T) {
+		assert.Equal(t, money.New(300, "EUR"), priceAt(t, item, item.CreatedAt.Add(-time.Hour)))
+	})
+
+	t.Run("Price several items at once", func(t *testing.T) {
+		_, prices, err := PricesAt([]*models.Item{item, other}, daysFromNow(3))
+		assert.NoError(t, err)
+		assert.Equal(t, map[uint]money.Money{item.ID: money.New(250, "EUR"), other.ID: money.New(400, "EUR")}, prices)
+	})
+}
+
+func (suite *PriceServiceTestSuite) TestService_PricesOfItemsCreatedBeforeTheHistory() {
+	t := suite.T()
+
+	// an item created before prices were recorded has no history of its own
+	createdAt := clock().AddDate(0, 0, -2)
+	legacy := &models.Item{Name: "upma", Description: "semolina porridge", Price: money.New(450, "EUR"), Quantity: 10}
+	legacy.CreatedAt = createdAt
+	assert.NoError(t, suite.db.Create(legacy).Error)
+	models.InitInventoryModels(suite.db)
+
+	t.Run("Keep the price from before the first change", func(t *testing.T) {
+		_, updated, err := UpdateItem(legacy.ID, &models.Item{Price: money.New(500, "EUR")}, []string{"price"})
+		assert.NoError(t, err)
+
+		assert.Equal(t, money.New(450, "EUR"), priceAt(t, updated, createdAt.Add(time.Minute)))
+		assert.Equal(t, money.New(500, "EUR"), priceAt(t, updated, clock()))
+
+		_, prices, err := GetPriceHistory(legacy.ID)
+		assert.NoError(t, err)
+		assert.Len(t, prices, 2)
+		assert.True(t, createdAt.Equal(prices[0].StartsAt))
+	})
+
+	t.Run("Backfill only once", func(t *testing.T) {
+		models.InitInventoryModels(suite.db)
+		_, prices, err := GetPriceHistory(legacy.ID)
+		assert.NoError(t, err)
+		assert.Len(t, prices, 2)
+	})
+}
+
+func (suite *PriceServiceTestSuite) TestService_GetPriceHistory() {
+	t := suite.T()
+
+	_, item, err := AddItem(&models.Item{Name: "idli", Description: "steamed rice cakes", Price: money.New(500, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+
+	t.Run("Record prices set on the item and scheduled ones", func(t *testing.T) {
+		_, _, err := UpdateItem(item.ID, &models.Item{Price: money.New(550, "EUR")}, []string{"price"})
+		assert.NoError(t, err)
+		_, _, err = UpdateItem(item.ID, &models.Item{Description: "steamed rice cakes, 3 pieces"}, []string{"description"})
+		assert.NoError(t, err)
+		_, _, err = SchedulePrice(item.ID, money.New(600, "EUR"), daysFromNow(7), nil, "")
+		assert.NoError(t, err)
+
+		status, prices, err := GetPriceHistory(item.ID)
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.Len(t, prices, 3)
+		assert.Equal(t, money.New(500, "EUR"), prices[0].Price)
+		assert.Equal(t, money.New(550, "EUR"), prices[1].Price)
+		assert.Equal(t, money.New(600, "EUR"), prices[2].Price)
+		assert.Equal(t, daysFromNow(7), prices[2].StartsAt)
+	})
+
+	t.Run("Return 404 for an unknown item", func(t *testing.T) {
+		status, prices, err := GetPriceHistory(99)
+		assert.Equal(t, uint32(http.StatusNotFound), status)
+		assert.Equal(t, errors.ErrItemNotFound, err)
+		assert.Nil(t, prices)
+	})
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"inventory-service/errors"
+	"inventory-service/models"
+	"inventory-service/money"
+	"net/http"
+	"time"
+)
+
+func AddSupplier(supplier *models.Supplier) (uint32, *models.Supplier, error) {
+	if supplier == nil || supplier.Name == "" {
+		return http.StatusBadRequest, nil, errors.ErrEmptyField
+	}
+	return models.CreateSupplier(supplier)
+}
+
+func ListSuppliers() (uint32, []*models.Supplier, error) {
+	return models.GetSuppliers()
+}
+
+// SetSupplierItem records what an item costs from a supplier.
+func SetSupplierItem(supplierID uint, itemID uint, costPrice money.Money) (uint32, *models.SupplierItem, error) {
+	if costPrice.MinorUnits <= 0 {
+		return http.StatusBadRequest, nil, errors.ErrEmptyField
+	}
+	if !costPrice.Valid() {
+		return http.StatusBadRequest, nil, errors.ErrInvalidCurrency
+	}
+	status, _, err := models.GetSupplier(supplierID)
+	if err != nil {
+		return status, nil, err
+	}
+	status, _, err = models.GetItem(itemID)
+	if err != nil {
+		return status, nil, err
+	}
+	return models.SaveSupplierItem(&models.SupplierItem{SupplierID: supplierID, ItemID: itemID, CostPrice: costPrice})
+}
+
+func ListSupplierItems(supplierID uint) (uint32, []*models.SupplierItem, error) {
+	status, _, err := models.GetSupplier(supplierID)
+	if err != nil {
+		return status, nil, err
+	}
+	return models.GetSupplierItems(supplierID)
+}
+
+// CreatePurchaseOrder drafts an order with a supplier. Every line must name a distinct item
+// the supplier sells and a quantity above zero; a line without a cost price is ordered at
+// the supplier's current cost for the item.
+func CreatePurchaseOrder(order *models.PurchaseOrder) (uint32, *models.PurchaseOrder, error) {
+	if order == nil || len(order.Lines) == 0 {
+		return http.StatusBadRequest, nil, errors.ErrInvalidPurchaseOrder
+	}
+	status, _, err := models.GetSupplier(order.SupplierID)
+	if err != nil {
+		return status, nil, err
+	}
+	status, supplied, err := models.GetSupplierItems(order.SupplierID)
+	if err != nil {
+		return status, nil, err
+	}
+	costs := map[uint]money.Money{}
+	for _, supplierItem := range supplied {
+		costs[supplierItem.ItemID] = supplierItem.CostPrice
+	}
+
+	seen := map[uint]bool{}
+	for _, line := range order.Lines {
+		if line.Quantity == 0 || line.CostPrice.MinorUnits < 0 || seen[line.ItemID] {
+			return http.StatusBadRequest, nil, errors.ErrInvalidPurchaseOrder
+		}
+		if line.CostPrice.MinorUnits > 0 && !line.CostPrice.Valid() {
+			return http.StatusBadRequest, nil, errors.ErrInvalidCurrency
+		}
+		seen[line.ItemID] = true
+		cost, ok := costs[line.ItemID]
+		if !ok {
+			return http.StatusBadRequest, nil, errors.ErrItemNotSupplied
+		}
+		if line.CostPrice.MinorUnits == 0 {
+			line.CostPrice = cost
+		}
+		line.ID = 0
+		line.Received = 0
+	}
+
+	order.ID = 0
+	order.CreatedAt = clock()
+	return models.CreatePurchaseOrder(order)
+}
+
+func GetPurchaseOrder(id uint) (uint32, *models.PurchaseOrder, error) {
+	return models.GetPurchaseOrder(id)
+}
+
+// ListPurchaseOrders returns purchase orders newest first; a supplierID of 0 or an empty
+// status leaves that filter out.
+func ListPurchaseOrders(supplierID uint, status string) (uint32, []*models.PurchaseOrder, error) {
+	return models.GetPurchaseOrders(supplierID, status)
+}
+
+// SendPurchaseOrder marks a draft order as sent to its supplier, expected to arrive after
+// the supplier's lead time.
+func SendPurchaseOrder(id uint) (uint32, *models.PurchaseOrder, error) {
+	status, order, err := models.GetPurchaseOrder(id)
+	if err != nil {
+		return status, nil, err
+	}
+	status, supplier, err := models.GetSupplier(order.SupplierID)
+	if err != nil {
+		return status, nil, err
+	}
+	now := clock()
+	return models.MarkPurchaseOrderSent(id, now, now.AddDate(0, 0, int(supplier.LeadTimeDays)))
+}
+
+// ReceivePurchaseOrderLine books a delivery against one line of a sent purchase order into
+// stock at a location; a locationID of 0 means the default location. Perishable deliveries
+// pass an expiry and go into a lot, as with ReceiveLot.
+func ReceivePurchaseOrderLine(orderID uint, lineID uint, locationID uint, quantity uint, expiresAt *time.Time, actor string) (uint32, *models.Item, *models.PurchaseOrder, error) {
+	if quantity == 0 {
+		return http.StatusBadRequest, nil, nil, errors.ErrEmptyField
+	}
+	now := clock()
+	if expiresAt != nil && !expiresAt.After(now) {
+		return http.StatusBadRequest, nil, nil, errors.ErrInvalidExpiry
+	}
+	status, item, order, err := models.ReceivePurchaseOrderLine(orderID, lineID, &models.StockMovement{
+		LocationID: locationID,
+		Delta:      int64(quantity),
+		Actor:      actor,
+		CreatedAt:  now,
+	}, expiresAt)
+	if err != nil {
+		return status, nil, nil, err
+	}
+	publishItemEvent(models.EventQuantityChanged, item)
+	return status, item, order, nil
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"inventory-service/errors"
+	"inventory-service/models"
+	"inventory-service/money"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type PurchaseServiceTestSuite struct {
+	suite.Suite
+	db *gorm.DB
+}
+
+func (suite *PurchaseServiceTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		suite.FailNow("failed to connect database")
+	}
+	suite.db = db
+	models.InitInventoryModels(db)
+	fixClock("2026-03-02T09:00:00Z")
+}
+
+func (suite *PurchaseServiceTestSuite) TearDownTest() {
+	clock = time.Now
+	_ = suite.db.Migrator().DropTable(&models.Item{}, &models.StockMovement{}, &models.Location{}, &models.LocationStock{}, &models.Lot{}, &models.Supplier{}, &models.SupplierItem{}, &models.PurchaseOrder{}, &models.PurchaseOrderLine{})
+	sql, _ := suite.db.DB()
+	sql.Close()
+}
+
+func TestPurchaseServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(PurchaseServiceTestSuite))
+}
+
+func (suite *PurchaseServiceTestSuite) TestService_Suppliers() {
+	t := suite.T()
+
+	_, flour, err := AddItem(&models.Item{Name: "flour", Description: "00 flour", Price: money.New(100, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+
+	status, supplier, err := AddSupplier(&models.Supplier{Name: "Mill & Co", Contact: "Ana", Email: "orders@mill.example", LeadTimeDays: 3})
+	assert.Equal(t, uint32(http.StatusCreated), status)
+	assert.NoError(t, err)
+
+	t.Run("Reject a supplier with a name already taken", func(t *testing.T) {
+		status, _, err := AddSupplier(&models.Supplier{Name: "Mill & Co"})
+		assert.Equal(t, uint32(http.StatusUnprocessableEntity), status)
+		assert.Equal(t, errors.ErrSupplierExists, err)
+	})
+
+	t.Run("Record and update what an item costs from a supplier", func(t *testing.T) {
+		_, _, err := SetSupplierItem(supplier.ID, flour.ID, money.New(80, "EUR"))
+		assert.NoError(t, err)
+		_, _, err = SetSupplierItem(supplier.ID, flour.ID, money.New(90, "EUR"))
+		assert.NoError(t, err)
+
+		_, supplierItems, err := ListSupplierItems(supplier.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, []*models.SupplierItem{{SupplierID: supplier.ID, ItemID: flour.ID, CostPrice: money.New(90, "EUR")}}, supplierItems)
+	})
+
+	t.Run("Reject a cost for an unknown supplier or item", func(t *testing.T) {
+		status, _, err := SetSupplierItem(99, flour.ID, money.New(100, "EUR"))
+		assert.Equal(t, uint32(http.StatusNotFound), status)
+		assert.Equal(t, errors.ErrSupplierNotFound, err)
+
+		status, _, err = SetSupplierItem(supplier.ID, 99, money.New(100, "EUR"))
+		assert.Equal(t, uint32(http.StatusNotFound), status)
+		assert.Equal(t, errors.ErrItemNotFound, err)
+	})
+}
+
+func (suite *PurchaseServiceTestSuite) TestService_PurchaseOrderLifecycle() {
+	t := suite.T()
+
+	_, flour, err := AddItem(&models.Item{Name: "flour", Description: "00 flour", Price: money.New(100, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+	_, milk, err := AddItem(&models.Item{Name: "milk", Description: "whole milk", Price: money.New(100, "EUR"), Quantity: 4})
+	assert.NoError(t, err)
+	_, salt, err := AddItem(&models.Item{Name: "salt", Description: "sea salt", Price: money.New(100, "EUR"), Quantity: 4})
+	assert.NoError(t, err)
+	_, supplier, err := AddSupplier(&models.Supplier{Name: "Mill & Co", LeadTimeDays: 3})
+	assert.NoError(t, err)
+	_, _, err = SetSupplierItem(supplier.ID, flour.ID, money.New(80, "EUR"))
+	assert.NoError(t, err)
+	_, _, err = SetSupplierItem(supplier.ID, milk.ID, money.New(120, "EUR"))
+	assert.NoError(t, err)
+
+	t.Run("Reject lines for items the supplier does not sell", func(t *testing.T) {
+		status, _, err := CreatePurchaseOrder(&models.PurchaseOrder{
+			SupplierID: supplier.ID,
+			Lines:      []*models.PurchaseOrderLine{{ItemID: salt.ID, Quantity: 1}},
+		})
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrItemNotSupplied, err)
+	})
+
+	status, order, err := CreatePurchaseOrder(&models.PurchaseOrder{
+		SupplierID: supplier.ID,
+		Actor:      "3",
+		Lines: []*models.PurchaseOrderLine{
+			{ItemID: flour.ID, Quantity: 20},
+			{ItemID: milk.ID, Quantity: 6, CostPrice: money.New(110, "EUR")},
+		},
+	})
+	assert.Equal(t, uint32(http.StatusCreated), status)
+	assert.NoError(t, err)
+	assert.Equal(t, models.PurchaseOrderDraft, order.Status)
+	assert.Equal(t, money.New(80, "EUR"), order.Lines[0].CostPrice)
+	assert.Equal(t, money.New(110, "EUR"), order.Lines[1].CostPrice)
+
+	t.Run("Refuse to receive a draft", func(t *testing.T) {
+		status, _, _, err := ReceivePurchaseOrderLine(order.ID, order.Lines[0].ID, 0, 5, nil, "3")
+		assert.Equal(t, uint32(http.StatusConflict), status)
+		assert.Equal(t, errors.ErrPurchaseOrderState, err)
+	})
+
+	t.Run("Send the order with its expected arrival", func(t *testing.T) {
+		_, sent, err := SendPurchaseOrder(order.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, models.PurchaseOrderSent, sent.Status)
+		assert.True(t, sent.ExpectedAt.Equal(clock().AddDate(0, 0, 3)))
+
+		status, _, err := SendPurchaseOrder(order.ID)
+		assert.Equal(t, uint32(http.StatusConflict), status)
+		assert.Equal(t, errors.ErrPurchaseOrderState, err)
+	})
+
+	t.Run("Receive part of a line into stock at its cost", func(t *testing.T) {
+		_, item, received, err := ReceivePurchaseOrderLine(order.ID, order.Lines[0].ID, 0, 15, nil, "3")
+		assert.NoError(t, err)
+		assert.Equal(t, uint(25), item.Quantity)
+		assert.Equal(t, models.PurchaseOrderPartiallyReceived, received.Status)
+		assert.Equal(t, uint(15), received.Lines[0].Received)
+
+		_, movements, _, err := ListStockMovements(flour.ID, 1, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, models.ReasonRestock, movements[0].Reason)
+		assert.Equal(t, "PO-1", movements[0].Reference)
+		assert.Equal(t, money.New(80, "EUR"), movements[0].UnitCost)
+	})
+
+	t.Run("Refuse to receive more than is outstanding", func(t *testing.T) {
+		status, _, _, err := ReceivePurchaseOrderLine(order.ID, order.Lines[0].ID, 0, 6, nil, "3")
+		assert.Equal(t, uint32(http.StatusConflict), status)
+		assert.Equal(t, errors.ErrOverReceipt, err)
+
+		_, stored, err := GetItem(flour.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, uint(25), stored.Quantity)
+	})
+
+	t.Run("Complete the order once every line has arrived", func(t *testing.T) {
+		_, _, _, err := ReceivePurchaseOrderLine(order.ID, order.Lines[0].ID, 0, 5, nil, "3")
+		assert.NoError(t, err)
+
+		expiresAt := clock().Add(72 * time.Hour)
+		_, item, received, err := ReceivePurchaseOrderLine(order.ID, order.Lines[1].ID, 0, 6, &expiresAt, "3")
+		assert.NoError(t, err)
+		assert.Equal(t, uint(10), item.Quantity)
+		assert.Equal(t, models.PurchaseOrderReceived, received.Status)
+		assert.NotNil(t, received.ReceivedAt)
+
+		_, lots, err := ListLots(milk.ID)
+		assert.NoError(t, err)
+		assert.Len(t, lots, 1)
+		assert.Equal(t, uint(6), lots[0].Quantity)
+
+		_, orders, err := ListPurchaseOrders(supplier.ID, models.PurchaseOrderReceived)
+		assert.NoError(t, err)
+		assert.Len(t, orders, 1)
+	})
+}
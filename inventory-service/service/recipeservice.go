@@ -0,0 +1,247 @@
+package service
+
+import (
+	"inventory-service/errors"
+	"inventory-service/models"
+	"inventory-service/units"
+	"net/http"
+	"sort"
+)
+
+// RecipeIngredient is a line of a dish's recipe together with the ingredient it names. A
+// recipe read back gives the quantity in the base unit of the ingredient's unit.
+type RecipeIngredient struct {
+	IngredientID uint
+	Name         string
+	Unit         string
+	Quantity     uint
+}
+
+// RecipeShortage is a dish put at risk by one of its ingredients running low: at or below
+// its reorder level, or too short to make a single portion.
+type RecipeShortage struct {
+	IngredientID   uint
+	IngredientName string
+	Unit           string
+	Available      uint
+	ReorderLevel   uint
+	DishID         uint
+	DishName       string
+	PerPortion     uint
+	Portions       uint
+}
+
+// SetRecipe replaces the recipe of a dish, and an empty recipe removes it. Every ingredient
+// must exist, appear once and take a quantity above zero. Recipes are one level deep: an
+// ingredient cannot have a recipe of its own, and a dish used as an ingredient cannot get
+// one. Bundles take no part in recipes at all. A line's quantity is in the ingredient's
+// own unit unless the line gives another that measures the same thing, so a recipe can
+// take 120 g of flour stocked in kg.
+func SetRecipe(dishID uint, ingredients []*RecipeIngredient) (uint32, []*RecipeIngredient, error) {
+	status, _, err := models.GetItem(dishID)
+	if err != nil {
+		return status, nil, err
+	}
+	if len(ingredients) > 0 {
+		status, used, err := models.IsIngredient(dishID)
+		if err != nil {
+			return status, nil, err
+		}
+		status, components, err := models.GetBundleComponents([]uint{dishID})
+		if err != nil {
+			return status, nil, err
+		}
+		if used || len(components) > 0 {
+			return http.StatusBadRequest, nil, errors.ErrInvalidRecipe
+		}
+	}
+
+	ids := []uint{}
+	seen := map[uint]bool{}
+	quantities := map[uint]uint{}
+	for _, ingredient := range ingredients {
+		if ingredient.Quantity == 0 || ingredient.IngredientID == dishID || seen[ingredient.IngredientID] {
+			return http.StatusBadRequest, nil, errors.ErrInvalidRecipe
+		}
+		seen[ingredient.IngredientID] = true
+		ids = append(ids, ingredient.IngredientID)
+	}
+
+	if len(ids) > 0 {
+		status, found, err := models.GetItemsByIDs(ids)
+		if err != nil {
+			return status, nil, err
+		}
+		if len(found) != len(ids) {
+			return http.StatusNotFound, nil, errors.ErrIngredientNotFound
+		}
+		itemUnits := map[uint]string{}
+		for _, item := range found {
+			itemUnits[item.ID] = item.Unit
+		}
+		for _, ingredient := range ingredients {
+			itemUnit, err := units.Lookup(itemUnits[ingredient.IngredientID])
+			if err != nil {
+				return http.StatusInternalServerError, nil, err
+			}
+			unit := itemUnit
+			if ingredient.Unit != "" {
+				if unit, err = units.Lookup(ingredient.Unit); err != nil {
+					return http.StatusBadRequest, nil, err
+				}
+			}
+			if !unit.Convertible(itemUnit) {
+				return http.StatusBadRequest, nil, errors.ErrUnitMismatch
+			}
+			quantities[ingredient.IngredientID] = unit.Of(ingredient.Quantity)
+		}
+
+		status, nested, err := models.GetRecipeLines(ids)
+		if err != nil {
+			return status, nil, err
+		}
+		status, bundles, err := models.GetBundleComponents(ids)
+		if err != nil {
+			return status, nil, err
+		}
+		if len(nested) > 0 || len(bundles) > 0 {
+			return http.StatusBadRequest, nil, errors.ErrInvalidRecipe
+		}
+	}
+
+	lines := []*models.RecipeLine{}
+	for _, ingredient := range ingredients {
+		lines = append(lines, &models.RecipeLine{
+			IngredientID: ingredient.IngredientID,
+			Quantity:     quantities[ingredient.IngredientID],
+		})
+	}
+	status, _, err = models.ReplaceRecipe(dishID, lines)
+	if err != nil {
+		return status, nil, err
+	}
+	return GetRecipe(dishID)
+}
+
+// GetRecipe returns the dish's recipe, which is empty for dishes that are not made to order.
+func GetRecipe(dishID uint) (uint32, []*RecipeIngredient, error) {
+	status, _, err := models.GetItem(dishID)
+	if err != nil {
+		return status, nil, err
+	}
+	status, lines, err := models.GetRecipeLines([]uint{dishID})
+	if err != nil {
+		return status, nil, err
+	}
+
+	ids := []uint{}
+	for _, line := range lines {
+		ids = append(ids, line.IngredientID)
+	}
+	byID := map[uint]*models.Item{}
+	if len(ids) > 0 {
+		status, found, err := models.GetItemsByIDs(ids)
+		if err != nil {
+			return status, nil, err
+		}
+		for _, item := range found {
+			byID[item.ID] = item
+		}
+	}
+
+	ingredients := []*RecipeIngredient{}
+	for _, line := range lines {
+		ingredient := &RecipeIngredient{IngredientID: line.IngredientID, Quantity: line.Quantity}
+		if item, ok := byID[line.IngredientID]; ok {
+			ingredient.Name = item.Name
+			ingredient.Unit = baseUnitName(item.Unit)
+		}
+		ingredients = append(ingredients, ingredient)
+	}
+	return http.StatusOK, ingredients, nil
+}
+
+// baseUnitName returns the name of the unit quantities in the named unit are stored in.
+func baseUnitName(name string) string {
+	unit, err := units.Lookup(name)
+	if err != nil {
+		return name
+	}
+	return unit.Base().Name
+}
+
+// portions is how many portions of a dish the given stock of its ingredients makes.
+func portions(lines []*models.RecipeLine, stock map[uint]uint) uint {
+	var made uint
+	for i, line := range lines {
+		count := stock[line.IngredientID] / line.Quantity
+		if i == 0 || count < made {
+			made = count
+		}
+	}
+	return made
+}
+
+// ListRecipeShortages reports every dish that uses an ingredient which is at or below its
+// reorder level or cannot make a single portion, with how many portions the ingredient has
+// left for that dish. Dishes closest to running out come first.
+func ListRecipeShortages() (uint32, []*RecipeShortage, error) {
+	status, lines, err := models.GetRecipeLines(nil)
+	if err != nil {
+		return status, nil, err
+	}
+	if len(lines) == 0 {
+		return http.StatusOK, []*RecipeShortage{}, nil
+	}
+
+	ids := []uint{}
+	for _, line := range lines {
+		ids = append(ids, line.DishID, line.IngredientID)
+	}
+	status, items, err := models.GetItemsByIDs(ids)
+	if err != nil {
+		return status, nil, err
+	}
+	byID := map[uint]*models.Item{}
+	ingredients := []*models.Item{}
+	for _, item := range items {
+		byID[item.ID] = item
+	}
+	for _, line := range lines {
+		if item, ok := byID[line.IngredientID]; ok {
+			ingredients = append(ingredients, item)
+		}
+	}
+	status, available, err := AvailableQuantities(ingredients)
+	if err != nil {
+		return status, nil, err
+	}
+
+	shortages := []*RecipeShortage{}
+	for _, line := range lines {
+		dish, ingredient := byID[line.DishID], byID[line.IngredientID]
+		if dish == nil || ingredient == nil {
+			continue
+		}
+		left := available[ingredient.ID] / line.Quantity
+		low := ingredient.ReorderLevel > 0 && available[ingredient.ID] <= ingredient.ReorderLevel
+		if !low && left > 0 {
+			continue
+		}
+		shortages = append(shortages, &RecipeShortage{
+			IngredientID:   ingredient.ID,
+			IngredientName: ingredient.Name,
+			Unit:           baseUnitName(ingredient.Unit),
+			Available:      available[ingredient.ID],
+			ReorderLevel:   ingredient.ReorderLevel,
+			DishID:         dish.ID,
+			DishName:       dish.Name,
+			PerPortion:     line.Quantity,
+			Portions:       left,
+		})
+	}
+	sort.SliceStable(shortages, func(i, j int) bool {
+		return shortages[i].Portions < shortages[j].Portions
+	})
+	return http.StatusOK, shortages, nil
+}
@@ -0,0 +1,184 @@
+package service
+
+import (
+	"net/http"
+	"testing"
+
+	"inventory-service/errors"
+	"inventory-service/models"
+	"inventory-service/money"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type RecipeServiceTestSuite struct {
+	suite.Suite
+	db *gorm.DB
+}
+
+func (suite *RecipeServiceTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		suite.FailNow("failed to connect database")
+	}
+	suite.db = db
+	models.InitInventoryModels(db)
+}
+
+func (suite *RecipeServiceTestSuite) TearDownTest() {
+	_ = suite.db.Migrator().DropTable(&models.Item{}, &models.StockMovement{}, &models.Location{}, &models.LocationStock{}, &models.RecipeLine{})
+	sql, _ := suite.db.DB()
+	sql.Close()
+}
+
+func TestRecipeServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(RecipeServiceTestSuite))
+}
+
+func (suite *RecipeServiceTestSuite) TestService_SetRecipe() {
+	t := suite.T()
+
+	_, dosa, err := AddItem(&models.Item{Name: "masala dosa", Description: "crisp rice crepe", Price: money.New(9000, "EUR"), Quantity: 1})
+	assert.NoError(t, err)
+	_, batter, err := AddItem(&models.Item{Name: "dosa batter", Description: "fermented batter", Price: money.New(100, "EUR"), Quantity: 2000, Unit: "g"})
+	assert.NoError(t, err)
+	_, potato, err := AddItem(&models.Item{Name: "potato masala", Description: "spiced potato", Price: money.New(100, "EUR"), Quantity: 900, Unit: "g"})
+	assert.NoError(t, err)
+
+	t.Run("Set a recipe and read it back", func(t *testing.T) {
+		status, ingredients, err := SetRecipe(dosa.ID, []*RecipeIngredient{
+			{IngredientID: batter.ID, Quantity: 120, Unit: "g"},
+			{IngredientID: potato.ID, Quantity: 80},
+		})
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.Equal(t, []*RecipeIngredient{
+			{IngredientID: batter.ID, Name: "dosa batter", Unit: "g", Quantity: 120},
+			{IngredientID: potato.ID, Name: "potato masala", Unit: "g", Quantity: 80},
+		}, ingredients)
+
+		_, stored, err := GetRecipe(dosa.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, ingredients, stored)
+	})
+
+	t.Run("Reject lines that are empty, repeated or the dish itself", func(t *testing.T) {
+		for _, ingredients := range [][]*RecipeIngredient{
+			{{IngredientID: batter.ID, Quantity: 0}},
+			{{IngredientID: batter.ID, Quantity: 1}, {IngredientID: batter.ID, Quantity: 2}},
+			{{IngredientID: dosa.ID, Quantity: 1}},
+		} {
+			status, _, err := SetRecipe(dosa.ID, ingredients)
+			assert.Equal(t, uint32(http.StatusBadRequest), status)
+			assert.Equal(t, errors.ErrInvalidRecipe, err)
+		}
+	})
+
+	t.Run("Reject an unknown ingredient", func(t *testing.T) {
+		status, _, err := SetRecipe(dosa.ID, []*RecipeIngredient{{IngredientID: 99, Quantity: 1}})
+		assert.Equal(t, uint32(http.StatusNotFound), status)
+		assert.Equal(t, errors.ErrIngredientNotFound, err)
+	})
+
+	t.Run("Reject a unit other than the ingredient's", func(t *testing.T) {
+		status, _, err := SetRecipe(dosa.ID, []*RecipeIngredient{{IngredientID: batter.ID, Quantity: 1, Unit: "ml"}})
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrUnitMismatch, err)
+	})
+
+	t.Run("Keep recipes one level deep", func(t *testing.T) {
+		// an ingredient cannot get a recipe, and a dish with one cannot be an ingredient
+		status, _, err := SetRecipe(batter.ID, []*RecipeIngredient{{IngredientID: potato.ID, Quantity: 1}})
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrInvalidRecipe, err)
+
+		status, _, err = SetRecipe(potato.ID, []*RecipeIngredient{{IngredientID: dosa.ID, Quantity: 1}})
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrInvalidRecipe, err)
+	})
+
+	t.Run("Remove a recipe with no lines", func(t *testing.T) {
+		_, _, err := SetRecipe(dosa.ID, nil)
+		assert.NoError(t, err)
+		_, ingredients, err := GetRecipe(dosa.ID)
+		assert.NoError(t, err)
+		assert.Empty(t, ingredients)
+	})
+}
+
+func (suite *RecipeServiceTestSuite) TestService_OrderDishWithRecipe() {
+	t := suite.T()
+
+	_, dosa, err := AddItem(&models.Item{Name: "masala dosa", Description: "crisp rice crepe", Price: money.New(9000, "EUR"), Quantity: 1})
+	assert.NoError(t, err)
+	_, batter, err := AddItem(&models.Item{Name: "dosa batter", Description: "fermented batter", Price: money.New(100, "EUR"), Quantity: 500, Unit: "g"})
+	assert.NoError(t, err)
+	_, potato, err := AddItem(&models.Item{Name: "potato masala", Description: "spiced potato", Price: money.New(100, "EUR"), Quantity: 250, Unit: "g", ReorderLevel: 100})
+	assert.NoError(t, err)
+	_, _, err = SetRecipe(dosa.ID, []*RecipeIngredient{
+		{IngredientID: batter.ID, Quantity: 120},
+		{IngredientID: potato.ID, Quantity: 80},
+	})
+	assert.NoError(t, err)
+
+	t.Run("Work out portions from the scarcest ingredient", func(t *testing.T) {
+		_, available, err := AvailableQuantities([]*models.Item{dosa})
+		assert.NoError(t, err)
+		assert.Equal(t, uint(3), available[dosa.ID])
+	})
+
+	t.Run("Take an order out of the ingredients", func(t *testing.T) {
+		_, updated, err := LowerQuantity(dosa.ID, 2, models.ReasonOrder, "ORD-1", "3")
+		assert.NoError(t, err)
+		assert.Equal(t, uint(1), updated.Quantity)
+
+		_, stored, err := GetItem(batter.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, uint(260), stored.Quantity)
+		_, movements, _, err := ListStockMovements(potato.ID, 1, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(-160), movements[0].Delta)
+		assert.Equal(t, models.ReasonOrder, movements[0].Reason)
+		assert.Equal(t, "ORD-1", movements[0].Reference)
+	})
+
+	t.Run("Refuse an order the ingredients cannot make", func(t *testing.T) {
+		status, _, err := LowerQuantity(dosa.ID, 2, models.ReasonOrder, "", "")
+		assert.Equal(t, uint32(http.StatusConflict), status)
+		assert.Equal(t, errors.ErrInsufficientQuantity, err)
+
+		// the batter taken before the potato ran short is put back
+		_, stored, err := GetItem(batter.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, uint(260), stored.Quantity)
+
+		_, open, err := ItemsAvailableNow([]*models.Item{dosa})
+		assert.NoError(t, err)
+		assert.True(t, open[dosa.ID])
+
+		_, _, err = LowerQuantity(dosa.ID, 1, models.ReasonOrder, "", "")
+		assert.NoError(t, err)
+		_, open, err = ItemsAvailableNow([]*models.Item{dosa})
+		assert.NoError(t, err)
+		assert.False(t, open[dosa.ID])
+	})
+
+	t.Run("Report the dishes put at risk by a low ingredient", func(t *testing.T) {
+		_, shortages, err := ListRecipeShortages()
+		assert.NoError(t, err)
+		assert.Equal(t, []*RecipeShortage{{
+			IngredientID:   potato.ID,
+			IngredientName: "potato masala",
+			Unit:           "g",
+			Available:      10,
+			ReorderLevel:   100,
+			DishID:         dosa.ID,
+			DishName:       "masala dosa",
+			PerPortion:     80,
+			Portions:       0,
+		}}, shortages)
+	})
+}
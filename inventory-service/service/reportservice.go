@@ -0,0 +1,283 @@
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"inventory-service/errors"
+	"inventory-service/models"
+	"inventory-service/money"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// DefaultSlowMovingDays is how far back SlowMovingItems looks when no period is given.
+const DefaultSlowMovingDays = 30
+
+// StockValue is what an item's stock on hand is worth.
+type StockValue struct {
+	Item     *models.Item
+	Quantity uint
+	UnitCost money.Money
+	Value    money.Money
+}
+
+// MovementSummary adds up an item's movements for one reason over a period.
+type MovementSummary struct {
+	Item        *models.Item
+	Reason      string
+	QuantityIn  uint
+	QuantityOut uint
+	ValueIn     money.Money
+	ValueOut    money.Money
+	Movements   uint
+}
+
+// SlowMovingItem is an item with stock on hand that has sold little or nothing lately.
+type SlowMovingItem struct {
+	Item         *models.Item
+	Quantity     uint
+	QuantitySold uint
+	LastSoldAt   *time.Time
+	Value        money.Money
+}
+
+// StockValuation values the stock of every item as it stood at the given time, or now when
+// it is zero, along with the total for each currency stock was bought in.
+func StockValuation(method string, at time.Time) (uint32, []*StockValue, []money.Money, error) {
+	if at.IsZero() {
+		at = clock()
+	}
+	status, items, err := models.GetAllItems()
+	if err != nil {
+		return status, nil, nil, err
+	}
+	status, costs, err := replayCosts(items, method, at, nil)
+	if err != nil {
+		return status, nil, nil, err
+	}
+
+	sort.Slice(items, func(i, j int) bool { return items[i].ID < items[j].ID })
+	values := []*StockValue{}
+	totals := map[string]money.Money{}
+	for _, item := range items {
+		cost := costs[item.ID]
+		if cost.quantity == 0 {
+			continue
+		}
+		value := cost.amount(cost.value)
+		values = append(values, &StockValue{Item: item, Quantity: cost.quantity, UnitCost: cost.unitCost(), Value: value})
+		if value.Currency != "" {
+			totals[value.Currency], _ = totals[value.Currency].Add(value)
+		}
+	}
+	return http.StatusOK, values, sortedTotals(totals), nil
+}
+
+// SummarizeMovements adds up what came in and went out of every item's stock, including
+// items deleted or purged since, for each reason from one time up to another, or up to now when
+// to is zero.
+func SummarizeMovements(method string, from time.Time, to time.Time) (uint32, []*MovementSummary, error) {
+	if to.IsZero() {
+		to = clock()
+	}
+	if !to.After(from) {
+		return http.StatusBadRequest, nil, errors.ErrInvalidPeriod
+	}
+	status, items, err := models.GetAllItems()
+	if err != nil {
+		return status, nil, err
+	}
+	status, archived, err := models.GetArchivedItems()
+	if err != nil {
+		return status, nil, err
+	}
+	items = append(items, archived...)
+	status, purged, err := models.GetPurgedItems()
+	if err != nil {
+		return status, nil, err
+	}
+	items = append(items, purged...)
+	sort.Slice(items, func(i, j int) bool { return items[i].ID < items[j].ID })
+
+	byItem := map[uint]*models.Item{}
+	for _, item := range items {
+		byItem[item.ID] = item
+	}
+	type key struct {
+		itemID uint
+		reason string
+	}
+	summaries := map[key]*MovementSummary{}
+	status, _, err = replayCosts(items, method, to, func(movement *models.StockMovement, value money.Money) {
+		if movement.CreatedAt.Before(from) {
+			return
+		}
+		k := key{itemID: movement.ItemID, reason: movement.Reason}
+		summary, ok := summaries[k]
+		if !ok {
+			summary = &MovementSummary{Item: byItem[movement.ItemID], Reason: movement.Reason}
+			summaries[k] = summary
+		}
+		summary.Movements++
+		if movement.Delta > 0 {
+			summary.QuantityIn += uint(movement.Delta)
+			summary.ValueIn, _ = summary.ValueIn.Add(value)
+		} else {
+			summary.QuantityOut += uint(-movement.Delta)
+			summary.ValueOut, _ = summary.ValueOut.Add(value)
+		}
+	})
+	if err != nil {
+		return status, nil, err
+	}
+
+	report := make([]*MovementSummary, 0, len(summaries))
+	for _, summary := range summaries {
+		report = append(report, summary)
+	}
+	sort.Slice(report, func(i, j int) bool {
+		if report[i].Item.ID != report[j].Item.ID {
+			return report[i].Item.ID < report[j].Item.ID
+		}
+		return report[i].Reason < report[j].Reason
+	})
+	return http.StatusOK, report, nil
+}
+
+// SlowMovingItems returns the items with stock on hand that have sold no more than
+// maxQuantity over the last days, most valuable stock first. Only stock going out for
+// orders counts as sold, and items added during the period are left out, having not had
+// the time to sell.
+func SlowMovingItems(method string, days uint, maxQuantity uint) (uint32, []*SlowMovingItem, error) {
+	if days == 0 {
+		days = DefaultSlowMovingDays
+	}
+	now := clock()
+	since := now.AddDate(0, 0, -int(days))
+	status, items, err := models.GetAllItems()
+	if err != nil {
+		return status, nil, err
+	}
+
+	sold := map[uint]uint{}
+	lastSold := map[uint]time.Time{}
+	status, costs, err := replayCosts(items, method, now, func(movement *models.StockMovement, _ money.Money) {
+		if movement.Reason != models.ReasonOrder || movement.Delta >= 0 {
+			return
+		}
+		lastSold[movement.ItemID] = movement.CreatedAt
+		if !movement.CreatedAt.Before(since) {
+			sold[movement.ItemID] += uint(-movement.Delta)
+		}
+	})
+	if err != nil {
+		return status, nil, err
+	}
+
+	report := []*SlowMovingItem{}
+	for _, item := range items {
+		cost := costs[item.ID]
+		if cost.quantity == 0 || item.CreatedAt.After(since) || sold[item.ID] > maxQuantity {
+			continue
+		}
+		slow := &SlowMovingItem{Item: item, Quantity: cost.quantity, QuantitySold: sold[item.ID], Value: cost.amount(cost.value)}
+		if last, ok := lastSold[item.ID]; ok {
+			slow.LastSoldAt = &last
+		}
+		report = append(report, slow)
+	}
+	sort.Slice(report, func(i, j int) bool {
+		if report[i].Value.MinorUnits != report[j].Value.MinorUnits {
+			return report[i].Value.MinorUnits > report[j].Value.MinorUnits
+		}
+		return report[i].Item.ID < report[j].Item.ID
+	})
+	return http.StatusOK, report, nil
+}
+
+// sortedTotals lists the totals by currency code.
+func sortedTotals(totals map[string]money.Money) []money.Money {
+	sorted := make([]money.Money, 0, len(totals))
+	for _, total := range totals {
+		sorted = append(sorted, total)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Currency < sorted[j].Currency })
+	return sorted
+}
+
+// StockValuationCSV writes a stock valuation as CSV, with a row for the total in each currency.
+func StockValuationCSV(values []*StockValue, totals []money.Money) ([]byte, error) {
+	records := [][]string{{"item_id", "name", "quantity", "unit", "unit_cost", "value", "currency"}}
+	for _, value := range values {
+		records = append(records, []string{
+			strconv.FormatUint(uint64(value.Item.ID), 10),
+			value.Item.Name,
+			QuantityAmount(value.Item, value.Quantity),
+			stockUnit(value.Item).Name,
+			value.UnitCost.String(),
+			value.Value.String(),
+			value.Value.Currency,
+		})
+	}
+	for _, total := range totals {
+		records = append(records, []string{"", "TOTAL", "", "", "", total.String(), total.Currency})
+	}
+	return writeCSV(records)
+}
+
+// MovementSummaryCSV writes a movement summary as CSV.
+func MovementSummaryCSV(summaries []*MovementSummary) ([]byte, error) {
+	records := [][]string{{"item_id", "name", "reason", "quantity_in", "quantity_out", "unit", "value_in", "value_out", "currency", "movements"}}
+	for _, summary := range summaries {
+		currency := summary.ValueIn.Currency
+		if currency == "" {
+			currency = summary.ValueOut.Currency
+		}
+		records = append(records, []string{
+			strconv.FormatUint(uint64(summary.Item.ID), 10),
+			summary.Item.Name,
+			summary.Reason,
+			QuantityAmount(summary.Item, summary.QuantityIn),
+			QuantityAmount(summary.Item, summary.QuantityOut),
+			stockUnit(summary.Item).Name,
+			summary.ValueIn.String(),
+			summary.ValueOut.String(),
+			currency,
+			strconv.FormatUint(uint64(summary.Movements), 10),
+		})
+	}
+	return writeCSV(records)
+}
+
+// SlowMovingItemsCSV writes the slow-moving items as CSV.
+func SlowMovingItemsCSV(items []*SlowMovingItem) ([]byte, error) {
+	records := [][]string{{"item_id", "name", "quantity", "quantity_sold", "unit", "last_sold_at", "value", "currency"}}
+	for _, item := range items {
+		lastSoldAt := ""
+		if item.LastSoldAt != nil {
+			lastSoldAt = item.LastSoldAt.UTC().Format(time.RFC3339)
+		}
+		records = append(records, []string{
+			strconv.FormatUint(uint64(item.Item.ID), 10),
+			item.Item.Name,
+			QuantityAmount(item.Item, item.Quantity),
+			QuantityAmount(item.Item, item.QuantitySold),
+			stockUnit(item.Item).Name,
+			lastSoldAt,
+			item.Value.String(),
+			item.Value.Currency,
+		})
+	}
+	return writeCSV(records)
+}
+
+func writeCSV(records [][]string) ([]byte, error) {
+	buffer := &bytes.Buffer{}
+	writer := csv.NewWriter(buffer)
+	if err := writer.WriteAll(records); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
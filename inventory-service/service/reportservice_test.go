@@ -0,0 +1,237 @@
+package service
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"inventory-service/errors"
+	"inventory-service/models"
+	"inventory-service/money"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type ReportServiceTestSuite struct {
+	suite.Suite
+	db *gorm.DB
+}
+
+func (suite *ReportServiceTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		suite.FailNow("failed to connect database")
+	}
+	suite.db = db
+	models.InitInventoryModels(db)
+}
+
+func (suite *ReportServiceTestSuite) TearDownTest() {
+	clock = time.Now
+	_ = suite.db.Migrator().DropTable(&models.Item{}, &models.StockMovement{}, &models.Lot{})
+	sql, _ := suite.db.DB()
+	sql.Close()
+}
+
+func TestReportServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ReportServiceTestSuite))
+}
+
+// addFlour adds 10kg of flour costing 2.00 a kg, restocks 5kg at 2.60 a kg and sells 4kg
+// and throws away 8kg of it, leaving 3kg.
+func addFlour(t *testing.T) *models.Item {
+	_, flour, err := AddItem(&models.Item{Name: "flour", Description: "wheat", Price: money.New(300, "EUR"), CostPrice: money.New(200, "EUR"), Quantity: 10000, Unit: "kg"})
+	assert.NoError(t, err)
+	_, _, err = AddQuantityAt(flour.ID, 0, 5000, money.New(260, "EUR"), models.ReasonRestock, "PO-1", "tester")
+	assert.NoError(t, err)
+	_, _, err = LowerQuantity(flour.ID, 4000, models.ReasonOrder, "order-1", "tester")
+	assert.NoError(t, err)
+	_, flour, err = LowerQuantity(flour.ID, 8000, models.ReasonWastage, "", "tester")
+	assert.NoError(t, err)
+	return flour
+}
+
+func (suite *ReportServiceTestSuite) TestService_StockValuation() {
+	t := suite.T()
+
+	flour := addFlour(t)
+	_, dosa, err := AddItem(&models.Item{Name: "dosa", Description: "rice crepe", Price: money.New(800, "USD"), CostPrice: money.New(150, "USD"), Quantity: 2})
+	assert.NoError(t, err)
+	_, _, err = AddItem(&models.Item{Name: "water", Description: "tap", Price: money.New(100, "EUR"), Quantity: 5})
+	assert.NoError(t, err)
+
+	t.Run("Value what is left at the cost of the stock received last", func(t *testing.T) {
+		status, values, totals, err := StockValuation(ValuationFIFO, time.Time{})
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(values))
+		assert.Equal(t, flour.ID, values[0].Item.ID)
+		assert.Equal(t, uint(3000), values[0].Quantity)
+		assert.Equal(t, money.New(260, "EUR"), values[0].UnitCost)
+		assert.Equal(t, money.New(780, "EUR"), values[0].Value)
+		assert.Equal(t, dosa.ID, values[1].Item.ID)
+		assert.Equal(t, money.New(300, "USD"), values[1].Value)
+		// stock without a cost counts but is worth nothing
+		assert.Equal(t, money.Money{}, values[2].Value)
+		assert.Equal(t, []money.Money{money.New(780, "EUR"), money.New(300, "USD")}, totals)
+
+		data, err := StockValuationCSV(values, totals)
+		assert.NoError(t, err)
+		assert.Contains(t, string(data), "1,flour,3,kg,2.60,7.80,EUR\n")
+		assert.Contains(t, string(data), ",TOTAL,,,,3.00,USD\n")
+	})
+
+	t.Run("Value what is left at the average cost of all stock received", func(t *testing.T) {
+		_, values, _, err := StockValuation(ValuationWeightedAverage, time.Time{})
+		assert.NoError(t, err)
+		assert.Equal(t, money.New(220, "EUR"), values[0].UnitCost)
+		assert.Equal(t, money.New(660, "EUR"), values[0].Value)
+	})
+
+	t.Run("Value the stock as it stood before anything was received", func(t *testing.T) {
+		_, values, totals, err := StockValuation(ValuationFIFO, flour.CreatedAt.Add(-time.Second))
+		assert.NoError(t, err)
+		assert.Empty(t, values)
+		assert.Empty(t, totals)
+	})
+
+	t.Run("Refuse an unknown valuation method", func(t *testing.T) {
+		status, _, _, err := StockValuation("LIFO", time.Time{})
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrInvalidValuationMethod, err)
+	})
+
+	t.Run("Value a lot received without a cost at the item's cost price", func(t *testing.T) {
+		_, _, lot, err := ReceiveLot(dosa.ID, 0, 4, money.Money{}, time.Now().AddDate(0, 0, 5), "", "tester")
+		assert.NoError(t, err)
+		assert.Equal(t, money.New(150, "USD"), lot.UnitCost)
+
+		_, values, _, err := StockValuation(ValuationFIFO, time.Time{})
+		assert.NoError(t, err)
+		assert.Equal(t, money.New(900, "USD"), values[1].Value)
+	})
+}
+
+func (suite *ReportServiceTestSuite) TestService_SummarizeMovements() {
+	t := suite.T()
+
+	flour := addFlour(t)
+
+	t.Run("Add up what came in and went out for each reason", func(t *testing.T) {
+		status, summaries, err := SummarizeMovements(ValuationFIFO, time.Time{}, time.Time{})
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.Equal(t, 4, len(summaries))
+
+		reasons := []string{}
+		for _, summary := range summaries {
+			assert.Equal(t, flour.ID, summary.Item.ID)
+			reasons = append(reasons, summary.Reason)
+		}
+		assert.Equal(t, []string{models.ReasonInitialStock, models.ReasonOrder, models.ReasonRestock, models.ReasonWastage}, reasons)
+		assert.Equal(t, uint(10000), summaries[0].QuantityIn)
+		assert.Equal(t, money.New(2000, "EUR"), summaries[0].ValueIn)
+		assert.Equal(t, uint(4000), summaries[1].QuantityOut)
+		assert.Equal(t, money.New(800, "EUR"), summaries[1].ValueOut)
+		assert.Equal(t, money.New(1300, "EUR"), summaries[2].ValueIn)
+		// the last 6kg of the first delivery and 2kg of the second
+		assert.Equal(t, uint(8000), summaries[3].QuantityOut)
+		assert.Equal(t, money.New(1720, "EUR"), summaries[3].ValueOut)
+
+		data, err := MovementSummaryCSV(summaries)
+		assert.NoError(t, err)
+		assert.Contains(t, string(data), "1,flour,WASTAGE,0,8,kg,0,17.20,EUR,1\n")
+	})
+
+	t.Run("Keep reporting the movements of an item once it is purged", func(t *testing.T) {
+		_, err := DeleteItem(flour.ID)
+		assert.NoError(t, err)
+		_, _, _, err = models.PurgeItem(flour.ID)
+		assert.NoError(t, err)
+
+		_, summaries, err := SummarizeMovements(ValuationFIFO, time.Time{}, time.Time{})
+		assert.NoError(t, err)
+		assert.Equal(t, 4, len(summaries))
+		assert.Equal(t, "flour", summaries[3].Item.Name)
+		assert.Equal(t, money.New(1720, "EUR"), summaries[3].ValueOut)
+
+		data, err := MovementSummaryCSV(summaries)
+		assert.NoError(t, err)
+		assert.Contains(t, string(data), "1,flour,WASTAGE,0,8,kg,0,17.20,EUR,1\n")
+	})
+
+	t.Run("Leave out movements before the period", func(t *testing.T) {
+		_, summaries, err := SummarizeMovements(ValuationFIFO, time.Now(), time.Time{})
+		assert.NoError(t, err)
+		assert.Empty(t, summaries)
+	})
+
+	t.Run("Refuse a period that ends before it starts", func(t *testing.T) {
+		status, _, err := SummarizeMovements(ValuationFIFO, time.Now(), time.Now().Add(-time.Hour))
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrInvalidPeriod, err)
+	})
+}
+
+func (suite *ReportServiceTestSuite) TestService_SlowMovingItems() {
+	t := suite.T()
+
+	_, dosa, err := AddItem(&models.Item{Name: "dosa", Description: "rice crepe", Price: money.New(800, "EUR"), CostPrice: money.New(150, "EUR"), Quantity: 20})
+	assert.NoError(t, err)
+	_, _, err = LowerQuantity(dosa.ID, 2, models.ReasonOrder, "order-1", "tester")
+	assert.NoError(t, err)
+	_, idli, err := AddItem(&models.Item{Name: "idli", Description: "steamed rice cakes", Price: money.New(600, "EUR"), CostPrice: money.New(100, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+	_, _, err = LowerQuantity(idli.ID, 8, models.ReasonOrder, "order-2", "tester")
+	assert.NoError(t, err)
+	_, _, err = LowerQuantity(idli.ID, 1, models.ReasonWastage, "", "tester")
+	assert.NoError(t, err)
+	_, vada, err := AddItem(&models.Item{Name: "vada", Description: "lentil doughnut", Price: money.New(500, "EUR"), Quantity: 1})
+	assert.NoError(t, err)
+	_, _, err = LowerQuantity(vada.ID, 1, models.ReasonOrder, "order-3", "tester")
+	assert.NoError(t, err)
+
+	// the items have been on the menu for two months
+	err = suite.db.Model(&models.Item{}).Where("id IN ?", []uint{dosa.ID, idli.ID, vada.ID}).Update("created_at", time.Now().AddDate(0, 0, -60)).Error
+	assert.NoError(t, err)
+
+	t.Run("List items that sold little over the period", func(t *testing.T) {
+		status, items, err := SlowMovingItems(ValuationFIFO, 30, 5)
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(items))
+		assert.Equal(t, dosa.ID, items[0].Item.ID)
+		assert.Equal(t, uint(2), items[0].QuantitySold)
+		assert.Equal(t, money.New(2700, "EUR"), items[0].Value)
+		assert.NotNil(t, items[0].LastSoldAt)
+	})
+
+	t.Run("List items that did not sell at all, most valuable stock first", func(t *testing.T) {
+		now := time.Now().AddDate(0, 0, 45)
+		clock = func() time.Time { return now }
+		defer func() { clock = time.Now }()
+
+		_, items, err := SlowMovingItems(ValuationFIFO, 0, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(items))
+		assert.Equal(t, dosa.ID, items[0].Item.ID)
+		assert.Equal(t, idli.ID, items[1].Item.ID)
+		assert.Equal(t, uint(0), items[1].QuantitySold)
+
+		data, err := SlowMovingItemsCSV(items)
+		assert.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+		assert.Equal(t, 3, len(lines))
+		assert.True(t, strings.HasPrefix(lines[2], "2,idli,1,0,each,"))
+	})
+
+	t.Run("Leave out items added during the period", func(t *testing.T) {
+		_, items, err := SlowMovingItems(ValuationFIFO, 90, 100)
+		assert.NoError(t, err)
+		assert.Empty(t, items)
+	})
+}
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"inventory-service/errors"
+	"inventory-service/models"
+	"net/http"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+const (
+	// SortByRating orders the catalog best rated first.
+	SortByRating = "rating"
+	// maxReviewText bounds how much a customer can write in a review, in characters.
+	maxReviewText = 2000
+	// maxReviewPhotos bounds how many photos a customer can add to a review.
+	maxReviewPhotos = 5
+)
+
+// reviewPhotoKey is the key a review photo, or one of its thumbnails, is stored under.
+func reviewPhotoKey(photo *models.ReviewPhoto, variant string) string {
+	return variantKey(fmt.Sprintf("reviews/%d/%d", photo.ReviewID, photo.ID), photo.ContentType, variant)
+}
+
+// PhotoURL returns where the review photo itself can be fetched.
+func PhotoURL(photo *models.ReviewPhoto) string {
+	return imageStore.URL(reviewPhotoKey(photo, originalImage))
+}
+
+// PhotoThumbnailURLs returns where each thumbnail of the review photo can be fetched, keyed
+// by size name.
+func PhotoThumbnailURLs(photo *models.ReviewPhoto) map[string]string {
+	return thumbnailURLs(func(variant string) string { return reviewPhotoKey(photo, variant) })
+}
+
+// deletePhotoBlobs drops the stored photo and its thumbnails, logging any that cannot be deleted.
+func deletePhotoBlobs(photo *models.ReviewPhoto) {
+	deleteBlobs(func(variant string) string { return reviewPhotoKey(photo, variant) })
+}
+
+// SubmitReview records a customer's review of an item, to be shown once an admin approves
+// it. Only customers the order service has delivered the item to can review it.
+func SubmitReview(ctx context.Context, itemID uint, userID uint, rating uint, text string, photos [][]byte) (uint32, *models.Review, error) {
+	text = strings.TrimSpace(text)
+	if rating < 1 || rating > 5 {
+		return http.StatusBadRequest, nil, errors.ErrInvalidRating
+	}
+	if utf8.RuneCountInString(text) > maxReviewText {
+		return http.StatusBadRequest, nil, errors.ErrReviewTooLong
+	}
+	if len(photos) > maxReviewPhotos {
+		return http.StatusBadRequest, nil, errors.ErrTooManyPhotos
+	}
+	uploads := make([]*uploadedImage, 0, len(photos))
+	for _, data := range photos {
+		status, upload, err := readImage(data)
+		if err != nil {
+			return status, nil, err
+		}
+		uploads = append(uploads, upload)
+	}
+	if status, err := checkDelivered(ctx, userID, itemID); err != nil {
+		return status, nil, err
+	}
+
+	status, review, err := models.CreateReview(&models.Review{
+		ItemID: itemID,
+		UserID: userID,
+		Rating: rating,
+		Text:   text,
+		Status: models.ReviewPending,
+	})
+	if err != nil {
+		return status, nil, err
+	}
+
+	for _, upload := range uploads {
+		_, photo, err := models.CreateReviewPhoto(&models.ReviewPhoto{
+			ReviewID:    review.ID,
+			ContentType: upload.contentType,
+			Width:       uint(upload.config.Width),
+			Height:      uint(upload.config.Height),
+			Size:        uint(len(upload.data)),
+		})
+		if err == nil {
+			review.Photos = append(review.Photos, photo)
+			err = storeImage(upload, func(variant string) string { return reviewPhotoKey(photo, variant) })
+		}
+		if err != nil {
+			logger.WithField("error", err.Error()).Error("failed to store review photo")
+			removeReview(review.ID)
+			return http.StatusInternalServerError, nil, err
+		}
+	}
+	return status, review, nil
+}
+
+// removeReview forgets a review and drops whatever was stored of its photos.
+func removeReview(id uint) {
+	_, photos, err := models.DeleteReview(id)
+	if err != nil {
+		return
+	}
+	for _, photo := range photos {
+		deletePhotoBlobs(photo)
+	}
+}
+
+// ListItemReviews returns the approved reviews of an item, newest first.
+func ListItemReviews(itemID uint) (uint32, []*models.Review, error) {
+	status, _, err := models.GetItem(itemID)
+	if err != nil {
+		return status, nil, err
+	}
+	return models.GetReviews(itemID, models.ReviewApproved)
+}
+
+// ListReviews returns the reviews of every item in the given state, those waiting to be
+// moderated when it is empty.
+func ListReviews(status string) (uint32, []*models.Review, error) {
+	if status == "" {
+		status = models.ReviewPending
+	}
+	return models.GetReviews(0, status)
+}
+
+// ModerateReview approves or rejects a review. Only approved reviews are shown and count
+// towards an item's rating.
+func ModerateReview(id uint, status string, actor string) (uint32, *models.Review, error) {
+	if status != models.ReviewApproved && status != models.ReviewRejected {
+		return http.StatusBadRequest, nil, errors.ErrInvalidReviewStatus
+	}
+	return models.SetReviewStatus(id, status, actor)
+}
+
+// ItemRatings returns the rating of each of the items that has approved reviews, keyed by
+// item id.
+func ItemRatings(items []*models.Item) (uint32, map[uint]*models.Rating, error) {
+	ids := []uint{}
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	status, ratings, err := models.GetRatings(ids)
+	if err != nil {
+		return status, nil, err
+	}
+	byItem := map[uint]*models.Rating{}
+	for _, rating := range ratings {
+		byItem[rating.ItemID] = rating
+	}
+	return http.StatusOK, byItem, nil
+}
+
+// SortItems orders items by the given key, leaving them as they are when it is empty. Sorted
+// by rating, items rated higher come first and, between equal ratings, those with more
+// reviews; unrated items come last.
+func SortItems(items []*models.Item, sortBy string) (uint32, []*models.Item, error) {
+	switch sortBy {
+	case "":
+		return http.StatusOK, items, nil
+	case SortByRating:
+	default:
+		return http.StatusBadRequest, nil, errors.ErrInvalidSort
+	}
+	status, ratings, err := ItemRatings(items)
+	if err != nil {
+		return status, nil, err
+	}
+	sorted := append([]*models.Item(nil), items...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := ratings[sorted[i].ID], ratings[sorted[j].ID]
+		if a == nil || b == nil {
+			return a != nil && b == nil
+		}
+		if a.Average != b.Average {
+			return a.Average > b.Average
+		}
+		return a.Count > b.Count
+	})
+	return http.StatusOK, sorted, nil
+}
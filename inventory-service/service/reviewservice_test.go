@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"inventory-service/blobstore"
+	"inventory-service/errors"
+	orderMocks "inventory-service/mocks/orderMocks"
+	"inventory-service/models"
+	"inventory-service/money"
+	"inventory-service/proto/orderpb"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type ReviewServiceTestSuite struct {
+	suite.Suite
+	db     *gorm.DB
+	store  *blobstore.LocalStore
+	orders *orderMocks.OrderServiceClient
+}
+
+func (suite *ReviewServiceTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		suite.FailNow("failed to connect database")
+	}
+	suite.db = db
+	models.InitInventoryModels(db)
+	suite.store = blobstore.NewLocalStore(suite.T().TempDir(), "/inventory/images")
+	SetImageStore(suite.store, DefaultMaxImageSize)
+	suite.orders = &orderMocks.OrderServiceClient{}
+	suite.orders.On("HasDeliveredOrder", mock.Anything, mock.Anything).Return(&orderpb.HasDeliveredOrderResponse{StatusCode: http.StatusOK, Delivered: true}, nil)
+	SetOrderService(suite.orders)
+}
+
+func (suite *ReviewServiceTestSuite) TearDownTest() {
+	SetImageStore(blobstore.NewLocalStore("images", "/inventory/images"), DefaultMaxImageSize)
+	SetOrderService(nil)
+	_ = suite.db.Migrator().DropTable(&models.Item{}, &models.StockMovement{}, &models.Review{}, &models.ReviewPhoto{})
+	sql, _ := suite.db.DB()
+	sql.Close()
+}
+
+func TestReviewServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ReviewServiceTestSuite))
+}
+
+func (suite *ReviewServiceTestSuite) TestService_SubmitReview() {
+	t := suite.T()
+	ctx := context.Background()
+
+	_, dosa, err := AddItem(&models.Item{Name: "dosa", Description: "rice crepe", Price: money.New(800, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+
+	t.Run("Submit a review with a photo, waiting to be moderated", func(t *testing.T) {
+		status, review, err := SubmitReview(ctx, dosa.ID, 7, 5, "  crisp and hot  ", [][]byte{testImage(t, 800, 400, true)})
+		assert.Equal(t, uint32(http.StatusCreated), status)
+		assert.NoError(t, err)
+		assert.Equal(t, models.ReviewPending, review.Status)
+		assert.Equal(t, "crisp and hot", review.Text)
+		assert.Equal(t, 1, len(review.Photos))
+
+		photo := review.Photos[0]
+		assert.Equal(t, "image/jpeg", photo.ContentType)
+		assert.Equal(t, uint(800), photo.Width)
+		assert.True(t, strings.HasSuffix(PhotoURL(photo), "/original.jpg"))
+		_, err = suite.store.Get(reviewPhotoKey(photo, "small"))
+		assert.NoError(t, err)
+	})
+
+	t.Run("Refuse a second review of the same item", func(t *testing.T) {
+		status, _, err := SubmitReview(ctx, dosa.ID, 7, 3, "", nil)
+		assert.Equal(t, uint32(http.StatusConflict), status)
+		assert.Equal(t, errors.ErrReviewExists, err)
+	})
+
+	t.Run("Refuse a rating out of range", func(t *testing.T) {
+		status, _, err := SubmitReview(ctx, dosa.ID, 8, 6, "", nil)
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrInvalidRating, err)
+	})
+
+	t.Run("Refuse a photo that is not an image", func(t *testing.T) {
+		status, _, err := SubmitReview(ctx, dosa.ID, 8, 4, "", [][]byte{[]byte("not an image")})
+		assert.Equal(t, uint32(http.StatusUnsupportedMediaType), status)
+		assert.Equal(t, errors.ErrInvalidImage, err)
+
+		_, reviews, err := ListReviews("")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(reviews))
+	})
+
+	t.Run("Refuse a review from a customer who has not had the item delivered", func(t *testing.T) {
+		orders := &orderMocks.OrderServiceClient{}
+		orders.On("HasDeliveredOrder", ctx, &orderpb.HasDeliveredOrderRequest{UserId: 8, ItemId: uint32(dosa.ID)}).Return(&orderpb.HasDeliveredOrderResponse{StatusCode: http.StatusOK}, nil).Once()
+		SetOrderService(orders)
+		defer SetOrderService(suite.orders)
+
+		status, _, err := SubmitReview(ctx, dosa.ID, 8, 4, "", [][]byte{testImage(t, 100, 100, false)})
+		assert.Equal(t, uint32(http.StatusForbidden), status)
+		assert.Equal(t, errors.ErrNotDelivered, err)
+		orders.AssertExpectations(t)
+
+		_, reviews, err := ListReviews("")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(reviews))
+	})
+
+	t.Run("Refuse a review when the order service cannot be asked", func(t *testing.T) {
+		orders := &orderMocks.OrderServiceClient{}
+		orders.On("HasDeliveredOrder", ctx, mock.Anything).Return(nil, assert.AnError).Once()
+		SetOrderService(orders)
+		defer SetOrderService(suite.orders)
+
+		status, _, err := SubmitReview(ctx, dosa.ID, 8, 4, "", nil)
+		assert.Equal(t, uint32(http.StatusServiceUnavailable), status)
+		assert.Equal(t, errors.ErrOrderServiceUnavailable, err)
+	})
+
+	t.Run("Refuse a review of an item not in the catalog", func(t *testing.T) {
+		status, _, err := SubmitReview(ctx, dosa.ID+100, 8, 4, "", nil)
+		assert.Equal(t, uint32(http.StatusNotFound), status)
+		assert.Equal(t, errors.ErrItemNotFound, err)
+	})
+}
+
+func (suite *ReviewServiceTestSuite) TestService_ModerateReview() {
+	t := suite.T()
+	ctx := context.Background()
+
+	_, dosa, err := AddItem(&models.Item{Name: "dosa", Description: "rice crepe", Price: money.New(800, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+	_, idli, err := AddItem(&models.Item{Name: "idli", Description: "steamed rice cakes", Price: money.New(600, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+	_, vada, err := AddItem(&models.Item{Name: "vada", Description: "lentil doughnut", Price: money.New(500, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+
+	reviews := []*models.Review{}
+	for _, submitted := range []struct {
+		item   *models.Item
+		user   uint
+		rating uint
+	}{{dosa, 1, 3}, {dosa, 2, 5}, {dosa, 3, 4}, {idli, 1, 4}, {idli, 2, 4}, {idli, 3, 1}, {vada, 1, 5}} {
+		_, review, err := SubmitReview(ctx, submitted.item.ID, submitted.user, submitted.rating, "", nil)
+		assert.NoError(t, err)
+		reviews = append(reviews, review)
+	}
+
+	t.Run("Show only approved reviews and rate items by them", func(t *testing.T) {
+		for _, review := range reviews[:5] {
+			_, _, err := ModerateReview(review.ID, models.ReviewApproved, "admin")
+			assert.NoError(t, err)
+		}
+		status, review, err := ModerateReview(reviews[5].ID, models.ReviewRejected, "admin")
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.Equal(t, models.ReviewRejected, review.Status)
+		assert.Equal(t, "admin", review.ModeratedBy)
+		assert.NotNil(t, review.ModeratedAt)
+
+		_, shown, err := ListItemReviews(idli.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(shown))
+
+		_, pending, err := ListReviews("")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(pending))
+		assert.Equal(t, vada.ID, pending[0].ItemID)
+
+		_, ratings, err := ItemRatings([]*models.Item{dosa, idli, vada})
+		assert.NoError(t, err)
+		assert.Equal(t, 4.0, ratings[dosa.ID].Average)
+		assert.Equal(t, uint(3), ratings[dosa.ID].Count)
+		assert.Equal(t, uint(2), ratings[idli.ID].Count)
+		assert.Nil(t, ratings[vada.ID])
+	})
+
+	t.Run("Sort the catalog by rating, then by number of reviews", func(t *testing.T) {
+		status, sorted, err := SortItems([]*models.Item{vada, idli, dosa}, SortByRating)
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.Equal(t, []uint{dosa.ID, idli.ID, vada.ID}, []uint{sorted[0].ID, sorted[1].ID, sorted[2].ID})
+
+		status, _, err = SortItems(sorted, "price")
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrInvalidSort, err)
+	})
+
+	t.Run("Refuse to put a review back to waiting", func(t *testing.T) {
+		status, _, err := ModerateReview(reviews[6].ID, models.ReviewPending, "admin")
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrInvalidReviewStatus, err)
+	})
+
+	t.Run("Moderate a review that does not exist", func(t *testing.T) {
+		status, _, err := ModerateReview(999, models.ReviewApproved, "admin")
+		assert.Equal(t, uint32(http.StatusNotFound), status)
+		assert.Equal(t, errors.ErrReviewNotFound, err)
+	})
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"inventory-service/errors"
+	"inventory-service/models"
+	"net/http"
+	"sort"
+)
+
+// StockVariance is one line of a stock-take's variance report: what was counted of an item at
+// a location against what the system held there at the time.
+type StockVariance struct {
+	ItemID     uint
+	Name       string
+	LocationID uint
+	Expected   uint
+	Counted    uint
+	Variance   int64
+}
+
+// StartStockTake opens a stock-take of one location, or of every location when locationID is 0.
+func StartStockTake(locationID uint, reference string, actor string) (uint32, *models.StockTake, error) {
+	return models.OpenStockTake(&models.StockTake{
+		LocationID: locationID,
+		Reference:  reference,
+		Actor:      actor,
+		CreatedAt:  clock(),
+	})
+}
+
+// RecordStockCount records how much of an item was counted at a location in an open stock-take.
+func RecordStockCount(takeID uint, itemID uint, locationID uint, counted uint, actor string) (uint32, *models.StockCount, error) {
+	if itemID == 0 {
+		return http.StatusBadRequest, nil, errors.ErrEmptyField
+	}
+	return models.RecordStockCount(&models.StockCount{
+		StockTakeID: takeID,
+		ItemID:      itemID,
+		LocationID:  locationID,
+		Counted:     counted,
+		CountedBy:   actor,
+		CountedAt:   clock(),
+	})
+}
+
+func GetStockTake(id uint) (uint32, *models.StockTake, error) {
+	return models.GetStockTake(id)
+}
+
+// ListStockTakes returns stock-takes newest first; an empty status lists them all.
+func ListStockTakes(status string) (uint32, []*models.StockTake, error) {
+	return models.GetStockTakes(status)
+}
+
+// GetVarianceReport compares every count of a stock-take with the system quantity at the time
+// of the count, largest discrepancies first.
+func GetVarianceReport(takeID uint) (uint32, []*StockVariance, error) {
+	status, take, err := models.GetStockTake(takeID)
+	if err != nil {
+		return status, nil, err
+	}
+
+	ids := []uint{}
+	for _, count := range take.Counts {
+		ids = append(ids, count.ItemID)
+	}
+	names := map[uint]string{}
+	if len(ids) > 0 {
+		status, items, err := models.GetItemsByIDs(ids)
+		if err != nil {
+			return status, nil, err
+		}
+		for _, item := range items {
+			names[item.ID] = item.Name
+		}
+	}
+
+	report := []*StockVariance{}
+	for _, count := range take.Counts {
+		report = append(report, &StockVariance{
+			ItemID:     count.ItemID,
+			Name:       names[count.ItemID],
+			LocationID: count.LocationID,
+			Expected:   count.Expected,
+			Counted:    count.Counted,
+			Variance:   count.Variance(),
+		})
+	}
+	sort.SliceStable(report, func(i, j int) bool {
+		return abs(report[i].Variance) > abs(report[j].Variance)
+	})
+	return http.StatusOK, report, nil
+}
+
+func abs(value int64) int64 {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
+
+// ApproveStockTake applies the variances of an open stock-take to stock and closes it.
+func ApproveStockTake(id uint, actor string) (uint32, *models.StockTake, []*models.StockMovement, error) {
+	status, take, items, movements, err := models.ApproveStockTake(id, actor, clock())
+	if err != nil {
+		return status, nil, nil, err
+	}
+	for i, item := range items {
+		publishItemEvent(models.EventQuantityChanged, item)
+		checkReorderLevel(item, uint(int64(item.Quantity)-movements[i].Delta))
+	}
+	return http.StatusOK, take, movements, nil
+}
+
+// CancelStockTake closes an open stock-take without adjusting stock.
+func CancelStockTake(id uint, actor string) (uint32, *models.StockTake, error) {
+	return models.CancelStockTake(id, actor, clock())
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"inventory-service/errors"
+	"inventory-service/models"
+	"inventory-service/money"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type StockTakeServiceTestSuite struct {
+	suite.Suite
+	db *gorm.DB
+}
+
+func (suite *StockTakeServiceTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		suite.FailNow("failed to connect database")
+	}
+	suite.db = db
+	models.InitInventoryModels(db)
+	fixClock("2026-03-02T09:00:00Z")
+}
+
+func (suite *StockTakeServiceTestSuite) TearDownTest() {
+	clock = time.Now
+	_ = suite.db.Migrator().DropTable(&models.Item{}, &models.StockMovement{}, &models.Location{}, &models.LocationStock{}, &models.Lot{}, &models.StockTake{}, &models.StockCount{})
+	sql, _ := suite.db.DB()
+	sql.Close()
+}
+
+func TestStockTakeServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(StockTakeServiceTestSuite))
+}
+
+func (suite *StockTakeServiceTestSuite) TestService_StockTake() {
+	t := suite.T()
+
+	_, flour, err := AddItem(&models.Item{Name: "flour", Description: "00 flour", Price: money.New(100, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+	_, milk, err := AddItem(&models.Item{Name: "milk", Description: "whole milk", Price: money.New(100, "EUR"), Quantity: 4})
+	assert.NoError(t, err)
+	_, salt, err := AddItem(&models.Item{Name: "salt", Description: "sea salt", Price: money.New(100, "EUR"), Quantity: 3})
+	assert.NoError(t, err)
+
+	status, take, err := StartStockTake(0, "monthly count", "3")
+	assert.Equal(t, uint32(http.StatusCreated), status)
+	assert.NoError(t, err)
+	assert.Equal(t, models.StockTakeOpen, take.Status)
+
+	t.Run("Allow one open stock-take over the same locations", func(t *testing.T) {
+		status, _, err := StartStockTake(0, "", "3")
+		assert.Equal(t, uint32(http.StatusConflict), status)
+		assert.Equal(t, errors.ErrStockTakeInProgress, err)
+	})
+
+	t.Run("Record counts against the system quantity at the time", func(t *testing.T) {
+		_, count, err := RecordStockCount(take.ID, flour.ID, 0, 7, "5")
+		assert.NoError(t, err)
+		assert.Equal(t, uint(10), count.Expected)
+		assert.Equal(t, models.DefaultLocationID(), count.LocationID)
+
+		_, _, err = RecordStockCount(take.ID, milk.ID, 0, 5, "5")
+		assert.NoError(t, err)
+		_, _, err = RecordStockCount(take.ID, salt.ID, 0, 3, "5")
+		assert.NoError(t, err)
+
+		status, _, err := RecordStockCount(take.ID, 99, 0, 1, "5")
+		assert.Equal(t, uint32(http.StatusNotFound), status)
+		assert.Equal(t, errors.ErrItemNotFound, err)
+	})
+
+	t.Run("Refuse corrections during the count but keep taking orders", func(t *testing.T) {
+		status, _, err := LowerQuantity(flour.ID, 1, models.ReasonCorrection, "", "3")
+		assert.Equal(t, uint32(http.StatusConflict), status)
+		assert.Equal(t, errors.ErrStockTakeInProgress, err)
+
+		_, _, err = LowerQuantity(flour.ID, 2, models.ReasonOrder, "ORD-1", "3")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Report variances largest first", func(t *testing.T) {
+		_, report, err := GetVarianceReport(take.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, []*StockVariance{
+			{ItemID: flour.ID, Name: "flour", LocationID: models.DefaultLocationID(), Expected: 10, Counted: 7, Variance: -3},
+			{ItemID: milk.ID, Name: "milk", LocationID: models.DefaultLocationID(), Expected: 4, Counted: 5, Variance: 1},
+			{ItemID: salt.ID, Name: "salt", LocationID: models.DefaultLocationID(), Expected: 3, Counted: 3, Variance: 0},
+		}, report)
+	})
+
+	t.Run("Apply the variances on approval", func(t *testing.T) {
+		_, approved, movements, err := ApproveStockTake(take.ID, "3")
+		assert.NoError(t, err)
+		assert.Equal(t, models.StockTakeApproved, approved.Status)
+		assert.Len(t, movements, 2)
+		assert.Equal(t, models.ReasonStockTake, movements[0].Reason)
+		assert.Equal(t, "stock-take 1", movements[0].Reference)
+
+		// the two sold after the count stay sold
+		_, stored, err := GetItem(flour.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, uint(5), stored.Quantity)
+		_, stored, err = GetItem(milk.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, uint(5), stored.Quantity)
+
+		status, _, _, err := ApproveStockTake(take.ID, "3")
+		assert.Equal(t, uint32(http.StatusConflict), status)
+		assert.Equal(t, errors.ErrStockTakeClosed, err)
+	})
+
+	t.Run("Allow corrections again once the count is closed", func(t *testing.T) {
+		_, _, err := LowerQuantity(flour.ID, 1, models.ReasonCorrection, "", "3")
+		assert.NoError(t, err)
+	})
+}
+
+func (suite *StockTakeServiceTestSuite) TestService_StockTakeAtLocation() {
+	t := suite.T()
+
+	_, flour, err := AddItem(&models.Item{Name: "flour", Description: "00 flour", Price: money.New(100, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+	_, warehouse, err := AddLocation(&models.Location{Name: "central store", Kind: models.LocationWarehouse})
+	assert.NoError(t, err)
+	_, _, err = AddQuantityAt(flour.ID, warehouse.ID, 20, money.Money{}, models.ReasonRestock, "", "3")
+	assert.NoError(t, err)
+
+	_, take, err := StartStockTake(warehouse.ID, "", "3")
+	assert.NoError(t, err)
+
+	t.Run("Only count the stock-take's location", func(t *testing.T) {
+		status, _, err := RecordStockCount(take.ID, flour.ID, models.DefaultLocationID(), 10, "5")
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrInvalidLocation, err)
+
+		_, count, err := RecordStockCount(take.ID, flour.ID, 0, 18, "5")
+		assert.NoError(t, err)
+		assert.Equal(t, warehouse.ID, count.LocationID)
+		assert.Equal(t, uint(20), count.Expected)
+	})
+
+	t.Run("Only block the location being counted", func(t *testing.T) {
+		_, _, err := LowerQuantityAt(flour.ID, models.DefaultLocationID(), 1, nil, models.ReasonCorrection, "", "3")
+		assert.NoError(t, err)
+
+		status, _, err := TransferStock(flour.ID, models.DefaultLocationID(), warehouse.ID, 1, "", "3")
+		assert.Equal(t, uint32(http.StatusConflict), status)
+		assert.Equal(t, errors.ErrStockTakeInProgress, err)
+	})
+
+	t.Run("Cancel without touching stock", func(t *testing.T) {
+		_, cancelled, err := CancelStockTake(take.ID, "3")
+		assert.NoError(t, err)
+		assert.Equal(t, models.StockTakeCancelled, cancelled.Status)
+
+		_, levels, err := GetItemStock(flour.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, uint(20), levels[1].Quantity)
+	})
+}
@@ -0,0 +1,291 @@
+package service
+
+import (
+	"inventory-service/errors"
+	"inventory-service/locale"
+	"inventory-service/models"
+	"net/http"
+	"strings"
+)
+
+const (
+	fieldName        = "name"
+	fieldDescription = "description"
+)
+
+// LocalizedText is an item's text as shown in a locale. Locale is the locale the name is
+// in, which is the default one when the item is not translated.
+type LocalizedText struct {
+	Name        string
+	Description string
+	Category    string
+	Locale      string
+}
+
+// LocaleCoverage is how much of the catalog is translated into a locale. An item counts as
+// translated once both its name and description are.
+type LocaleCoverage struct {
+	Locale               string
+	Items                uint
+	ItemsTranslated      uint
+	Categories           uint
+	CategoriesTranslated uint
+}
+
+// MissingItemTranslation is an item whose name or description, named in Fields, is shown
+// in the default locale to customers asking for another.
+type MissingItemTranslation struct {
+	Locale string
+	ItemID uint
+	Name   string
+	Fields []string
+}
+
+// MissingCategoryTranslation is a category shown in the default locale to customers asking
+// for another.
+type MissingCategoryTranslation struct {
+	Locale   string
+	Category string
+}
+
+// TranslationReport is what is left to translate for each locale.
+type TranslationReport struct {
+	Coverage   []*LocaleCoverage
+	Items      []*MissingItemTranslation
+	Categories []*MissingCategoryTranslation
+}
+
+// translatedItem picks the item's name and description out of its translations, trying
+// the locales in order. The description can come from a later locale than the name when
+// the first translation leaves it out; either is empty when no locale has it.
+func translatedItem(translations map[string]*models.ItemTranslation, fallbacks []string) (name string, nameLocale string, description string) {
+	for _, tag := range fallbacks {
+		translation, ok := translations[tag]
+		if !ok {
+			continue
+		}
+		if name == "" {
+			name, nameLocale = translation.Name, tag
+		}
+		if description == "" {
+			description = translation.Description
+		}
+	}
+	return name, nameLocale, description
+}
+
+// translatedCategory returns the category's name in the first of the locales it is
+// translated into, or nothing when it is in none of them.
+func translatedCategory(translations map[string]*models.CategoryTranslation, fallbacks []string) string {
+	for _, tag := range fallbacks {
+		if translation, ok := translations[tag]; ok {
+			return translation.Name
+		}
+	}
+	return ""
+}
+
+// LocalizeItems returns the text of each item in the locale, keyed by item id. Text that is
+// not translated into the locale, or the language it is a regional form of, is left in the
+// default locale.
+func LocalizeItems(items []*models.Item, tag string) (uint32, map[uint]*LocalizedText, error) {
+	localized := map[uint]*LocalizedText{}
+	ids := []uint{}
+	categories := []string{}
+	for _, item := range items {
+		localized[item.ID] = &LocalizedText{Name: item.Name, Description: item.Description, Category: item.Category, Locale: locale.Default()}
+		ids = append(ids, item.ID)
+		if item.Category != "" {
+			categories = append(categories, item.Category)
+		}
+	}
+	fallbacks := locale.Fallbacks(tag)
+	if len(fallbacks) == 0 {
+		return http.StatusOK, localized, nil
+	}
+
+	status, itemTranslations, err := models.GetItemTranslations(ids, fallbacks)
+	if err != nil {
+		return status, nil, err
+	}
+	status, categoryTranslations, err := models.GetCategoryTranslations(categories, fallbacks)
+	if err != nil {
+		return status, nil, err
+	}
+	for _, item := range items {
+		text := localized[item.ID]
+		name, nameLocale, description := translatedItem(itemTranslations[item.ID], fallbacks)
+		if name != "" {
+			text.Name, text.Locale = name, nameLocale
+		}
+		if description != "" {
+			text.Description = description
+		}
+		if category := translatedCategory(categoryTranslations[item.Category], fallbacks); category != "" {
+			text.Category = category
+		}
+	}
+	return http.StatusOK, localized, nil
+}
+
+// SetItemTranslation sets the item's name and description in a supported locale other than
+// the default. The description can be left out to keep showing the item's own.
+func SetItemTranslation(itemID uint, tag string, name string, description string, actor string) (uint32, *models.ItemTranslation, error) {
+	name, description = strings.TrimSpace(name), strings.TrimSpace(description)
+	if name == "" {
+		return http.StatusBadRequest, nil, errors.ErrInvalidTranslation
+	}
+	tag, err := locale.Check(tag)
+	if err != nil {
+		return http.StatusBadRequest, nil, err
+	}
+	status, _, err := models.GetItem(itemID)
+	if err != nil {
+		return status, nil, err
+	}
+	return models.SaveItemTranslation(&models.ItemTranslation{
+		ItemID:      itemID,
+		Locale:      tag,
+		Name:        name,
+		Description: description,
+		UpdatedBy:   actor,
+	})
+}
+
+func DeleteItemTranslation(itemID uint, tag string) (uint32, error) {
+	tag, err := locale.Parse(tag)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	return models.DeleteItemTranslation(itemID, tag)
+}
+
+// ListItemTranslations returns every translation of an item in the catalog.
+func ListItemTranslations(itemID uint) (uint32, []*models.ItemTranslation, error) {
+	status, _, err := models.GetItem(itemID)
+	if err != nil {
+		return status, nil, err
+	}
+	return models.ListItemTranslations(itemID)
+}
+
+// SetCategoryTranslation sets the name of a category items in the catalog are in, in a
+// supported locale other than the default.
+func SetCategoryTranslation(category string, tag string, name string, actor string) (uint32, *models.CategoryTranslation, error) {
+	category, name = strings.TrimSpace(category), strings.TrimSpace(name)
+	if name == "" {
+		return http.StatusBadRequest, nil, errors.ErrInvalidTranslation
+	}
+	tag, err := locale.Check(tag)
+	if err != nil {
+		return http.StatusBadRequest, nil, err
+	}
+	status, categories, err := models.ListCategories()
+	if err != nil {
+		return status, nil, err
+	}
+	if !containsString(categories, category) {
+		return http.StatusNotFound, nil, errors.ErrCategoryNotFound
+	}
+	return models.SaveCategoryTranslation(&models.CategoryTranslation{
+		Category:  category,
+		Locale:    tag,
+		Name:      name,
+		UpdatedBy: actor,
+	})
+}
+
+func DeleteCategoryTranslation(category string, tag string) (uint32, error) {
+	tag, err := locale.Parse(tag)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	return models.DeleteCategoryTranslation(strings.TrimSpace(category), tag)
+}
+
+// ListCategoryTranslations returns the translations of a category, or of every category when
+// none is given.
+func ListCategoryTranslations(category string) (uint32, []*models.CategoryTranslation, error) {
+	return models.ListCategoryTranslations(strings.TrimSpace(category))
+}
+
+// MissingTranslations reports the item and category text in the catalog that customers
+// asking for each of the locales see in the default locale instead, for every supported
+// locale but the default when none are given. Like LocalizeItems, text translated into the
+// language a locale is a regional form of counts as translated.
+func MissingTranslations(tags []string) (uint32, *TranslationReport, error) {
+	locales := []string{}
+	for _, tag := range tags {
+		canonical, err := locale.Check(tag)
+		if err != nil {
+			return http.StatusBadRequest, nil, err
+		}
+		if !containsString(locales, canonical) {
+			locales = append(locales, canonical)
+		}
+	}
+	if len(tags) == 0 {
+		locales = locale.Translated()
+	}
+
+	status, items, err := models.GetAllItems()
+	if err != nil {
+		return status, nil, err
+	}
+	status, categories, err := models.ListCategories()
+	if err != nil {
+		return status, nil, err
+	}
+	ids := []uint{}
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+
+	report := &TranslationReport{}
+	for _, tag := range locales {
+		fallbacks := locale.Fallbacks(tag)
+		status, itemTranslations, err := models.GetItemTranslations(ids, fallbacks)
+		if err != nil {
+			return status, nil, err
+		}
+		status, categoryTranslations, err := models.GetCategoryTranslations(categories, fallbacks)
+		if err != nil {
+			return status, nil, err
+		}
+
+		coverage := &LocaleCoverage{Locale: tag, Items: uint(len(items)), Categories: uint(len(categories))}
+		for _, item := range items {
+			name, _, description := translatedItem(itemTranslations[item.ID], fallbacks)
+			fields := []string{}
+			if name == "" {
+				fields = append(fields, fieldName)
+			}
+			if description == "" {
+				fields = append(fields, fieldDescription)
+			}
+			if len(fields) == 0 {
+				coverage.ItemsTranslated++
+				continue
+			}
+			report.Items = append(report.Items, &MissingItemTranslation{Locale: tag, ItemID: item.ID, Name: item.Name, Fields: fields})
+		}
+		for _, category := range categories {
+			if translatedCategory(categoryTranslations[category], fallbacks) != "" {
+				coverage.CategoriesTranslated++
+				continue
+			}
+			report.Categories = append(report.Categories, &MissingCategoryTranslation{Locale: tag, Category: category})
+		}
+		report.Coverage = append(report.Coverage, coverage)
+	}
+	return http.StatusOK, report, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,210 @@
+package service
+
+import (
+	"net/http"
+	"testing"
+
+	"inventory-service/errors"
+	"inventory-service/locale"
+	"inventory-service/models"
+	"inventory-service/money"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type TranslationServiceTestSuite struct {
+	suite.Suite
+	db *gorm.DB
+}
+
+func (suite *TranslationServiceTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		suite.FailNow("failed to connect database")
+	}
+	suite.db = db
+	models.InitInventoryModels(db)
+	suite.NoError(locale.Configure("en", []string{"hi", "ta"}))
+}
+
+func (suite *TranslationServiceTestSuite) TearDownTest() {
+	suite.NoError(locale.Configure("en", nil))
+	_ = suite.db.Migrator().DropTable(&models.Item{}, &models.StockMovement{}, &models.ItemTranslation{}, &models.CategoryTranslation{})
+	sql, _ := suite.db.DB()
+	sql.Close()
+}
+
+func TestTranslationServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TranslationServiceTestSuite))
+}
+
+func (suite *TranslationServiceTestSuite) TestService_LocalizeItems() {
+	t := suite.T()
+
+	_, dosa, err := AddItem(&models.Item{Name: "dosa", Description: "rice crepe", Category: "mains", Price: money.New(800, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+	_, idli, err := AddItem(&models.Item{Name: "idli", Description: "steamed rice cakes", Category: "mains", Price: money.New(600, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+
+	status, translation, err := SetItemTranslation(dosa.ID, "ta", " தோசை ", "அரிசி அடை", "1")
+	assert.Equal(t, uint32(http.StatusOK), status)
+	assert.NoError(t, err)
+	assert.Equal(t, "தோசை", translation.Name)
+	assert.Equal(t, "1", translation.UpdatedBy)
+	_, _, err = SetItemTranslation(idli.ID, "ta", "இட்லி", "", "1")
+	assert.NoError(t, err)
+	_, _, err = SetCategoryTranslation("mains", "ta", "முதன்மை உணவுகள்", "1")
+	assert.NoError(t, err)
+
+	t.Run("Show items in the locale asked for", func(t *testing.T) {
+		status, texts, err := LocalizeItems([]*models.Item{dosa, idli}, "ta")
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.Equal(t, &LocalizedText{Name: "தோசை", Description: "அரிசி அடை", Category: "முதன்மை உணவுகள்", Locale: "ta"}, texts[dosa.ID])
+	})
+
+	t.Run("Keep the item's own description when the translation leaves it out", func(t *testing.T) {
+		_, texts, err := LocalizeItems([]*models.Item{idli}, "ta")
+		assert.NoError(t, err)
+		assert.Equal(t, "இட்லி", texts[idli.ID].Name)
+		assert.Equal(t, "steamed rice cakes", texts[idli.ID].Description)
+	})
+
+	t.Run("Fall back from a regional locale to its language", func(t *testing.T) {
+		_, texts, err := LocalizeItems([]*models.Item{dosa}, "ta-IN")
+		assert.NoError(t, err)
+		assert.Equal(t, "தோசை", texts[dosa.ID].Name)
+		assert.Equal(t, "ta", texts[dosa.ID].Locale)
+	})
+
+	t.Run("Fall back to the default locale", func(t *testing.T) {
+		for _, tag := range []string{"hi", "", "en", "not a locale"} {
+			_, texts, err := LocalizeItems([]*models.Item{dosa}, tag)
+			assert.NoError(t, err)
+			assert.Equal(t, &LocalizedText{Name: "dosa", Description: "rice crepe", Category: "mains", Locale: "en"}, texts[dosa.ID], tag)
+		}
+	})
+
+	t.Run("Replace a translation that is set again", func(t *testing.T) {
+		_, _, err := SetItemTranslation(dosa.ID, "ta", "மசாலா தோசை", "அரிசி அடை", "2")
+		assert.NoError(t, err)
+
+		status, translations, err := ListItemTranslations(dosa.ID)
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(translations))
+		assert.Equal(t, "மசாலா தோசை", translations[0].Name)
+		assert.Equal(t, "2", translations[0].UpdatedBy)
+	})
+
+	t.Run("Show the item's own text once its translation is deleted", func(t *testing.T) {
+		status, err := DeleteItemTranslation(dosa.ID, "TA")
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+
+		_, texts, err := LocalizeItems([]*models.Item{dosa}, "ta")
+		assert.NoError(t, err)
+		assert.Equal(t, "dosa", texts[dosa.ID].Name)
+		assert.Equal(t, "முதன்மை உணவுகள்", texts[dosa.ID].Category)
+
+		status, err = DeleteItemTranslation(dosa.ID, "ta")
+		assert.Equal(t, uint32(http.StatusNotFound), status)
+		assert.ErrorIs(t, err, errors.ErrTranslationNotFound)
+	})
+}
+
+func (suite *TranslationServiceTestSuite) TestService_SetTranslationErrors() {
+	t := suite.T()
+
+	_, dosa, err := AddItem(&models.Item{Name: "dosa", Description: "rice crepe", Category: "mains", Price: money.New(800, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+
+	for _, test := range []struct {
+		name     string
+		itemID   uint
+		locale   string
+		text     string
+		status   uint32
+		expected error
+	}{
+		{"default locale", dosa.ID, "en", "dosa", http.StatusBadRequest, errors.ErrDefaultLocale},
+		{"unsupported locale", dosa.ID, "fr", "crêpe", http.StatusBadRequest, errors.ErrUnsupportedLocale},
+		{"invalid locale", dosa.ID, "tamil!", "தோசை", http.StatusBadRequest, errors.ErrInvalidLocale},
+		{"no name", dosa.ID, "ta", " ", http.StatusBadRequest, errors.ErrInvalidTranslation},
+		{"unknown item", dosa.ID + 1, "ta", "தோசை", http.StatusNotFound, errors.ErrItemNotFound},
+	} {
+		status, _, err := SetItemTranslation(test.itemID, test.locale, test.text, "", "1")
+		assert.Equal(t, test.status, status, test.name)
+		assert.ErrorIs(t, err, test.expected, test.name)
+	}
+
+	status, _, err := SetCategoryTranslation("desserts", "ta", "இனிப்புகள்", "1")
+	assert.Equal(t, uint32(http.StatusNotFound), status)
+	assert.ErrorIs(t, err, errors.ErrCategoryNotFound)
+}
+
+func (suite *TranslationServiceTestSuite) TestService_MissingTranslations() {
+	t := suite.T()
+
+	_, dosa, err := AddItem(&models.Item{Name: "dosa", Description: "rice crepe", Category: "mains", Price: money.New(800, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+	_, idli, err := AddItem(&models.Item{Name: "idli", Description: "steamed rice cakes", Category: "mains", Price: money.New(600, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+	_, vada, err := AddItem(&models.Item{Name: "vada", Description: "lentil fritter", Category: "snacks", Price: money.New(500, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+
+	_, _, err = SetItemTranslation(dosa.ID, "ta", "தோசை", "அரிசி அடை", "1")
+	assert.NoError(t, err)
+	_, _, err = SetItemTranslation(idli.ID, "ta", "இட்லி", "", "1")
+	assert.NoError(t, err)
+	_, _, err = SetCategoryTranslation("mains", "ta", "முதன்மை உணவுகள்", "1")
+	assert.NoError(t, err)
+	_, _, err = SetItemTranslation(dosa.ID, "hi", "डोसा", "चावल का चीला", "1")
+	assert.NoError(t, err)
+
+	t.Run("Report what is left to translate into every supported locale", func(t *testing.T) {
+		status, report, err := MissingTranslations(nil)
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.Equal(t, []*LocaleCoverage{
+			{Locale: "hi", Items: 3, ItemsTranslated: 1, Categories: 2, CategoriesTranslated: 0},
+			{Locale: "ta", Items: 3, ItemsTranslated: 1, Categories: 2, CategoriesTranslated: 1},
+		}, report.Coverage)
+		assert.Equal(t, []*MissingItemTranslation{
+			{Locale: "hi", ItemID: idli.ID, Name: "idli", Fields: []string{"name", "description"}},
+			{Locale: "hi", ItemID: vada.ID, Name: "vada", Fields: []string{"name", "description"}},
+			{Locale: "ta", ItemID: idli.ID, Name: "idli", Fields: []string{"description"}},
+			{Locale: "ta", ItemID: vada.ID, Name: "vada", Fields: []string{"name", "description"}},
+		}, report.Items)
+		assert.Equal(t, []*MissingCategoryTranslation{
+			{Locale: "hi", Category: "mains"},
+			{Locale: "hi", Category: "snacks"},
+			{Locale: "ta", Category: "snacks"},
+		}, report.Categories)
+	})
+
+	t.Run("Report only the locales asked for", func(t *testing.T) {
+		_, report, err := MissingTranslations([]string{"TA", "ta"})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(report.Coverage))
+		assert.Equal(t, "ta", report.Coverage[0].Locale)
+	})
+
+	t.Run("Leave archived items out of the report", func(t *testing.T) {
+		_, err := DeleteItem(vada.ID)
+		assert.NoError(t, err)
+
+		_, report, err := MissingTranslations([]string{"ta"})
+		assert.NoError(t, err)
+		assert.Equal(t, &LocaleCoverage{Locale: "ta", Items: 2, ItemsTranslated: 1, Categories: 1, CategoriesTranslated: 1}, report.Coverage[0])
+	})
+
+	t.Run("Refuse locales translations are not kept for", func(t *testing.T) {
+		status, _, err := MissingTranslations([]string{"en"})
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.ErrorIs(t, err, errors.ErrDefaultLocale)
+	})
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"inventory-service/errors"
+	"inventory-service/models"
+	"inventory-service/units"
+	"net/http"
+)
+
+// validateUnits checks the item's stock and sale units, filling in each when no stock unit
+// is given, and writes them in their canonical form. A sale unit has to measure the same
+// thing as the stock unit, since both count the same stored quantity.
+func validateUnits(item *models.Item) error {
+	if item.Unit == "" {
+		item.Unit = units.Default
+	}
+	stockUnit, err := units.Lookup(item.Unit)
+	if err != nil {
+		return err
+	}
+	item.Unit = stockUnit.Name
+	if item.SaleUnit == "" {
+		return nil
+	}
+	saleUnit, err := units.Lookup(item.SaleUnit)
+	if err != nil {
+		return err
+	}
+	if !saleUnit.Convertible(stockUnit) {
+		return errors.ErrUnitMismatch
+	}
+	item.SaleUnit = saleUnit.Name
+	return nil
+}
+
+// ToBaseQuantity returns how many base units a quantity given in the named unit makes. The
+// decimal amount is used when one is given, and the whole quantity otherwise.
+func ToBaseQuantity(unitName string, quantity uint, amount string) (uint32, uint, error) {
+	if unitName == "" {
+		unitName = units.Default
+	}
+	unit, err := units.Lookup(unitName)
+	if err != nil {
+		return http.StatusBadRequest, 0, err
+	}
+	if amount == "" {
+		return http.StatusOK, unit.Of(quantity), nil
+	}
+	base, err := unit.Parse(amount)
+	if err != nil {
+		return http.StatusBadRequest, 0, err
+	}
+	return http.StatusOK, base, nil
+}
+
+// ItemQuantity works out how many base units of an item a stock operation asks for. The
+// quantity is in unitName when one is given, which has to measure the same thing as the
+// item's units. Otherwise it is in the unit the item is sold in when selling is set, as
+// orders are, and in the unit it is stocked in when not.
+func ItemQuantity(itemID uint, quantity uint, amount string, unitName string, selling bool) (uint32, uint, error) {
+	status, item, err := models.GetItem(itemID)
+	if err != nil {
+		return status, 0, err
+	}
+	itemUnit, err := units.Lookup(item.Unit)
+	if err != nil {
+		return http.StatusInternalServerError, 0, err
+	}
+	if unitName == "" && selling {
+		unitName = item.SellingUnit()
+	} else if unitName == "" {
+		unitName = item.Unit
+	}
+	unit, err := units.Lookup(unitName)
+	if err != nil {
+		return http.StatusBadRequest, 0, err
+	}
+	if !unit.Convertible(itemUnit) {
+		return http.StatusBadRequest, 0, errors.ErrUnitMismatch
+	}
+	return ToBaseQuantity(unit.Name, quantity, amount)
+}
+
+// StockAmount returns the item's stock as a decimal amount of the unit it is stocked in.
+func StockAmount(item *models.Item) string {
+	return QuantityAmount(item, item.Quantity)
+}
+
+// QuantityAmount returns a base quantity of the item as a decimal amount of the unit it is
+// stocked in.
+func QuantityAmount(item *models.Item, quantity uint) string {
+	return stockUnit(item).Format(quantity)
+}
+
+// stockUnit returns the unit the item is stocked in, falling back to pieces.
+func stockUnit(item *models.Item) units.Unit {
+	unit, err := units.Lookup(item.Unit)
+	if err != nil {
+		unit, _ = units.Lookup(units.Default)
+	}
+	return unit
+}
+
+// SellableQuantity returns how many whole units of what the item is sold in the given base
+// quantity makes, such as the pieces an order can take.
+func SellableQuantity(item *models.Item, quantity uint) uint {
+	unit, err := units.Lookup(item.SellingUnit())
+	if err != nil {
+		return quantity
+	}
+	return unit.Whole(quantity)
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"net/http"
+	"testing"
+
+	"inventory-service/errors"
+	"inventory-service/models"
+	"inventory-service/money"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type UnitServiceTestSuite struct {
+	suite.Suite
+	db *gorm.DB
+}
+
+func (suite *UnitServiceTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		suite.FailNow("failed to connect database")
+	}
+	suite.db = db
+	models.InitInventoryModels(db)
+}
+
+func (suite *UnitServiceTestSuite) TearDownTest() {
+	_ = suite.db.Migrator().DropTable(&models.Item{}, &models.StockMovement{}, &models.Location{}, &models.LocationStock{}, &models.RecipeLine{})
+	sql, _ := suite.db.DB()
+	sql.Close()
+}
+
+func TestUnitServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(UnitServiceTestSuite))
+}
+
+func (suite *UnitServiceTestSuite) TestService_AddItemUnits() {
+	t := suite.T()
+
+	t.Run("Keep units in their canonical form", func(t *testing.T) {
+		status, item, err := AddItem(&models.Item{Name: "flour", Description: "atta", Price: money.New(250, "EUR"), Quantity: 2500, Unit: "KG", SaleUnit: "g"})
+		assert.Equal(t, uint32(http.StatusCreated), status)
+		assert.NoError(t, err)
+		assert.Equal(t, "kg", item.Unit)
+		assert.Equal(t, "g", item.SellingUnit())
+		assert.Equal(t, "2.5", StockAmount(item))
+	})
+
+	t.Run("Default to each", func(t *testing.T) {
+		_, item, err := AddItem(&models.Item{Name: "samosa", Description: "fried", Price: money.New(300, "EUR"), Quantity: 4})
+		assert.NoError(t, err)
+		assert.Equal(t, "each", item.Unit)
+		assert.Equal(t, "each", item.SellingUnit())
+	})
+
+	t.Run("Refuse an unknown unit", func(t *testing.T) {
+		status, _, err := AddItem(&models.Item{Name: "rice", Description: "basmati", Price: money.New(300, "EUR"), Quantity: 4, Unit: "cup"})
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrInvalidUnit, err)
+	})
+
+	t.Run("Refuse a sale unit that measures something else", func(t *testing.T) {
+		status, _, err := AddItem(&models.Item{Name: "milk", Description: "whole", Price: money.New(120, "EUR"), Quantity: 4000, Unit: "l", SaleUnit: "kg"})
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrUnitMismatch, err)
+	})
+}
+
+func (suite *UnitServiceTestSuite) TestService_ItemQuantity() {
+	t := suite.T()
+
+	// eggs are stocked by the dozen and sold by the piece
+	_, eggs, err := AddItem(&models.Item{Name: "eggs", Description: "free range", Price: money.New(40, "EUR"), Quantity: 24, Unit: "dozen", SaleUnit: "each"})
+	assert.NoError(t, err)
+	_, milk, err := AddItem(&models.Item{Name: "milk", Description: "whole", Price: money.New(120, "EUR"), Quantity: 4000, Unit: "l"})
+	assert.NoError(t, err)
+
+	t.Run("Take deliveries in the stock unit and sales in the sale unit", func(t *testing.T) {
+		_, delivered, err := ItemQuantity(eggs.ID, 2, "", "", false)
+		assert.NoError(t, err)
+		assert.Equal(t, uint(24), delivered)
+
+		_, sold, err := ItemQuantity(eggs.ID, 2, "", "", true)
+		assert.NoError(t, err)
+		assert.Equal(t, uint(2), sold)
+
+		_, updated, err := LowerQuantity(eggs.ID, sold, models.ReasonOrder, "ORD-1", "3")
+		assert.NoError(t, err)
+		assert.Equal(t, "1.833", StockAmount(updated))
+		assert.Equal(t, uint(22), SellableQuantity(updated, updated.Quantity))
+	})
+
+	t.Run("Read decimal amounts in any unit of the same kind", func(t *testing.T) {
+		_, quantity, err := ItemQuantity(milk.ID, 0, "1.25", "", false)
+		assert.NoError(t, err)
+		assert.Equal(t, uint(1250), quantity)
+
+		_, quantity, err = ItemQuantity(milk.ID, 0, "250", "ml", true)
+		assert.NoError(t, err)
+		assert.Equal(t, uint(250), quantity)
+	})
+
+	t.Run("Refuse amounts that are not whole base units or units of another kind", func(t *testing.T) {
+		status, _, err := ItemQuantity(milk.ID, 0, "0.0005", "", false)
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrInvalidQuantity, err)
+
+		status, _, err = ItemQuantity(milk.ID, 1, "", "kg", false)
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrUnitMismatch, err)
+	})
+}
+
+func (suite *UnitServiceTestSuite) TestService_UpdateItemUnits() {
+	t := suite.T()
+
+	_, flour, err := AddItem(&models.Item{Name: "flour", Description: "atta", Price: money.New(250, "EUR"), Quantity: 2500, Unit: "kg"})
+	assert.NoError(t, err)
+
+	t.Run("Change to a unit of the same kind", func(t *testing.T) {
+		status, updated, err := UpdateItem(flour.ID, &models.Item{Unit: "g", SaleUnit: "kg"}, []string{"unit", "sale_unit"})
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.Equal(t, "g", updated.Unit)
+		assert.Equal(t, "kg", updated.SellingUnit())
+		assert.Equal(t, uint(2500), updated.Quantity)
+	})
+
+	t.Run("Refuse a unit of another kind", func(t *testing.T) {
+		status, _, err := UpdateItem(flour.ID, &models.Item{Unit: "l"}, []string{"unit"})
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrUnitMismatch, err)
+	})
+}
+
+func (suite *UnitServiceTestSuite) TestService_RecipeUnitConversion() {
+	t := suite.T()
+
+	_, roti, err := AddItem(&models.Item{Name: "roti", Description: "flatbread", Price: money.New(150, "EUR"), Quantity: 1})
+	assert.NoError(t, err)
+	_, flour, err := AddItem(&models.Item{Name: "flour", Description: "atta", Price: money.New(250, "EUR"), Quantity: 2000, Unit: "kg"})
+	assert.NoError(t, err)
+
+	_, ingredients, err := SetRecipe(roti.ID, []*RecipeIngredient{{IngredientID: flour.ID, Quantity: 80, Unit: "g"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []*RecipeIngredient{{IngredientID: flour.ID, Name: "flour", Unit: "g", Quantity: 80}}, ingredients)
+
+	_, available, err := AvailableQuantities([]*models.Item{roti})
+	assert.NoError(t, err)
+	assert.Equal(t, uint(25), available[roti.ID])
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"net/http"
+	"testing"
+
+	"inventory-service/errors"
+	"inventory-service/models"
+	"inventory-service/money"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type UpdateItemServiceTestSuite struct {
+	suite.Suite
+	db *gorm.DB
+}
+
+func (suite *UpdateItemServiceTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		suite.FailNow("failed to connect database")
+	}
+	suite.db = db
+	models.InitInventoryModels(db)
+}
+
+func (suite *UpdateItemServiceTestSuite) TearDownTest() {
+	_ = suite.db.Migrator().DropTable(&models.Item{}, &models.StockMovement{})
+	sql, _ := suite.db.DB()
+	sql.Close()
+}
+
+func TestUpdateItemServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(UpdateItemServiceTestSuite))
+}
+
+func (suite *UpdateItemServiceTestSuite) TestService_UpdateItem() {
+	t := suite.T()
+
+	_, item, err := AddItem(&models.Item{Name: "panner tikka", Description: "grilled paneer", Price: money.New(25000, "EUR"), Quantity: 10})
+	assert.NoError(t, err)
+	_, other, err := AddItem(&models.Item{Name: "dal makhani", Description: "black lentils", Price: money.New(18000, "EUR"), Quantity: 20})
+	assert.NoError(t, err)
+
+	t.Run("Update only the fields in the mask", func(t *testing.T) {
+		status, updated, err := UpdateItem(item.ID, &models.Item{Name: "paneer tikka", Price: money.New(100, "EUR")}, []string{"name"})
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.Equal(t, item.ID, updated.ID)
+		assert.Equal(t, "paneer tikka", updated.Name)
+
+		_, stored, err := GetItem(item.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "paneer tikka", stored.Name)
+		assert.Equal(t, "grilled paneer", stored.Description)
+		assert.Equal(t, money.New(25000, "EUR"), stored.Price)
+		assert.Equal(t, uint(10), stored.Quantity)
+	})
+
+	t.Run("Update description and price together", func(t *testing.T) {
+		status, updated, err := UpdateItem(item.ID, &models.Item{Description: "grilled paneer with mint chutney", Price: money.New(27000, "EUR")}, []string{"description", "price"})
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.NoError(t, err)
+		assert.Equal(t, "paneer tikka", updated.Name)
+		assert.Equal(t, "grilled paneer with mint chutney", updated.Description)
+		assert.Equal(t, money.New(27000, "EUR"), updated.Price)
+	})
+
+	t.Run("expect error with empty value for a masked field", func(t *testing.T) {
+		status, updated, err := UpdateItem(item.ID, &models.Item{Price: money.New(0, "EUR")}, []string{"price"})
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrEmptyField, err)
+		assert.Nil(t, updated)
+	})
+
+	t.Run("expect error with empty or unknown mask", func(t *testing.T) {
+		status, _, err := UpdateItem(item.ID, &models.Item{Name: "naan"}, nil)
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrInvalidUpdateMask, err)
+
+		status, _, err = UpdateItem(item.ID, &models.Item{Quantity: 3}, []string{"quantity"})
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+		assert.Equal(t, errors.ErrInvalidUpdateMask, err)
+	})
+
+	t.Run("expect error with name of another item", func(t *testing.T) {
+		status, updated, err := UpdateItem(other.ID, &models.Item{Name: "paneer tikka"}, []string{"name"})
+		assert.Equal(t, uint32(http.StatusUnprocessableEntity), status)
+		assert.Equal(t, errors.ErrItemExists, err)
+		assert.Nil(t, updated)
+	})
+
+	t.Run("expect error with non-exist id", func(t *testing.T) {
+		status, updated, err := UpdateItem(999, &models.Item{Name: "naan"}, []string{"name"})
+		assert.Equal(t, uint32(http.StatusNotFound), status)
+		assert.Error(t, err)
+		assert.Nil(t, updated)
+	})
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"inventory-service/errors"
+	"inventory-service/models"
+	"inventory-service/money"
+	"math/big"
+	"net/http"
+	"time"
+)
+
+const (
+	// ValuationFIFO values stock as what was received last, taking what goes out of the oldest
+	// stock first.
+	ValuationFIFO = "FIFO"
+	// ValuationWeightedAverage values stock at the average cost of everything on hand, which
+	// is what whatever goes out costs too.
+	ValuationWeightedAverage = "WEIGHTED_AVERAGE"
+)
+
+// validateCost checks a cost given for stock, which may be left out.
+func validateCost(cost money.Money) error {
+	if cost == (money.Money{}) {
+		return nil
+	}
+	if cost.MinorUnits < 0 {
+		return errors.ErrInvalidAmount
+	}
+	if !cost.Valid() {
+		return errors.ErrInvalidCurrency
+	}
+	return nil
+}
+
+// costLayer is stock received at one cost that has not gone out yet.
+type costLayer struct {
+	quantity uint
+	cost     int64
+}
+
+// stockCost follows what an item's stock cost through its ledger. Quantities are in base
+// units and costs per unit the item is stocked in, so values are kept as their product,
+// minor units times the size of the stock unit, and only rounded when read off.
+type stockCost struct {
+	method   string
+	size     int64
+	currency string
+	quantity uint
+	value    int64
+	layers   []costLayer
+}
+
+func newStockCost(item *models.Item, method string) *stockCost {
+	return &stockCost{method: method, size: int64(stockUnit(item).Size)}
+}
+
+// receive adds stock at the given cost and returns what it cost.
+func (c *stockCost) receive(quantity uint, cost money.Money) (int64, error) {
+	if cost.Currency != "" && c.currency == "" {
+		c.currency = cost.Currency
+	} else if cost.Currency != "" && cost.Currency != c.currency {
+		return 0, errors.ErrCurrencyMismatch
+	}
+	value := int64(quantity) * cost.MinorUnits
+	c.quantity += quantity
+	c.value += value
+	if c.method == ValuationFIFO {
+		c.layers = append(c.layers, costLayer{quantity: quantity, cost: cost.MinorUnits})
+	}
+	return value, nil
+}
+
+// issue takes stock out and returns what it cost. A ledger only takes out more than it
+// received when it does not add up, and the stock that was never received costs nothing.
+func (c *stockCost) issue(quantity uint) int64 {
+	if quantity > c.quantity {
+		quantity = c.quantity
+	}
+	var value int64
+	if c.method == ValuationFIFO {
+		left := quantity
+		for left > 0 {
+			layer := &c.layers[0]
+			taken := left
+			if layer.quantity < taken {
+				taken = layer.quantity
+			}
+			value += int64(taken) * layer.cost
+			layer.quantity -= taken
+			left -= taken
+			if layer.quantity == 0 {
+				c.layers = c.layers[1:]
+			}
+		}
+	} else if quantity > 0 {
+		value = mulDivRound(c.value, int64(quantity), int64(c.quantity))
+	}
+	c.quantity -= quantity
+	c.value -= value
+	return value
+}
+
+// amount reads a value off in minor units of the currency the item's stock was bought in.
+func (c *stockCost) amount(value int64) money.Money {
+	if c.currency == "" {
+		return money.Money{}
+	}
+	return money.Money{MinorUnits: mulDivRound(value, 1, c.size), Currency: c.currency}
+}
+
+// unitCost is what the stock on hand cost on average per unit the item is stocked in.
+func (c *stockCost) unitCost() money.Money {
+	if c.currency == "" || c.quantity == 0 {
+		return money.Money{}
+	}
+	return money.Money{MinorUnits: mulDivRound(c.value, 1, int64(c.quantity)), Currency: c.currency}
+}
+
+// mulDivRound returns a times b divided by c, rounded half up, without overflowing on the way.
+func mulDivRound(a int64, b int64, c int64) int64 {
+	product := new(big.Int).Mul(big.NewInt(a), big.NewInt(b))
+	product.Add(product, big.NewInt(c/2))
+	return product.Quo(product, big.NewInt(c)).Int64()
+}
+
+// replayCosts runs the ledger before the given time through the valuation method for each of
+// the items, calling visit, when given, with every movement and what the stock it brought
+// in or took out cost. Transfers between locations leave what an item's stock cost alone
+// and are skipped.
+func replayCosts(items []*models.Item, method string, before time.Time, visit func(*models.StockMovement, money.Money)) (uint32, map[uint]*stockCost, error) {
+	if method == "" {
+		method = ValuationFIFO
+	}
+	if method != ValuationFIFO && method != ValuationWeightedAverage {
+		return http.StatusBadRequest, nil, errors.ErrInvalidValuationMethod
+	}
+
+	costs := map[uint]*stockCost{}
+	for _, item := range items {
+		costs[item.ID] = newStockCost(item, method)
+	}
+	status, movements, err := models.GetLedgerBefore(before)
+	if err != nil {
+		return status, nil, err
+	}
+	for _, movement := range movements {
+		cost, ok := costs[movement.ItemID]
+		if !ok || movement.Reason == models.ReasonTransfer {
+			continue
+		}
+		var value int64
+		if movement.Delta > 0 {
+			if value, err = cost.receive(uint(movement.Delta), movement.UnitCost); err != nil {
+				return http.StatusUnprocessableEntity, nil, err
+			}
+		} else {
+			value = cost.issue(uint(-movement.Delta))
+		}
+		if visit != nil {
+			visit(movement, cost.amount(value))
+		}
+	}
+	return http.StatusOK, costs, nil
+}
@@ -0,0 +1,121 @@
+package units
+
+import (
+	"inventory-service/errors"
+	"strconv"
+	"strings"
+)
+
+// Dimension is what a unit measures. Quantities convert only between units of the same one.
+type Dimension string
+
+const (
+	Count  Dimension = "count"
+	Mass   Dimension = "mass"
+	Volume Dimension = "volume"
+)
+
+// Unit is a unit of measure stock is counted, bought or sold in. Quantities are stored as
+// a whole number of the base unit of their dimension (pieces, grams or millilitres), so
+// Size is how many base units one of the unit holds.
+type Unit struct {
+	Name      string
+	Dimension Dimension
+	Size      uint
+}
+
+// Default is the unit of items stocked and sold by the piece.
+const Default = "each"
+
+var units = map[string]Unit{
+	"each":  {Name: "each", Dimension: Count, Size: 1},
+	"dozen": {Name: "dozen", Dimension: Count, Size: 12},
+	"g":     {Name: "g", Dimension: Mass, Size: 1},
+	"kg":    {Name: "kg", Dimension: Mass, Size: 1000},
+	"ml":    {Name: "ml", Dimension: Volume, Size: 1},
+	"l":     {Name: "l", Dimension: Volume, Size: 1000},
+}
+
+// formatDecimals is how many decimals Format gives at most.
+const formatDecimals = 3
+
+// Lookup returns the unit with the given name, ignoring case and surrounding spaces.
+func Lookup(name string) (Unit, error) {
+	unit, ok := units[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return Unit{}, errors.ErrInvalidUnit
+	}
+	return unit, nil
+}
+
+// Base returns the unit of the dimension quantities in u are stored in.
+func (u Unit) Base() Unit {
+	for _, unit := range units {
+		if unit.Dimension == u.Dimension && unit.Size == 1 {
+			return unit
+		}
+	}
+	return u
+}
+
+// Convertible reports whether quantities in u can be expressed in other.
+func (u Unit) Convertible(other Unit) bool {
+	return u.Dimension == other.Dimension
+}
+
+// Of returns how many base units n of the unit make.
+func (u Unit) Of(n uint) uint {
+	return n * u.Size
+}
+
+// Whole returns how many whole units the base quantity makes, leaving out any remainder.
+func (u Unit) Whole(base uint) uint {
+	return base / u.Size
+}
+
+// Parse reads a decimal amount of the unit, such as "2.5", without going through floating
+// point and returns it in base units. The amount must come to a whole number of base
+// units, so "0.5" dozen is fine but "0.0005" kg is not.
+func (u Unit) Parse(value string) (uint, error) {
+	value = strings.TrimSpace(value)
+	whole, fraction, _ := strings.Cut(value, ".")
+	if whole == "" && fraction == "" || len(whole)+len(fraction) > 15 {
+		return 0, errors.ErrInvalidQuantity
+	}
+	for _, digit := range whole + fraction {
+		if digit < '0' || digit > '9' {
+			return 0, errors.ErrInvalidQuantity
+		}
+	}
+	scaled, err := strconv.ParseUint(whole+fraction, 10, 64)
+	if err != nil {
+		return 0, errors.ErrInvalidQuantity
+	}
+	divisor := uint64(1)
+	for range fraction {
+		divisor *= 10
+	}
+	scaled *= uint64(u.Size)
+	if scaled%divisor != 0 {
+		return 0, errors.ErrInvalidQuantity
+	}
+	return uint(scaled / divisor), nil
+}
+
+// Format writes a base quantity as a decimal amount of the unit, such as "2.5", the form
+// Parse reads. Amounts that do not divide exactly, like five pieces in dozens, are rounded
+// to three decimals.
+func (u Unit) Format(base uint) string {
+	scale := uint64(1)
+	for i := 0; i < formatDecimals; i++ {
+		scale *= 10
+	}
+	scaled := (uint64(base)*scale + uint64(u.Size)/2) / uint64(u.Size)
+	whole, fraction := scaled/scale, scaled%scale
+	if fraction == 0 {
+		return strconv.FormatUint(whole, 10)
+	}
+	digits := strconv.FormatUint(fraction, 10)
+	digits = strings.Repeat("0", formatDecimals-len(digits)) + digits
+	return strconv.FormatUint(whole, 10) + "." + strings.TrimRight(digits, "0")
+}
@@ -0,0 +1,61 @@
+package units
+
+import (
+	"inventory-service/errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookup(t *testing.T) {
+	unit, err := Lookup(" KG ")
+	assert.NoError(t, err)
+	assert.Equal(t, Unit{Name: "kg", Dimension: Mass, Size: 1000}, unit)
+	assert.Equal(t, "g", unit.Base().Name)
+
+	dozen, err := Lookup("dozen")
+	assert.NoError(t, err)
+	assert.Equal(t, "each", dozen.Base().Name)
+	assert.False(t, dozen.Convertible(unit))
+
+	_, err = Lookup("cup")
+	assert.ErrorIs(t, err, errors.ErrInvalidUnit)
+}
+
+func TestParse(t *testing.T) {
+	kg, _ := Lookup("kg")
+	dozen, _ := Lookup("dozen")
+	each, _ := Lookup("each")
+
+	for value, expected := range map[string]uint{"2.5": 2500, "0.125": 125, "3": 3000, ".5": 500, "1.2500": 1250} {
+		base, err := kg.Parse(value)
+		assert.NoError(t, err, value)
+		assert.Equal(t, expected, base, value)
+	}
+
+	base, err := dozen.Parse("0.5")
+	assert.NoError(t, err)
+	assert.Equal(t, uint(6), base)
+
+	for _, value := range []string{"0.0005", "", ".", "-1", "1,5", "1e3"} {
+		_, err := kg.Parse(value)
+		assert.ErrorIs(t, err, errors.ErrInvalidQuantity, value)
+	}
+	_, err = each.Parse("1.5")
+	assert.ErrorIs(t, err, errors.ErrInvalidQuantity)
+	_, err = dozen.Parse("0.1")
+	assert.ErrorIs(t, err, errors.ErrInvalidQuantity)
+}
+
+func TestFormat(t *testing.T) {
+	kg, _ := Lookup("kg")
+	dozen, _ := Lookup("dozen")
+
+	assert.Equal(t, "2.5", kg.Format(2500))
+	assert.Equal(t, "0.005", kg.Format(5))
+	assert.Equal(t, "0", kg.Format(0))
+	assert.Equal(t, "12", kg.Format(12000))
+	assert.Equal(t, "1.5", dozen.Format(18))
+	assert.Equal(t, "0.417", dozen.Format(5))
+	assert.Equal(t, uint(1), dozen.Whole(23))
+}
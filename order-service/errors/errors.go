@@ -10,4 +10,9 @@ var (
 	ErrEmptyField = errors.New("empty field(s)")
 	ErrLimitedSupplies = errors.New("limited supplies")
 	ErrBadGateway = errors.New("bad gateway while connecting to inventory service")
+	ErrInvalidOptions = errors.New("invalid option selection")
+	ErrItemUnavailable = errors.New("item is not available right now")
+	ErrInvalidCurrency = errors.New("unknown currency")
+	ErrCurrencyMismatch = errors.New("amounts are in different currencies")
+	ErrAlreadyDelivered = errors.New("order has already been delivered")
 )
@@ -5,8 +5,10 @@ import (
 	"order-service/config"
 	"order-service/database"
 	"order-service/inventoryClient"
+	"order-service/money"
 	"order-service/proto/orderpb"
 	logger "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
 	"google.golang.org/grpc"
 	"order-service/orderServer"
 )
@@ -15,6 +17,12 @@ func main() {
 	inventoryClient.InitGRPCClient()
 	config.Load()
 
+	if currency := viper.GetString("CURRENCY"); currency != "" {
+		if err := money.SetDefaultCurrency(currency); err != nil {
+			logger.WithField("currency", currency).Error("Unknown default currency, keeping " + money.DefaultCurrency())
+		}
+	}
+
 	if err := database.InitDB(); err != nil {
 		logger.WithField("error", err).Error("Error connecting to database")
 		return
@@ -3,25 +3,48 @@ package models
 import (
 	"net/http"
 	"order-service/errors"
+	"order-service/money"
 
+	logger "github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 )
 
 var db *gorm.DB
 
+const (
+	OrderPlaced    = "PLACED"
+	OrderDelivered = "DELIVERED"
+)
+
 type Order struct {
 	gorm.Model
-	ID        uint32  `gorm:"column:id; primary_key; AUTO_INCREMENT"`
-	UserID    uint32  `gorm:"column:user_id; not null"`
-	ItemID    uint32  `gorm:"column:item_id; not null"`
-	Quantity  uint32  `gorm:"column:quantity; not null"`
-	Amount    float32 `gorm:"column:amount; not null"`
-	OrderTime string  `gorm:"column:order_time; not null"`
+	ID        uint32        `gorm:"column:id; primary_key; AUTO_INCREMENT"`
+	UserID    uint32        `gorm:"column:user_id; not null"`
+	ItemID    uint32        `gorm:"column:item_id; not null"`
+	Quantity  uint32        `gorm:"column:quantity; not null"`
+	Amount    money.Money   `gorm:"embedded; embeddedPrefix:amount_"`
+	OrderTime string        `gorm:"column:order_time; not null"`
+	Status    string        `gorm:"column:status; not null; default:PLACED"`
+	Options   []OrderOption `gorm:"foreignKey:OrderID"`
+}
+
+// OrderOption records an option chosen with an order, with its name and price delta as
+// they were when the order was placed.
+type OrderOption struct {
+	ID         uint32      `gorm:"column:id; primary_key; AUTO_INCREMENT"`
+	OrderID    uint32      `gorm:"column:order_id; index; not null"`
+	OptionID   uint32      `gorm:"column:option_id; not null"`
+	Group      string      `gorm:"column:group_name; not null"`
+	Name       string      `gorm:"column:name; not null"`
+	PriceDelta money.Money `gorm:"embedded; embeddedPrefix:price_delta_"`
 }
 
 func InitOrderModels(database *gorm.DB) {
 	db = database
-	db.AutoMigrate(&Order{})
+	db.AutoMigrate(&Order{}, &OrderOption{})
+	if err := migrateMoneyColumns(); err != nil {
+		logger.WithField("error", err.Error()).Error("failed to move amounts to minor units")
+	}
 }
 
 func CreateOrder(order *Order) (uint32, error) {
@@ -34,13 +57,31 @@ func CreateOrder(order *Order) (uint32, error) {
 	return http.StatusCreated, nil
 }
 
+// DeleteOrder removes an order and its options for good. It is for an order that never went
+// through, so nothing is left behind to count as open or to be marked delivered.
+func DeleteOrder(order *Order) (uint32, error) {
+	if order == nil || order.ID == 0 {
+		return http.StatusBadRequest, errors.ErrInvalidOrder
+	}
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("order_id = ?", order.ID).Delete(&OrderOption{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(&Order{}, order.ID).Error
+	})
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return http.StatusOK, nil
+}
+
 func GetOrder(orderID uint32) (uint32, *Order, error) {
 	if orderID == 0 {
 		return http.StatusBadRequest, nil, errors.ErrEmptyField
 	}
 
 	order := &Order{}
-	err := db.Where("id = ?", orderID).First(&order).Error
+	err := db.Preload("Options").Where("id = ?", orderID).First(&order).Error
 
 	if err == gorm.ErrRecordNotFound {
 		return http.StatusNotFound, nil, err
@@ -56,9 +97,49 @@ func GetAllOrders(userID uint32) (uint32, []*Order, error) {
 		return http.StatusBadRequest, nil, errors.ErrEmptyField
 	}
 	orders := []*Order{}
-	err := db.Where("user_id = ?", userID).Find(&orders).Error;
+	err := db.Preload("Options").Where("user_id = ?", userID).Find(&orders).Error;
 	if err != nil && err != gorm.ErrRecordNotFound{
 		return http.StatusInternalServerError, nil, err
 	}
 	return http.StatusOK, orders, nil
 }
+
+// CountOpenOrders returns how many orders for the item have not been delivered yet.
+func CountOpenOrders(itemID uint32) (uint32, uint32, error) {
+	if itemID == 0 {
+		return http.StatusBadRequest, 0, errors.ErrEmptyField
+	}
+	var count int64
+	if err := db.Model(&Order{}).Where("item_id = ? AND status <> ?", itemID, OrderDelivered).Count(&count).Error; err != nil {
+		return http.StatusInternalServerError, 0, err
+	}
+	return http.StatusOK, uint32(count), nil
+}
+
+// MarkOrderDelivered records that an order has reached the customer.
+func MarkOrderDelivered(orderID uint32) (uint32, *Order, error) {
+	status, order, err := GetOrder(orderID)
+	if err != nil {
+		return status, nil, err
+	}
+	if order.Status == OrderDelivered {
+		return http.StatusConflict, nil, errors.ErrAlreadyDelivered
+	}
+	if err := db.Model(order).Update("status", OrderDelivered).Error; err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+	return http.StatusOK, order, nil
+}
+
+// HasDeliveredOrder reports whether the user has had an order for the item delivered.
+func HasDeliveredOrder(userID uint32, itemID uint32) (uint32, bool, error) {
+	if userID == 0 || itemID == 0 {
+		return http.StatusBadRequest, false, errors.ErrEmptyField
+	}
+	var count int64
+	err := db.Model(&Order{}).Where("user_id = ? AND item_id = ? AND status = ?", userID, itemID, OrderDelivered).Count(&count).Error
+	if err != nil {
+		return http.StatusInternalServerError, false, err
+	}
+	return http.StatusOK, count > 0, nil
+}
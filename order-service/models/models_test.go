@@ -2,6 +2,7 @@ package models
 
 import (
 	"net/http"
+	"order-service/money"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -53,7 +54,7 @@ func (suite *OrderModelTestSuite) TestOrderModel_CreateOrder() {
 					UserID:   1,
 					ItemID:   1,
 					Quantity: 1,
-					Amount:   1.0,
+					Amount:   money.Money{MinorUnits: 100, Currency: "EUR"},
 				},
 			},
 			wantErr:    false,
@@ -91,7 +92,7 @@ func (suite *OrderModelTestSuite) TestOrderModel_GetOrder() {
 			UserID:   1,
 			ItemID:   1,
 			Quantity: 1,
-			Amount:   1.0,
+			Amount:   money.Money{MinorUnits: 100, Currency: "EUR"},
 		}
 		suite.db.Create(order)
 
@@ -146,7 +147,7 @@ func (suite *OrderModelTestSuite) TestOrderModels_GetAllOrders() {
 			UserID:   1,
 			ItemID:   1,
 			Quantity: 1,
-			Amount:   1.0,
+			Amount:   money.Money{MinorUnits: 100, Currency: "EUR"},
 		}
 		suite.db.Create(order)
 
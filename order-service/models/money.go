@@ -0,0 +1,46 @@
+package models
+
+import (
+	"math"
+	"order-service/money"
+
+	"gorm.io/gorm"
+)
+
+// legacyMoneyColumns are the float columns amounts were kept in before they moved to minor
+// units, with the prefix of the columns that replaced them.
+var legacyMoneyColumns = []struct {
+	model  interface{}
+	column string
+	prefix string
+}{
+	{&Order{}, "amount", "amount_"},
+	{&OrderOption{}, "price_delta", "price_delta_"},
+}
+
+// migrateMoneyColumns moves amounts still held in a legacy float column into minor units of
+// the default currency, rounding to the nearest minor unit, and drops the float column.
+func migrateMoneyColumns() error {
+	currency := money.DefaultCurrency()
+	exponent, _ := money.Exponent(currency)
+	scale := math.Pow10(exponent)
+	for _, legacy := range legacyMoneyColumns {
+		if !db.Migrator().HasColumn(legacy.model, legacy.column) {
+			continue
+		}
+		err := db.Transaction(func(tx *gorm.DB) error {
+			err := tx.Session(&gorm.Session{AllowGlobalUpdate: true}).Unscoped().Model(legacy.model).UpdateColumns(map[string]interface{}{
+				legacy.prefix + "minor_units": gorm.Expr("CAST(ROUND("+legacy.column+" * ?) AS BIGINT)", scale),
+				legacy.prefix + "currency":    currency,
+			}).Error
+			if err != nil {
+				return err
+			}
+			return tx.Migrator().DropColumn(legacy.model, legacy.column)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
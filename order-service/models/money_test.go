@@ -0,0 +1,47 @@
+package models
+
+import (
+	"net/http"
+	"order-service/money"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// legacyOrder is the orders table as it was while amounts were kept as floats.
+type legacyOrder struct {
+	gorm.Model
+	UserID    uint32  `gorm:"column:user_id; not null"`
+	ItemID    uint32  `gorm:"column:item_id; not null"`
+	Quantity  uint32  `gorm:"column:quantity; not null"`
+	Amount    float32 `gorm:"column:amount; not null"`
+	OrderTime string  `gorm:"column:order_time; not null"`
+}
+
+func (legacyOrder) TableName() string {
+	return "orders"
+}
+
+func TestMigrateMoneyColumns(t *testing.T) {
+	database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	assert.NoError(t, err)
+	defer func() {
+		sql, _ := database.DB()
+		sql.Close()
+	}()
+
+	assert.NoError(t, database.AutoMigrate(&legacyOrder{}))
+	legacy := &legacyOrder{UserID: 1, ItemID: 1, Quantity: 3, Amount: 0.3, OrderTime: "2021-01-01 00:00:00"}
+	assert.NoError(t, database.Create(legacy).Error)
+
+	InitOrderModels(database)
+
+	assert.False(t, database.Migrator().HasColumn(&Order{}, "amount"))
+
+	status, order, err := GetOrder(uint32(legacy.ID))
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(http.StatusOK), status)
+	assert.Equal(t, money.Money{MinorUnits: 30, Currency: "EUR"}, order.Amount)
+}
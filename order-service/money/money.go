@@ -0,0 +1,80 @@
+package money
+
+import (
+	"order-service/errors"
+	"strings"
+)
+
+// Money is an exact amount: a whole number of the currency's minor units (cents for EUR,
+// yen for JPY) and the currency's ISO 4217 code. Stored in a model it is embedded with a
+// prefix, so an order's Amount is kept in amount_minor_units and amount_currency.
+type Money struct {
+	MinorUnits int64  `gorm:"column:minor_units; not null; default:0"`
+	Currency   string `gorm:"column:currency; not null; default:''"`
+}
+
+// exponents is the number of minor-unit digits of each supported currency.
+var exponents = map[string]int{
+	"AUD": 2,
+	"BHD": 3,
+	"CAD": 2,
+	"CHF": 2,
+	"CNY": 2,
+	"CZK": 2,
+	"DKK": 2,
+	"EUR": 2,
+	"GBP": 2,
+	"HUF": 2,
+	"INR": 2,
+	"JPY": 0,
+	"KRW": 0,
+	"KWD": 3,
+	"NOK": 2,
+	"NZD": 2,
+	"PLN": 2,
+	"SEK": 2,
+	"USD": 2,
+}
+
+var defaultCurrency = "EUR"
+
+// SetDefaultCurrency changes the currency orders placed before amounts carried one are taken
+// to be in.
+func SetDefaultCurrency(currency string) error {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if _, ok := exponents[currency]; !ok {
+		return errors.ErrInvalidCurrency
+	}
+	defaultCurrency = currency
+	return nil
+}
+
+func DefaultCurrency() string {
+	return defaultCurrency
+}
+
+// Exponent returns the number of minor-unit digits of the currency.
+func Exponent(currency string) (int, bool) {
+	exponent, ok := exponents[currency]
+	return exponent, ok
+}
+
+// Add returns the sum of two amounts in the same currency. The zero Money has no currency
+// and adds to an amount in any currency, so it can start a total.
+func (m Money) Add(other Money) (Money, error) {
+	if m == (Money{}) {
+		return other, nil
+	}
+	if other == (Money{}) {
+		return m, nil
+	}
+	if m.Currency != other.Currency {
+		return Money{}, errors.ErrCurrencyMismatch
+	}
+	return Money{MinorUnits: m.MinorUnits + other.MinorUnits, Currency: m.Currency}, nil
+}
+
+// Times returns the amount multiplied by a whole number, such as a quantity.
+func (m Money) Times(n int64) Money {
+	return Money{MinorUnits: m.MinorUnits * n, Currency: m.Currency}
+}
@@ -0,0 +1,34 @@
+package money
+
+import (
+	"order-service/errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdd(t *testing.T) {
+	total, err := Money{}.Add(Money{MinorUnits: 10, Currency: "EUR"})
+	assert.NoError(t, err)
+
+	// ten cents added thirty times is exactly three euros, which float32 never was
+	for i := 0; i < 29; i++ {
+		total, err = total.Add(Money{MinorUnits: 10, Currency: "EUR"})
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, Money{MinorUnits: 300, Currency: "EUR"}, total)
+	assert.Equal(t, total, Money{MinorUnits: 10, Currency: "EUR"}.Times(30))
+
+	_, err = total.Add(Money{MinorUnits: 10, Currency: "USD"})
+	assert.ErrorIs(t, err, errors.ErrCurrencyMismatch)
+}
+
+func TestSetDefaultCurrency(t *testing.T) {
+	defer SetDefaultCurrency("EUR")
+
+	assert.ErrorIs(t, SetDefaultCurrency("XXX"), errors.ErrInvalidCurrency)
+	assert.Equal(t, "EUR", DefaultCurrency())
+
+	assert.NoError(t, SetDefaultCurrency("usd"))
+	assert.Equal(t, "USD", DefaultCurrency())
+}
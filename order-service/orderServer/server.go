@@ -3,7 +3,10 @@ package orderServer
 import (
 	"context"
 
+	"order-service/models"
+	"order-service/money"
 	client "order-service/proto/inventorypb"
+	"order-service/proto/moneypb"
 	proto "order-service/proto/orderpb"
 	"order-service/service"
 )
@@ -21,8 +24,32 @@ func NewGRPCServer(Client client.InventoryServiceClient) *GRPCServer {
 	}
 }
 
+// toMoneyProto leaves out the zero Money, which stands for no amount at all.
+func toMoneyProto(amount money.Money) *moneypb.Money {
+	if amount == (money.Money{}) {
+		return nil
+	}
+	return &moneypb.Money{
+		MinorUnits: amount.MinorUnits,
+		Currency:   amount.Currency,
+	}
+}
+
+func toOrderOptions(order *models.Order) []*proto.OrderOption {
+	var options []*proto.OrderOption
+	for _, option := range order.Options {
+		options = append(options, &proto.OrderOption{
+			OptionId:   option.OptionID,
+			Group:      option.Group,
+			Name:       option.Name,
+			PriceDelta: toMoneyProto(option.PriceDelta),
+		})
+	}
+	return options
+}
+
 func (s *GRPCServer) PlaceOrder(ctx context.Context, req *proto.PlaceOrderRequest) (*proto.PlaceOrderResponse, error) {
-	statusCode, order, err := s.OrderService.PlaceOrder(req.UserId, req.ItemId, req.Quantity)
+	statusCode, order, err := s.OrderService.PlaceOrder(req.UserId, req.ItemId, req.Quantity, req.OptionIds)
 	if err != nil {
 		return &proto.PlaceOrderResponse{
 			StatusCode: statusCode,
@@ -37,7 +64,9 @@ func (s *GRPCServer) PlaceOrder(ctx context.Context, req *proto.PlaceOrderReques
 			UserId:   order.UserID,
 			ItemId:   order.ItemID,
 			Quantity: order.Quantity,
-			Amount:   order.Amount,
+			Amount:   toMoneyProto(order.Amount),
+			Options:  toOrderOptions(order),
+			Status:   order.Status,
 		},
 	}, nil
 }
@@ -58,7 +87,9 @@ func (s *GRPCServer) GetOrder(ctx context.Context, req *proto.GetOrderRequest) (
 			UserId:   order.UserID,
 			ItemId:   order.ItemID,
 			Quantity: order.Quantity,
-			Amount:   order.Amount,
+			Amount:   toMoneyProto(order.Amount),
+			Options:  toOrderOptions(order),
+			Status:   order.Status,
 		},
 	}, nil
 }
@@ -79,7 +110,9 @@ func (s *GRPCServer) GetAllOrders(ctx context.Context, req *proto.GetAllOrdersRe
 			UserId:   order.UserID,
 			ItemId:   order.ItemID,
 			Quantity: order.Quantity,
-			Amount:   order.Amount,
+			Amount:   toMoneyProto(order.Amount),
+			Options:  toOrderOptions(order),
+			Status:   order.Status,
 		})
 	}
 
@@ -88,3 +121,53 @@ func (s *GRPCServer) GetAllOrders(ctx context.Context, req *proto.GetAllOrdersRe
 		Orders:     ordersResponse,
 	}, nil
 }
+
+func (s *GRPCServer) CountOpenOrders(ctx context.Context, req *proto.CountOpenOrdersRequest) (*proto.CountOpenOrdersResponse, error) {
+	statusCode, count, err := s.OrderService.CountOpenOrders(req.ItemId)
+	if err != nil {
+		return &proto.CountOpenOrdersResponse{
+			StatusCode: statusCode,
+		}, err
+	}
+
+	return &proto.CountOpenOrdersResponse{
+		StatusCode: statusCode,
+		Count:      count,
+	}, nil
+}
+
+func (s *GRPCServer) MarkOrderDelivered(ctx context.Context, req *proto.MarkOrderDeliveredRequest) (*proto.MarkOrderDeliveredResponse, error) {
+	statusCode, order, err := s.OrderService.MarkOrderDelivered(req.OrderId)
+	if err != nil {
+		return &proto.MarkOrderDeliveredResponse{
+			StatusCode: statusCode,
+		}, err
+	}
+
+	return &proto.MarkOrderDeliveredResponse{
+		StatusCode: statusCode,
+		Order: &proto.Order{
+			OrderId:  order.ID,
+			UserId:   order.UserID,
+			ItemId:   order.ItemID,
+			Quantity: order.Quantity,
+			Amount:   toMoneyProto(order.Amount),
+			Options:  toOrderOptions(order),
+			Status:   order.Status,
+		},
+	}, nil
+}
+
+func (s *GRPCServer) HasDeliveredOrder(ctx context.Context, req *proto.HasDeliveredOrderRequest) (*proto.HasDeliveredOrderResponse, error) {
+	statusCode, delivered, err := s.OrderService.HasDeliveredOrder(req.UserId, req.ItemId)
+	if err != nil {
+		return &proto.HasDeliveredOrderResponse{
+			StatusCode: statusCode,
+		}, err
+	}
+
+	return &proto.HasDeliveredOrderResponse{
+		StatusCode: statusCode,
+		Delivered:  delivered,
+	}, nil
+}
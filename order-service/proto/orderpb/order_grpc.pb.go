@@ -19,9 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	OrderService_PlaceOrder_FullMethodName   = "/OrderService/PlaceOrder"
-	OrderService_GetOrder_FullMethodName     = "/OrderService/GetOrder"
-	OrderService_GetAllOrders_FullMethodName = "/OrderService/GetAllOrders"
+	OrderService_PlaceOrder_FullMethodName         = "/OrderService/PlaceOrder"
+	OrderService_GetOrder_FullMethodName           = "/OrderService/GetOrder"
+	OrderService_GetAllOrders_FullMethodName       = "/OrderService/GetAllOrders"
+	OrderService_CountOpenOrders_FullMethodName    = "/OrderService/CountOpenOrders"
+	OrderService_MarkOrderDelivered_FullMethodName = "/OrderService/MarkOrderDelivered"
+	OrderService_HasDeliveredOrder_FullMethodName  = "/OrderService/HasDeliveredOrder"
 )
 
 // OrderServiceClient is the client API for OrderService service.
@@ -31,6 +34,9 @@ type OrderServiceClient interface {
 	PlaceOrder(ctx context.Context, in *PlaceOrderRequest, opts ...grpc.CallOption) (*PlaceOrderResponse, error)
 	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*GetOrderResponse, error)
 	GetAllOrders(ctx context.Context, in *GetAllOrdersRequest, opts ...grpc.CallOption) (*GetAllOrdersResponse, error)
+	CountOpenOrders(ctx context.Context, in *CountOpenOrdersRequest, opts ...grpc.CallOption) (*CountOpenOrdersResponse, error)
+	MarkOrderDelivered(ctx context.Context, in *MarkOrderDeliveredRequest, opts ...grpc.CallOption) (*MarkOrderDeliveredResponse, error)
+	HasDeliveredOrder(ctx context.Context, in *HasDeliveredOrderRequest, opts ...grpc.CallOption) (*HasDeliveredOrderResponse, error)
 }
 
 type orderServiceClient struct {
@@ -68,6 +74,33 @@ func (c *orderServiceClient) GetAllOrders(ctx context.Context, in *GetAllOrdersR
 	return out, nil
 }
 
+func (c *orderServiceClient) CountOpenOrders(ctx context.Context, in *CountOpenOrdersRequest, opts ...grpc.CallOption) (*CountOpenOrdersResponse, error) {
+	out := new(CountOpenOrdersResponse)
+	err := c.cc.Invoke(ctx, OrderService_CountOpenOrders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) MarkOrderDelivered(ctx context.Context, in *MarkOrderDeliveredRequest, opts ...grpc.CallOption) (*MarkOrderDeliveredResponse, error) {
+	out := new(MarkOrderDeliveredResponse)
+	err := c.cc.Invoke(ctx, OrderService_MarkOrderDelivered_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) HasDeliveredOrder(ctx context.Context, in *HasDeliveredOrderRequest, opts ...grpc.CallOption) (*HasDeliveredOrderResponse, error) {
+	out := new(HasDeliveredOrderResponse)
+	err := c.cc.Invoke(ctx, OrderService_HasDeliveredOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServiceServer is the server API for OrderService service.
 // All implementations must embed UnimplementedOrderServiceServer
 // for forward compatibility
@@ -75,6 +108,9 @@ type OrderServiceServer interface {
 	PlaceOrder(context.Context, *PlaceOrderRequest) (*PlaceOrderResponse, error)
 	GetOrder(context.Context, *GetOrderRequest) (*GetOrderResponse, error)
 	GetAllOrders(context.Context, *GetAllOrdersRequest) (*GetAllOrdersResponse, error)
+	CountOpenOrders(context.Context, *CountOpenOrdersRequest) (*CountOpenOrdersResponse, error)
+	MarkOrderDelivered(context.Context, *MarkOrderDeliveredRequest) (*MarkOrderDeliveredResponse, error)
+	HasDeliveredOrder(context.Context, *HasDeliveredOrderRequest) (*HasDeliveredOrderResponse, error)
 	mustEmbedUnimplementedOrderServiceServer()
 }
 
@@ -91,6 +127,15 @@ func (UnimplementedOrderServiceServer) GetOrder(context.Context, *GetOrderReques
 func (UnimplementedOrderServiceServer) GetAllOrders(context.Context, *GetAllOrdersRequest) (*GetAllOrdersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllOrders not implemented")
 }
+func (UnimplementedOrderServiceServer) CountOpenOrders(context.Context, *CountOpenOrdersRequest) (*CountOpenOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountOpenOrders not implemented")
+}
+func (UnimplementedOrderServiceServer) MarkOrderDelivered(context.Context, *MarkOrderDeliveredRequest) (*MarkOrderDeliveredResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkOrderDelivered not implemented")
+}
+func (UnimplementedOrderServiceServer) HasDeliveredOrder(context.Context, *HasDeliveredOrderRequest) (*HasDeliveredOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HasDeliveredOrder not implemented")
+}
 func (UnimplementedOrderServiceServer) mustEmbedUnimplementedOrderServiceServer() {}
 
 // UnsafeOrderServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -158,6 +203,60 @@ func _OrderService_GetAllOrders_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_CountOpenOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountOpenOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CountOpenOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CountOpenOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CountOpenOrders(ctx, req.(*CountOpenOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_MarkOrderDelivered_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkOrderDeliveredRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).MarkOrderDelivered(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_MarkOrderDelivered_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).MarkOrderDelivered(ctx, req.(*MarkOrderDeliveredRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_HasDeliveredOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HasDeliveredOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).HasDeliveredOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_HasDeliveredOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).HasDeliveredOrder(ctx, req.(*HasDeliveredOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrderService_ServiceDesc is the grpc.ServiceDesc for OrderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -177,6 +276,18 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllOrders",
 			Handler:    _OrderService_GetAllOrders_Handler,
 		},
+		{
+			MethodName: "CountOpenOrders",
+			Handler:    _OrderService_CountOpenOrders_Handler,
+		},
+		{
+			MethodName: "MarkOrderDelivered",
+			Handler:    _OrderService_MarkOrderDelivered_Handler,
+		},
+		{
+			MethodName: "HasDeliveredOrder",
+			Handler:    _OrderService_HasDeliveredOrder_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/order.proto",
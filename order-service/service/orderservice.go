@@ -5,9 +5,16 @@ import (
 	"net/http"
 	"order-service/errors"
 	"order-service/models"
+	"order-service/money"
+	"strconv"
 	"time"
 	grpc "order-service/inventoryClient"
+
+	logger "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
 	proto "order-service/proto/inventorypb"
+	"order-service/proto/moneypb"
 )
 
 type OrderService struct {
@@ -20,7 +27,10 @@ func NewOrderService(Client proto.InventoryServiceClient) *OrderService {
 	}
 }
 
-func (service *OrderService) PlaceOrder(userID uint32, itemID uint32, quantity uint32) (uint32, *models.Order, error) {
+// PlaceOrder orders quantity of an item with the chosen options. The amount is worked out
+// here from the item's price and the price deltas of its options, never taken from the client,
+// and in whole minor units so that it is exact.
+func (service *OrderService) PlaceOrder(userID uint32, itemID uint32, quantity uint32, optionIDs []uint32) (uint32, *models.Order, error) {
 	if userID == 0 || itemID == 0 || quantity == 0 {
 		return http.StatusBadRequest, nil, errors.ErrEmptyField
 	}
@@ -34,16 +44,36 @@ func (service *OrderService) PlaceOrder(userID uint32, itemID uint32, quantity u
 		return itemResponse.StatusCode, nil, err
 	}
 
-	if itemResponse.Quantity < quantity {
+	if !itemResponse.AvailableNow {
+		return http.StatusUnprocessableEntity, nil, errors.ErrItemUnavailable
+	}
+
+	if itemResponse.AvailableToSell < quantity {
 		return http.StatusUnprocessableEntity, nil, errors.ErrLimitedSupplies
 	}
 
+	options, err := selectOptions(itemResponse.OptionGroups, optionIDs, quantity)
+	if err != nil {
+		return http.StatusUnprocessableEntity, nil, err
+	}
+	unitPrice := fromMoneyProto(itemResponse.Price)
+	for _, option := range options {
+		unitPrice, err = unitPrice.Add(option.PriceDelta)
+		if err != nil {
+			return http.StatusUnprocessableEntity, nil, err
+		}
+	}
+	if unitPrice.MinorUnits <= 0 {
+		return http.StatusUnprocessableEntity, nil, errors.ErrInvalidOptions
+	}
+
 	order := &models.Order{
 		UserID:    userID,
 		ItemID:    itemID,
 		Quantity:  quantity,
-		Amount:    float32(quantity) * float32(itemResponse.Price),
+		Amount:    unitPrice.Times(int64(quantity)),
 		OrderTime: time.Now().Format("2006-01-02 15:04:05"),
+		Options:   options,
 	}
 
 	status, err := models.CreateOrder(order)
@@ -51,14 +81,102 @@ func (service *OrderService) PlaceOrder(userID uint32, itemID uint32, quantity u
 		return status, nil, err
 	}
 
-	updateResponse, err := service.client.LowerQuantity(context.Background(), &proto.LowerQuantityRequest{Id: itemID, Quantity: quantity})
+	_, err = service.client.LowerQuantity(context.Background(), &proto.LowerQuantityRequest{
+		Id:        itemID,
+		Quantity:  quantity,
+		Reason:    proto.MovementReason_REASON_ORDER,
+		Reference: strconv.FormatUint(uint64(order.ID), 10),
+		Actor:     strconv.FormatUint(uint64(userID), 10),
+		OptionIds: optionIDs,
+	})
 	if err != nil {
-		return updateResponse.StatusCode, nil, err
+		// The stock was not taken, so the order must not stay behind as an open order.
+		if _, deleteErr := models.DeleteOrder(order); deleteErr != nil {
+			logger.WithFields(logger.Fields{"order": order.ID, "error": deleteErr.Error()}).Error("failed to delete an order whose stock was not taken")
+		}
+		return statusFromError(err), nil, err
 	}
 
 	return status, order, nil
 }
 
+// statusFromError works out the HTTP status for an error returned by an inventory service
+// call. The client returns no response along with an error, so the status comes from the
+// gRPC status of the error itself.
+func statusFromError(err error) uint32 {
+	grpcErr, _ := grpcStatus.FromError(err)
+	switch grpcErr.Code() {
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.FailedPrecondition, codes.ResourceExhausted:
+		return http.StatusUnprocessableEntity
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return http.StatusBadGateway
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// selectOptions checks the chosen option IDs against the item's option groups and returns
+// them as order options. Every required group must be chosen from, each group must stay
+// within its minimum and maximum selections, and options that track stock must have
+// enough of it for the order.
+func selectOptions(groups []*proto.OptionGroup, optionIDs []uint32, quantity uint32) ([]models.OrderOption, error) {
+	type choice struct {
+		group  *proto.OptionGroup
+		option *proto.Option
+	}
+	choices := map[uint32]choice{}
+	for _, group := range groups {
+		for _, option := range group.Options {
+			choices[option.Id] = choice{group: group, option: option}
+		}
+	}
+
+	selected := []models.OrderOption{}
+	counts := map[uint32]uint32{}
+	seen := map[uint32]bool{}
+	for _, id := range optionIDs {
+		chosen, ok := choices[id]
+		if !ok || seen[id] {
+			return nil, errors.ErrInvalidOptions
+		}
+		if chosen.option.TrackStock && chosen.option.Quantity < quantity {
+			return nil, errors.ErrLimitedSupplies
+		}
+		seen[id] = true
+		counts[chosen.group.Id]++
+		selected = append(selected, models.OrderOption{
+			OptionID:   id,
+			Group:      chosen.group.Name,
+			Name:       chosen.option.Name,
+			PriceDelta: fromMoneyProto(chosen.option.PriceDelta),
+		})
+	}
+
+	for _, group := range groups {
+		count := counts[group.Id]
+		if count == 0 && !group.Required {
+			continue
+		}
+		if count < group.MinSelections || count > group.MaxSelections {
+			return nil, errors.ErrInvalidOptions
+		}
+	}
+	return selected, nil
+}
+
+func fromMoneyProto(amount *moneypb.Money) money.Money {
+	if amount == nil {
+		return money.Money{}
+	}
+	return money.Money{MinorUnits: amount.MinorUnits, Currency: amount.Currency}
+}
+
 func (service *OrderService) GetOrder(orderID uint32) (uint32, *models.Order, error) {
 	if orderID == 0 {
 		return http.StatusBadRequest, nil, errors.ErrEmptyField
@@ -83,4 +201,33 @@ func (service *OrderService) GetAllOrders(userID uint32) (uint32, []*models.Orde
 	}
 
 	return http.StatusOK, orders, nil
-}
\ No newline at end of file
+}
+
+// CountOpenOrders returns how many open orders are for the item, which has to stay in the
+// inventory for as long as there are any.
+func (service *OrderService) CountOpenOrders(itemID uint32) (uint32, uint32, error) {
+	if itemID == 0 {
+		return http.StatusBadRequest, 0, errors.ErrEmptyField
+	}
+
+	return models.CountOpenOrders(itemID)
+}
+
+// MarkOrderDelivered records that an order has reached the customer, after which they can
+// review what they ordered.
+func (service *OrderService) MarkOrderDelivered(orderID uint32) (uint32, *models.Order, error) {
+	if orderID == 0 {
+		return http.StatusBadRequest, nil, errors.ErrEmptyField
+	}
+
+	return models.MarkOrderDelivered(orderID)
+}
+
+// HasDeliveredOrder reports whether the user has had an order for the item delivered.
+func (service *OrderService) HasDeliveredOrder(userID uint32, itemID uint32) (uint32, bool, error) {
+	if userID == 0 || itemID == 0 {
+		return http.StatusBadRequest, false, errors.ErrEmptyField
+	}
+
+	return models.HasDeliveredOrder(userID, itemID)
+}
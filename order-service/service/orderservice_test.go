@@ -4,13 +4,19 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	orderErrors "order-service/errors"
 	mocks "order-service/mocks/inventoryMocks"
 	"order-service/models"
+	"order-service/money"
 	proto "order-service/proto/inventorypb"
+	"order-service/proto/moneypb"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
@@ -52,15 +58,18 @@ func (suite *OrderServiceTestSuite) TestService_PlaceOrder() {
 	t.Run("PlaceOrder successfully", func(t *testing.T) {
 		//Arrange
 		itemID, userID := uint32(1), uint32(1)
-		quantity, amount := uint32(2), float32(20.0)
+		quantity, amount := uint32(2), money.Money{MinorUnits: 2000, Currency: "EUR"}
 
 		itemResponse := &proto.GetItemResponse{
-			StatusCode:  http.StatusOK,
-			Id:          itemID,
-			Name:        "item",
-			Description: "item description",
-			Price:       10,
-			Quantity:    12,
+			StatusCode:        http.StatusOK,
+			Id:                itemID,
+			Name:              "item",
+			Description:       "item description",
+			Price:             &moneypb.Money{MinorUnits: 1000, Currency: "EUR"},
+			Quantity:          12,
+			AvailableQuantity: 12,
+			AvailableToSell:   12,
+			AvailableNow:      true,
 		}
 
 		order := &models.Order{
@@ -75,16 +84,15 @@ func (suite *OrderServiceTestSuite) TestService_PlaceOrder() {
 		suite.service.client.(*mocks.InventoryServiceClient).On("GetItem", context.Background(), &proto.GetItemRequest{
 			Id: itemID}).Return(itemResponse, nil).Once()
 
-		suite.service.client.(*mocks.InventoryServiceClient).On("LowerQuantity", context.Background(), &proto.LowerQuantityRequest{
-			Id:       itemID,
-			Quantity: quantity,
-		}).Return(&proto.LowerQuantityResponse{
+		suite.service.client.(*mocks.InventoryServiceClient).On("LowerQuantity", context.Background(), mock.MatchedBy(func(req *proto.LowerQuantityRequest) bool {
+			return req.Id == itemID && req.Quantity == quantity && req.Reason == proto.MovementReason_REASON_ORDER
+		})).Return(&proto.LowerQuantityResponse{
 			StatusCode: http.StatusOK,
 			Id:         itemID,
 			Quantity:   itemResponse.Quantity - quantity,
 		}, nil).Once()
 
-		status, placedOrder, err := suite.service.PlaceOrder(userID, itemID, quantity)
+		status, placedOrder, err := suite.service.PlaceOrder(userID, itemID, quantity, nil)
 
 		//Assert
 		assert.NoError(t, err)
@@ -105,7 +113,7 @@ func (suite *OrderServiceTestSuite) TestService_PlaceOrder() {
 			Id:          0,
 			Name:        "",
 			Description: "",
-			Price:       0,
+			Price:       &moneypb.Money{MinorUnits: 0, Currency: "EUR"},
 			Quantity:    0,
 		}
 
@@ -113,7 +121,7 @@ func (suite *OrderServiceTestSuite) TestService_PlaceOrder() {
 		suite.service.client.(*mocks.InventoryServiceClient).On("GetItem", context.Background(), &proto.GetItemRequest{
 			Id: itemID}).Return(itemResponse, errors.New("mocked error")).Once()
 
-		status, placedOrder, err := suite.service.PlaceOrder(userID, itemID, quantity)
+		status, placedOrder, err := suite.service.PlaceOrder(userID, itemID, quantity, nil)
 
 		//Assert
 		assert.Error(t, err)
@@ -121,25 +129,57 @@ func (suite *OrderServiceTestSuite) TestService_PlaceOrder() {
 		assert.Nil(t, placedOrder)
 	})
 
+	t.Run("PlaceOrder failed due to item not available right now", func(t *testing.T) {
+		//Arrange
+		itemID, userID := uint32(1), uint32(1)
+		quantity := uint32(2)
+
+		itemResponse := &proto.GetItemResponse{
+			StatusCode:        http.StatusOK,
+			Id:                itemID,
+			Name:              "item",
+			Description:       "item description",
+			Price:             &moneypb.Money{MinorUnits: 1000, Currency: "EUR"},
+			Quantity:          12,
+			AvailableQuantity: 12,
+			AvailableToSell:   12,
+			AvailableNow:      false,
+		}
+
+		//Act
+		suite.service.client.(*mocks.InventoryServiceClient).On("GetItem", context.Background(), &proto.GetItemRequest{
+			Id: itemID}).Return(itemResponse, nil).Once()
+
+		status, placedOrder, err := suite.service.PlaceOrder(userID, itemID, quantity, nil)
+
+		//Assert
+		assert.Equal(t, orderErrors.ErrItemUnavailable, err)
+		assert.Equal(t, uint32(http.StatusUnprocessableEntity), status)
+		assert.Nil(t, placedOrder)
+	})
+
 	t.Run("PlaceOrder failed due to item quantity not enough", func(t *testing.T) {
 		//Arrange
 		itemID, userID := uint32(1), uint32(1)
 		quantity := uint32(2)
 
 		itemResponse := &proto.GetItemResponse{
-			StatusCode:  http.StatusOK,
-			Id:          itemID,
-			Name:        "item",
-			Description: "item description",
-			Price:       10,
-			Quantity:    1,
+			StatusCode:        http.StatusOK,
+			Id:                itemID,
+			Name:              "item",
+			Description:       "item description",
+			Price:             &moneypb.Money{MinorUnits: 1000, Currency: "EUR"},
+			Quantity:          1,
+			AvailableQuantity: 1,
+			AvailableToSell:   1,
+			AvailableNow:      true,
 		}
 
 		//Act
 		suite.service.client.(*mocks.InventoryServiceClient).On("GetItem", context.Background(), &proto.GetItemRequest{
 			Id: itemID}).Return(itemResponse, nil).Once()
 
-		status, placedOrder, err := suite.service.PlaceOrder(userID, itemID, quantity)
+		status, placedOrder, err := suite.service.PlaceOrder(userID, itemID, quantity, nil)
 
 		//Assert
 		assert.Error(t, err)
@@ -147,13 +187,42 @@ func (suite *OrderServiceTestSuite) TestService_PlaceOrder() {
 		assert.Nil(t, placedOrder)
 	})
 
+	t.Run("PlaceOrder failed due to the rest of the stock having expired", func(t *testing.T) {
+		//Arrange
+		itemID, userID := uint32(1), uint32(1)
+		quantity := uint32(2)
+
+		itemResponse := &proto.GetItemResponse{
+			StatusCode:        http.StatusOK,
+			Id:                itemID,
+			Name:              "item",
+			Description:       "item description",
+			Price:             &moneypb.Money{MinorUnits: 1000, Currency: "EUR"},
+			Quantity:          12,
+			AvailableQuantity: 1,
+			AvailableToSell:   1,
+			AvailableNow:      true,
+		}
+
+		//Act
+		suite.service.client.(*mocks.InventoryServiceClient).On("GetItem", context.Background(), &proto.GetItemRequest{
+			Id: itemID}).Return(itemResponse, nil).Once()
+
+		status, placedOrder, err := suite.service.PlaceOrder(userID, itemID, quantity, nil)
+
+		//Assert
+		assert.Equal(t, orderErrors.ErrLimitedSupplies, err)
+		assert.Equal(t, uint32(http.StatusUnprocessableEntity), status)
+		assert.Nil(t, placedOrder)
+	})
+
 	t.Run("PlaceOrder failed due to zero quantity", func(t *testing.T) {
 		//Arrange
 		itemID, userID := uint32(1), uint32(1)
 		quantity := uint32(0)
 
 		//Act
-		status, placedOrder, err := suite.service.PlaceOrder(userID, itemID, quantity)
+		status, placedOrder, err := suite.service.PlaceOrder(userID, itemID, quantity, nil)
 
 		//Assert
 		assert.Error(t, err)
@@ -167,7 +236,7 @@ func (suite *OrderServiceTestSuite) TestService_PlaceOrder() {
 		quantity := uint32(1)
 
 		//Act
-		status, placedOrder, err := suite.service.PlaceOrder(userID, itemID, quantity)
+		status, placedOrder, err := suite.service.PlaceOrder(userID, itemID, quantity, nil)
 
 		//Assert
 		assert.Error(t, err)
@@ -181,7 +250,7 @@ func (suite *OrderServiceTestSuite) TestService_PlaceOrder() {
 		quantity := uint32(1)
 
 		//Act
-		status, placedOrder, err := suite.service.PlaceOrder(userID, itemID, quantity)
+		status, placedOrder, err := suite.service.PlaceOrder(userID, itemID, quantity, nil)
 
 		//Assert
 		assert.Error(t, err)
@@ -191,37 +260,75 @@ func (suite *OrderServiceTestSuite) TestService_PlaceOrder() {
 
 	t.Run("PlaceOrder failed when LowerQuantity returned error", func(t *testing.T) {
 		//Arrange
-		itemID, userID := uint32(1), uint32(1)
+		itemID, userID := uint32(41), uint32(1)
 		quantity := uint32(2)
 
 		itemResponse := &proto.GetItemResponse{
-			StatusCode:  http.StatusOK,
-			Id:          itemID,
-			Name:        "item",
-			Description: "item description",
-			Price:       10,
-			Quantity:    12,
+			StatusCode:        http.StatusOK,
+			Id:                itemID,
+			Name:              "item",
+			Description:       "item description",
+			Price:             &moneypb.Money{MinorUnits: 1000, Currency: "EUR"},
+			Quantity:          12,
+			AvailableQuantity: 12,
+			AvailableToSell:   12,
+			AvailableNow:      true,
 		}
 
 		//Act
 		suite.service.client.(*mocks.InventoryServiceClient).On("GetItem", context.Background(), &proto.GetItemRequest{
 			Id: itemID}).Return(itemResponse, nil).Once()
 
-		suite.service.client.(*mocks.InventoryServiceClient).On("LowerQuantity", context.Background(), &proto.LowerQuantityRequest{
-			Id:       itemID,
-			Quantity: quantity,
-		}).Return(&proto.LowerQuantityResponse{
-			StatusCode: http.StatusInternalServerError,
-			Id:         0,
-			Quantity:   0,
-		}, errors.New("mocked error")).Once()
+		suite.service.client.(*mocks.InventoryServiceClient).On("LowerQuantity", context.Background(), mock.MatchedBy(func(req *proto.LowerQuantityRequest) bool {
+			return req.Id == itemID && req.Quantity == quantity && req.Reason == proto.MovementReason_REASON_ORDER
+		})).Return(nil, errors.New("mocked error")).Once()
 
-		status, placedOrder, err := suite.service.PlaceOrder(userID, itemID, quantity)
+		status, placedOrder, err := suite.service.PlaceOrder(userID, itemID, quantity, nil)
 
 		//Assert
 		assert.Error(t, err)
 		assert.Equal(t, uint32(http.StatusInternalServerError), status)
 		assert.Nil(t, placedOrder)
+
+		_, open, err := models.CountOpenOrders(itemID)
+		assert.NoError(t, err)
+		assert.Zero(t, open)
+	})
+
+	t.Run("PlaceOrder failed when the inventory service was unavailable", func(t *testing.T) {
+		//Arrange
+		itemID, userID := uint32(42), uint32(1)
+		quantity := uint32(1)
+
+		itemResponse := &proto.GetItemResponse{
+			StatusCode:        http.StatusOK,
+			Id:                itemID,
+			Name:              "item",
+			Price:             &moneypb.Money{MinorUnits: 1000, Currency: "EUR"},
+			Quantity:          12,
+			AvailableQuantity: 12,
+			AvailableToSell:   12,
+			AvailableNow:      true,
+		}
+
+		//Act
+		suite.service.client.(*mocks.InventoryServiceClient).On("GetItem", context.Background(), &proto.GetItemRequest{
+			Id: itemID}).Return(itemResponse, nil).Once()
+
+		suite.service.client.(*mocks.InventoryServiceClient).On("LowerQuantity", context.Background(), mock.MatchedBy(func(req *proto.LowerQuantityRequest) bool {
+			return req.Id == itemID
+		})).Return(nil, grpcStatus.Error(codes.Unavailable, "connection refused")).Once()
+
+		status, placedOrder, err := suite.service.PlaceOrder(userID, itemID, quantity, nil)
+
+		//Assert
+		assert.Error(t, err)
+		assert.Equal(t, uint32(http.StatusBadGateway), status)
+		assert.Nil(t, placedOrder)
+
+		_, open, err := models.CountOpenOrders(itemID)
+		assert.NoError(t, err)
+		assert.Zero(t, open)
 	})
 }
 
@@ -234,28 +341,30 @@ func (suite *OrderServiceTestSuite) TestGetOrder() {
 		quantity := uint32(4)
 
 		itemResponse := &proto.GetItemResponse{
-			StatusCode:  http.StatusOK,
-			Id:          itemID,
-			Name:        "item",
-			Description: "item description",
-			Price:       10,
-			Quantity:    12,
+			StatusCode:        http.StatusOK,
+			Id:                itemID,
+			Name:              "item",
+			Description:       "item description",
+			Price:             &moneypb.Money{MinorUnits: 1000, Currency: "EUR"},
+			Quantity:          12,
+			AvailableQuantity: 12,
+			AvailableToSell:   12,
+			AvailableNow:      true,
 		}
 
 		//Act
 		suite.service.client.(*mocks.InventoryServiceClient).On("GetItem", context.Background(), &proto.GetItemRequest{
 			Id: itemID}).Return(itemResponse, nil).Once()
 
-		suite.service.client.(*mocks.InventoryServiceClient).On("LowerQuantity", context.Background(), &proto.LowerQuantityRequest{
-			Id:       itemID,
-			Quantity: quantity,
-		}).Return(&proto.LowerQuantityResponse{
+		suite.service.client.(*mocks.InventoryServiceClient).On("LowerQuantity", context.Background(), mock.MatchedBy(func(req *proto.LowerQuantityRequest) bool {
+			return req.Id == itemID && req.Quantity == quantity && req.Reason == proto.MovementReason_REASON_ORDER
+		})).Return(&proto.LowerQuantityResponse{
 			StatusCode: http.StatusOK,
 			Id:         itemID,
 			Quantity:   itemResponse.Quantity - quantity,
 		}, nil).Once()
 
-		_, order, _ := suite.service.PlaceOrder(userID, itemID, quantity)
+		_, order, _ := suite.service.PlaceOrder(userID, itemID, quantity, nil)
 
 		//Act
 		status, getOrder, err := suite.service.GetOrder(order.ID)
@@ -294,28 +403,30 @@ func (suite *OrderServiceTestSuite) TestOrderService_GetAllOrders() {
 		quantity := uint32(4)
 
 		itemResponse := &proto.GetItemResponse{
-			StatusCode:  http.StatusOK,
-			Id:          itemID,
-			Name:        "item",
-			Description: "item description",
-			Price:       10,
-			Quantity:    12,
+			StatusCode:        http.StatusOK,
+			Id:                itemID,
+			Name:              "item",
+			Description:       "item description",
+			Price:             &moneypb.Money{MinorUnits: 1000, Currency: "EUR"},
+			Quantity:          12,
+			AvailableQuantity: 12,
+			AvailableToSell:   12,
+			AvailableNow:      true,
 		}
 
 		//Act
 		suite.service.client.(*mocks.InventoryServiceClient).On("GetItem", context.Background(), &proto.GetItemRequest{
 			Id: itemID}).Return(itemResponse, nil).Once()
 
-		suite.service.client.(*mocks.InventoryServiceClient).On("LowerQuantity", context.Background(), &proto.LowerQuantityRequest{
-			Id:       itemID,
-			Quantity: quantity,
-		}).Return(&proto.LowerQuantityResponse{
+		suite.service.client.(*mocks.InventoryServiceClient).On("LowerQuantity", context.Background(), mock.MatchedBy(func(req *proto.LowerQuantityRequest) bool {
+			return req.Id == itemID && req.Quantity == quantity && req.Reason == proto.MovementReason_REASON_ORDER
+		})).Return(&proto.LowerQuantityResponse{
 			StatusCode: http.StatusOK,
 			Id:         itemID,
 			Quantity:   itemResponse.Quantity - quantity,
 		}, nil).Once()
 
-		_, order, _ := suite.service.PlaceOrder(userID, itemID, quantity)
+		_, order, _ := suite.service.PlaceOrder(userID, itemID, quantity, nil)
 
 		//Act
 		status, getAllOrders, err := suite.service.GetAllOrders(userID)
@@ -329,4 +440,233 @@ func (suite *OrderServiceTestSuite) TestOrderService_GetAllOrders() {
 		assert.Equal(t, order.UserID, getAllOrders[1].UserID)
 		assert.EqualValues(t, order.ID, getAllOrders[1].ID)
 	})
-}
\ No newline at end of file
+}
+func (suite *OrderServiceTestSuite) TestService_PlaceOrderWithOptions() {
+	t := suite.T()
+
+	itemID, userID := uint32(2), uint32(2)
+	itemResponse := &proto.GetItemResponse{
+		StatusCode:        http.StatusOK,
+		Id:                itemID,
+		Name:              "pizza",
+		Description:       "margherita",
+		Price:             &moneypb.Money{MinorUnits: 30000, Currency: "EUR"},
+		Quantity:          12,
+		AvailableQuantity: 12,
+		AvailableToSell:   12,
+		AvailableNow:      true,
+		OptionGroups: []*proto.OptionGroup{
+			{
+				Id:            1,
+				ItemId:        itemID,
+				Name:          "size",
+				SelectionType: proto.SelectionType_SELECTION_SINGLE,
+				MinSelections: 1,
+				MaxSelections: 1,
+				Required:      true,
+				Options: []*proto.Option{
+					{Id: 1, Name: "regular"},
+					{Id: 2, Name: "large", PriceDelta: &moneypb.Money{MinorUnits: 12000, Currency: "EUR"}},
+				},
+			},
+			{
+				Id:            2,
+				ItemId:        itemID,
+				Name:          "toppings",
+				SelectionType: proto.SelectionType_SELECTION_MULTI,
+				MaxSelections: 2,
+				Options: []*proto.Option{
+					{Id: 3, Name: "extra cheese", PriceDelta: &moneypb.Money{MinorUnits: 4000, Currency: "EUR"}, TrackStock: true, Quantity: 1},
+					{Id: 4, Name: "olives", PriceDelta: &moneypb.Money{MinorUnits: 3000, Currency: "EUR"}},
+				},
+			},
+		},
+	}
+
+	t.Run("PlaceOrder prices the selected options", func(t *testing.T) {
+		//Arrange
+		quantity := uint32(1)
+
+		suite.service.client.(*mocks.InventoryServiceClient).On("GetItem", context.Background(), &proto.GetItemRequest{
+			Id: itemID}).Return(itemResponse, nil).Once()
+
+		suite.service.client.(*mocks.InventoryServiceClient).On("LowerQuantity", context.Background(), mock.MatchedBy(func(req *proto.LowerQuantityRequest) bool {
+			return req.Id == itemID && req.Quantity == quantity && len(req.OptionIds) == 2
+		})).Return(&proto.LowerQuantityResponse{
+			StatusCode: http.StatusOK,
+			Id:         itemID,
+			Quantity:   itemResponse.Quantity - quantity,
+		}, nil).Once()
+
+		//Act
+		status, order, err := suite.service.PlaceOrder(userID, itemID, quantity, []uint32{2, 3})
+
+		//Assert
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(http.StatusCreated), status)
+		assert.Equal(t, money.Money{MinorUnits: 46000, Currency: "EUR"}, order.Amount)
+		assert.Equal(t, 2, len(order.Options))
+		assert.Equal(t, "size", order.Options[0].Group)
+		assert.Equal(t, "large", order.Options[0].Name)
+
+		_, stored, err := suite.service.GetOrder(order.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(stored.Options))
+		assert.Equal(t, money.Money{MinorUnits: 4000, Currency: "EUR"}, stored.Options[1].PriceDelta)
+	})
+
+	t.Run("PlaceOrder failed due to invalid selections", func(t *testing.T) {
+		invalid := [][]uint32{
+			nil,
+			{1, 2},
+			{1, 1},
+			{1, 99},
+		}
+		for _, optionIDs := range invalid {
+			//Arrange
+			suite.service.client.(*mocks.InventoryServiceClient).On("GetItem", context.Background(), &proto.GetItemRequest{
+				Id: itemID}).Return(itemResponse, nil).Once()
+
+			//Act
+			status, order, err := suite.service.PlaceOrder(userID, itemID, 1, optionIDs)
+
+			//Assert
+			assert.Error(t, err)
+			assert.Equal(t, uint32(http.StatusUnprocessableEntity), status)
+			assert.Nil(t, order)
+		}
+	})
+
+	t.Run("PlaceOrder failed due to option stock not enough", func(t *testing.T) {
+		//Arrange
+		suite.service.client.(*mocks.InventoryServiceClient).On("GetItem", context.Background(), &proto.GetItemRequest{
+			Id: itemID}).Return(itemResponse, nil).Once()
+
+		//Act
+		status, order, err := suite.service.PlaceOrder(userID, itemID, 2, []uint32{1, 3})
+
+		//Assert
+		assert.Error(t, err)
+		assert.Equal(t, uint32(http.StatusUnprocessableEntity), status)
+		assert.Nil(t, order)
+	})
+}
+
+func (suite *OrderServiceTestSuite) TestOrderService_CountOpenOrders() {
+	t := suite.T()
+
+	t.Run("Count every order for the item", func(t *testing.T) {
+		//Arrange
+		itemID := uint32(77)
+		for _, userID := range []uint32{901, 902} {
+			_, err := models.CreateOrder(&models.Order{UserID: userID, ItemID: itemID, Quantity: 1, Amount: money.Money{MinorUnits: 500, Currency: "EUR"}})
+			assert.NoError(t, err)
+		}
+
+		//Act
+		status, count, err := suite.service.CountOpenOrders(itemID)
+
+		//Assert
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.Equal(t, uint32(2), count)
+	})
+
+	t.Run("No orders for an item never ordered", func(t *testing.T) {
+		//Act
+		status, count, err := suite.service.CountOpenOrders(78)
+
+		//Assert
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.Equal(t, uint32(0), count)
+	})
+
+	t.Run("Leave out orders that have been delivered", func(t *testing.T) {
+		//Arrange
+		order := &models.Order{UserID: 903, ItemID: 79, Quantity: 1, Amount: money.Money{MinorUnits: 500, Currency: "EUR"}}
+		_, err := models.CreateOrder(order)
+		assert.NoError(t, err)
+		_, _, err = suite.service.MarkOrderDelivered(order.ID)
+		assert.NoError(t, err)
+
+		//Act
+		status, count, err := suite.service.CountOpenOrders(79)
+
+		//Assert
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.Equal(t, uint32(0), count)
+	})
+
+	t.Run("CountOpenOrders with no item id", func(t *testing.T) {
+		//Act
+		status, _, err := suite.service.CountOpenOrders(0)
+
+		//Assert
+		assert.Equal(t, orderErrors.ErrEmptyField, err)
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+	})
+}
+
+func (suite *OrderServiceTestSuite) TestOrderService_MarkOrderDelivered() {
+	t := suite.T()
+
+	order := &models.Order{UserID: 904, ItemID: 80, Quantity: 1, Amount: money.Money{MinorUnits: 500, Currency: "EUR"}}
+	_, err := models.CreateOrder(order)
+	assert.NoError(t, err)
+
+	t.Run("Nothing has been delivered to the user yet", func(t *testing.T) {
+		//Act
+		status, delivered, err := suite.service.HasDeliveredOrder(904, 80)
+
+		//Assert
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.False(t, delivered)
+	})
+
+	t.Run("MarkOrderDelivered successfully", func(t *testing.T) {
+		//Act
+		status, delivered, err := suite.service.MarkOrderDelivered(order.ID)
+
+		//Assert
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(http.StatusOK), status)
+		assert.Equal(t, models.OrderDelivered, delivered.Status)
+
+		_, hasDelivered, err := suite.service.HasDeliveredOrder(904, 80)
+		assert.NoError(t, err)
+		assert.True(t, hasDelivered)
+		_, hasDelivered, err = suite.service.HasDeliveredOrder(905, 80)
+		assert.NoError(t, err)
+		assert.False(t, hasDelivered)
+	})
+
+	t.Run("MarkOrderDelivered twice", func(t *testing.T) {
+		//Act
+		status, _, err := suite.service.MarkOrderDelivered(order.ID)
+
+		//Assert
+		assert.Equal(t, orderErrors.ErrAlreadyDelivered, err)
+		assert.Equal(t, uint32(http.StatusConflict), status)
+	})
+
+	t.Run("MarkOrderDelivered for an order that does not exist", func(t *testing.T) {
+		//Act
+		status, _, err := suite.service.MarkOrderDelivered(99999)
+
+		//Assert
+		assert.Error(t, err)
+		assert.Equal(t, uint32(http.StatusNotFound), status)
+	})
+
+	t.Run("HasDeliveredOrder with no user id", func(t *testing.T) {
+		//Act
+		status, _, err := suite.service.HasDeliveredOrder(0, 80)
+
+		//Assert
+		assert.Equal(t, orderErrors.ErrEmptyField, err)
+		assert.Equal(t, uint32(http.StatusBadRequest), status)
+	})
+}